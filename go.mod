@@ -5,6 +5,7 @@ require (
 	cloud.google.com/go/storage v1.15.0 // indirect
 	github.com/Azure/azure-sdk-for-go v54.2.1+incompatible
 	github.com/Azure/go-autorest/autorest v0.11.18
+	github.com/Azure/go-autorest/autorest/adal v0.9.13
 	github.com/Azure/go-autorest/autorest/date v0.3.0
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-cidr v1.1.0 // indirect
@@ -33,10 +34,14 @@ require (
 	github.com/sethvargo/go-password v0.2.0
 	github.com/ulikunitz/xz v0.5.10 // indirect
 	github.com/zclconf/go-cty v1.8.3 // indirect
-	golang.org/x/crypto v0.0.0-20210513164829-c07d793c2f9a // indirect
+	golang.org/x/crypto v0.0.0-20210513164829-c07d793c2f9a
 	golang.org/x/net v0.0.0-20210510120150-4163338589ed // indirect
+	golang.org/x/oauth2 v0.0.0-20210514164344-f6687ab2804c
 	google.golang.org/api v0.47.0 // indirect
 	google.golang.org/genproto v0.0.0-20210518161634-ec7691c0a37d // indirect
 )
 
 go 1.16
+
+// TODO: remove when hamilton allows the HTTP client of msgraph.Client to be configured, see third_party/hamilton/PATCHES.md
+replace github.com/manicminer/hamilton => ./third_party/hamilton
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"regexp"
 	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -14,6 +16,15 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
+// runSuffixRegex matches suffixes which can safely be used in user principal names and mail nicknames
+var runSuffixRegex = regexp.MustCompile(`^[a-z0-9]{1,8}$`)
+
+var (
+	runSuffix     string
+	runSuffixErr  error
+	runSuffixOnce sync.Once
+)
+
 type TestData struct {
 	// RandomInteger is a random integer which unique to this test case
 	RandomInteger int
@@ -24,6 +35,10 @@ type TestData struct {
 	// RandomID is a random UUID unique to this test case
 	RandomID string
 
+	// RunSuffix is a random 6 character lowercase alphanumeric string which is shared by all test cases in this run,
+	// and which can be specified with the ARM_TEST_RUN_SUFFIX environment variable
+	RunSuffix string
+
 	// RandomPassword is a random password unique to this test case
 	// This is not securely generated and only suitable for ephemeral test cases
 	RandomPassword string
@@ -63,10 +78,16 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		t.Fatalf("retrieving Environment: %+v", err)
 	}
 
+	suffix, err := RunSuffix()
+	if err != nil {
+		t.Fatalf("determining run suffix: %+v", err)
+	}
+
 	testData := TestData{
 		RandomInteger:   tf.AccRandTimeInt(),
 		RandomString:    acctest.RandString(5),
 		RandomID:        uuid.New().String(),
+		RunSuffix:       suffix,
 		RandomPassword:  fmt.Sprintf("%s%s", "p@$$Wd", acctest.RandString(6)),
 		ResourceName:    fmt.Sprintf("%s.%s", resourceType, resourceLabel),
 		Environment:     *env,
@@ -80,6 +101,49 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 	return testData
 }
 
+// RunSuffix returns a suffix which is shared by all test cases in this run, so that user principal names and mail
+// nicknames do not collide with those of other runs using the same tenant. The suffix can be specified with the
+// ARM_TEST_RUN_SUFFIX environment variable, otherwise a random suffix is generated.
+func RunSuffix() (string, error) {
+	runSuffixOnce.Do(func() {
+		runSuffix, runSuffixErr = parseRunSuffix(os.Getenv("ARM_TEST_RUN_SUFFIX"))
+	})
+	return runSuffix, runSuffixErr
+}
+
+func parseRunSuffix(v string) (string, error) {
+	if v == "" {
+		return acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum), nil
+	}
+	if !runSuffixRegex.MatchString(v) {
+		return "", fmt.Errorf("ARM_TEST_RUN_SUFFIX must consist of 1 to 8 lowercase letters or digits, got %q", v)
+	}
+	return v, nil
+}
+
+// DefaultDomainTemplate returns a data source for the tenant's default domain, which must be included in configurations
+// using UserPrincipalName
+func (td TestData) DefaultDomainTemplate() string {
+	return `
+data "azuread_domains" "acctest_default" {
+  only_default = true
+}
+`
+}
+
+// UserPrincipalName returns a user principal name in the tenant's default domain, beginning with name and ending with
+// the random integer for this test case and the run suffix, e.g. `acctestUser.1234.abc123@example.com`. The domain
+// name is interpolated from the data source returned by DefaultDomainTemplate.
+func (td TestData) UserPrincipalName(name string) string {
+	return fmt.Sprintf("%s.%d.%s@${data.azuread_domains.acctest_default.domains.0.domain_name}", name, td.RandomInteger, td.RunSuffix)
+}
+
+// MailNickname returns a mail nickname beginning with name and ending with the random integer for this test case and
+// the run suffix, e.g. `acctestUser-1234-abc123`
+func (td TestData) MailNickname(name string) string {
+	return fmt.Sprintf("%s-%d-%s", name, td.RandomInteger, td.RunSuffix)
+}
+
 // RandomIntOfLength is a random 8 to 18 digit integer which is unique to this test case
 func (td *TestData) RandomIntOfLength(len int) int {
 	// len should not be
@@ -0,0 +1,48 @@
+package acceptance
+
+import (
+	"testing"
+)
+
+func TestParseRunSuffix(t *testing.T) {
+	cases := []struct {
+		input string
+		valid bool
+	}{
+		{input: "ci42", valid: true},
+		{input: "abcdefgh", valid: true},
+		{input: "abcdefghi", valid: false},
+		{input: "CI42", valid: false},
+		{input: "ci-42", valid: false},
+		{input: "ci.42", valid: false},
+	}
+
+	for _, c := range cases {
+		suffix, err := parseRunSuffix(c.input)
+		if c.valid && (err != nil || suffix != c.input) {
+			t.Fatalf("expected suffix %q to be accepted, got %q: %v", c.input, suffix, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("expected suffix %q to be rejected", c.input)
+		}
+	}
+
+	suffix, err := parseRunSuffix("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !runSuffixRegex.MatchString(suffix) || len(suffix) != 6 {
+		t.Fatalf("expected a random 6 character suffix, got %q", suffix)
+	}
+}
+
+func TestTestData_uniqueNames(t *testing.T) {
+	td := TestData{RandomInteger: 1234, RunSuffix: "abc123"}
+
+	if expected, v := "acctestUser.1234.abc123@${data.azuread_domains.acctest_default.domains.0.domain_name}", td.UserPrincipalName("acctestUser"); v != expected {
+		t.Fatalf("expected user principal name %q, got %q", expected, v)
+	}
+	if expected, v := "acctestUser-1234-abc123", td.MailNickname("acctestUser"); v != expected {
+		t.Fatalf("expected mail nickname %q, got %q", expected, v)
+	}
+}
@@ -0,0 +1,67 @@
+package fakegraph
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+	"golang.org/x/oauth2"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+// Authorizer is an auth.Authorizer issuing unsigned access tokens for the caller, which contain the claims needed by
+// the provider. The fake API does not validate access tokens.
+type Authorizer struct{}
+
+var _ auth.Authorizer = Authorizer{}
+
+func (Authorizer) Token() (*oauth2.Token, error) {
+	claims, err := json.Marshal(auth.Claims{
+		Audience: "https://graph.microsoft.com",
+		ObjectId: CallerObjectID,
+		AppId:    CallerClientID,
+		IdType:   "app",
+		Roles:    []string{"Application.ReadWrite.All", "Directory.ReadWrite.All", "Group.ReadWrite.All", "User.ReadWrite.All"},
+		TenantId: TenantID,
+		Version:  "1.0",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: fmt.Sprintf("%s.%s.", base64.RawStdEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)), base64.RawStdEncoding.EncodeToString(claims)),
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	}, nil
+}
+
+// Environment returns a copy of the global environment, in which the Microsoft Graph endpoint is the fake API
+func (s *Server) Environment() environments.Environment {
+	env := environments.Global
+	env.MsGraph.Endpoint = environments.ApiEndpoint(s.URL())
+	return env
+}
+
+// Client returns a base client for the specified API version, which is configured to use the fake API
+func (s *Server) Client(version msgraph.ApiVersion) msgraph.Client {
+	client := msgraph.NewClient(version, TenantID)
+	client.Endpoint = environments.ApiEndpoint(s.URL())
+	client.Authorizer = Authorizer{}
+	return client
+}
+
+// ClientOptions returns options for configuring the provider's service clients to use the fake API
+func (s *Server) ClientOptions() *common.ClientOptions {
+	return &common.ClientOptions{
+		Environment:       s.Environment(),
+		TenantID:          TenantID,
+		TerraformVersion:  "0.15.0",
+		MsGraphAuthorizer: Authorizer{},
+	}
+}
@@ -0,0 +1,678 @@
+// Package fakegraph provides an in-memory fake of the subset of the Microsoft Graph API used by the provider, so that
+// client and resource logic can be unit tested without a live tenant.
+package fakegraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	// TenantID is the tenant ID served by the fake API
+	TenantID = "00000000-0000-0000-0000-0000000000aa"
+
+	// CallerObjectID is the object ID of the service principal which the fake access token is issued to
+	CallerObjectID = "00000000-0000-0000-0000-0000000000bb"
+
+	// CallerClientID is the application ID of the service principal which the fake access token is issued to
+	CallerClientID = "00000000-0000-0000-0000-0000000000cc"
+)
+
+// collectionTypes maps the supported collections to the type of directory object they contain
+var collectionTypes = map[string]string{
+	"applications":      "application",
+	"groups":            "group",
+	"servicePrincipals": "servicePrincipal",
+	"users":             "user",
+}
+
+var (
+	filterEqRegex         = regexp.MustCompile(`^(\w+) eq '(.*)'$`)
+	filterStartsWithRegex = regexp.MustCompile(`^startswith\((\w+),\s*'(.*)'\)$`)
+)
+
+// entry is a directory object held by the fake API, along with the objects it references via navigation properties
+type entry struct {
+	collection string
+	object     map[string]interface{}
+	refs       map[string][]string
+}
+
+// Server is a fake Microsoft Graph API backed by an httptest.Server. Applications, groups, service principals and
+// users can be created, retrieved, listed, updated and deleted, and deleted objects can be retrieved, restored and
+// permanently deleted. Owners and members can be managed using references, and passwords can be added to and removed
+// from applications and service principals. Requests for anything else receive a 501 Not Implemented response, so
+// that tests exercising unsupported endpoints fail clearly. Both the v1.0 and beta APIs are served from the same data.
+type Server struct {
+	t      testing.TB
+	server *httptest.Server
+
+	mutex    sync.Mutex
+	objects  map[string]*entry
+	deleted  map[string]*entry
+	order    []string
+	requests []string
+}
+
+// NewServer starts a new fake Microsoft Graph API, which is stopped when the test completes. The server contains a
+// service principal for the caller, having the object ID CallerObjectID.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		t:       t,
+		objects: make(map[string]*entry),
+		deleted: make(map[string]*entry),
+	}
+	s.server = httptest.NewServer(s)
+	t.Cleanup(s.server.Close)
+
+	s.Seed("servicePrincipals", map[string]interface{}{
+		"id":          CallerObjectID,
+		"appId":       CallerClientID,
+		"displayName": "Terraform",
+	})
+
+	return s
+}
+
+// URL returns the base URL of the fake API
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Seed adds an object to the specified collection, e.g. `users`, without going through the API, and returns its object
+// ID. The object is given an ID and any other generated properties when they are not specified.
+func (s *Server) Seed(collection string, object map[string]interface{}) string {
+	s.t.Helper()
+
+	if _, ok := collectionTypes[collection]; !ok {
+		s.t.Fatalf("fakegraph: unsupported collection %q", collection)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	e, err := s.create(collection, copyObject(object))
+	if err != nil {
+		s.t.Fatalf("fakegraph: seeding %s: %v", collection, err)
+	}
+	return e.object["id"].(string)
+}
+
+// Object returns a copy of the object with the specified ID, or nil when no such object exists
+func (s *Server) Object(id string) map[string]interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if e, ok := s.objects[id]; ok {
+		return copyObject(e.object)
+	}
+	return nil
+}
+
+// DeletedObject returns a copy of the deleted object with the specified ID, or nil when no such deleted object exists
+func (s *Server) DeletedObject(id string) map[string]interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if e, ok := s.deleted[id]; ok {
+		return copyObject(e.object)
+	}
+	return nil
+}
+
+// Refs returns the object IDs referenced by the specified navigation property, e.g. `owners`, of the object with the
+// specified ID
+func (s *Server) Refs(id, property string) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if e, ok := s.objects[id]; ok {
+		return append([]string{}, e.refs[property]...)
+	}
+	return nil
+}
+
+// Requests returns the method and path, excluding the API version and tenant ID, of each request received so far,
+// e.g. `POST /applications`
+func (s *Server) Requests() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) == 0 || (segments[0] != "v1.0" && segments[0] != "beta") {
+		writeError(w, http.StatusNotFound, "Request_BadRequest", fmt.Sprintf("Unsupported API version in path %q", r.URL.Path))
+		return
+	}
+	segments = segments[1:]
+	if len(segments) > 0 && segments[0] == TenantID {
+		segments = segments[1:]
+	}
+	if len(segments) == 0 {
+		writeError(w, http.StatusNotFound, "Request_BadRequest", "No resource was specified")
+		return
+	}
+
+	s.requests = append(s.requests, fmt.Sprintf("%s /%s", r.Method, strings.Join(segments, "/")))
+
+	switch {
+	case len(segments) > 2 && segments[0] == "directory" && segments[1] == "deletedItems":
+		s.serveDeletedItems(w, r, segments[2:])
+	case len(segments) == 2 && segments[0] == "directoryObjects" && r.Method == http.MethodGet:
+		e, ok := s.objects[segments[1]]
+		if !ok {
+			writeNotFound(w, segments[1])
+			return
+		}
+		writeJSON(w, http.StatusOK, selectProperties(e.object, r))
+	case collectionTypes[segments[0]] != "":
+		s.serveCollection(w, r, segments[0], segments[1:])
+	default:
+		writeNotImplemented(w, r)
+	}
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, collection string, segments []string) {
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.serveList(w, r, collection)
+		case http.MethodPost:
+			body, err := readObject(r)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+				return
+			}
+			e, err := s.create(collection, body)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+				return
+			}
+			writeJSON(w, http.StatusCreated, e.object)
+		default:
+			writeNotImplemented(w, r)
+		}
+		return
+	}
+
+	id := segments[0]
+	e, ok := s.objects[id]
+	if !ok || e.collection != collection {
+		writeNotFound(w, id)
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, selectProperties(e.object, r))
+
+	case len(segments) == 1 && r.Method == http.MethodPatch:
+		body, err := readObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+			return
+		}
+		if err := s.update(e, body); err != nil {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		s.delete(id)
+		w.WriteHeader(http.StatusNoContent)
+
+	case len(segments) == 2 && r.Method == http.MethodGet:
+		s.serveNavigation(w, r, id, segments[1], "")
+
+	case len(segments) == 3 && strings.HasPrefix(segments[2], "microsoft.graph.") && r.Method == http.MethodGet:
+		s.serveNavigation(w, r, id, segments[1], segments[2])
+
+	case len(segments) == 2 && r.Method == http.MethodPost:
+		s.serveAction(w, r, e, segments[1])
+
+	case len(segments) == 3 && segments[2] == "$ref" && r.Method == http.MethodPost:
+		body, err := readObject(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+			return
+		}
+		ref, _ := body["@odata.id"].(string)
+		if err := s.addRefs(e, segments[1], []string{ref}); err != nil {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case len(segments) == 4 && segments[3] == "$ref" && (r.Method == http.MethodGet || r.Method == http.MethodDelete):
+		refs := e.refs[segments[1]]
+		for i, ref := range refs {
+			if ref != segments[2] {
+				continue
+			}
+			if r.Method == http.MethodGet {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"@odata.type": s.objects[ref].object["@odata.type"],
+					"id":          ref,
+					"url":         fmt.Sprintf("%s/v1.0/directoryObjects/%s", s.URL(), ref),
+				})
+				return
+			}
+			e.refs[segments[1]] = append(refs[:i:i], refs[i+1:]...)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeNotFound(w, segments[2])
+
+	default:
+		writeNotImplemented(w, r)
+	}
+}
+
+func (s *Server) serveList(w http.ResponseWriter, r *http.Request, collection string) {
+	match, err := parseFilter(r.URL.Query().Get("$filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Request_UnsupportedQuery", err.Error())
+		return
+	}
+
+	result := make([]map[string]interface{}, 0)
+	for _, id := range s.order {
+		if e, ok := s.objects[id]; ok && e.collection == collection && match(e.object) {
+			result = append(result, selectProperties(e.object, r))
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"value": result})
+}
+
+// serveNavigation lists the objects referenced by a navigation property, optionally limited to those of the type
+// specified by cast, e.g. `microsoft.graph.group`
+func (s *Server) serveNavigation(w http.ResponseWriter, r *http.Request, id, property, cast string) {
+	var ids []string
+	switch property {
+	case "memberOf":
+		for _, groupId := range s.order {
+			if g, ok := s.objects[groupId]; ok && g.collection == "groups" && contains(g.refs["members"], id) {
+				ids = append(ids, groupId)
+			}
+		}
+	case "members", "owners":
+		ids = s.objects[id].refs[property]
+	default:
+		writeNotImplemented(w, r)
+		return
+	}
+
+	result := make([]map[string]interface{}, 0)
+	for _, ref := range ids {
+		if e, ok := s.objects[ref]; ok && (cast == "" || e.object["@odata.type"] == "#"+cast) {
+			result = append(result, selectProperties(e.object, r))
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"value": result})
+}
+
+func (s *Server) serveAction(w http.ResponseWriter, r *http.Request, e *entry, action string) {
+	body, err := readObject(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Request_BadRequest", err.Error())
+		return
+	}
+
+	switch {
+	case action == "addPassword" && (e.collection == "applications" || e.collection == "servicePrincipals"):
+		// the API accepts the credential wrapped in a passwordCredential property, or unwrapped
+		credential := body
+		if v, ok := body["passwordCredential"].(map[string]interface{}); ok {
+			credential = v
+		}
+		writeJSON(w, http.StatusOK, addPassword(e, credential))
+
+	case action == "removePassword" && (e.collection == "applications" || e.collection == "servicePrincipals"):
+		keyId, _ := body["keyId"].(string)
+		if !removePassword(e, keyId) {
+			writeError(w, http.StatusBadRequest, "Request_BadRequest", fmt.Sprintf("No password credential found with keyId %q", keyId))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case action == "revokeSignInSessions" && e.collection == "users":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"value": true})
+
+	default:
+		writeNotImplemented(w, r)
+	}
+}
+
+func (s *Server) serveDeletedItems(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 1 && strings.HasPrefix(segments[0], "microsoft.graph.") {
+		if r.Method != http.MethodGet {
+			writeNotImplemented(w, r)
+			return
+		}
+		objectType := strings.TrimPrefix(segments[0], "microsoft.graph.")
+		result := make([]map[string]interface{}, 0)
+		for _, id := range s.order {
+			if e, ok := s.deleted[id]; ok && collectionTypes[e.collection] == objectType {
+				result = append(result, selectProperties(e.object, r))
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"value": result})
+		return
+	}
+
+	id := segments[0]
+	e, ok := s.deleted[id]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, selectProperties(e.object, r))
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		delete(s.deleted, id)
+		w.WriteHeader(http.StatusNoContent)
+	case len(segments) == 2 && segments[1] == "restore" && r.Method == http.MethodPost:
+		delete(s.deleted, id)
+		delete(e.object, "deletedDateTime")
+		s.objects[id] = e
+		writeJSON(w, http.StatusOK, e.object)
+	default:
+		writeNotImplemented(w, r)
+	}
+}
+
+// create adds a new object to the specified collection, populating the properties which are generated by the API
+func (s *Server) create(collection string, object map[string]interface{}) (*entry, error) {
+	e := &entry{
+		collection: collection,
+		object:     make(map[string]interface{}),
+		refs:       make(map[string][]string),
+	}
+
+	id, _ := object["id"].(string)
+	if id == "" {
+		id = newUUID()
+	}
+	if _, exists := s.objects[id]; exists {
+		return nil, fmt.Errorf("an object with ID %q already exists", id)
+	}
+
+	switch collection {
+	case "applications":
+		if v, _ := object["appId"].(string); v == "" {
+			object["appId"] = newUUID()
+		}
+	case "servicePrincipals":
+		appId, _ := object["appId"].(string)
+		if appId == "" {
+			return nil, fmt.Errorf("appId must be specified when creating a service principal")
+		}
+		for _, other := range s.objects {
+			if other.collection == collection && other.object["appId"] == appId {
+				return nil, fmt.Errorf("a service principal already exists for the application with appId %q", appId)
+			}
+			if other.collection == "applications" && other.object["appId"] == appId && object["displayName"] == nil {
+				object["displayName"] = other.object["displayName"]
+			}
+		}
+	}
+
+	if err := s.update(e, object); err != nil {
+		return nil, err
+	}
+
+	e.object["@odata.type"] = fmt.Sprintf("#microsoft.graph.%s", collectionTypes[collection])
+	e.object["id"] = id
+	if _, ok := e.object["createdDateTime"]; !ok {
+		e.object["createdDateTime"] = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	s.objects[id] = e
+	s.order = append(s.order, id)
+
+	return e, nil
+}
+
+// update merges the specified properties into an object, adding any references bound with `@odata.bind`
+func (s *Server) update(e *entry, properties map[string]interface{}) error {
+	for k, v := range properties {
+		if property := strings.TrimSuffix(k, "@odata.bind"); property != k {
+			refs, ok := v.([]interface{})
+			if !ok {
+				return fmt.Errorf("%s must be an array of references", k)
+			}
+			values := make([]string, 0, len(refs))
+			for _, ref := range refs {
+				value, _ := ref.(string)
+				values = append(values, value)
+			}
+			if err := s.addRefs(e, property, values); err != nil {
+				return err
+			}
+			continue
+		}
+		if k == "id" || k == "@odata.type" {
+			continue
+		}
+		e.object[k] = v
+	}
+	return nil
+}
+
+// addRefs adds references, which are URLs ending in an object ID, to the specified navigation property of an object
+func (s *Server) addRefs(e *entry, property string, refs []string) error {
+	if property != "members" && property != "owners" {
+		return fmt.Errorf("unsupported navigation property %q", property)
+	}
+
+	for _, ref := range refs {
+		id := ref[strings.LastIndex(ref, "/")+1:]
+		if _, ok := s.objects[id]; !ok {
+			return fmt.Errorf("referenced object %q does not exist", id)
+		}
+		if contains(e.refs[property], id) {
+			return fmt.Errorf("One or more added object references already exist for the following modified properties: '%s'.", property)
+		}
+		e.refs[property] = append(e.refs[property], id)
+	}
+	return nil
+}
+
+// delete moves an object to the deleted items container, removing any references to it from other objects
+func (s *Server) delete(id string) {
+	e := s.objects[id]
+	delete(s.objects, id)
+
+	e.object["deletedDateTime"] = time.Now().UTC().Format(time.RFC3339)
+	s.deleted[id] = e
+
+	for _, other := range s.objects {
+		for property, refs := range other.refs {
+			for i, ref := range refs {
+				if ref == id {
+					other.refs[property] = append(refs[:i:i], refs[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+// addPassword adds a password credential to an object, returning the new credential including its secret text
+func addPassword(e *entry, credential map[string]interface{}) map[string]interface{} {
+	secret := strings.ReplaceAll(newUUID(), "-", "")
+	now := time.Now().UTC()
+
+	result := copyObject(credential)
+	result["keyId"] = newUUID()
+	result["hint"] = secret[:3]
+	if v, _ := result["startDateTime"].(string); v == "" {
+		result["startDateTime"] = now.Format(time.RFC3339)
+	}
+	if v, _ := result["endDateTime"].(string); v == "" {
+		result["endDateTime"] = now.AddDate(2, 0, 0).Format(time.RFC3339)
+	}
+
+	credentials, _ := e.object["passwordCredentials"].([]interface{})
+	e.object["passwordCredentials"] = append(credentials, copyObject(result))
+
+	result["secretText"] = secret
+	return result
+}
+
+// removePassword removes the password credential having the specified key ID from an object, returning false when no
+// such credential exists
+func removePassword(e *entry, keyId string) bool {
+	credentials, _ := e.object["passwordCredentials"].([]interface{})
+	for i, c := range credentials {
+		if credential, ok := c.(map[string]interface{}); ok && credential["keyId"] == keyId {
+			e.object["passwordCredentials"] = append(credentials[:i:i], credentials[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// parseFilter returns a function matching the objects satisfied by an OData filter. Only `eq` and `startswith`
+// comparisons of string properties are supported, optionally combined with `and`.
+func parseFilter(filter string) (func(map[string]interface{}) bool, error) {
+	type condition struct {
+		property, value string
+		prefix          bool
+	}
+
+	conditions := make([]condition, 0)
+	if filter = strings.TrimSpace(filter); filter != "" {
+		for _, term := range regexp.MustCompile(`(?i)\s+and\s+`).Split(filter, -1) {
+			if m := filterEqRegex.FindStringSubmatch(term); m != nil {
+				conditions = append(conditions, condition{property: m[1], value: strings.ReplaceAll(m[2], "''", "'")})
+			} else if m := filterStartsWithRegex.FindStringSubmatch(term); m != nil {
+				conditions = append(conditions, condition{property: m[1], value: strings.ReplaceAll(m[2], "''", "'"), prefix: true})
+			} else {
+				return nil, fmt.Errorf("Unsupported query: %q", term)
+			}
+		}
+	}
+
+	return func(object map[string]interface{}) bool {
+		for _, c := range conditions {
+			v, _ := object[c.property].(string)
+			if c.prefix && !strings.HasPrefix(strings.ToLower(v), strings.ToLower(c.value)) {
+				return false
+			}
+			if !c.prefix && !strings.EqualFold(v, c.value) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// selectProperties returns a copy of an object, limited to the properties specified by any `$select` query parameter
+func selectProperties(object map[string]interface{}, r *http.Request) map[string]interface{} {
+	sel := r.URL.Query().Get("$select")
+	if sel == "" {
+		return copyObject(object)
+	}
+
+	result := map[string]interface{}{
+		"@odata.type": object["@odata.type"],
+		"id":          object["id"],
+	}
+	for _, property := range strings.Split(sel, ",") {
+		if v, ok := object[strings.TrimSpace(property)]; ok {
+			result[strings.TrimSpace(property)] = v
+		}
+	}
+	return result
+}
+
+// copyObject returns a deep copy of an object by round-tripping it through JSON
+func copyObject(object map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if object == nil {
+		return result
+	}
+	b, err := json.Marshal(object)
+	if err != nil {
+		panic(fmt.Sprintf("fakegraph: copying object: %v", err))
+	}
+	if err := json.Unmarshal(b, &result); err != nil {
+		panic(fmt.Sprintf("fakegraph: copying object: %v", err))
+	}
+	return result
+}
+
+func readObject(r *http.Request) (map[string]interface{}, error) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %v", err)
+	}
+	object := make(map[string]interface{})
+	if len(b) == 0 {
+		return object, nil
+	}
+	if err := json.Unmarshal(b, &object); err != nil {
+		return nil, fmt.Errorf("parsing request body: %v", err)
+	}
+	return object, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	writeError(w, http.StatusNotFound, "Request_ResourceNotFound", fmt.Sprintf("Resource '%s' does not exist or one of its queried reference-property objects are not present.", id))
+}
+
+func writeNotImplemented(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("fakegraph does not implement %s %s", r.Method, r.URL.Path))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func newUUID() string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		panic(fmt.Sprintf("fakegraph: generating UUID: %v", err))
+	}
+	return id
+}
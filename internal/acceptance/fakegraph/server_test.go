@@ -0,0 +1,145 @@
+package fakegraph_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/fakegraph"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestServer_applications(t *testing.T) {
+	ctx := context.Background()
+	server := fakegraph.NewServer(t)
+
+	client := msgraph.NewApplicationsClient(fakegraph.TenantID)
+	client.BaseClient = server.Client(msgraph.Version10)
+
+	app, _, err := client.Create(ctx, msgraph.Application{DisplayName: utils.String("acctest-example")})
+	if err != nil {
+		t.Fatalf("creating application: %v", err)
+	}
+	if app.ID == nil || app.AppId == nil {
+		t.Fatalf("expected object ID and application ID to be generated, got %v and %v", app.ID, app.AppId)
+	}
+
+	apps, _, err := client.List(ctx, "startswith(displayName,'ACCTEST-')")
+	if err != nil {
+		t.Fatalf("listing applications: %v", err)
+	}
+	if apps == nil || len(*apps) != 1 || *(*apps)[0].ID != *app.ID {
+		t.Fatalf("expected filtered list to contain the application, got %v", apps)
+	}
+
+	if _, err := client.Update(ctx, msgraph.Application{ID: app.ID, DisplayName: utils.String("acctest-renamed")}); err != nil {
+		t.Fatalf("updating application: %v", err)
+	}
+	app, _, err = client.Get(ctx, *app.ID)
+	if err != nil {
+		t.Fatalf("retrieving application: %v", err)
+	}
+	if *app.DisplayName != "acctest-renamed" {
+		t.Fatalf("expected display name %q, got %q", "acctest-renamed", *app.DisplayName)
+	}
+
+	password, _, err := client.AddPassword(ctx, *app.ID, msgraph.PasswordCredential{DisplayName: utils.String("secret")})
+	if err != nil {
+		t.Fatalf("adding password: %v", err)
+	}
+	if password.KeyId == nil || password.SecretText == nil || password.Hint == nil || (*password.SecretText)[:3] != *password.Hint {
+		t.Fatalf("expected key ID, secret text and matching hint, got %+v", password)
+	}
+	if credentials := server.Object(*app.ID)["passwordCredentials"].([]interface{}); len(credentials) != 1 || credentials[0].(map[string]interface{})["secretText"] != nil {
+		t.Fatalf("expected a single stored credential without secret text, got %v", credentials)
+	}
+	if _, err := client.RemovePassword(ctx, *app.ID, *password.KeyId); err != nil {
+		t.Fatalf("removing password: %v", err)
+	}
+
+	if _, err := client.Delete(ctx, *app.ID); err != nil {
+		t.Fatalf("deleting application: %v", err)
+	}
+	if _, status, err := client.Get(ctx, *app.ID); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected status 404 for deleted application, got %d: %v", status, err)
+	}
+	if server.DeletedObject(*app.ID) == nil {
+		t.Fatalf("expected application to be in deleted items")
+	}
+
+	if _, _, err := helpers.DeletedItemGet(ctx, client.BaseClient, *app.ID); err != nil {
+		t.Fatalf("retrieving deleted application: %v", err)
+	}
+	if _, err := helpers.DeletedItemRestore(ctx, client.BaseClient, *app.ID); err != nil {
+		t.Fatalf("restoring application: %v", err)
+	}
+	if server.Object(*app.ID) == nil {
+		t.Fatalf("expected application to be restored")
+	}
+}
+
+func TestServer_groupMembers(t *testing.T) {
+	ctx := context.Background()
+	server := fakegraph.NewServer(t)
+	userId := server.Seed("users", map[string]interface{}{"displayName": "User", "userPrincipalName": "user@example.com"})
+
+	client := msgraph.NewGroupsClient(fakegraph.TenantID)
+	client.BaseClient = server.Client(msgraph.Version10)
+
+	group := msgraph.Group{
+		DisplayName:     utils.String("acctest-group"),
+		MailEnabled:     utils.Bool(false),
+		MailNickname:    utils.String("acctest-group"),
+		SecurityEnabled: utils.Bool(true),
+	}
+	group.AppendOwner(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, fakegraph.CallerObjectID)
+	created, _, err := client.Create(ctx, group)
+	if err != nil {
+		t.Fatalf("creating group: %v", err)
+	}
+	if owners := server.Refs(*created.ID, "owners"); len(owners) != 1 || owners[0] != fakegraph.CallerObjectID {
+		t.Fatalf("expected caller to be an owner, got %v", owners)
+	}
+
+	created.Members = nil
+	created.AppendMember(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, userId)
+	if _, err := client.AddMembers(ctx, created); err != nil {
+		t.Fatalf("adding member: %v", err)
+	}
+	// adding an existing member is tolerated by the client
+	if _, err := client.AddMembers(ctx, created); err != nil {
+		t.Fatalf("adding existing member: %v", err)
+	}
+
+	members, _, err := client.ListMembers(ctx, *created.ID)
+	if err != nil {
+		t.Fatalf("listing members: %v", err)
+	}
+	if members == nil || len(*members) != 1 || (*members)[0] != userId {
+		t.Fatalf("expected user to be a member, got %v", members)
+	}
+
+	if _, err := client.RemoveMembers(ctx, *created.ID, &[]string{userId}); err != nil {
+		t.Fatalf("removing member: %v", err)
+	}
+	if members := server.Refs(*created.ID, "members"); len(members) != 0 {
+		t.Fatalf("expected no members, got %v", members)
+	}
+}
+
+func TestServer_notImplemented(t *testing.T) {
+	server := fakegraph.NewServer(t)
+
+	resp, err := http.Get(server.URL() + "/v1.0/" + fakegraph.TenantID + "/domains")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected status 501, got %d", resp.StatusCode)
+	}
+}
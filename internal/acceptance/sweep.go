@@ -0,0 +1,175 @@
+package acceptance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+const (
+	// SweepPrefix is the prefix of the display names of objects created by acceptance tests
+	SweepPrefix = "acctest"
+
+	// SweepTimeout limits how long each sweeper is allowed to run
+	SweepTimeout = 60 * time.Minute
+
+	sweepDefaultAgeHours = 24
+)
+
+// sweepObject describes a directory object which is a candidate for sweeping
+type sweepObject struct {
+	ID              *string    `json:"id"`
+	DisplayName     *string    `json:"displayName"`
+	CreatedDateTime *time.Time `json:"createdDateTime"`
+}
+
+// SweepClient returns a client for use by sweepers, which is configured from the same environment variables as the
+// acceptance tests. Sweepers always use Microsoft Graph.
+func SweepClient(ctx context.Context) (*clients.Client, error) {
+	EnsureProvidersAreInitialised()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"use_microsoft_graph": true,
+	})
+	if diags := AzureADProvider.Configure(ctx, config); diags.HasError() {
+		for _, d := range diags {
+			if d.Detail != "" {
+				return nil, fmt.Errorf("configuring provider: %s: %s", d.Summary, d.Detail)
+			}
+		}
+		return nil, fmt.Errorf("configuring provider: %s", diags[0].Summary)
+	}
+
+	return AzureADProvider.Meta().(*clients.Client), nil
+}
+
+// SweepCutoff returns the time before which objects must have been created (or deleted) in order to be swept. The
+// minimum age of objects can be specified in hours with the ARM_SWEEP_AGE_HOURS environment variable, and defaults to
+// 24 hours, so that objects belonging to tests which are still running are left alone.
+func SweepCutoff() (time.Time, error) {
+	hours := sweepDefaultAgeHours
+	if v := os.Getenv("ARM_SWEEP_AGE_HOURS"); v != "" {
+		var err error
+		if hours, err = strconv.Atoi(v); err != nil || hours < 0 {
+			return time.Time{}, fmt.Errorf("ARM_SWEEP_AGE_HOURS must be a non-negative number of hours, got %q", v)
+		}
+	}
+	return time.Now().Add(-time.Duration(hours) * time.Hour), nil
+}
+
+// Sweep deletes the objects in the specified collection, e.g. `/applications`, of the specified type, which must be
+// one of `application`, `group` or `user`, having display names beginning with SweepPrefix and which were created before
+// cutoff. Deleted objects are then permanently deleted, along with any such objects which were deleted before cutoff
+// but not purged.
+func Sweep(ctx context.Context, client msgraph.Client, collection, objectType string, cutoff time.Time) error {
+	objects, err := sweepList(ctx, client, collection, cutoff)
+	if err != nil {
+		return fmt.Errorf("listing %s objects: %v", objectType, err)
+	}
+
+	for _, o := range objects {
+		log.Printf("[DEBUG] Deleting %s %q (object ID %q)", objectType, *o.DisplayName, *o.ID)
+		_, status, _, err := client.Delete(ctx, msgraph.DeleteHttpRequestInput{
+			ValidStatusCodes: []int{http.StatusNoContent},
+			Uri: msgraph.Uri{
+				Entity:      fmt.Sprintf("%s/%s", collection, *o.ID),
+				HasTenantId: true,
+			},
+		})
+		if err != nil {
+			if status == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("deleting %s with object ID %q: %v", objectType, *o.ID, err)
+		}
+
+		// The deleted object may not appear in the deleted items container straight away
+		if _, err := helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+			return helpers.DeletedItemGet(ctx, client, *o.ID)
+		}); err != nil {
+			return fmt.Errorf("waiting for deleted %s with object ID %q: %v", objectType, *o.ID, err)
+		}
+		if status, err := helpers.DeletedItemPermanentlyDelete(ctx, client, *o.ID); err != nil && status != http.StatusNotFound {
+			return fmt.Errorf("purging deleted %s with object ID %q: %v", objectType, *o.ID, err)
+		}
+	}
+
+	items, _, err := helpers.DeletedItemsList(ctx, client, objectType)
+	if err != nil {
+		return fmt.Errorf("listing deleted %s objects: %v", objectType, err)
+	}
+
+	for _, item := range items {
+		if item.ID == nil || item.DisplayName == nil || !strings.HasPrefix(strings.ToLower(*item.DisplayName), SweepPrefix) {
+			continue
+		}
+		if item.DeletedDateTime == nil || item.DeletedDateTime.After(cutoff) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Purging deleted %s %q (object ID %q)", objectType, *item.DisplayName, *item.ID)
+		if status, err := helpers.DeletedItemPermanentlyDelete(ctx, client, *item.ID); err != nil && status != http.StatusNotFound {
+			return fmt.Errorf("purging deleted %s with object ID %q: %v", objectType, *item.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// sweepList returns the objects in the specified collection having display names beginning with SweepPrefix, which
+// were created before cutoff. All pages of results are retrieved.
+func sweepList(ctx context.Context, client msgraph.Client, collection string, cutoff time.Time) ([]sweepObject, error) {
+	resp, _, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: collection,
+			Params: url.Values{
+				"$filter": []string{fmt.Sprintf("startswith(displayName,'%s')", SweepPrefix)},
+				"$select": []string{"id,displayName,createdDateTime"},
+			},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Value []sweepObject `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	objects := make([]sweepObject, 0)
+	for _, o := range data.Value {
+		if o.ID == nil || o.DisplayName == nil || !strings.HasPrefix(strings.ToLower(*o.DisplayName), SweepPrefix) {
+			continue
+		}
+		if o.CreatedDateTime == nil || o.CreatedDateTime.After(cutoff) {
+			continue
+		}
+		objects = append(objects, o)
+	}
+
+	return objects, nil
+}
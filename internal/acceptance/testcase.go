@@ -35,6 +35,17 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 	td.runAcceptanceTest(t, testCase)
 }
 
+// ResourceTestIgnoreCheckDestroy runs the test steps without checking that the resource was destroyed,
+// for resources which manage settings that always exist and are only reset upon destroy
+func (td TestData) ResourceTestIgnoreCheckDestroy(t *testing.T, testResource types.TestResource, steps []resource.TestStep) {
+	testCase := resource.TestCase{
+		PreCheck: func() { PreCheck(t) },
+		Steps:    steps,
+	}
+
+	td.runAcceptanceTest(t, testCase)
+}
+
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ProviderFactories = map[string]func() (*schema.Provider, error){
 		"azuread": func() (*schema.Provider, error) {
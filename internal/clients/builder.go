@@ -3,20 +3,28 @@ package clients
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/authentication"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/manicminer/hamilton/auth"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type ClientBuilder struct {
-	AuthConfig       *auth.Config
-	AadAuthConfig    *authentication.Config
-	EnableMsGraph    bool
-	PartnerID        string
-	TerraformVersion string
+	AuthConfig           *auth.Config
+	AadAuthConfig        *authentication.Config
+	ClientCertificate    *ClientCertificate
+	CustomCaCertificates string
+	EnableMsGraph        bool
+	Features             Features
+	MaxRetries           int
+	PartnerID            string
+	TerraformVersion     string
 }
 
 // Build is a helper method which returns a fully instantiated *Client based on the auth Config's current settings.
@@ -26,23 +34,13 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 		return nil, err
 	}
 
-	objectID := ""
-	// TODO remove this when we confirm that MSI no longer returns nil with getAuthenticatedObjectID
-	if getAuthenticatedObjectID := b.AadAuthConfig.GetAuthenticatedObjectID; getAuthenticatedObjectID != nil {
-		v, err := getAuthenticatedObjectID(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("getting authenticated object ID: %v", err)
-		}
-		objectID = v
-	}
-
 	// client declarations:
 	client := Client{
 		TenantID: b.AadAuthConfig.TenantID, // TODO: v2.0 use AuthConfig
 		ClientID: b.AadAuthConfig.ClientID, // TODO: v2.0 use AuthConfig
-		ObjectID: objectID,                 // TODO: remove in v2.0, use client.Claims.ObjectId instead
 
 		TerraformVersion: b.TerraformVersion,
+		Features:         b.Features,
 
 		AuthenticatedAsAServicePrincipal: b.AadAuthConfig.AuthenticatedAsAServicePrincipal,
 	}
@@ -51,7 +49,15 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 		client.Environment = b.AuthConfig.Environment
 	}
 
-	sender := sender.BuildSender("AzureAD") // TODO: remove in v2.0
+	// Proxies and custom CA certificates are configured on a transport belonging to this provider instance, which is
+	// used for all requests including token requests, since other provider instances in the same process may be
+	// configured differently
+	transport, err := common.NewTransport(b.CustomCaCertificates)
+	if err != nil {
+		return nil, fmt.Errorf("configuring custom CA certificates: %v", err)
+	}
+
+	sender := common.NewAadGraphSender(transport) // TODO: remove in v2.0
 
 	// TODO: remove in v2.0
 	oauth, err := b.AadAuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -62,20 +68,45 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 	// AAD Graph Endpoints
 	// TODO: remove in v2.0
 	aadGraphEndpoint := env.GraphEndpoint
-	aadGraphAuthorizer, err := b.AadAuthConfig.GetAuthorizationToken(sender, oauth, aadGraphEndpoint)
+	var aadGraphAuthorizer autorest.Authorizer
+	if b.ClientCertificate != nil {
+		aadGraphAuthorizer, err = b.ClientCertificate.aadGraphAuthorizer(sender, oauth, b.AadAuthConfig.ClientID, aadGraphEndpoint)
+	} else {
+		aadGraphAuthorizer, err = b.AadAuthConfig.GetAuthorizationToken(sender, oauth, aadGraphEndpoint)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	// The object ID of a service principal is looked up here rather than by go-azure-helpers, which would not use the
+	// transport of this provider instance
+	// TODO: remove in v2.0, use client.Claims.ObjectId instead
+	if client.AuthenticatedAsAServicePrincipal {
+		client.ObjectID, err = servicePrincipalObjectID(ctx, sender, aadGraphAuthorizer, aadGraphEndpoint, client.TenantID, client.ClientID)
+		if err != nil {
+			return nil, fmt.Errorf("getting authenticated object ID: %v", err)
+		}
+	} else if getAuthenticatedObjectID := b.AadAuthConfig.GetAuthenticatedObjectID; getAuthenticatedObjectID != nil {
+		// TODO remove this when we confirm that MSI no longer returns nil with getAuthenticatedObjectID
+		client.ObjectID, err = getAuthenticatedObjectID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting authenticated object ID: %v", err)
+		}
+	}
+
 	o := &common.ClientOptions{
 		Environment: client.Environment,
 		TenantID:    client.TenantID,
 
+		MaxRetries:       b.MaxRetries,
 		PartnerID:        b.PartnerID,
 		TerraformVersion: client.TerraformVersion,
 
+		BetaApiServices: b.Features.BetaApiServices,
+
 		AadGraphAuthorizer: aadGraphAuthorizer, // TODO: remove in v2.0
 		AadGraphEndpoint:   aadGraphEndpoint,   // TODO: remove in v2.0
+		AadGraphSender:     sender,             // TODO: remove in v2.0
 	}
 
 	// MS Graph
@@ -85,16 +116,40 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 		}
 
 		client.EnableMsGraphBeta = true
-		o.MsGraphAuthorizer, err = b.AuthConfig.NewAuthorizer(ctx, auth.MsGraph)
+		msGraphAuthorizer, err := b.newMsGraphAuthorizer(ctx, transport)
 		if err != nil {
 			return nil, err
 		}
 
+		// All clients share a single authorizer, so that tokens are refreshed before they expire during long runs. The
+		// authorizers provided by hamilton cache their tokens, so a new authorizer is configured for each refresh. These
+		// retain the context they are configured with for their token requests, and refreshes happen long after the
+		// provider has been configured, so they are not bound to the context used for configuring the provider.
+		authorizer := common.NewRefreshingAuthorizer(msGraphAuthorizer, func() (auth.Authorizer, error) {
+			return b.newMsGraphAuthorizer(context.Background(), transport)
+		})
+		o.MsGraphAuthorizer = authorizer
+
+		msGraphEndpoint, err := url.Parse(string(b.AuthConfig.Environment.MsGraph.Endpoint))
+		if err != nil {
+			return nil, fmt.Errorf("parsing Microsoft Graph endpoint: %v", err)
+		}
+
+		// Throttling, request correlation and token renewal are handled by the transport of an HTTP client belonging to
+		// this provider instance
+		o.MsGraphHttpClient = common.NewMsGraphHttpClient(transport, authorizer, msGraphEndpoint.Host, b.MaxRetries)
+
 		// Obtain the tenant ID from Azure CLI
-		if cli, ok := o.MsGraphAuthorizer.(auth.AzureCliAuthorizer); ok {
+		if cli, ok := msGraphAuthorizer.(*auth.AzureCliAuthorizer); ok {
 			if cli.TenantID == "" {
 				return nil, fmt.Errorf("azure-cli could not determine tenant ID to use")
 			}
+
+			// the tenant ID was not configured explicitly, so use the one detected by Azure CLI
+			if client.TenantID == "" {
+				client.TenantID = cli.TenantID
+				o.TenantID = cli.TenantID
+			}
 		}
 	}
 
@@ -104,3 +159,50 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 
 	return &client, nil
 }
+
+// newMsGraphAuthorizer returns an Authorizer for Microsoft Graph. Service principals, and user-assigned identities when
+// using managed identity authentication with a client ID and no service principal credentials, authenticate using the
+// specified transport. Other authentication methods are provided by hamilton.
+func (b *ClientBuilder) newMsGraphAuthorizer(ctx context.Context, transport http.RoundTripper) (auth.Authorizer, error) {
+	c := b.AuthConfig
+	hasServicePrincipalIds := strings.TrimSpace(c.TenantID) != "" && strings.TrimSpace(c.ClientID) != ""
+	hasClientSecret := strings.TrimSpace(c.ClientSecret) != ""
+
+	if b.ClientCertificate != nil && hasServicePrincipalIds {
+		return newClientCredentialsAuthorizer(transport, c, b.ClientCertificate), nil
+	}
+
+	if hasClientSecret && hasServicePrincipalIds {
+		return newClientCredentialsAuthorizer(transport, c, nil), nil
+	}
+
+	if c.EnableMsiAuth && strings.TrimSpace(c.ClientID) != "" && !hasClientSecret && b.ClientCertificate == nil {
+		a, err := newUserAssignedMsiAuthorizer(transport, c.Environment, c.MsiEndpoint, c.ClientID)
+		if err != nil {
+			return nil, fmt.Errorf("could not configure MSI Authorizer for user-assigned identity: %v", err)
+		}
+		return a, nil
+	}
+
+	return c.NewAuthorizer(ctx, auth.MsGraph)
+}
+
+// servicePrincipalObjectID returns the object ID of the service principal for the application with the specified
+// client ID, as retrieved from Azure Active Directory Graph
+// TODO: remove in v2.0
+func servicePrincipalObjectID(ctx context.Context, sender autorest.Sender, authorizer autorest.Authorizer, endpoint, tenantId, clientId string) (string, error) {
+	client := graphrbac.NewServicePrincipalsClientWithBaseURI(endpoint, tenantId)
+	client.Authorizer = authorizer
+	client.Sender = sender
+
+	result, err := client.List(ctx, fmt.Sprintf("appId eq '%s'", clientId))
+	if err != nil {
+		return "", fmt.Errorf("listing service principals: %v", err)
+	}
+
+	if values := result.Values(); len(values) != 1 || values[0].ObjectID == nil {
+		return "", fmt.Errorf("expected 1 service principal with client ID %q, found %d", clientId, len(values))
+	}
+
+	return *result.Values()[0].ObjectID, nil
+}
@@ -5,17 +5,40 @@ import (
 	"fmt"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/manicminer/hamilton/auth"
 	"github.com/manicminer/hamilton/environments"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 	applications "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	customsecurityattributes "github.com/hashicorp/terraform-provider-azuread/internal/services/customsecurityattributes/client"
+	directoryobjects "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryobjects/client"
+	directoryroles "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
 	domains "github.com/hashicorp/terraform-provider-azuread/internal/services/domains/client"
+	externalidentities "github.com/hashicorp/terraform-provider-azuread/internal/services/externalidentities/client"
 	groups "github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
+	identitygovernance "github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	policies "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
 	serviceprincipals "github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/client"
 	users "github.com/hashicorp/terraform-provider-azuread/internal/services/users/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
+// BetaApiServices are the names of the services which can be configured to use the Microsoft Graph beta API
+var BetaApiServices = []string{
+	"applications",
+	"custom_security_attributes",
+	"directory_objects",
+	"directory_roles",
+	"domains",
+	"external_identities",
+	"groups",
+	"identity_governance",
+	"policies",
+	"service_principals",
+	"users",
+}
+
 // Client contains the handles to all the specific Azure AD resource classes' respective clients
 type Client struct {
 	Environment environments.Environment
@@ -28,25 +51,62 @@ type Client struct {
 
 	AuthenticatedAsAServicePrincipal bool
 	EnableMsGraphBeta                bool // TODO: remove in v2.0
+	FastUserCreation                 bool
+	Features                         Features
+	ValidatePermissions              bool
 
 	StopContext context.Context
 
-	Applications      *applications.Client
-	Domains           *domains.Client
-	Groups            *groups.Client
-	ServicePrincipals *serviceprincipals.Client
-	Users             *users.Client
+	Applications             *applications.Client
+	CustomSecurityAttributes *customsecurityattributes.Client
+	DirectoryObjects         *directoryobjects.Client
+	DirectoryRoles           *directoryroles.Client
+	Domains                  *domains.Client
+	ExternalIdentities       *externalidentities.Client
+	Groups                   *groups.Client
+	IdentityGovernance       *identitygovernance.Client
+	Policies                 *policies.Client
+	ServicePrincipals        *serviceprincipals.Client
+	Users                    *users.Client
+}
+
+// NewMsGraphClient returns a Client using Microsoft Graph, which is configured with the specified options rather than
+// by the provider. This allows resource functions to be tested against a fake API.
+func NewMsGraphClient(ctx context.Context, o *common.ClientOptions, features Features) (*Client, error) {
+	client := Client{
+		Environment:       o.Environment,
+		TenantID:          o.TenantID,
+		TerraformVersion:  o.TerraformVersion,
+		EnableMsGraphBeta: true,
+		Features:          features,
+	}
+
+	if err := client.build(ctx, o); err != nil {
+		return nil, err
+	}
+
+	client.ClientID = client.Claims.AppId
+	client.ObjectID = client.Claims.ObjectId
+	client.AuthenticatedAsAServicePrincipal = client.Claims.IdType == "app"
+
+	return &client, nil
 }
 
 func (client *Client) build(ctx context.Context, o *common.ClientOptions) error { //nolint:unparam
 	autorest.Count429AsRetry = false
 	client.StopContext = ctx
 
-	client.Applications = applications.NewClient(o)
-	client.Domains = domains.NewClient(o)
-	client.Groups = groups.NewClient(o)
-	client.ServicePrincipals = serviceprincipals.NewClient(o)
-	client.Users = users.NewClient(o)
+	client.Applications = applications.NewClient(o.ForService("applications"))
+	client.CustomSecurityAttributes = customsecurityattributes.NewClient(o.ForService("custom_security_attributes"))
+	client.DirectoryObjects = directoryobjects.NewClient(o.ForService("directory_objects"))
+	client.DirectoryRoles = directoryroles.NewClient(o.ForService("directory_roles"))
+	client.Domains = domains.NewClient(o.ForService("domains"))
+	client.ExternalIdentities = externalidentities.NewClient(o.ForService("external_identities"))
+	client.Groups = groups.NewClient(o.ForService("groups"))
+	client.IdentityGovernance = identitygovernance.NewClient(o.ForService("identity_governance"))
+	client.Policies = policies.NewClient(o.ForService("policies"))
+	client.ServicePrincipals = serviceprincipals.NewClient(o.ForService("service_principals"))
+	client.Users = users.NewClient(o.ForService("users"))
 
 	if client.EnableMsGraphBeta {
 		// Acquire an access token upfront so we can decode and populate the JWT claims
@@ -65,3 +125,12 @@ func (client *Client) build(ctx context.Context, o *common.ClientOptions) error
 
 	return nil
 }
+
+// RequireMsGraph returns an error diagnostic when the named resource or data source, which is only supported
+// with Microsoft Graph, is used without having enabled the `use_microsoft_graph` provider option.
+func (client *Client) RequireMsGraph(name string) diag.Diagnostics {
+	if client.EnableMsGraphBeta {
+		return nil
+	}
+	return tf.ErrorDiagF(fmt.Errorf("%s is only supported when using Microsoft Graph, please set `use_microsoft_graph = true` in the provider block", name), "Microsoft Graph is required")
+}
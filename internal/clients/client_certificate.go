@@ -0,0 +1,40 @@
+package clients
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-azure-helpers/authentication"
+)
+
+// ClientCertificate is a client certificate and its private key, which have been decoded in memory from a PKCS#12
+// bundle, for authenticating as a service principal without writing the private key to disk
+type ClientCertificate struct {
+	Certificate *x509.Certificate
+	PrivateKey  *rsa.PrivateKey
+}
+
+// aadGraphAuthorizer returns an Authorizer for Azure Active Directory Graph which authenticates with the client
+// certificate
+// TODO: remove in v2.0
+func (c ClientCertificate) aadGraphAuthorizer(sender autorest.Sender, oauth *authentication.OAuthConfig, clientId, endpoint string) (autorest.Authorizer, error) {
+	if oauth.OAuth == nil {
+		return nil, fmt.Errorf("getting authorization token for client certificate: OAuth configuration is missing")
+	}
+
+	spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauth.OAuth, clientId, c.Certificate, c.PrivateKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	spt.SetSender(sender)
+
+	if err = spt.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return autorest.NewBearerAuthorizer(spt), nil
+}
@@ -0,0 +1,126 @@
+package clients
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/manicminer/hamilton/auth"
+	"golang.org/x/oauth2"
+)
+
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// clientCredentialsAuthorizer is an Authorizer which acquires tokens for a service principal using the OAuth 2.0 client
+// credentials flow, authenticating with either a client secret or a client certificate. The authorizers provided by
+// hamilton always request tokens with http.DefaultClient, so this is used instead in order that tokens are requested
+// with the same proxy and custom CA certificates as other requests made by the provider instance.
+type clientCredentialsAuthorizer struct {
+	httpClient   *http.Client
+	tokenUrl     string
+	clientId     string
+	clientSecret string
+	certificate  *ClientCertificate
+	resource     string
+	scope        string
+}
+
+// newClientCredentialsAuthorizer returns an Authorizer for Microsoft Graph, which authenticates as the service principal
+// configured in config using the specified transport. When certificate is nil, the client secret is used.
+func newClientCredentialsAuthorizer(transport http.RoundTripper, config *auth.Config, certificate *ClientCertificate) auth.Authorizer {
+	a := &clientCredentialsAuthorizer{
+		httpClient:   &http.Client{Transport: transport},
+		tokenUrl:     auth.TokenEndpoint(config.Environment.AzureADEndpoint, config.TenantID, config.Version),
+		clientId:     config.ClientID,
+		clientSecret: config.ClientSecret,
+		certificate:  certificate,
+	}
+	if config.Version == auth.TokenVersion1 {
+		a.resource = fmt.Sprintf("%s/", config.Environment.MsGraph.Endpoint)
+	} else {
+		a.scope = fmt.Sprintf("%s/.default", config.Environment.MsGraph.Endpoint)
+	}
+	return a
+}
+
+// Token requests a new access token for the service principal
+func (a *clientCredentialsAuthorizer) Token() (*oauth2.Token, error) {
+	v := url.Values{
+		"client_id":  {a.clientId},
+		"grant_type": {"client_credentials"},
+	}
+	if a.resource != "" {
+		v.Set("resource", a.resource)
+	} else {
+		v.Set("scope", a.scope)
+	}
+
+	if a.certificate != nil {
+		assertion, err := a.certificate.assertion(a.clientId, a.tokenUrl, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("building client assertion for service principal with client ID %q: %v", a.clientId, err)
+		}
+		v.Set("client_assertion", assertion)
+		v.Set("client_assertion_type", clientAssertionType)
+	} else {
+		v.Set("client_secret", a.clientSecret)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, a.tokenUrl, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("building token request for service principal with client ID %q: %v", a.clientId, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return requestToken(a.httpClient, req, fmt.Sprintf("service principal with client ID %q", a.clientId))
+}
+
+// assertion returns a JWT for the specified audience, signed with the private key of the client certificate, which
+// authenticates the service principal in place of a client secret
+func (c ClientCertificate) assertion(clientId, audience string, now time.Time) (string, error) {
+	thumbprint := sha1.Sum(c.Certificate.Raw)
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	jti, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud": audience,
+		"exp": now.Add(10 * time.Minute).Unix(),
+		"iss": clientId,
+		"jti": jti,
+		"nbf": now.Unix(),
+		"sub": clientId,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := fmt.Sprintf("%s.%s", base64.RawURLEncoding.EncodeToString(header), base64.RawURLEncoding.EncodeToString(claims))
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.PrivateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s.%s", unsigned, base64.RawURLEncoding.EncodeToString(signature)), nil
+}
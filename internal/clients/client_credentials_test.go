@@ -0,0 +1,157 @@
+package clients
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+)
+
+func testClientCertificate(t *testing.T) *ClientCertificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-azuread"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+	return &ClientCertificate{
+		Certificate: cert,
+		PrivateKey:  key,
+	}
+}
+
+func TestClientCredentialsAuthorizer_Token(t *testing.T) {
+	certificate := testClientCertificate(t)
+
+	cases := []struct {
+		Name        string
+		Secret      string
+		Certificate *ClientCertificate
+	}{
+		{
+			Name:   "client secret",
+			Secret: "s3cr3t",
+		},
+		{
+			Name:        "client certificate",
+			Certificate: certificate,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var tokenUrl string
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("parsing token request: %v", err)
+				}
+				if v := r.PostForm.Get("client_id"); v != "11111111-1111-1111-1111-111111111111" {
+					t.Errorf("expected client_id %q, got %q", "11111111-1111-1111-1111-111111111111", v)
+				}
+				if v := r.PostForm.Get("scope"); v != "https://graph.microsoft.com/.default" {
+					t.Errorf("expected scope %q, got %q", "https://graph.microsoft.com/.default", v)
+				}
+				if tc.Certificate == nil {
+					if v := r.PostForm.Get("client_secret"); v != tc.Secret {
+						t.Errorf("expected client_secret %q, got %q", tc.Secret, v)
+					}
+				} else {
+					if v := r.PostForm.Get("client_assertion_type"); v != clientAssertionType {
+						t.Errorf("expected client_assertion_type %q, got %q", clientAssertionType, v)
+					}
+					verifyClientAssertion(t, r.PostForm.Get("client_assertion"), tc.Certificate, tokenUrl)
+				}
+				fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3599}`)
+			}))
+			defer server.Close()
+
+			environment := environments.Global
+			environment.AzureADEndpoint = environments.AzureADEndpoint(server.URL)
+			config := &auth.Config{
+				Environment:  environment,
+				TenantID:     "00000000-0000-0000-0000-000000000000",
+				ClientID:     "11111111-1111-1111-1111-111111111111",
+				ClientSecret: tc.Secret,
+			}
+			tokenUrl = auth.TokenEndpoint(environment.AzureADEndpoint, config.TenantID, config.Version)
+
+			// the test server is only trusted by its own transport, so a token is only obtained when it is used
+			a := newClientCredentialsAuthorizer(server.Client().Transport, config, tc.Certificate)
+			token, err := a.Token()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if token.AccessToken != "token" {
+				t.Fatalf("expected access token %q, got %q", "token", token.AccessToken)
+			}
+			if d := time.Until(token.Expiry); d < 59*time.Minute || d > time.Hour {
+				t.Fatalf("expected token to expire in 3599s, got %s", token.Expiry)
+			}
+		})
+	}
+}
+
+func verifyClientAssertion(t *testing.T, assertion string, certificate *ClientCertificate, audience string) {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		t.Errorf("expected client assertion to be a JWT, got %q", assertion)
+		return
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Errorf("decoding client assertion signature: %v", err)
+		return
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&certificate.PrivateKey.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Errorf("verifying client assertion signature: %v", err)
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Errorf("decoding client assertion claims: %v", err)
+		return
+	}
+	var claims struct {
+		Audience string `json:"aud"`
+		Issuer   string `json:"iss"`
+		Subject  string `json:"sub"`
+	}
+	if err := json.Unmarshal(b, &claims); err != nil {
+		t.Errorf("unmarshaling client assertion claims: %v", err)
+		return
+	}
+	if claims.Audience != audience {
+		t.Errorf("expected aud claim %q, got %q", audience, claims.Audience)
+	}
+	if claims.Issuer != "11111111-1111-1111-1111-111111111111" || claims.Subject != claims.Issuer {
+		t.Errorf("expected iss and sub claims to be the client ID, got %q and %q", claims.Issuer, claims.Subject)
+	}
+}
@@ -0,0 +1,68 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CredentialExpiryDiags returns a warning diagnostic when the credential held in the state of a resource, having an
+// `end_date` attribute, expires within the window configured with the `expiry_warning_days` property of the resource's
+// `lifecycle_hints` block, or else the `expiry_warning_days` feature, so that upcoming expirations are surfaced when
+// planning. The kind is a description of the credential, e.g. "Application password".
+func (client *Client) CredentialExpiryDiags(d *schema.ResourceData, kind string) diag.Diagnostics {
+	if d.Id() == "" {
+		return nil
+	}
+	hints, _ := d.Get("lifecycle_hints").([]interface{})
+	window := credentialExpiryWarningWindow(hints, client.Features.Credentials.ExpiryWarningWindow)
+	return credentialExpiryDiags(d.Id(), d.Get("end_date").(string), kind, window, time.Now())
+}
+
+// credentialExpiryWarningWindow returns the window specified in a `lifecycle_hints` block, falling back to the provider
+// default when the block is not specified
+func credentialExpiryWarningWindow(hints []interface{}, fallback time.Duration) time.Duration {
+	if len(hints) == 0 || hints[0] == nil {
+		return fallback
+	}
+	days, ok := hints[0].(map[string]interface{})["expiry_warning_days"].(int)
+	if !ok {
+		return fallback
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func credentialExpiryDiags(id, endDate, kind string, window time.Duration, now time.Time) diag.Diagnostics {
+	if window <= 0 || endDate == "" {
+		return nil
+	}
+
+	expiry, err := time.Parse(time.RFC3339, endDate)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to parse end date %q for %s %q: %v", endDate, kind, id, err)
+		return nil
+	}
+
+	remaining := expiry.Sub(now)
+	if remaining > window {
+		return nil
+	}
+
+	if remaining <= 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s has expired", kind),
+			Detail:   fmt.Sprintf("%s with ID %q expired at %s and should be replaced.", kind, id, expiry.Format(time.RFC3339)),
+		}}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s expires soon", kind),
+		Detail: fmt.Sprintf("%s with ID %q expires at %s, which is within %d days. Consider rotating it before it expires.",
+			kind, id, expiry.Format(time.RFC3339), int(window.Hours()/24)),
+	}}
+}
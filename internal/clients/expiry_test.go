@@ -0,0 +1,115 @@
+package clients
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestCredentialExpiryDiags(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	window := 30 * 24 * time.Hour
+
+	cases := []struct {
+		Name    string
+		EndDate string
+		Window  time.Duration
+		Summary string
+	}{
+		{
+			Name:    "disabled",
+			EndDate: "2021-06-02T12:00:00Z",
+			Window:  0,
+		},
+		{
+			Name:    "no end date",
+			EndDate: "",
+			Window:  window,
+		},
+		{
+			Name:    "invalid end date",
+			EndDate: "tomorrow",
+			Window:  window,
+		},
+		{
+			Name:    "outside window",
+			EndDate: "2021-08-01T12:00:00Z",
+			Window:  window,
+		},
+		{
+			Name:    "within window",
+			EndDate: "2021-06-15T12:00:00Z",
+			Window:  window,
+			Summary: "Application password expires soon",
+		},
+		{
+			Name:    "expired",
+			EndDate: "2021-05-01T12:00:00Z",
+			Window:  window,
+			Summary: "Application password has expired",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			diags := credentialExpiryDiags("00000000-0000-0000-0000-000000000000/password/11111111-1111-1111-1111-111111111111", c.EndDate, "Application password", c.Window, now)
+			if c.Summary == "" {
+				if len(diags) > 0 {
+					t.Fatalf("expected no diagnostics, got %+v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %+v", diags)
+			}
+			if diags[0].Severity != diag.Warning {
+				t.Fatalf("expected a warning, got severity %v", diags[0].Severity)
+			}
+			if diags[0].Summary != c.Summary {
+				t.Fatalf("expected summary %q, got %q", c.Summary, diags[0].Summary)
+			}
+			if !strings.Contains(diags[0].Detail, c.EndDate) {
+				t.Fatalf("expected detail to contain end date %q, got %q", c.EndDate, diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestCredentialExpiryWarningWindow(t *testing.T) {
+	fallback := 30 * 24 * time.Hour
+
+	cases := []struct {
+		Name     string
+		Hints    []interface{}
+		Expected time.Duration
+	}{
+		{
+			Name:     "not specified",
+			Hints:    nil,
+			Expected: fallback,
+		},
+		{
+			Name:     "empty block",
+			Hints:    []interface{}{nil},
+			Expected: fallback,
+		},
+		{
+			Name:     "override",
+			Hints:    []interface{}{map[string]interface{}{"expiry_warning_days": 7}},
+			Expected: 7 * 24 * time.Hour,
+		},
+		{
+			Name:     "disabled",
+			Hints:    []interface{}{map[string]interface{}{"expiry_warning_days": 0}},
+			Expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := credentialExpiryWarningWindow(tc.Hints, fallback); actual != tc.Expected {
+			t.Fatalf("%s: expected window %s, got %s", tc.Name, tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package clients
+
+import "time"
+
+// Features contains the behaviours configured in the `features` block of the provider, which apply to all resources
+// managed by the provider instance
+type Features struct {
+	// BetaApiServices lists the services for which the Microsoft Graph beta API should be used instead of v1.0
+	BetaApiServices []string
+
+	Applications ApplicationsFeatures
+	Credentials  CredentialsFeatures
+	Groups       GroupsFeatures
+	Users        UsersFeatures
+}
+
+type ApplicationsFeatures struct {
+	// PurgeSoftDeletedOnDestroy permanently deletes applications when they are destroyed, rather than leaving them
+	// in the deleted items container from which they can be restored
+	PurgeSoftDeletedOnDestroy bool
+}
+
+type CredentialsFeatures struct {
+	// ExpiryWarningWindow is how long before their expiry a warning is shown when reading application and service
+	// principal credentials. No warnings are shown when this is zero.
+	ExpiryWarningWindow time.Duration
+}
+
+type GroupsFeatures struct {
+	// RecoverSoftDeleted restores a matching deleted group when creating a group, instead of creating a new one
+	RecoverSoftDeleted bool
+}
+
+type UsersFeatures struct {
+	// RevokeSessionsOnDelete revokes the refresh tokens and session cookies issued to users before they are deleted
+	RevokeSessionsOnDelete bool
+}
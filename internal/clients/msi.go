@@ -0,0 +1,67 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+	"golang.org/x/oauth2"
+)
+
+const (
+	msiApiVersion      = "2018-02-01"
+	msiDefaultEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+	msiTimeout         = 10 * time.Second
+)
+
+// userAssignedMsiAuthorizer is an Authorizer which acquires tokens for a user-assigned managed identity.
+// The MSI authorizer provided by hamilton only supports system-assigned identities, since it has no way to
+// specify which identity a token should be issued for.
+type userAssignedMsiAuthorizer struct {
+	httpClient *http.Client
+	endpoint   string
+	resource   string
+	clientId   string
+}
+
+// newUserAssignedMsiAuthorizer returns an Authorizer for the user-assigned managed identity with the specified client
+// ID, which requests tokens using the specified transport
+func newUserAssignedMsiAuthorizer(transport http.RoundTripper, environment environments.Environment, msiEndpoint, clientId string) (auth.Authorizer, error) {
+	endpoint := msiDefaultEndpoint
+	if msiEndpoint != "" {
+		endpoint = msiEndpoint
+	}
+
+	if _, err := url.Parse(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid MSI endpoint configured: %q", endpoint)
+	}
+
+	return &userAssignedMsiAuthorizer{
+		httpClient: &http.Client{Transport: transport, Timeout: msiTimeout},
+		endpoint:   endpoint,
+		resource:   fmt.Sprintf("%s/", environment.MsGraph.Endpoint),
+		clientId:   clientId,
+	}, nil
+}
+
+// Token returns an access token for the user-assigned identity, acquired from the metadata endpoint. Tokens are
+// requested for the lifetime of the provider, so each request is bounded by a timeout rather than by the context used
+// to configure the provider.
+func (a *userAssignedMsiAuthorizer) Token() (*oauth2.Token, error) {
+	query := url.Values{
+		"api-version": []string{msiApiVersion},
+		"client_id":   []string{a.clientId},
+		"resource":    []string{a.resource},
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s?%s", a.endpoint, query.Encode()), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("building token request for managed identity with client ID %q: %v", a.clientId, err)
+	}
+	req.Header.Set("Metadata", "true")
+
+	return requestToken(a.httpClient, req, fmt.Sprintf("managed identity with client ID %q", a.clientId))
+}
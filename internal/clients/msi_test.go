@@ -0,0 +1,112 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/environments"
+)
+
+func TestUserAssignedMsiAuthorizer_Token(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Status int
+		Body   string
+		Error  string
+		Expiry time.Duration
+	}{
+		{
+			Name:   "expires_in string",
+			Status: http.StatusOK,
+			Body:   `{"access_token": "token", "token_type": "Bearer", "expires_in": "3599"}`,
+			Expiry: 3599 * time.Second,
+		},
+		{
+			Name:   "expires_in number",
+			Status: http.StatusOK,
+			Body:   `{"access_token": "token", "token_type": "Bearer", "expires_in": 3599}`,
+			Expiry: 3599 * time.Second,
+		},
+		{
+			Name:   "expires_on",
+			Status: http.StatusOK,
+			Body:   fmt.Sprintf(`{"access_token": "token", "token_type": "Bearer", "expires_on": "%d"}`, time.Now().Add(time.Hour).Unix()),
+			Expiry: time.Hour,
+		},
+		{
+			Name:   "error response",
+			Status: http.StatusBadRequest,
+			Body:   `{"error": "invalid_request", "error_description": "Identity not found"}`,
+			Error:  "received HTTP status 400",
+		},
+		{
+			Name:   "malformed expires_in",
+			Status: http.StatusOK,
+			Body:   `{"access_token": "token", "token_type": "Bearer", "expires_in": "soon"}`,
+			Error:  "invalid expires_in",
+		},
+		{
+			Name:   "zero expires_in",
+			Status: http.StatusOK,
+			Body:   `{"access_token": "token", "token_type": "Bearer", "expires_in": "0"}`,
+			Error:  "invalid expires_in",
+		},
+		{
+			Name:   "malformed expires_on",
+			Status: http.StatusOK,
+			Body:   `{"access_token": "token", "token_type": "Bearer", "expires_on": "tomorrow"}`,
+			Error:  "invalid expires_on",
+		},
+		{
+			Name:   "no expiry",
+			Status: http.StatusOK,
+			Body:   `{"access_token": "token", "token_type": "Bearer"}`,
+			Error:  "expires_in or expires_on must be specified",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Metadata") != "true" {
+					t.Errorf("expected Metadata header to be set")
+				}
+				if v := r.URL.Query().Get("client_id"); v != "11111111-1111-1111-1111-111111111111" {
+					t.Errorf("expected client_id %q, got %q", "11111111-1111-1111-1111-111111111111", v)
+				}
+				if v := r.URL.Query().Get("resource"); v != "https://graph.microsoft.com/" {
+					t.Errorf("expected resource %q, got %q", "https://graph.microsoft.com/", v)
+				}
+				w.WriteHeader(tc.Status)
+				fmt.Fprint(w, tc.Body)
+			}))
+			defer server.Close()
+
+			a, err := newUserAssignedMsiAuthorizer(http.DefaultTransport, environments.Global, server.URL, "11111111-1111-1111-1111-111111111111")
+			if err != nil {
+				t.Fatalf("unexpected error configuring authorizer: %v", err)
+			}
+
+			token, err := a.Token()
+			if tc.Error != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.Error) {
+					t.Fatalf("expected error containing %q, got: %v", tc.Error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if token.AccessToken != "token" {
+				t.Fatalf("expected access token %q, got %q", "token", token.AccessToken)
+			}
+			if d := time.Until(token.Expiry) - tc.Expiry; d > time.Minute || d < -time.Minute {
+				t.Fatalf("expected token to expire in %s, got %s", tc.Expiry, token.Expiry)
+			}
+		})
+	}
+}
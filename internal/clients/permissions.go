@@ -0,0 +1,168 @@
+package clients
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// directoryAccessAsUser is a delegated permission which grants the same access as the signed-in user, so when present
+// the user's directory roles determine what can be managed and these cannot be determined from the token
+const directoryAccessAsUser = "Directory.AccessAsUser.All"
+
+var (
+	applicationReadWrite = []string{"Application.ReadWrite.All", "Application.ReadWrite.OwnedBy", "Directory.ReadWrite.All"}
+	applicationRead      = []string{"Application.Read.All", "Application.ReadWrite.All", "Application.ReadWrite.OwnedBy", "Directory.Read.All", "Directory.ReadWrite.All"}
+
+	accessPackageReadWrite = []string{"EntitlementManagement.ReadWrite.All"}
+	adminConsent           = []string{"AppRoleAssignment.ReadWrite.All"}
+	appManagementPolicy    = []string{"Policy.ReadWrite.ApplicationConfiguration"}
+	crossTenantAccess      = []string{"Policy.ReadWrite.CrossTenantAccess"}
+	customSecurityAttrs    = []string{"CustomSecAttributeDefinition.ReadWrite.All"}
+	domainRead             = []string{"Domain.Read.All", "Domain.ReadWrite.All", "Directory.Read.All", "Directory.ReadWrite.All"}
+	groupRead              = []string{"Group.Read.All", "Group.ReadWrite.All", "Directory.Read.All", "Directory.ReadWrite.All"}
+	groupReadWrite         = []string{"Group.ReadWrite.All", "Directory.ReadWrite.All"}
+	roleManagement         = []string{"RoleManagement.ReadWrite.Directory"}
+	userRead               = []string{"User.Read.All", "User.ReadWrite.All", "Directory.Read.All", "Directory.ReadWrite.All"}
+)
+
+// requiredPermissions lists, for each resource and data source, the Microsoft Graph permissions of which at least one
+// must be present in the access token. These are checked when `validate_permissions` is enabled.
+var requiredPermissions = map[string][]string{
+	// Resources
+	"azuread_access_package":                                 accessPackageReadWrite,
+	"azuread_access_package_assignment":                      accessPackageReadWrite,
+	"azuread_access_package_assignment_policy":               accessPackageReadWrite,
+	"azuread_access_package_catalog":                         accessPackageReadWrite,
+	"azuread_access_package_resource_catalog_association":    accessPackageReadWrite,
+	"azuread_access_package_resource_role_scope":             accessPackageReadWrite,
+	"azuread_admin_consent":                                  adminConsent,
+	"azuread_admin_consent_request_policy":                   {"Policy.ReadWrite.ConsentRequest"},
+	"azuread_app_management_policy":                          appManagementPolicy,
+	"azuread_app_management_policy_assignment":               appManagementPolicy,
+	"azuread_application":                                    applicationReadWrite,
+	"azuread_application_app_role":                           applicationReadWrite,
+	"azuread_application_certificate":                        applicationReadWrite,
+	"azuread_application_extension_property":                 applicationReadWrite,
+	"azuread_application_identifier_uri":                     applicationReadWrite,
+	"azuread_application_known_client":                       applicationReadWrite,
+	"azuread_application_oauth2_permission":                  applicationReadWrite,
+	"azuread_application_oauth2_permission_scope":            applicationReadWrite,
+	"azuread_application_optional_claim":                     applicationReadWrite,
+	"azuread_application_password":                           applicationReadWrite,
+	"azuread_application_proxy":                              {"Application.ReadWrite.All", "Directory.ReadWrite.All"},
+	"azuread_attribute_set":                                  customSecurityAttrs,
+	"azuread_authentication_strength_policy":                 {"Policy.ReadWrite.ConditionalAccess"},
+	"azuread_authorization_policy":                           {"Policy.ReadWrite.Authorization"},
+	"azuread_connected_organization":                         accessPackageReadWrite,
+	"azuread_cross_tenant_access_policy_default":             crossTenantAccess,
+	"azuread_cross_tenant_access_policy_partner":             crossTenantAccess,
+	"azuread_custom_security_attribute_definition":           customSecurityAttrs,
+	"azuread_deleted_directory_object":                       {"Application.ReadWrite.All", "Group.ReadWrite.All", "User.ReadWrite.All", "Directory.ReadWrite.All"},
+	"azuread_directory_role_assignment_schedule_request":     {"RoleAssignmentSchedule.ReadWrite.Directory", "RoleManagement.ReadWrite.Directory"},
+	"azuread_directory_role_eligibility_schedule_request":    {"RoleEligibilitySchedule.ReadWrite.Directory", "RoleManagement.ReadWrite.Directory"},
+	"azuread_directory_role_member":                          roleManagement,
+	"azuread_domain":                                         {"Domain.ReadWrite.All", "Directory.ReadWrite.All"},
+	"azuread_group":                                          groupReadWrite,
+	"azuread_group_mailbox_settings":                         groupReadWrite,
+	"azuread_group_member":                                   {"GroupMember.ReadWrite.All", "Group.ReadWrite.All", "Directory.ReadWrite.All"},
+	"azuread_guest_user_settings":                            {"Policy.ReadWrite.Authorization"},
+	"azuread_identity_provider":                              {"IdentityProvider.ReadWrite.All"},
+	"azuread_lifecycle_workflow":                             {"LifecycleWorkflows.ReadWrite.All"},
+	"azuread_role_management_policy":                         {"RoleManagementPolicy.ReadWrite.Directory"},
+	"azuread_service_principal":                              applicationReadWrite,
+	"azuread_service_principal_app_role_assignment_approval": applicationRead,
+	"azuread_service_principal_certificate":                  applicationReadWrite,
+	"azuread_service_principal_password":                     applicationReadWrite,
+	"azuread_team":                                           {"Team.Create", "Group.ReadWrite.All", "Directory.ReadWrite.All"},
+	"azuread_tenant_app_management_policy":                   appManagementPolicy,
+	"azuread_terms_of_use_agreement":                         {"Agreement.ReadWrite.All"},
+	"azuread_user":                                           {"User.ReadWrite.All", "Directory.ReadWrite.All"},
+
+	// Data Sources
+	"data.azuread_app_role_assignments":                          applicationRead,
+	"data.azuread_application":                                   applicationRead,
+	"data.azuread_application_credentials":                       applicationRead,
+	"data.azuread_application_sign_in_activity":                  {"AuditLog.Read.All"},
+	"data.azuread_application_template":                          applicationRead,
+	"data.azuread_applications":                                  applicationRead,
+	"data.azuread_deleted_applications":                          applicationRead,
+	"data.azuread_deleted_groups":                                groupRead,
+	"data.azuread_deleted_users":                                 userRead,
+	"data.azuread_directory_object":                              {"Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_directory_role_template":                       {"RoleManagement.Read.Directory", "RoleManagement.ReadWrite.Directory", "Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_directory_role_templates":                      {"RoleManagement.Read.Directory", "RoleManagement.ReadWrite.Directory", "Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_directory_roles":                               {"RoleManagement.Read.Directory", "RoleManagement.ReadWrite.Directory", "Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_domains":                                       domainRead,
+	"data.azuread_group":                                         groupRead,
+	"data.azuread_group_dynamic_membership_preview":              groupRead,
+	"data.azuread_group_member_of":                               {"Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_groups":                                        groupRead,
+	"data.azuread_service_principal":                             applicationRead,
+	"data.azuread_service_principal_credentials":                 applicationRead,
+	"data.azuread_service_principal_delegated_permission_grants": {"Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_service_principals":                            applicationRead,
+	"data.azuread_tenant":                                        {"Organization.Read.All", "Organization.ReadWrite.All", "Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_user":                                          userRead,
+	"data.azuread_user_member_of":                                {"Directory.Read.All", "Directory.ReadWrite.All"},
+	"data.azuread_user_principal_names":                          userRead,
+	"data.azuread_users":                                         userRead,
+}
+
+// CheckPermissions returns an error when `validate_permissions` is enabled and the access token for Microsoft Graph
+// does not contain any of the permissions required by the named resource, or data source when prefixed with `data.`.
+func (client *Client) CheckPermissions(name string) error {
+	if !client.ValidatePermissions || !client.EnableMsGraphBeta {
+		return nil
+	}
+
+	required, ok := requiredPermissions[name]
+	if !ok {
+		return nil
+	}
+
+	// delegated tokens contain scopes, whereas tokens issued to service principals contain application roles
+	delegated := client.Claims.Scopes != ""
+
+	granted := client.grantedPermissions()
+	if _, ok := granted[directoryAccessAsUser]; ok && delegated {
+		return nil
+	}
+
+	for _, p := range required {
+		if _, ok := granted[p]; ok {
+			return nil
+		}
+	}
+
+	held := make([]string, 0, len(granted))
+	for p := range granted {
+		held = append(held, p)
+	}
+	sort.Strings(held)
+	if len(held) == 0 {
+		held = append(held, "(none)")
+	}
+
+	kind := "application roles"
+	advice := fmt.Sprintf("Please assign one of these application roles to the service principal for the application with ID %q and grant admin consent.", client.Claims.AppId)
+	if delegated {
+		kind = "delegated scopes"
+		advice = fmt.Sprintf("Please ensure that the client application with ID %q has been granted consent for one of these delegated permissions.", client.Claims.AppId)
+	}
+
+	return fmt.Errorf("the access token for the authenticated principal (object ID %q) does not contain any of the Microsoft Graph %s required to manage %s: %s. %s The token currently contains: %s",
+		client.Claims.ObjectId, kind, strings.TrimPrefix(name, "data."), strings.Join(required, ", "), advice, strings.Join(held, ", "))
+}
+
+// grantedPermissions returns the application roles or delegated scopes present in the access token
+func (client *Client) grantedPermissions() map[string]struct{} {
+	granted := make(map[string]struct{})
+	for _, r := range client.Claims.Roles {
+		granted[r] = struct{}{}
+	}
+	for _, s := range strings.Fields(client.Claims.Scopes) {
+		granted[s] = struct{}{}
+	}
+	return granted
+}
@@ -0,0 +1,109 @@
+package clients
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/manicminer/hamilton/auth"
+)
+
+func TestCheckPermissions(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Resource string
+		Claims   auth.Claims
+		Disabled bool
+		Error    bool
+	}{
+		{
+			Name:     "application role granted",
+			Resource: "azuread_group",
+			Claims:   auth.Claims{Roles: []string{"Group.ReadWrite.All"}},
+		},
+		{
+			Name:     "broader application role granted",
+			Resource: "azuread_group",
+			Claims:   auth.Claims{Roles: []string{"Directory.ReadWrite.All"}},
+		},
+		{
+			Name:     "application role missing",
+			Resource: "azuread_group",
+			Claims:   auth.Claims{Roles: []string{"Application.ReadWrite.All"}},
+			Error:    true,
+		},
+		{
+			Name:     "read permission insufficient for resource",
+			Resource: "azuread_user",
+			Claims:   auth.Claims{Roles: []string{"User.Read.All"}},
+			Error:    true,
+		},
+		{
+			Name:     "read permission sufficient for data source",
+			Resource: "data.azuread_user",
+			Claims:   auth.Claims{Roles: []string{"User.Read.All"}},
+		},
+		{
+			Name:     "delegated scope granted",
+			Resource: "azuread_application",
+			Claims:   auth.Claims{Scopes: "openid Application.ReadWrite.All profile"},
+		},
+		{
+			Name:     "delegated scope missing",
+			Resource: "azuread_application",
+			Claims:   auth.Claims{Scopes: "openid User.Read profile"},
+			Error:    true,
+		},
+		{
+			Name:     "delegated directory access",
+			Resource: "azuread_domain",
+			Claims:   auth.Claims{Scopes: "Directory.AccessAsUser.All"},
+		},
+		{
+			Name:     "no permissions",
+			Resource: "azuread_application",
+			Error:    true,
+		},
+		{
+			Name:     "unknown resource",
+			Resource: "azuread_client_config",
+		},
+		{
+			Name:     "validation disabled",
+			Resource: "azuread_group",
+			Disabled: true,
+		},
+	}
+
+	for _, tc := range cases {
+		client := Client{
+			Claims:              tc.Claims,
+			EnableMsGraphBeta:   true,
+			ValidatePermissions: !tc.Disabled,
+		}
+		err := client.CheckPermissions(tc.Resource)
+		if tc.Error && err == nil {
+			t.Fatalf("%s: expected an error", tc.Name)
+		}
+		if !tc.Error && err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.Name, err)
+		}
+	}
+}
+
+func TestCheckPermissions_errorDescribesRequiredPermissions(t *testing.T) {
+	client := Client{
+		Claims:              auth.Claims{ObjectId: "00000000-0000-0000-0000-000000000000", Roles: []string{"User.Read.All"}},
+		EnableMsGraphBeta:   true,
+		ValidatePermissions: true,
+	}
+
+	err := client.CheckPermissions("azuread_group")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, s := range []string{"azuread_group", "Group.ReadWrite.All", "Directory.ReadWrite.All", "application roles", "User.Read.All"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Fatalf("expected error to contain %q, got: %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package clients
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// requestToken sends a token request with the specified HTTP client, and returns the access token from the response.
+// The principal is described in any error messages.
+func requestToken(httpClient *http.Client, req *http.Request, principal string) (*oauth2.Token, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting token for %s: %v", principal, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("reading token response for %s: %v", principal, err)
+	}
+	if c := resp.StatusCode; c < 200 || c > 299 {
+		return nil, fmt.Errorf("requesting token for %s: received HTTP status %d: %s", principal, c, body)
+	}
+
+	var tokenRes struct {
+		AccessToken string      `json:"access_token"`
+		TokenType   string      `json:"token_type"`
+		ExpiresIn   interface{} `json:"expires_in"`
+		ExpiresOn   interface{} `json:"expires_on"`
+	}
+	if err := json.Unmarshal(body, &tokenRes); err != nil {
+		return nil, fmt.Errorf("unmarshaling token for %s: %v", principal, err)
+	}
+
+	expiry, err := tokenExpiry(tokenRes.ExpiresIn, tokenRes.ExpiresOn, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("parsing token for %s: %v", principal, err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: tokenRes.AccessToken,
+		TokenType:   tokenRes.TokenType,
+		Expiry:      expiry,
+	}, nil
+}
+
+// tokenExpiry returns the expiry time of a token, from either the number of seconds it is valid for (expires_in) or the
+// Unix time at which it expires (expires_on). These may be returned as strings or as numbers, depending on the endpoint. An error is returned when neither is a positive integer, since a
+// token without an expiry would never be refreshed.
+func tokenExpiry(expiresIn, expiresOn interface{}, now time.Time) (time.Time, error) {
+	if expiresIn != nil {
+		secs, err := tokenInt(expiresIn)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid expires_in: %v", err)
+		}
+		return now.Add(time.Duration(secs) * time.Second), nil
+	}
+
+	if expiresOn != nil {
+		secs, err := tokenInt(expiresOn)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid expires_on: %v", err)
+		}
+		return time.Unix(secs, 0), nil
+	}
+
+	return time.Time{}, fmt.Errorf("expires_in or expires_on must be specified")
+}
+
+func tokenInt(v interface{}) (int64, error) {
+	var i int64
+	switch v := v.(type) {
+	case string:
+		var err error
+		if i, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return 0, err
+		}
+	case float64:
+		if v != float64(int64(v)) {
+			return 0, fmt.Errorf("%v is not an integer", v)
+		}
+		i = int64(v)
+	default:
+		return 0, fmt.Errorf("unexpected value %v", v)
+	}
+	if i <= 0 {
+		return 0, fmt.Errorf("%d is not a positive integer", i)
+	}
+	return i, nil
+}
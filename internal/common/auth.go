@@ -0,0 +1,175 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/manicminer/hamilton/auth"
+	"golang.org/x/oauth2"
+)
+
+// tokenRefreshWindow is how long before its expiry a cached access token is proactively refreshed, so that tokens do
+// not expire whilst requests are in flight or waiting to be retried
+const tokenRefreshWindow = 5 * time.Minute
+
+// RefreshingAuthorizer is an auth.Authorizer which caches the access token acquired from Source, and refreshes it
+// shortly before it expires. It is safe for concurrent use, so a single RefreshingAuthorizer can be shared by all clients.
+//
+// The authorizers provided by hamilton cache their own tokens until just before they expire, so they cannot be asked
+// for a new token sooner. When NewSource is set, each refresh acquires its token from a new source returned by it,
+// which belongs to this RefreshingAuthorizer alone, rather than reusing Source.
+type RefreshingAuthorizer struct {
+	Source    auth.Authorizer
+	NewSource func() (auth.Authorizer, error)
+
+	mutex      sync.Mutex
+	token      *oauth2.Token
+	sourceUsed bool
+}
+
+// NewRefreshingAuthorizer returns a RefreshingAuthorizer which acquires its first token from source, and subsequent
+// tokens from a new source returned by newSource. When newSource is nil, source is reused, which is only suitable for
+// sources which do not cache tokens. When source is already a RefreshingAuthorizer, it is returned as-is.
+func NewRefreshingAuthorizer(source auth.Authorizer, newSource func() (auth.Authorizer, error)) *RefreshingAuthorizer {
+	if a, ok := source.(*RefreshingAuthorizer); ok {
+		return a
+	}
+	return &RefreshingAuthorizer{
+		Source:    source,
+		NewSource: newSource,
+	}
+}
+
+// Token returns the cached access token, acquiring a new one when there is no cached token or when it is due to
+// expire within the refresh window. Should a refresh fail, the error is returned so that it is surfaced rather than
+// the request failing later with an expired token.
+func (a *RefreshingAuthorizer) Token() (*oauth2.Token, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.token != nil && !tokenExpiresWithin(a.token, tokenRefreshWindow) {
+		return a.token, nil
+	}
+
+	token, err := a.acquireToken()
+	if err != nil {
+		if a.token != nil {
+			return nil, fmt.Errorf("refreshing access token: %v", err)
+		}
+		return nil, err
+	}
+	if token == nil {
+		return nil, fmt.Errorf("no access token was returned by the authorizer")
+	}
+
+	a.token = token
+	a.sourceUsed = true
+	return a.token, nil
+}
+
+// acquireToken obtains a token from Source, or from a new source once Source has been used, since Source may return
+// the same cached token again
+func (a *RefreshingAuthorizer) acquireToken() (*oauth2.Token, error) {
+	if a.sourceUsed && a.NewSource != nil {
+		source, err := a.NewSource()
+		if err != nil {
+			return nil, fmt.Errorf("configuring authorizer to refresh access token: %v", err)
+		}
+		a.Source = source
+	}
+	return a.Source.Token()
+}
+
+// Invalidate discards the cached token if it is the specified token, so that a new token is acquired on the next call
+// to Token. Tokens which have since been replaced by a concurrent refresh are left alone.
+func (a *RefreshingAuthorizer) Invalidate(token *oauth2.Token) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.token != nil && token != nil && a.token.AccessToken == token.AccessToken {
+		a.token = nil
+	}
+}
+
+func tokenExpiresWithin(token *oauth2.Token, d time.Duration) bool {
+	if token.Expiry.IsZero() {
+		return false
+	}
+	return time.Until(token.Expiry) < d
+}
+
+// AuthTransport is an http.RoundTripper which sets a current access token on each request to Host that is authorized
+// with a bearer token, so that requests which are retried after a delay do not reuse an expired token. When the API
+// responds with 401 Unauthorized, the token is invalidated and the request is sent once more with a new token.
+type AuthTransport struct {
+	Base       http.RoundTripper
+	Authorizer *RefreshingAuthorizer
+	Host       string
+}
+
+// NewAuthTransport returns an AuthTransport wrapping the specified RoundTripper
+func NewAuthTransport(base http.RoundTripper, authorizer *RefreshingAuthorizer, host string) *AuthTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &AuthTransport{
+		Base:       base,
+		Authorizer: authorizer,
+		Host:       host,
+	}
+}
+
+func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Authorizer == nil || !strings.EqualFold(req.URL.Host, t.Host) || !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return t.Base.RoundTrip(req)
+	}
+
+	token, err := t.Authorizer.Token()
+	if err != nil {
+		return nil, fmt.Errorf("obtaining access token for %s request to %s: %v", req.Method, req.URL.Host, err)
+	}
+
+	resp, err := t.Base.RoundTrip(withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// the token may have been revoked or expired in transit, so acquire a new one and send the request once more
+	t.Authorizer.Invalidate(token)
+	refreshed, err := t.Authorizer.Token()
+	if err != nil {
+		log.Printf("[DEBUG] %s request to %s returned HTTP status 401, unable to obtain a new access token: %v", req.Method, req.URL, err)
+		return resp, nil
+	}
+	if refreshed.AccessToken == token.AccessToken {
+		return resp, nil
+	}
+
+	r := withToken(req, refreshed)
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return resp, nil
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		r.Body = body
+	}
+	drainBody(resp.Body)
+
+	log.Printf("[DEBUG] %s request to %s returned HTTP status 401, retrying with a new access token", req.Method, req.URL)
+
+	return t.Base.RoundTrip(r)
+}
+
+// withToken returns a copy of req authorized with the specified token
+func withToken(req *http.Request, token *oauth2.Token) *http.Request {
+	r := req.Clone(req.Context())
+	token.SetAuthHeader(r)
+	return r
+}
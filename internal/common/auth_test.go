@@ -0,0 +1,223 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/auth"
+	"golang.org/x/oauth2"
+)
+
+type testAuthorizer struct {
+	calls  int
+	expiry time.Duration
+}
+
+func (a *testAuthorizer) Token() (*oauth2.Token, error) {
+	a.calls++
+	return &oauth2.Token{
+		AccessToken: fmt.Sprintf("token-%d", a.calls),
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(a.expiry),
+	}, nil
+}
+
+func TestRefreshingAuthorizer_cachesToken(t *testing.T) {
+	source := &testAuthorizer{expiry: time.Hour}
+	a := NewRefreshingAuthorizer(source, nil)
+
+	for i := 0; i < 3; i++ {
+		token, err := a.Token()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token.AccessToken != "token-1" {
+			t.Fatalf("expected cached token %q, got %q", "token-1", token.AccessToken)
+		}
+	}
+	if source.calls != 1 {
+		t.Fatalf("expected 1 token request, got %d", source.calls)
+	}
+}
+
+func TestRefreshingAuthorizer_refreshesBeforeExpiry(t *testing.T) {
+	source := &testAuthorizer{expiry: tokenRefreshWindow - time.Minute}
+	a := NewRefreshingAuthorizer(source, nil)
+
+	if _, err := a.Token(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := a.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.AccessToken != "token-2" {
+		t.Fatalf("expected refreshed token %q, got %q", "token-2", token.AccessToken)
+	}
+}
+
+func TestRefreshingAuthorizer_invalidate(t *testing.T) {
+	source := &testAuthorizer{expiry: time.Hour}
+	a := NewRefreshingAuthorizer(source, nil)
+
+	first, _ := a.Token()
+	a.Invalidate(&oauth2.Token{AccessToken: "stale"})
+	if token, _ := a.Token(); token.AccessToken != first.AccessToken {
+		t.Fatalf("expected token to be retained after invalidating a different token, got %q", token.AccessToken)
+	}
+
+	a.Invalidate(first)
+	if token, _ := a.Token(); token.AccessToken != "token-2" {
+		t.Fatalf("expected new token %q after invalidation, got %q", "token-2", token.AccessToken)
+	}
+}
+
+// The authorizers provided by hamilton cache tokens until shortly before they expire, so refreshing must acquire a new
+// token from a new source
+func TestRefreshingAuthorizer_refreshesCachedSource(t *testing.T) {
+	source := &testAuthorizer{expiry: tokenRefreshWindow - time.Minute}
+	sources := 0
+	a := NewRefreshingAuthorizer(auth.CachedAuthorizer(source), func() (auth.Authorizer, error) {
+		sources++
+		return auth.CachedAuthorizer(source), nil
+	})
+
+	first, err := a.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := a.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.AccessToken != "token-2" {
+		t.Fatalf("expected refreshed token %q, got %q", "token-2", token.AccessToken)
+	}
+	if sources != 1 {
+		t.Fatalf("expected 1 new source, got %d", sources)
+	}
+	if !first.Valid() {
+		t.Fatalf("expected the token acquired from the original source to be unmodified")
+	}
+}
+
+func TestRefreshingAuthorizer_invalidateCachedSource(t *testing.T) {
+	source := &testAuthorizer{expiry: time.Hour}
+	cached := auth.CachedAuthorizer(source)
+	a := NewRefreshingAuthorizer(cached, func() (auth.Authorizer, error) {
+		return auth.CachedAuthorizer(source), nil
+	})
+
+	first, _ := a.Token()
+	a.Invalidate(first)
+	token, err := a.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.AccessToken != "token-2" {
+		t.Fatalf("expected new token %q after invalidation, got %q", "token-2", token.AccessToken)
+	}
+
+	// the original source still holds its own token, which is unaffected by the refresh
+	if original, _ := cached.Token(); original.AccessToken != first.AccessToken || !original.Valid() {
+		t.Fatalf("expected the original source to retain its valid token %q, got %q", first.AccessToken, original.AccessToken)
+	}
+}
+
+func TestRefreshingAuthorizer_newSourceError(t *testing.T) {
+	source := &testAuthorizer{expiry: tokenRefreshWindow - time.Minute}
+	a := NewRefreshingAuthorizer(source, func() (auth.Authorizer, error) {
+		return nil, fmt.Errorf("unavailable")
+	})
+
+	if _, err := a.Token(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := a.Token(); err == nil || !strings.Contains(err.Error(), "unavailable") {
+		t.Fatalf("expected the refresh error to be returned when a new source cannot be configured, got: %v", err)
+	}
+}
+
+func TestAuthTransport_retriesUnauthorizedOnce(t *testing.T) {
+	var auths, bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		auths = append(auths, r.Header.Get("Authorization"))
+		bodies = append(bodies, string(b))
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	source := &testAuthorizer{expiry: time.Hour}
+	authorizer := NewRefreshingAuthorizer(auth.CachedAuthorizer(source), func() (auth.Authorizer, error) {
+		return auth.CachedAuthorizer(source), nil
+	})
+	client := &http.Client{Transport: NewAuthTransport(nil, authorizer, u.Host)}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer original")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if expected := []string{"Bearer token-1", "Bearer token-2"}; fmt.Sprint(auths) != fmt.Sprint(expected) {
+		t.Fatalf("expected authorization headers %v, got %v", expected, auths)
+	}
+	for i, b := range bodies {
+		if b != "payload" {
+			t.Fatalf("expected request %d to have body %q, got %q", i, "payload", b)
+		}
+	}
+}
+
+func TestAuthTransport_otherHostsUnchanged(t *testing.T) {
+	var auths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	authorizer := NewRefreshingAuthorizer(&testAuthorizer{expiry: time.Hour}, nil)
+	client := &http.Client{Transport: NewAuthTransport(nil, authorizer, "graph.microsoft.com")}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer original")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", resp.StatusCode)
+	}
+	if len(auths) != 1 || auths[0] != "Bearer original" {
+		t.Fatalf("expected a single request with the original authorization header, got %v", auths)
+	}
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -19,25 +20,80 @@ type ClientOptions struct {
 	Environment environments.Environment
 	TenantID    string
 
+	MaxRetries       int
 	PartnerID        string
 	TerraformVersion string
 
+	// BetaApiServices lists the services for which the Microsoft Graph beta API should be used instead of v1.0
+	BetaApiServices []string
+
+	// UseBetaApi is set by ForService when the service has opted in to the Microsoft Graph beta API
+	UseBetaApi bool
+
 	AadGraphAuthorizer autorest.Authorizer // TODO: delete in v2.0
 	AadGraphEndpoint   string              // TODO: delete in v2.0
+	AadGraphSender     autorest.Sender     // TODO: delete in v2.0
 
 	MsGraphAuthorizer auth.Authorizer // TODO: rename in v2.0
+
+	// MsGraphHttpClient is used to send requests to Microsoft Graph, so that transport settings such as proxies,
+	// custom CA certificates, retries and token renewal apply only to clients configured by this provider instance
+	MsGraphHttpClient *http.Client
 }
 
 func (o ClientOptions) ConfigureClient(c *msgraph.Client, ar *autorest.Client) {
+	o.ConfigureMsGraphClient(c)
+
+	ar.Authorizer = o.AadGraphAuthorizer
+	aadGraphSender := o.AadGraphSender
+	if aadGraphSender == nil {
+		aadGraphSender = sender.BuildSender("AzureAD")
+	}
+	ar.Sender = autorest.DecorateSender(aadGraphSender, WithCorrelation())
+	ar.UserAgent = o.userAgent(ar.UserAgent)
+	ar.RetryAttempts = o.MaxRetries
+}
+
+// ConfigureMsGraphClient configures a client for services which are only available with Microsoft Graph. The client
+// keeps the API version it was created with, unless the service has opted in to the beta API.
+func (o ClientOptions) ConfigureMsGraphClient(c *msgraph.Client) {
+	o.configureMsGraphClient(c)
+	if o.UseBetaApi {
+		c.ApiVersion = msgraph.VersionBeta
+	}
+}
+
+// ConfigureMsGraphBetaClient configures a client for endpoints which are only available in the Microsoft Graph beta
+// API, so the client always uses the beta API regardless of whether the service has opted in to it.
+func (o ClientOptions) ConfigureMsGraphBetaClient(c *msgraph.Client) {
+	o.configureMsGraphClient(c)
+	c.ApiVersion = msgraph.VersionBeta
+}
+
+func (o ClientOptions) configureMsGraphClient(c *msgraph.Client) {
 	if o.MsGraphAuthorizer != nil {
 		c.Authorizer = o.MsGraphAuthorizer
 		c.Endpoint = o.Environment.MsGraph.Endpoint
 		c.UserAgent = o.userAgent(c.UserAgent)
+		if o.MsGraphHttpClient != nil {
+			// Throttled requests are retried by the HTTP client, so they are not retried again by the Microsoft Graph client
+			c.HttpClient = o.MsGraphHttpClient
+			c.DisableRetries = true
+		}
 	}
+}
 
-	ar.Authorizer = o.AadGraphAuthorizer
-	ar.Sender = sender.BuildSender("AzureAD")
-	ar.UserAgent = o.userAgent(ar.UserAgent)
+// ForService returns a copy of the ClientOptions for the named service, which uses the Microsoft Graph beta API
+// when the service is listed in BetaApiServices
+func (o ClientOptions) ForService(name string) *ClientOptions {
+	o.UseBetaApi = false
+	for _, v := range o.BetaApiServices {
+		if strings.EqualFold(v, name) {
+			o.UseBetaApi = true
+			break
+		}
+	}
+	return &o
 }
 
 func (o ClientOptions) userAgent(sdkUserAgent string) (userAgent string) {
@@ -51,7 +107,7 @@ func (o ClientOptions) userAgent(sdkUserAgent string) (userAgent string) {
 	}
 
 	if o.PartnerID != "" {
-		userAgent = fmt.Sprintf("%s pid-%s", userAgent, o.PartnerID)
+		userAgent = fmt.Sprintf("%s pid-%s", userAgent, strings.TrimPrefix(o.PartnerID, "pid-"))
 	}
 
 	return
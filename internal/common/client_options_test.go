@@ -0,0 +1,89 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestClientOptions_userAgentPartnerId(t *testing.T) {
+	cases := []struct {
+		PartnerID string
+		Expected  string
+	}{
+		{
+			PartnerID: "",
+		},
+		{
+			PartnerID: "222c6c49-1b0a-5959-a213-6608f9eb8820",
+			Expected:  " pid-222c6c49-1b0a-5959-a213-6608f9eb8820",
+		},
+		{
+			PartnerID: "pid-00000000-0000-0000-0000-000000000000",
+			Expected:  " pid-00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, tc := range cases {
+		o := ClientOptions{
+			PartnerID:        tc.PartnerID,
+			TerraformVersion: "1.0.0",
+		}
+		userAgent := o.userAgent("sdk/1.0")
+
+		if !strings.HasPrefix(userAgent, "sdk/1.0 HashiCorp Terraform/1.0.0") {
+			t.Fatalf("unexpected user agent for partner ID %q: %q", tc.PartnerID, userAgent)
+		}
+		if tc.Expected == "" {
+			if strings.Contains(userAgent, "pid-") {
+				t.Fatalf("expected no partner ID in user agent, got %q", userAgent)
+			}
+			continue
+		}
+		if !strings.HasSuffix(userAgent, tc.Expected) {
+			t.Fatalf("expected user agent to end with %q, got %q", tc.Expected, userAgent)
+		}
+		if strings.Count(userAgent, "pid-") != 1 {
+			t.Fatalf("expected partner ID to appear exactly once in user agent, got %q", userAgent)
+		}
+	}
+}
+
+func TestClientOptions_forServiceBetaApi(t *testing.T) {
+	o := ClientOptions{
+		BetaApiServices:   []string{"groups"},
+		MsGraphAuthorizer: &testAuthorizer{},
+	}
+
+	groups := msgraph.NewClient(msgraph.Version10, "")
+	o.ForService("groups").ConfigureMsGraphClient(&groups)
+	if groups.ApiVersion != msgraph.VersionBeta {
+		t.Fatalf("expected groups client to use API version %q, got %q", msgraph.VersionBeta, groups.ApiVersion)
+	}
+
+	users := msgraph.NewClient(msgraph.Version10, "")
+	o.ForService("users").ConfigureMsGraphClient(&users)
+	if users.ApiVersion != msgraph.Version10 {
+		t.Fatalf("expected users client to use API version %q, got %q", msgraph.Version10, users.ApiVersion)
+	}
+
+	// Clients for services which have not opted in keep the API version they were created with
+	applications := msgraph.NewClient(msgraph.VersionBeta, "")
+	o.ForService("applications").ConfigureMsGraphClient(&applications)
+	if applications.ApiVersion != msgraph.VersionBeta {
+		t.Fatalf("expected applications client to use API version %q, got %q", msgraph.VersionBeta, applications.ApiVersion)
+	}
+}
+
+func TestClientOptions_configureMsGraphBetaClient(t *testing.T) {
+	o := ClientOptions{
+		MsGraphAuthorizer: &testAuthorizer{},
+	}
+
+	c := msgraph.NewClient(msgraph.Version10, "")
+	o.ForService("groups").ConfigureMsGraphBetaClient(&c)
+	if c.ApiVersion != msgraph.VersionBeta {
+		t.Fatalf("expected beta-only client to use API version %q, got %q", msgraph.VersionBeta, c.ApiVersion)
+	}
+}
@@ -0,0 +1,141 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+)
+
+const (
+	clientRequestIdHeader = "client-request-id"
+	requestIdHeader       = "request-id"
+)
+
+// CorrelationTransport is an http.RoundTripper which ensures that every request carries a `client-request-id` header,
+// logs the correlation IDs for each request and response, and appends them to any error message returned by the API.
+// This allows errors surfaced in diagnostics to be correlated with Azure support tickets. Any headers attached to the
+// request context using WithRequestHeaders are also added to the request.
+type CorrelationTransport struct {
+	Base http.RoundTripper
+}
+
+// NewCorrelationTransport returns a CorrelationTransport wrapping the specified RoundTripper
+func NewCorrelationTransport(base http.RoundTripper) *CorrelationTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &CorrelationTransport{
+		Base: base,
+	}
+}
+
+func (t *CorrelationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return sendWithCorrelation(req, t.Base.RoundTrip)
+}
+
+// WithCorrelation returns an autorest.SendDecorator providing the same behaviour as CorrelationTransport for clients
+// using autorest
+func WithCorrelation() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return sendWithCorrelation(r, s.Do)
+		})
+	}
+}
+
+func sendWithCorrelation(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if headers := requestHeadersFromContext(req.Context()); len(headers) > 0 {
+		req = req.Clone(req.Context())
+		for k, v := range headers {
+			req.Header[k] = v
+		}
+	}
+
+	if req.Header.Get(clientRequestIdHeader) == "" {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, fmt.Errorf("generating client request ID: %v", err)
+		}
+		req = req.Clone(req.Context())
+		req.Header.Set(clientRequestIdHeader, id)
+	}
+
+	log.Printf("[DEBUG] Sending %s request to %s (client-request-id: %s)", req.Method, req.URL, req.Header.Get(clientRequestIdHeader))
+
+	resp, err := send(req)
+	if err != nil {
+		log.Printf("[DEBUG] %s request to %s failed (client-request-id: %s): %v", req.Method, req.URL, req.Header.Get(clientRequestIdHeader), err)
+		return resp, err
+	}
+
+	ids := CorrelationIds(resp)
+	log.Printf("[DEBUG] Received HTTP status %d for %s request to %s (%s)", resp.StatusCode, req.Method, req.URL, ids)
+
+	if resp.StatusCode >= http.StatusBadRequest && resp.Body != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading response body for %s request to %s (%s): %v", req.Method, req.URL, ids, err)
+		}
+		body = annotateErrorBody(body, ids)
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+
+	return resp, nil
+}
+
+// CorrelationIds returns a description of the correlation IDs for a response, suitable for including in error messages
+func CorrelationIds(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	clientRequestId := resp.Header.Get(clientRequestIdHeader)
+	if clientRequestId == "" && resp.Request != nil {
+		clientRequestId = resp.Request.Header.Get(clientRequestIdHeader)
+	}
+	return fmt.Sprintf("request-id: %s, client-request-id: %s", resp.Header.Get(requestIdHeader), clientRequestId)
+}
+
+// annotateErrorBody appends correlation IDs to the error message in a Microsoft Graph or Azure Active Directory Graph
+// error response, so that they are included in errors returned by the SDKs. Any other response is returned unchanged.
+func annotateErrorBody(body []byte, ids string) []byte {
+	var data map[string]interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return body
+	}
+
+	annotated := false
+	if e, ok := data["error"].(map[string]interface{}); ok {
+		// Microsoft Graph: {"error": {"code": "...", "message": "..."}}
+		if message, ok := e["message"].(string); ok {
+			e["message"] = fmt.Sprintf("%s (%s)", message, ids)
+			annotated = true
+		}
+	} else if e, ok := data["odata.error"].(map[string]interface{}); ok {
+		// Azure Active Directory Graph: {"odata.error": {"code": "...", "message": {"lang": "en", "value": "..."}}}
+		if message, ok := e["message"].(map[string]interface{}); ok {
+			if value, ok := message["value"].(string); ok {
+				message["value"] = fmt.Sprintf("%s (%s)", value, ids)
+				annotated = true
+			}
+		}
+	}
+	if !annotated {
+		return body
+	}
+
+	result, err := json.Marshal(data)
+	if err != nil {
+		return body
+	}
+	return result
+}
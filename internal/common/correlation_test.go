@@ -0,0 +1,163 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCorrelationTransport_setsClientRequestId(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("client-request-id")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewCorrelationTransport(nil)}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if received == "" {
+		t.Fatal("expected a client-request-id header to be sent")
+	}
+	if req.Header.Get("client-request-id") != "" {
+		t.Fatal("expected the original request not to be modified")
+	}
+
+	req, err = http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("client-request-id", "existing")
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if received != "existing" {
+		t.Fatalf("expected existing client-request-id to be preserved, got %q", received)
+	}
+}
+
+func TestCorrelationTransport_annotatesErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("request-id", "11111111-1111-1111-1111-111111111111")
+		w.Header().Set("client-request-id", r.Header.Get("client-request-id"))
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":"Authorization_RequestDenied","message":"Insufficient privileges to complete the operation."}}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewCorrelationTransport(nil)}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ContentLength != int64(len(body)) {
+		t.Fatalf("expected ContentLength to be %d, got %d", len(body), resp.ContentLength)
+	}
+
+	var data struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		t.Fatalf("unmarshaling annotated body: %v", err)
+	}
+	if data.Error.Code != "Authorization_RequestDenied" {
+		t.Fatalf("expected error code to be preserved, got %q", data.Error.Code)
+	}
+	if !strings.HasPrefix(data.Error.Message, "Insufficient privileges to complete the operation.") {
+		t.Fatalf("expected error message to be preserved, got %q", data.Error.Message)
+	}
+	if !strings.Contains(data.Error.Message, "request-id: 11111111-1111-1111-1111-111111111111") {
+		t.Fatalf("expected error message to contain the request ID, got %q", data.Error.Message)
+	}
+	if !strings.Contains(data.Error.Message, "client-request-id: "+resp.Header.Get("client-request-id")) {
+		t.Fatalf("expected error message to contain the client request ID, got %q", data.Error.Message)
+	}
+}
+
+func TestAnnotateErrorBody(t *testing.T) {
+	ids := "request-id: abc, client-request-id: def"
+
+	cases := []struct {
+		Body     string
+		Expected string
+	}{
+		{
+			Body:     `{"error":{"code":"Request_ResourceNotFound","message":"Resource not found."}}`,
+			Expected: `{"error":{"code":"Request_ResourceNotFound","message":"Resource not found. (request-id: abc, client-request-id: def)"}}`,
+		},
+		{
+			Body:     `{"odata.error":{"code":"Request_BadRequest","message":{"lang":"en","value":"Invalid value."}}}`,
+			Expected: `{"odata.error":{"code":"Request_BadRequest","message":{"lang":"en","value":"Invalid value. (request-id: abc, client-request-id: def)"}}}`,
+		},
+		{
+			Body:     `{"value":[]}`,
+			Expected: `{"value":[]}`,
+		},
+		{
+			Body:     `not json`,
+			Expected: `not json`,
+		},
+	}
+
+	for _, tc := range cases {
+		if v := string(annotateErrorBody([]byte(tc.Body), ids)); v != tc.Expected {
+			t.Fatalf("expected %s, got %s", tc.Expected, v)
+		}
+	}
+}
+
+func TestCorrelationTransport_contextHeaders(t *testing.T) {
+	var ifMatch string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifMatch = r.Header.Get("If-Match")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	ctx := WithRequestHeaders(context.Background(), http.Header{"if-match": []string{`W/"etag"`}})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: NewCorrelationTransport(nil)}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if ifMatch != `W/"etag"` {
+		t.Fatalf("expected If-Match header to be sent, got %q", ifMatch)
+	}
+	if req.Header.Get("If-Match") != "" {
+		t.Fatal("expected the original request not to be modified")
+	}
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"context"
+	"net/http"
+)
+
+type requestHeadersKey struct{}
+
+// WithRequestHeaders returns a copy of ctx carrying additional headers, which are added by CorrelationTransport to any
+// request made with the returned context. This allows headers such as If-Match to be sent with requests made by SDKs
+// which do not otherwise support custom headers.
+func WithRequestHeaders(ctx context.Context, headers http.Header) context.Context {
+	merged := http.Header{}
+	if existing, ok := ctx.Value(requestHeadersKey{}).(http.Header); ok {
+		for k, v := range existing {
+			merged[k] = v
+		}
+	}
+	for k, v := range headers {
+		merged[http.CanonicalHeaderKey(k)] = v
+	}
+	return context.WithValue(ctx, requestHeadersKey{}, merged)
+}
+
+func requestHeadersFromContext(ctx context.Context) http.Header {
+	if headers, ok := ctx.Value(requestHeadersKey{}).(http.Header); ok {
+		return headers
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package common
+
+import (
+	"net/http"
+)
+
+// NewMsGraphHttpClient returns an HTTP client for a provider instance, which sends requests to Microsoft Graph using
+// the specified transport. Requests to host are authorized with a current access token from authorizer, and throttled
+// requests are retried up to maxRetries times.
+func NewMsGraphHttpClient(transport http.RoundTripper, authorizer *RefreshingAuthorizer, host string, maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: NewRetryTransport(NewAuthTransport(NewCorrelationTransport(transport), authorizer, host), maxRetries),
+	}
+}
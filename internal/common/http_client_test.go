@@ -0,0 +1,151 @@
+package common
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientOptions_msGraphHttpClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	defaultTransport := http.DefaultClient.Transport
+
+	first, second := &countingTransport{}, &countingTransport{}
+	for _, transport := range []*countingTransport{first, second} {
+		o := ClientOptions{
+			Environment:       environments.Environment{MsGraph: environments.Api{Endpoint: environments.ApiEndpoint(server.URL)}},
+			MsGraphAuthorizer: &testAuthorizer{},
+			MsGraphHttpClient: &http.Client{Transport: transport},
+		}
+		c := msgraph.NewClient(msgraph.Version10, "")
+		o.ConfigureMsGraphClient(&c)
+
+		if _, _, _, err := c.Get(context.Background(), msgraph.GetHttpRequestInput{
+			ValidStatusCodes: []int{http.StatusOK},
+			Uri:              msgraph.Uri{Entity: "/organization"},
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if first.requests != 1 || second.requests != 1 {
+		t.Fatalf("expected each client to send 1 request using its own transport, got %d and %d", first.requests, second.requests)
+	}
+	if http.DefaultClient.Transport != defaultTransport {
+		t.Fatalf("expected http.DefaultClient to be unmodified")
+	}
+}
+
+// Throttled requests are retried only by the HTTP client of the provider instance, and not again by hamilton
+func TestClientOptions_msGraphRetries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0.001")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	authorizer := NewRefreshingAuthorizer(&testAuthorizer{expiry: time.Hour}, nil)
+
+	o := ClientOptions{
+		Environment:       environments.Environment{MsGraph: environments.Api{Endpoint: environments.ApiEndpoint(server.URL)}},
+		MsGraphAuthorizer: authorizer,
+		MsGraphHttpClient: NewMsGraphHttpClient(http.DefaultTransport, authorizer, u.Host, 2),
+	}
+	c := msgraph.NewClient(msgraph.Version10, "")
+	o.ConfigureMsGraphClient(&c)
+
+	if _, _, _, err := c.Get(context.Background(), msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri:              msgraph.Uri{Entity: "/organization"},
+	}); err == nil {
+		t.Fatalf("expected an error once retries were exhausted")
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests with max retries 2, got %d", requests)
+	}
+}
+
+func TestNewMsGraphHttpClient_maxRetries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0.001")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	authorizer := NewRefreshingAuthorizer(&testAuthorizer{expiry: time.Hour}, nil)
+
+	// each provider instance retries according to its own configuration
+	for _, maxRetries := range []int{2, 0} {
+		requests = 0
+		client := NewMsGraphHttpClient(http.DefaultTransport, authorizer, u.Host, maxRetries)
+		if _, err := client.Get(server.URL); err == nil {
+			t.Fatalf("expected an error once retries were exhausted")
+		}
+		if expected := maxRetries + 1; requests != expected {
+			t.Fatalf("expected %d requests with max retries %d, got %d", expected, maxRetries, requests)
+		}
+	}
+}
+
+func TestNewMsGraphHttpClient_customCaCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	u, _ := url.Parse(server.URL)
+	authorizer := NewRefreshingAuthorizer(&testAuthorizer{expiry: time.Hour}, nil)
+	caCertificates := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	trusting, err := NewTransport(caCertificates)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	untrusting, err := NewTransport("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the certificates configured for one provider instance are not trusted by another
+	trustingClient := NewMsGraphHttpClient(trusting, authorizer, u.Host, 0)
+	untrustingClient := NewMsGraphHttpClient(untrusting, authorizer, u.Host, 0)
+
+	resp, err := trustingClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if _, err := untrustingClient.Get(server.URL); err == nil {
+		t.Fatalf("expected an error connecting to a server with an untrusted certificate")
+	}
+	if _, err := http.DefaultClient.Get(server.URL); err == nil {
+		t.Fatalf("expected http.DefaultClient not to trust the custom CA certificates")
+	}
+}
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 10
+
+	retryInitialBackoff = 1 * time.Second
+	retryMaxBackoff     = 64 * time.Second
+)
+
+// retryableStatusCodes are returned by Microsoft Graph when requests are throttled or the service is temporarily unavailable
+var retryableStatusCodes = []int{
+	http.StatusFailedDependency,   // 424, returned for throttled requests within a batch
+	http.StatusTooManyRequests,    // 429
+	http.StatusServiceUnavailable, // 503
+}
+
+// RetryTransport is an http.RoundTripper which retries throttled requests, honouring any Retry-After header returned
+// by the API, and otherwise backing off exponentially with jitter. Waits are cancelled along with the request context.
+type RetryTransport struct {
+	Base       http.RoundTripper
+	MaxRetries int
+}
+
+// NewRetryTransport returns a RetryTransport wrapping the specified RoundTripper. When base is already a RetryTransport,
+// its underlying RoundTripper is wrapped instead, so that retries are not compounded.
+func NewRetryTransport(base http.RoundTripper, maxRetries int) *RetryTransport {
+	if t, ok := base.(*RetryTransport); ok {
+		base = t.Base
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &RetryTransport{
+		Base:       base,
+		MaxRetries: maxRetries,
+	}
+}
+
+// RoundTrip sends the request, retrying up to MaxRetries times whilst the API returns a retryable status. Once retries
+// are exhausted, an error is returned rather than the throttled response, so that callers do not retry again.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return nil, fmt.Errorf("unable to retry %s request to %s: request body cannot be rewound", req.Method, req.URL)
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("unable to retry %s request to %s: rewinding request body: %v", req.Method, req.URL, err)
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := t.Base.RoundTrip(r)
+		if err != nil || !isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+
+		if attempt >= t.MaxRetries {
+			drainBody(resp.Body)
+			return nil, fmt.Errorf("%s request to %s was throttled (HTTP status %d) and did not succeed after %d retries, consider increasing `max_retries` (%s)", req.Method, req.URL.Host, resp.StatusCode, t.MaxRetries, CorrelationIds(resp))
+		}
+
+		delay := retryAfter(resp.Header.Get("Retry-After"))
+		if delay == 0 {
+			delay = retryBackoff(attempt)
+		}
+		drainBody(resp.Body)
+
+		log.Printf("[DEBUG] %s request to %s returned HTTP status %d, retrying in %s (retry %d of %d)", req.Method, req.URL, resp.StatusCode, delay, attempt+1, t.MaxRetries)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func isRetryableStatus(status int) bool {
+	for _, v := range retryableStatusCodes {
+		if status == v {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter parses the value of a Retry-After header, which can be either a number of seconds or an HTTP date
+func retryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.ParseFloat(v, 64); err == nil {
+		if secs > 0 {
+			return time.Duration(secs * float64(time.Second))
+		}
+		return 0
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// retryBackoff returns an exponential backoff for the specified attempt, capped at retryMaxBackoff, with up to half of
+// the delay randomised so that concurrent requests do not retry in lockstep
+func retryBackoff(attempt int) time.Duration {
+	backoff := retryMaxBackoff
+	if attempt < 7 {
+		if b := retryInitialBackoff << uint(attempt); b < retryMaxBackoff {
+			backoff = b
+		}
+	}
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func drainBody(body io.ReadCloser) {
+	if body == nil {
+		return
+	}
+	_, _ = io.Copy(ioutil.Discard, body)
+	_ = body.Close()
+}
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport_retriesThrottledRequests(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			w.Header().Set("Retry-After", "0.01")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(nil, 5)}
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(bodies))
+	}
+	for i, b := range bodies {
+		if b != "payload" {
+			t.Fatalf("expected request %d to have body %q, got %q", i, "payload", b)
+		}
+	}
+}
+
+func TestRetryTransport_maxRetriesExceeded(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0.01")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(nil, 2)}
+	if _, err := client.Get(server.URL); err == nil {
+		t.Fatal("expected an error once retries were exhausted")
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryTransport_contextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	client := &http.Client{Transport: NewRetryTransport(nil, 5)}
+	if _, err := client.Do(req); err == nil {
+		t.Fatal("expected an error when the context was cancelled")
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Fatalf("expected the retry wait to be cancelled, took %s", d)
+	}
+}
+
+func TestNewRetryTransport_doesNotCompound(t *testing.T) {
+	first := NewRetryTransport(nil, 1)
+	second := NewRetryTransport(first, 3)
+	if _, ok := second.Base.(*RetryTransport); ok {
+		t.Fatal("expected RetryTransport not to wrap another RetryTransport")
+	}
+	if second.MaxRetries != 3 {
+		t.Fatalf("expected MaxRetries to be 3, got %d", second.MaxRetries)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Expected time.Duration
+	}{
+		{Value: "", Expected: 0},
+		{Value: "0", Expected: 0},
+		{Value: "5", Expected: 5 * time.Second},
+		{Value: "1.5", Expected: 1500 * time.Millisecond},
+		{Value: "invalid", Expected: 0},
+		{Value: "Wed, 21 Oct 2015 07:28:00 GMT", Expected: 0},
+	}
+
+	for _, tc := range cases {
+		if v := retryAfter(tc.Value); v != tc.Expected {
+			t.Fatalf("expected Retry-After %q to be %s, got %s", tc.Value, tc.Expected, v)
+		}
+	}
+
+	if v := retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)); v <= 0 || v > time.Minute {
+		t.Fatalf("expected Retry-After date to be within the next minute, got %s", v)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	for attempt := 0; attempt < 20; attempt++ {
+		max := retryMaxBackoff
+		if attempt < 7 && retryInitialBackoff<<uint(attempt) < max {
+			max = retryInitialBackoff << uint(attempt)
+		}
+		if v := retryBackoff(attempt); v < max/2 || v > max {
+			t.Fatalf("expected backoff for attempt %d to be between %s and %s, got %s", attempt, max/2, max, v)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"log"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NewAadGraphSender returns an autorest.Sender for a provider instance, which sends requests to Azure Active Directory
+// Graph, including token requests, using the specified transport. Each request and response is logged.
+// TODO: remove in v2.0
+func NewAadGraphSender(transport http.RoundTripper) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{Transport: transport}, withRequestLogging())
+}
+
+func withRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// strip the authorization header prior to logging the request
+			auth := r.Header.Get("Authorization")
+			if auth != "" {
+				r.Header.Del("Authorization")
+			}
+			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+				log.Printf("[DEBUG] AzureAD Request: \n%s\n", dump)
+			} else {
+				log.Printf("[DEBUG] AzureAD Request: %s to %s\n", r.Method, r.URL)
+			}
+			if auth != "" {
+				r.Header.Set("Authorization", auth)
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				if dump, err := httputil.DumpResponse(resp, true); err == nil {
+					log.Printf("[DEBUG] AzureAD Response for %s: \n%s\n", r.URL, dump)
+				} else {
+					log.Printf("[DEBUG] AzureAD Response: %s for %s\n", resp.Status, r.URL)
+				}
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+			}
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+)
+
+// NewTransport returns an http.Transport which honours the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+// When caCertificates contains one or more PEM-encoded certificates, these are trusted in addition to the system root
+// certificates, for example to allow connections via a TLS-intercepting proxy.
+func NewTransport(caCertificates string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+
+	if caCertificates == "" {
+		return transport, nil
+	}
+
+	pool, err := CertPoolWithCertificates(caCertificates)
+	if err != nil {
+		return nil, err
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.RootCAs = pool
+
+	return transport, nil
+}
+
+// CertPoolWithCertificates returns a copy of the system certificate pool, to which the specified PEM-encoded
+// certificates are added. An error is returned if any PEM block is not a valid certificate.
+func CertPoolWithCertificates(caCertificates string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	rest := []byte(caCertificates)
+	count := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block of type %q, expected %q", block.Type, "CERTIFICATE")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate %d: %v", count+1, err)
+		}
+		pool.AddCert(cert)
+		count++
+	}
+
+	if count == 0 {
+		return nil, fmt.Errorf("no PEM-encoded certificates were found")
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,70 @@
+package common
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewTransport_customCaCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// the test server certificate is not trusted by default
+	transport, err := NewTransport("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (&http.Client{Transport: transport}).Get(server.URL); err == nil {
+		t.Fatalf("expected an error connecting to a server with an untrusted certificate")
+	}
+
+	caCertificates := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	transport, err = NewTransport(caCertificates)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestCertPoolWithCertificates_invalid(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "not a certificate",
+			Expected: "no PEM-encoded certificates",
+		},
+		{
+			Input:    string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("key")})),
+			Expected: "unexpected PEM block",
+		},
+		{
+			Input:    string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")})),
+			Expected: "parsing certificate 1",
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := CertPoolWithCertificates(tc.Input)
+		if err == nil {
+			t.Fatalf("expected an error for input %q", tc.Input)
+		}
+		if !strings.Contains(err.Error(), tc.Expected) {
+			t.Fatalf("expected error containing %q, got %q", tc.Expected, err)
+		}
+	}
+}
@@ -202,7 +202,9 @@ func ApplicationFindByName(ctx context.Context, client *graphrbac.ApplicationsCl
 	return nil, nil
 }
 
-func ApplicationSetOwnersTo(ctx context.Context, client *graphrbac.ApplicationsClient, id string, desiredOwners []string) error {
+// ApplicationSetOwnersTo ensures the owners of an application match the desired owners. When managedOwners is not nil,
+// only existing owners which appear in managedOwners will be removed, so that owners added out-of-band are retained.
+func ApplicationSetOwnersTo(ctx context.Context, client *graphrbac.ApplicationsClient, id string, desiredOwners []string, managedOwners *[]string) error {
 	existingOwners, err := ApplicationAllOwners(ctx, client, id)
 	if err != nil {
 		return err
@@ -211,6 +213,10 @@ func ApplicationSetOwnersTo(ctx context.Context, client *graphrbac.ApplicationsC
 	ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
 	ownersToAdd := utils.Difference(desiredOwners, existingOwners)
 
+	if managedOwners != nil {
+		ownersForRemoval = utils.Intersection(ownersForRemoval, *managedOwners)
+	}
+
 	// add owners first to prevent a possible situation where terraform revokes its own access before adding it back.
 	if err := ApplicationAddOwners(ctx, client, id, ownersToAdd); err != nil {
 		return err
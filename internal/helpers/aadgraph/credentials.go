@@ -55,7 +55,7 @@ func PasswordCredentialForResource(d *schema.ResourceData) (*graphrbac.PasswordC
 		if err != nil {
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
 		}
-		endDate = time.Now().Add(d)
+		endDate = utils.RelativeEndTime(d)
 	} else {
 		// MS Graph compatibility: default the end date to T + 2 years
 		endDate = time.Now().Add(17520 * time.Hour)
@@ -81,6 +81,13 @@ func PasswordCredentialForResource(d *schema.ResourceData) (*graphrbac.PasswordC
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
 		}
 		credential.StartDate = &date.Time{Time: startDate}
+	} else if v, ok := d.GetOk("start_date_relative"); ok && v.(string) != "" {
+		d, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `start_date_relative` (%q) as a duration", v), attr: "start_date_relative"}
+		}
+		startDate := utils.RelativeStartTime(d)
+		credential.StartDate = &date.Time{Time: startDate}
 	}
 
 	return &credential, nil
@@ -153,6 +160,32 @@ func PasswordCredentialResultRemoveByKeyId(existing graphrbac.PasswordCredential
 	return &newCreds, nil
 }
 
+// PasswordCredentialResultExpireByKeyId returns the existing credentials with the end date of the credential matching
+// `keyId` brought forward to `endDate`. Credentials already expiring before `endDate` are left unchanged.
+func PasswordCredentialResultExpireByKeyId(existing graphrbac.PasswordCredentialListResult, keyId string, endDate time.Time) (*[]graphrbac.PasswordCredential, error) {
+	if keyId == "" {
+		return nil, fmt.Errorf("ID of key to be expired is empty")
+	}
+
+	newCreds := make([]graphrbac.PasswordCredential, 0)
+
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.KeyID == nil {
+				continue
+			}
+
+			if *v.KeyID == keyId && (v.EndDate == nil || v.EndDate.Time.After(endDate)) {
+				v.EndDate = &date.Time{Time: endDate}
+			}
+
+			newCreds = append(newCreds, v)
+		}
+	}
+
+	return &newCreds, nil
+}
+
 func WaitForPasswordCredentialReplication(ctx context.Context, keyId string, timeout time.Duration, f func() (graphrbac.PasswordCredentialListResult, error)) (interface{}, error) {
 	return (&resource.StateChangeConf{
 		Pending:                   []string{"NotFound"},
@@ -186,7 +219,7 @@ func KeyCredentialForResource(d *schema.ResourceData) (*graphrbac.KeyCredential,
 	var encodedValue string
 	encoding := d.Get("encoding").(string)
 	switch encoding {
-	case "base64":
+	case "base64", "der":
 		der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode base64 certificate data")
@@ -218,6 +251,27 @@ func KeyCredentialForResource(d *schema.ResourceData) (*graphrbac.KeyCredential,
 		encodedValue = base64.StdEncoding.EncodeToString(pemVal)
 	case "pem":
 		encodedValue = base64.StdEncoding.EncodeToString([]byte(value))
+	case "pkcs12":
+		if keyType != "AsymmetricX509Cert" {
+			return nil, CredentialError{str: "`type` must be `AsymmetricX509Cert` when using `pkcs12` encoding", attr: "type"}
+		}
+		pfx, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 PKCS#12 data")
+		}
+		der, err := utils.CertificateFromPKCS12(pfx, d.Get("password").(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to extract certificate from PKCS#12 data: %v", err), attr: "value"}
+		}
+		block := pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: der,
+		}
+		pemVal := pem.EncodeToMemory(&block)
+		if pemVal == nil {
+			return nil, fmt.Errorf("failed to PEM-encode certificate")
+		}
+		encodedValue = base64.StdEncoding.EncodeToString(pemVal)
 	}
 
 	// errors should be handled by the validation
@@ -245,7 +299,7 @@ func KeyCredentialForResource(d *schema.ResourceData) (*graphrbac.KeyCredential,
 		if err != nil {
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
 		}
-		endDate = time.Now().Add(d)
+		endDate = utils.RelativeEndTime(d)
 	} else {
 		return nil, CredentialError{str: "One of `end_date` or `end_date_relative` must be specified", attr: "end_date"}
 	}
@@ -264,6 +318,13 @@ func KeyCredentialForResource(d *schema.ResourceData) (*graphrbac.KeyCredential,
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
 		}
 		credential.StartDate = &date.Time{Time: startDate}
+	} else if v, ok := d.GetOk("start_date_relative"); ok && v.(string) != "" {
+		d, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `start_date_relative` (%q) as a duration", v), attr: "start_date_relative"}
+		}
+		startDate := utils.RelativeStartTime(d)
+		credential.StartDate = &date.Time{Time: startDate}
 	}
 
 	return &credential, nil
@@ -329,6 +390,67 @@ func KeyCredentialResultRemoveByKeyId(existing graphrbac.KeyCredentialListResult
 	return &newCreds, nil
 }
 
+// KeyCredentialResultExpireByKeyId returns the existing credentials with the end date of the credential matching
+// `keyId` brought forward to `endDate`. Credentials already expiring before `endDate` are left unchanged.
+func KeyCredentialResultExpireByKeyId(existing graphrbac.KeyCredentialListResult, keyId string, endDate time.Time) (*[]graphrbac.KeyCredential, error) {
+	if keyId == "" {
+		return nil, fmt.Errorf("ID of key to be expired is empty")
+	}
+
+	newCreds := make([]graphrbac.KeyCredential, 0)
+
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.KeyID == nil {
+				continue
+			}
+
+			if *v.KeyID == keyId && (v.EndDate == nil || v.EndDate.Time.After(endDate)) {
+				v.EndDate = &date.Time{Time: endDate}
+			}
+
+			newCreds = append(newCreds, v)
+		}
+	}
+
+	return &newCreds, nil
+}
+
+func KeyCredentialResultUpdateDatesByKeyId(existing graphrbac.KeyCredentialListResult, keyId string, startDate, endDate *time.Time) (*[]graphrbac.KeyCredential, error) {
+	if keyId == "" {
+		return nil, fmt.Errorf("ID of key to be updated is empty")
+	}
+
+	found := false
+	newCreds := make([]graphrbac.KeyCredential, 0)
+
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.KeyID == nil {
+				continue
+			}
+
+			if *v.KeyID == keyId {
+				found = true
+				if startDate != nil {
+					v.StartDate = &date.Time{Time: *startDate}
+				}
+				if endDate != nil {
+					v.EndDate = &date.Time{Time: *endDate}
+				}
+			}
+
+			newCreds = append(newCreds, v)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("key with ID %q was not found", keyId)
+	}
+
+	return &newCreds, nil
+}
+
 func WaitForKeyCredentialReplication(ctx context.Context, keyId string, timeout time.Duration, f func() (graphrbac.KeyCredentialListResult, error)) (interface{}, error) {
 	return (&resource.StateChangeConf{
 		Pending:                   []string{"NotFound"},
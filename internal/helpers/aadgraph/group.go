@@ -14,7 +14,19 @@ import (
 )
 
 func GroupGetByDisplayName(ctx context.Context, client *graphrbac.GroupsClient, displayName string, mailEnabled *bool, securityEnabled *bool) (*graphrbac.ADGroup, error) {
-	filter := fmt.Sprintf("displayName eq '%s'", displayName)
+	return groupGetByProperty(ctx, client, "displayName", displayName, func(g graphrbac.ADGroup) *string { return g.DisplayName }, mailEnabled, securityEnabled)
+}
+
+func GroupGetByMail(ctx context.Context, client *graphrbac.GroupsClient, mail string, mailEnabled *bool, securityEnabled *bool) (*graphrbac.ADGroup, error) {
+	return groupGetByProperty(ctx, client, "mail", mail, func(g graphrbac.ADGroup) *string { return g.Mail }, mailEnabled, securityEnabled)
+}
+
+func GroupGetByMailNickname(ctx context.Context, client *graphrbac.GroupsClient, mailNickname string, mailEnabled *bool, securityEnabled *bool) (*graphrbac.ADGroup, error) {
+	return groupGetByProperty(ctx, client, "mailNickname", mailNickname, func(g graphrbac.ADGroup) *string { return g.MailNickname }, mailEnabled, securityEnabled)
+}
+
+func groupGetByProperty(ctx context.Context, client *graphrbac.GroupsClient, property, value string, getter func(graphrbac.ADGroup) *string, mailEnabled *bool, securityEnabled *bool) (*graphrbac.ADGroup, error) {
+	filter := fmt.Sprintf("%s eq '%s'", property, value)
 
 	if mailEnabled != nil {
 		filter = fmt.Sprintf("%s and mailEnabled eq %t", filter, *mailEnabled)
@@ -36,16 +48,17 @@ func GroupGetByDisplayName(ctx context.Context, client *graphrbac.GroupsClient,
 	if len(*values) == 0 {
 		return nil, fmt.Errorf("found no Groups matching %q", filter)
 	}
-	if len(*values) > 2 {
+	if len(*values) > 1 {
 		return nil, fmt.Errorf("found multiple Groups matching %q", filter)
 	}
 
 	group := (*values)[0]
-	if group.DisplayName == nil {
-		return nil, fmt.Errorf("nil DisplayName for Group matching %q", filter)
+	actual := getter(group)
+	if actual == nil {
+		return nil, fmt.Errorf("nil %s for Group matching %q", property, filter)
 	}
-	if !strings.EqualFold(*group.DisplayName, displayName) {
-		return nil, fmt.Errorf("displayname for Group matching %q does not match (%q!=%q)", filter, *group.DisplayName, displayName)
+	if !strings.EqualFold(*actual, value) {
+		return nil, fmt.Errorf("%s for Group matching %q does not match (%q!=%q)", property, filter, *actual, value)
 	}
 
 	return &group, nil
@@ -229,7 +242,7 @@ func GroupAddOwners(ctx context.Context, client *graphrbac.GroupsClient, groupId
 	return nil
 }
 
-func GroupFindByName(ctx context.Context, client *graphrbac.GroupsClient, name string) (*graphrbac.ADGroup, error) {
+func GroupFindByName(ctx context.Context, client *graphrbac.GroupsClient, name string, caseSensitive bool) (*graphrbac.ADGroup, error) {
 	nameFilter := fmt.Sprintf("displayName eq '%s'", name)
 	resp, err := client.List(ctx, nameFilter)
 
@@ -238,7 +251,10 @@ func GroupFindByName(ctx context.Context, client *graphrbac.GroupsClient, name s
 	}
 
 	for _, group := range resp.Values() {
-		if group.DisplayName != nil && *group.DisplayName == name {
+		if group.DisplayName == nil {
+			continue
+		}
+		if (caseSensitive && *group.DisplayName == name) || (!caseSensitive && strings.EqualFold(*group.DisplayName, name)) {
 			return &group, nil
 		}
 	}
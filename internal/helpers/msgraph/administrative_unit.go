@@ -0,0 +1,134 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// AdministrativeUnitCreateGroup creates a new group as a member of the administrative unit with the specified ID, so
+// that administrators scoped to the administrative unit are able to create and manage the group.
+func AdministrativeUnitCreateGroup(ctx context.Context, client msgraph.Client, administrativeUnitId string, group msgraph.Group) (*msgraph.Group, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		ODataType string `json:"@odata.type"`
+		msgraph.Group
+	}{
+		ODataType: "#microsoft.graph.group",
+		Group:     group,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/administrativeUnits/%s/members", administrativeUnitId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newGroup msgraph.Group
+	if err := json.Unmarshal(respBody, &newGroup); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newGroup, status, nil
+}
+
+// AdministrativeUnitAddMember adds the directory object with the specified ID to an administrative unit
+func AdministrativeUnitAddMember(ctx context.Context, client msgraph.Client, administrativeUnitId, memberId string) (int, error) {
+	body, err := json.Marshal(struct {
+		Member string `json:"@odata.id"`
+	}{
+		Member: fmt.Sprintf("%s/%s/directoryObjects/%s", client.Endpoint, client.ApiVersion, memberId),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/administrativeUnits/%s/members/$ref", administrativeUnitId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// AdministrativeUnitRemoveMember removes the directory object with the specified ID from an administrative unit
+func AdministrativeUnitRemoveMember(ctx context.Context, client msgraph.Client, administrativeUnitId, memberId string) (int, error) {
+	_, status, _, err := client.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/administrativeUnits/%s/members/%s/$ref", administrativeUnitId, memberId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// GroupAdministrativeUnitIds returns the IDs of the administrative units that the group with the specified ID is a
+// member of. All pages of results are retrieved.
+func GroupAdministrativeUnitIds(ctx context.Context, client msgraph.Client, id string) ([]string, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s/memberOf/microsoft.graph.administrativeUnit", id),
+			Params:      url.Values{"$select": []string{"id"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Value []struct {
+			ID *string `json:"id"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	ids := make([]string, 0, len(data.Value))
+	for _, v := range data.Value {
+		if v.ID != nil {
+			ids = append(ids, *v.ID)
+		}
+	}
+
+	return ids, status, nil
+}
@@ -0,0 +1,86 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestAdministrativeUnitGroups(t *testing.T) {
+	var addedMember string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.0/tenant/administrativeUnits/au1/members":
+			body, _ := ioutil.ReadAll(r.Body)
+			var group map[string]interface{}
+			if err := json.Unmarshal(body, &group); err != nil || group["@odata.type"] != "#microsoft.graph.group" || group["displayName"] != "example" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"group1","displayName":"example"}`))
+		case "/v1.0/tenant/administrativeUnits/au2/members/$ref":
+			body, _ := ioutil.ReadAll(r.Body)
+			var ref map[string]string
+			_ = json.Unmarshal(body, &ref)
+			addedMember = ref["@odata.id"]
+			w.WriteHeader(http.StatusNoContent)
+		case "/v1.0/tenant/administrativeUnits/au2/members/group1/$ref":
+			if r.Method != http.MethodDelete {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "/v1.0/tenant/groups/group1/memberOf/microsoft.graph.administrativeUnit":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"value":[{"id":"au1"},{"id":"au2"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	group, _, err := AdministrativeUnitCreateGroup(context.Background(), client, "au1", msgraph.Group{DisplayName: utils.String("example")})
+	if err != nil {
+		t.Fatalf("unexpected error creating group: %v", err)
+	}
+	if group.ID == nil || *group.ID != "group1" {
+		t.Fatalf("expected group with ID %q, got %v", "group1", group.ID)
+	}
+
+	if _, err := AdministrativeUnitAddMember(context.Background(), client, "au2", "group1"); err != nil {
+		t.Fatalf("unexpected error adding member: %v", err)
+	}
+	if expected := server.URL + "/v1.0/directoryObjects/group1"; addedMember != expected {
+		t.Fatalf("expected member %q, got %q", expected, addedMember)
+	}
+
+	ids, _, err := GroupAdministrativeUnitIds(context.Background(), client, "group1")
+	if err != nil {
+		t.Fatalf("unexpected error listing administrative units: %v", err)
+	}
+	if expected := []string{"au1", "au2"}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected administrative units %v, got %v", expected, ids)
+	}
+
+	if _, err := AdministrativeUnitRemoveMember(context.Background(), client, "au2", "group1"); err != nil {
+		t.Fatalf("unexpected error removing member: %v", err)
+	}
+
+	if _, status, err := GroupAdministrativeUnitIds(context.Background(), client, "missing"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
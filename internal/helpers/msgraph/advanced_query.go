@@ -0,0 +1,112 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+// AdvancedQuerySearchParam returns a value for the $search parameter. Each clause of a search expression must be
+// enclosed in double quotes, e.g. `"displayName:sales"`, so expressions without any quotes are quoted as a single clause.
+func AdvancedQuerySearchParam(search string) string {
+	search = strings.TrimSpace(search)
+	if strings.Contains(search, `"`) {
+		return search
+	}
+	return fmt.Sprintf("%q", search)
+}
+
+// ApplicationsSearch returns the applications matching the specified $search expression, such as `displayName:sales`
+func ApplicationsSearch(ctx context.Context, client msgraph.Client, search string) ([]msgraph.Application, int, error) {
+	var applications []msgraph.Application
+	status, err := advancedQuerySearch(ctx, client, "/applications", search, "", &applications)
+	return applications, status, err
+}
+
+// GroupsSearch returns the groups matching the specified $search expression, such as `displayName:sales`
+func GroupsSearch(ctx context.Context, client msgraph.Client, search string) ([]msgraph.Group, int, error) {
+	var groups []msgraph.Group
+	status, err := advancedQuerySearch(ctx, client, "/groups", search, "", &groups)
+	return groups, status, err
+}
+
+// UsersSearch returns the users matching the specified $search expression, such as `displayName:sales`
+func UsersSearch(ctx context.Context, client msgraph.Client, search string) ([]msgraph.User, int, error) {
+	var users []msgraph.User
+	status, err := advancedQuerySearch(ctx, client, "/users", search, "", &users)
+	return users, status, err
+}
+
+// ServicePrincipalsSearch returns the service principals matching the specified $search expression, such as
+// `displayName:sales`, and/or the specified $filter expression. At least one of search or filter must be specified.
+func ServicePrincipalsSearch(ctx context.Context, client msgraph.Client, search, filter string) ([]msgraph.ServicePrincipal, int, error) {
+	var servicePrincipals []msgraph.ServicePrincipal
+	status, err := advancedQuerySearch(ctx, client, "/servicePrincipals", search, filter, &servicePrincipals)
+	return servicePrincipals, status, err
+}
+
+// TagsFilter returns a $filter expression matching objects which have all of the specified tags.
+func TagsFilter(tags []string) string {
+	clauses := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		clauses = append(clauses, fmt.Sprintf("tags/any(t:t eq '%s')", strings.ReplaceAll(tag, "'", "''")))
+	}
+	return strings.Join(clauses, " and ")
+}
+
+// advancedQuerySearch lists the objects in a collection matching a $search and/or $filter expression, and unmarshals
+// them into out.
+// Searching is an advanced query capability of directory objects, which requires the `ConsistencyLevel: eventual`
+// header. The $count parameter is not sent, since the count is returned as a number which the SDK cannot parse.
+// All pages of results are retrieved.
+func advancedQuerySearch(ctx context.Context, client msgraph.Client, entity, search, filter string, out interface{}) (int, error) {
+	ctx = common.WithRequestHeaders(ctx, http.Header{"ConsistencyLevel": []string{"eventual"}})
+
+	params := url.Values{}
+	if search != "" {
+		params.Add("$search", AdvancedQuerySearchParam(search))
+	}
+	if filter != "" {
+		params.Add("$filter", filter)
+	}
+
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			Params:      params,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	if len(data.Value) == 0 {
+		return status, nil
+	}
+	if err := json.Unmarshal(data.Value, out); err != nil {
+		return status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return status, nil
+}
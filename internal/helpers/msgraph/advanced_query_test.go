@@ -0,0 +1,108 @@
+package msgraph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+func TestAdvancedQuerySearchParam(t *testing.T) {
+	cases := map[string]string{
+		"displayName:sales":                     `"displayName:sales"`,
+		" displayName:sales ":                   `"displayName:sales"`,
+		`"displayName:sales"`:                   `"displayName:sales"`,
+		`"displayName:sales" OR "mail:sales"`:   `"displayName:sales" OR "mail:sales"`,
+		"description:finance and accounting":    `"description:finance and accounting"`,
+		`"description:finance" AND "mail:team"`: `"description:finance" AND "mail:team"`,
+	}
+
+	for search, expected := range cases {
+		if v := AdvancedQuerySearchParam(search); v != expected {
+			t.Errorf("for search %q: expected %s, got %s", search, expected, v)
+		}
+	}
+}
+
+func TestGroupsSearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.0/tenant/groups" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("ConsistencyLevel") != "eventual" || r.URL.Query().Get("$search") != `"displayName:sales"` {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_UnsupportedQuery","message":"Unsupported query."}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value":[{"id":"group1","displayName":"Sales"},{"id":"group2","displayName":"Sales EMEA"}]}`))
+	}))
+	defer server.Close()
+
+	// The ConsistencyLevel header is added by the correlation transport, which wraps http.DefaultClient for the provider
+	defaultClient := http.DefaultClient
+	http.DefaultClient = &http.Client{Transport: common.NewCorrelationTransport(nil)}
+	defer func() { http.DefaultClient = defaultClient }()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	groups, _, err := GroupsSearch(context.Background(), client, "displayName:sales")
+	if err != nil {
+		t.Fatalf("unexpected error searching groups: %v", err)
+	}
+	if len(groups) != 2 || groups[1].ID == nil || *groups[1].ID != "group2" {
+		t.Fatalf("unexpected groups returned: %+v", groups)
+	}
+}
+
+func TestTagsFilter(t *testing.T) {
+	cases := []struct {
+		tags     []string
+		expected string
+	}{
+		{tags: []string{}, expected: ""},
+		{tags: []string{"WindowsAzureActiveDirectoryIntegratedApp"}, expected: "tags/any(t:t eq 'WindowsAzureActiveDirectoryIntegratedApp')"},
+		{tags: []string{"owner:team", "it's"}, expected: "tags/any(t:t eq 'owner:team') and tags/any(t:t eq 'it''s')"},
+	}
+
+	for _, tc := range cases {
+		if v := TagsFilter(tc.tags); v != tc.expected {
+			t.Errorf("for tags %v: expected %q, got %q", tc.tags, tc.expected, v)
+		}
+	}
+}
+
+func TestServicePrincipalsSearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.0/tenant/servicePrincipals" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if _, ok := r.URL.Query()["$search"]; ok || r.URL.Query().Get("$filter") != "tags/any(t:t eq 'example')" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_UnsupportedQuery","message":"Unsupported query."}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value":[{"id":"sp1","appId":"app1","tags":["example"]}]}`))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	servicePrincipals, _, err := ServicePrincipalsSearch(context.Background(), client, "", TagsFilter([]string{"example"}))
+	if err != nil {
+		t.Fatalf("unexpected error searching service principals: %v", err)
+	}
+	if len(servicePrincipals) != 1 || servicePrincipals[0].ID == nil || *servicePrincipals[0].ID != "sp1" {
+		t.Fatalf("unexpected service principals returned: %+v", servicePrincipals)
+	}
+}
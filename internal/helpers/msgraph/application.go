@@ -2,13 +2,19 @@ package msgraph
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"log"
 	"net/http"
+	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/manicminer/hamilton/msgraph"
 
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
@@ -238,8 +244,6 @@ func ApplicationSetAppRoles(ctx context.Context, client *msgraph.ApplicationsCli
 		newRoles = &[]msgraph.AppRole{}
 	}
 
-	// Roles must be disabled before they can be edited or removed.
-	// Since we cannot match them by ID, we have to disable all the roles, and replace them in one pass.
 	app, status, err := client.Get(ctx, *application.ID)
 	if err != nil {
 		if status == http.StatusNotFound {
@@ -254,23 +258,14 @@ func ApplicationSetAppRoles(ctx context.Context, client *msgraph.ApplicationsCli
 		return nil
 	}
 
-	// first disable any existing roles
-	if app.AppRoles != nil && len(*app.AppRoles) > 0 {
-		properties := msgraph.Application{
-			ID:       application.ID,
-			AppRoles: app.AppRoles,
-		}
-
-		for _, role := range *properties.AppRoles {
-			*role.IsEnabled = false
-		}
-
-		if _, err := client.Update(ctx, properties); err != nil {
+	// App Roles must be disabled before they can be removed or their value or allowed member types changed, so first
+	// disable only those roles, then set the new roles which re-enables any which should remain enabled
+	if disable := AppRolesToDisable(app.AppRoles, newRoles); len(disable) > 0 {
+		if _, err := ApplicationDisableAppRoles(ctx, client, *application.ID, disable); err != nil {
 			return fmt.Errorf("disabling App Roles for Application with object ID %q: %+v", *application.ID, err)
 		}
 	}
 
-	// then set the new roles
 	properties := msgraph.Application{
 		ID:       application.ID,
 		AppRoles: newRoles,
@@ -283,6 +278,95 @@ func ApplicationSetAppRoles(ctx context.Context, client *msgraph.ApplicationsCli
 	return nil
 }
 
+// AppRolesToDisable returns the IDs of enabled roles in existing which are either absent from desired, or whose value or
+// allowed member types differ from the role with the same ID in desired. The API rejects the removal of such roles, or
+// changes to these properties, unless they are disabled first.
+func AppRolesToDisable(existing, desired *[]msgraph.AppRole) []string {
+	result := make([]string, 0)
+	if existing == nil {
+		return result
+	}
+
+	desiredById := make(map[string]msgraph.AppRole)
+	if desired != nil {
+		for _, role := range *desired {
+			if role.ID != nil {
+				desiredById[strings.ToLower(*role.ID)] = role
+			}
+		}
+	}
+
+	memberTypes := func(in *[]msgraph.AppRoleAllowedMemberType) []string {
+		out := make([]string, 0)
+		if in != nil {
+			for _, t := range *in {
+				out = append(out, string(t))
+			}
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	for _, role := range *existing {
+		if role.ID == nil || role.IsEnabled == nil || !*role.IsEnabled {
+			continue
+		}
+
+		d, ok := desiredById[strings.ToLower(*role.ID)]
+		if !ok {
+			result = append(result, *role.ID)
+			continue
+		}
+
+		var existingValue, desiredValue string
+		if role.Value != nil {
+			existingValue = *role.Value
+		}
+		if d.Value != nil {
+			desiredValue = *d.Value
+		}
+		if existingValue != desiredValue || !reflect.DeepEqual(memberTypes(role.AllowedMemberTypes), memberTypes(d.AllowedMemberTypes)) {
+			result = append(result, *role.ID)
+		}
+	}
+
+	return result
+}
+
+// ApplicationDisableAppRoles disables the App Roles of an application having the specified IDs, leaving all other roles
+// unchanged. No update is made when none of the roles are enabled.
+func ApplicationDisableAppRoles(ctx context.Context, client *msgraph.ApplicationsClient, id string, roleIds []string) (int, error) {
+	return ApplicationModify(ctx, client, id, func(app *msgraph.Application) (*msgraph.Application, error) {
+		if app.AppRoles == nil {
+			return nil, nil
+		}
+
+		changed := false
+		roles := make([]msgraph.AppRole, 0, len(*app.AppRoles))
+		for _, role := range *app.AppRoles {
+			if role.ID != nil && role.IsEnabled != nil && *role.IsEnabled {
+				for _, roleId := range roleIds {
+					if strings.EqualFold(*role.ID, roleId) {
+						role.IsEnabled = utils.Bool(false)
+						changed = true
+						break
+					}
+				}
+			}
+			roles = append(roles, role)
+		}
+
+		if !changed {
+			return nil, nil
+		}
+
+		return &msgraph.Application{
+			ID:       app.ID,
+			AppRoles: &roles,
+		}, nil
+	})
+}
+
 func ApplicationSetOAuth2PermissionScopes(ctx context.Context, client *msgraph.ApplicationsClient, application *msgraph.Application, newScopes *[]msgraph.PermissionScope) error {
 	if application.ID == nil {
 		return fmt.Errorf("Cannot use Application model with nil ID")
@@ -292,9 +376,6 @@ func ApplicationSetOAuth2PermissionScopes(ctx context.Context, client *msgraph.A
 		newScopes = &[]msgraph.PermissionScope{}
 	}
 
-	// OAuth2 Permission Scopes must be disabled before they can be edited or removed.
-	// Since we cannot match them by ID, we have to disable all the scopes, and replace them in one pass.
-	// TODO: v2.0 don't do this! we should be able to find the updated ones by ID and disable them selectively as we update them
 	app, status, err := client.Get(ctx, *application.ID)
 	if err != nil {
 		if status == http.StatusNotFound {
@@ -304,30 +385,24 @@ func ApplicationSetOAuth2PermissionScopes(ctx context.Context, client *msgraph.A
 		return fmt.Errorf("retrieving Application with object ID %q: %+v", *application.ID, err)
 	}
 
+	var existingScopes *[]msgraph.PermissionScope
+	if app.Api != nil {
+		existingScopes = app.Api.OAuth2PermissionScopes
+	}
+
 	// don't update if no changes to be made
-	if app.Api != nil && app.Api.OAuth2PermissionScopes != nil && reflect.DeepEqual(*app.Api.OAuth2PermissionScopes, *newScopes) {
+	if existingScopes != nil && reflect.DeepEqual(*existingScopes, *newScopes) {
 		return nil
 	}
 
-	// first disable any existing scopes
-	if app.Api != nil && app.Api.OAuth2PermissionScopes != nil && len(*app.Api.OAuth2PermissionScopes) > 0 {
-		properties := msgraph.Application{
-			ID: application.ID,
-			Api: &msgraph.ApplicationApi{
-				OAuth2PermissionScopes: app.Api.OAuth2PermissionScopes,
-			},
-		}
-
-		for _, scope := range *properties.Api.OAuth2PermissionScopes {
-			*scope.IsEnabled = false
-		}
-
-		if _, err := client.Update(ctx, properties); err != nil {
+	// OAuth2 Permission Scopes must be disabled before they can be removed or their value changed, so first disable
+	// only those scopes, then set the new scopes which re-enables any which should remain enabled
+	if disable := OAuth2PermissionScopesToDisable(existingScopes, newScopes); len(disable) > 0 {
+		if _, err := ApplicationDisableOAuth2PermissionScopes(ctx, client, *application.ID, disable); err != nil {
 			return fmt.Errorf("disabling OAuth2 Permission Scopes for Application with object ID %q: %+v", *application.ID, err)
 		}
 	}
 
-	// then set the new scopes
 	properties := msgraph.Application{
 		ID: application.ID,
 		Api: &msgraph.ApplicationApi{
@@ -342,7 +417,89 @@ func ApplicationSetOAuth2PermissionScopes(ctx context.Context, client *msgraph.A
 	return nil
 }
 
-func ApplicationSetOwners(ctx context.Context, client *msgraph.ApplicationsClient, application *msgraph.Application, desiredOwners []string) error {
+// OAuth2PermissionScopesToDisable returns the IDs of enabled scopes in existing which are either absent from desired, or
+// whose value differs from the scope with the same ID in desired. The API rejects the removal of such scopes, or changes
+// to their value, unless they are disabled first.
+func OAuth2PermissionScopesToDisable(existing, desired *[]msgraph.PermissionScope) []string {
+	result := make([]string, 0)
+	if existing == nil {
+		return result
+	}
+
+	desiredById := make(map[string]msgraph.PermissionScope)
+	if desired != nil {
+		for _, scope := range *desired {
+			if scope.ID != nil {
+				desiredById[strings.ToLower(*scope.ID)] = scope
+			}
+		}
+	}
+
+	for _, scope := range *existing {
+		if scope.ID == nil || scope.IsEnabled == nil || !*scope.IsEnabled {
+			continue
+		}
+
+		d, ok := desiredById[strings.ToLower(*scope.ID)]
+		if !ok {
+			result = append(result, *scope.ID)
+			continue
+		}
+
+		var existingValue, desiredValue string
+		if scope.Value != nil {
+			existingValue = *scope.Value
+		}
+		if d.Value != nil {
+			desiredValue = *d.Value
+		}
+		if existingValue != desiredValue {
+			result = append(result, *scope.ID)
+		}
+	}
+
+	return result
+}
+
+// ApplicationDisableOAuth2PermissionScopes disables the OAuth2 Permission Scopes of an application having the specified
+// IDs, leaving all other scopes unchanged. No update is made when none of the scopes are enabled.
+func ApplicationDisableOAuth2PermissionScopes(ctx context.Context, client *msgraph.ApplicationsClient, id string, scopeIds []string) (int, error) {
+	return ApplicationModify(ctx, client, id, func(app *msgraph.Application) (*msgraph.Application, error) {
+		if app.Api == nil || app.Api.OAuth2PermissionScopes == nil {
+			return nil, nil
+		}
+
+		changed := false
+		scopes := make([]msgraph.PermissionScope, 0, len(*app.Api.OAuth2PermissionScopes))
+		for _, scope := range *app.Api.OAuth2PermissionScopes {
+			if scope.ID != nil && scope.IsEnabled != nil && *scope.IsEnabled {
+				for _, scopeId := range scopeIds {
+					if strings.EqualFold(*scope.ID, scopeId) {
+						scope.IsEnabled = utils.Bool(false)
+						changed = true
+						break
+					}
+				}
+			}
+			scopes = append(scopes, scope)
+		}
+
+		if !changed {
+			return nil, nil
+		}
+
+		return &msgraph.Application{
+			ID: app.ID,
+			Api: &msgraph.ApplicationApi{
+				OAuth2PermissionScopes: &scopes,
+			},
+		}, nil
+	})
+}
+
+// ApplicationSetOwners ensures the owners of an application match the desired owners. When managedOwners is not nil,
+// only existing owners which appear in managedOwners will be removed, so that owners added out-of-band are retained.
+func ApplicationSetOwners(ctx context.Context, client *msgraph.ApplicationsClient, application *msgraph.Application, desiredOwners []string, managedOwners *[]string) error {
 	if application.ID == nil {
 		return fmt.Errorf("Cannot use Application model with nil ID")
 	}
@@ -356,12 +513,18 @@ func ApplicationSetOwners(ctx context.Context, client *msgraph.ApplicationsClien
 	ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
 	ownersToAdd := utils.Difference(desiredOwners, existingOwners)
 
+	if managedOwners != nil {
+		ownersForRemoval = utils.Intersection(ownersForRemoval, *managedOwners)
+	}
+
 	if ownersToAdd != nil {
 		for _, m := range ownersToAdd {
 			application.AppendOwner(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, m)
 		}
 
-		if _, err := client.AddOwners(ctx, application); err != nil {
+		if err := WaitForReferenceReplication(ctx, func() (int, error) {
+			return client.AddOwners(ctx, application)
+		}); err != nil {
 			return fmt.Errorf("adding owners to Application with object ID %q: %+v", *application.ID, err)
 		}
 	}
@@ -416,3 +579,196 @@ func OAuth2PermissionFindById(app *msgraph.Application, scopeId string) (*msgrap
 
 	return nil, nil
 }
+
+// applicationModifyMaxAttempts limits how many times an update is reattempted when an application is modified concurrently
+const applicationModifyMaxAttempts = 5
+
+// ApplicationGetWithEtag retrieves an application along with its current ETag, which is empty if the API did not return one
+func ApplicationGetWithEtag(ctx context.Context, client *msgraph.ApplicationsClient, id string) (*msgraph.Application, string, int, error) {
+	resp, status, o, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, "", status, fmt.Errorf("ApplicationsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+
+	var app msgraph.Application
+	if err := json.Unmarshal(respBody, &app); err != nil {
+		return nil, "", status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	etag := resp.Header.Get("ETag")
+	if etag == "" && o != nil && o.Etag != nil {
+		etag = *o.Etag
+	}
+
+	return &app, etag, status, nil
+}
+
+// ApplicationModify retrieves an application and calls modify to determine the properties to update, which are then
+// sent with an If-Match header containing the ETag of the retrieved application. Should the application be modified
+// elsewhere in the meantime, the API responds with 412 Precondition Failed and the application is retrieved again and
+// modify called with the latest version, so that concurrent changes to the same application are not lost. Once
+// applicationModifyMaxAttempts is reached, the 412 status and error are returned. When modify returns nil properties,
+// no update is made. Any error returned by modify is returned unchanged.
+//
+// Microsoft Graph does not currently return an ETag when retrieving applications, in which case the update is sent
+// without an If-Match header and is not protected against concurrent modification. This is logged as a warning.
+func ApplicationModify(ctx context.Context, client *msgraph.ApplicationsClient, id string, modify func(app *msgraph.Application) (*msgraph.Application, error)) (int, error) {
+	for attempt := 1; ; attempt++ {
+		app, etag, status, err := ApplicationGetWithEtag(ctx, client, id)
+		if err != nil {
+			return status, err
+		}
+
+		properties, err := modify(app)
+		if err != nil {
+			return status, err
+		}
+		if properties == nil {
+			return status, nil
+		}
+
+		updateCtx := ctx
+		if etag != "" {
+			updateCtx = common.WithRequestHeaders(ctx, http.Header{"If-Match": []string{etag}})
+		} else {
+			log.Printf("[WARN] No ETag was returned for application with object ID %q, so it will be updated without checking for concurrent modification", id)
+		}
+
+		status, err = client.Update(updateCtx, *properties)
+		if err != nil && status == http.StatusPreconditionFailed && attempt < applicationModifyMaxAttempts {
+			log.Printf("[DEBUG] Application with object ID %q was modified concurrently, retrying update (attempt %d of %d)", id, attempt, applicationModifyMaxAttempts)
+			continue
+		}
+
+		return status, err
+	}
+}
+
+// ApplicationGet retrieves the application with the specified object ID. When selectFields is not empty, only those
+// properties are requested, which reduces the size of the response and avoids requesting properties which may need
+// additional permissions.
+func ApplicationGet(ctx context.Context, client msgraph.Client, id string, selectFields []string) (*msgraph.Application, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s", id),
+			Params:      applicationSelectParams(selectFields),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var application msgraph.Application
+	if err := json.Unmarshal(respBody, &application); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &application, status, nil
+}
+
+// ApplicationList returns the applications matching the specified filter. When selectFields is not empty, only those
+// properties are requested. All pages of results are retrieved.
+func ApplicationList(ctx context.Context, client msgraph.Client, filter string, selectFields []string) (*[]msgraph.Application, int, error) {
+	params := applicationSelectParams(selectFields)
+	if filter != "" {
+		params.Add("$filter", filter)
+	}
+
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/applications",
+			Params:      params,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Applications []msgraph.Application `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Applications, status, nil
+}
+
+func applicationSelectParams(selectFields []string) url.Values {
+	params := url.Values{}
+	if len(selectFields) > 0 {
+		params.Add("$select", strings.Join(selectFields, ","))
+	}
+	return params
+}
+
+// ApplicationsOwnedBy returns the applications owned by the user or service principal with the specified object ID.
+// All pages of results are retrieved.
+func ApplicationsOwnedBy(ctx context.Context, client msgraph.Client, ownerId string) ([]msgraph.Application, int, error) {
+	objectType, status, err := DirectoryObjectType(ctx, client, ownerId)
+	if err != nil {
+		return nil, status, err
+	}
+
+	var collection string
+	switch objectType {
+	case "User":
+		collection = "users"
+	case "ServicePrincipal":
+		collection = "servicePrincipals"
+	default:
+		return nil, status, fmt.Errorf("directory object with ID %q is a %s, expected a User or ServicePrincipal", ownerId, objectType)
+	}
+
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/%s/%s/ownedObjects/microsoft.graph.application", collection, ownerId),
+			Params:      url.Values{"$select": []string{"id,appId,displayName"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Applications []msgraph.Application `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return data.Applications, status, nil
+}
@@ -0,0 +1,137 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const ApplicationTemplateSingleSignOnModeSaml = "saml"
+
+// ApplicationTemplate describes an application in the Azure AD application gallery.
+type ApplicationTemplate struct {
+	ID                         *string   `json:"id,omitempty"`
+	Categories                 *[]string `json:"categories,omitempty"`
+	Description                *string   `json:"description,omitempty"`
+	DisplayName                *string   `json:"displayName,omitempty"`
+	HomePageUrl                *string   `json:"homePageUrl,omitempty"`
+	LogoUrl                    *string   `json:"logoUrl,omitempty"`
+	Publisher                  *string   `json:"publisher,omitempty"`
+	SupportedProvisioningTypes *[]string `json:"supportedProvisioningTypes,omitempty"`
+	SupportedSingleSignOnModes *[]string `json:"supportedSingleSignOnModes,omitempty"`
+}
+
+// SupportsSingleSignOnMode returns true when the specified single sign-on mode is supported by the application.
+func (t ApplicationTemplate) SupportsSingleSignOnMode(mode string) bool {
+	if t.SupportedSingleSignOnModes == nil {
+		return false
+	}
+	for _, v := range *t.SupportedSingleSignOnModes {
+		if v == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplicationServicePrincipal is the application and service principal pair created when instantiating an application
+// template.
+type ApplicationServicePrincipal struct {
+	Application      *msgraph.Application      `json:"application,omitempty"`
+	ServicePrincipal *msgraph.ServicePrincipal `json:"servicePrincipal,omitempty"`
+}
+
+// ApplicationTemplateList returns the application templates matching the specified filter.
+func ApplicationTemplateList(ctx context.Context, client msgraph.Client, filter string) ([]ApplicationTemplate, int, error) {
+	params := url.Values{}
+	if filter != "" {
+		params.Add("$filter", filter)
+	}
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/applicationTemplates",
+			Params:      params,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		ApplicationTemplates []ApplicationTemplate `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return data.ApplicationTemplates, status, nil
+}
+
+// ApplicationTemplateGet retrieves the application template with the specified ID.
+func ApplicationTemplateGet(ctx context.Context, client msgraph.Client, id string) (*ApplicationTemplate, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applicationTemplates/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var template ApplicationTemplate
+	if err := json.Unmarshal(respBody, &template); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &template, status, nil
+}
+
+// ApplicationTemplateInstantiate creates an application and service principal from the application template with the
+// specified ID, using the specified display name.
+func ApplicationTemplateInstantiate(ctx context.Context, client msgraph.Client, id, displayName string) (*ApplicationServicePrincipal, int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		DisplayName string `json:"displayName"`
+	}{
+		DisplayName: displayName,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applicationTemplates/%s/instantiate", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var result ApplicationServicePrincipal
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &result, status, nil
+}
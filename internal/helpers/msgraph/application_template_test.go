@@ -0,0 +1,74 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestApplicationTemplateInstantiate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1.0/tenant/applicationTemplates/template/instantiate" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist."}}`))
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		var data struct {
+			DisplayName string `json:"displayName"`
+		}
+		_ = json.Unmarshal(body, &data)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"application":      map[string]string{"id": "app", "displayName": data.DisplayName},
+			"servicePrincipal": map[string]string{"id": "sp", "appDisplayName": data.DisplayName},
+		})
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	result, _, err := ApplicationTemplateInstantiate(context.Background(), client, "template", "example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Application == nil || result.Application.ID == nil || *result.Application.ID != "app" {
+		t.Fatalf("expected application with ID %q, got %+v", "app", result.Application)
+	}
+	if result.Application.DisplayName == nil || *result.Application.DisplayName != "example" {
+		t.Fatalf("expected application display name %q, got %+v", "example", result.Application.DisplayName)
+	}
+	if result.ServicePrincipal == nil || result.ServicePrincipal.ID == nil || *result.ServicePrincipal.ID != "sp" {
+		t.Fatalf("expected service principal with ID %q, got %+v", "sp", result.ServicePrincipal)
+	}
+}
+
+func TestApplicationTemplateSupportsSingleSignOnMode(t *testing.T) {
+	cases := []struct {
+		modes    *[]string
+		mode     string
+		expected bool
+	}{
+		{modes: nil, mode: "saml", expected: false},
+		{modes: &[]string{}, mode: "saml", expected: false},
+		{modes: &[]string{"password", "saml"}, mode: "saml", expected: true},
+		{modes: &[]string{"oidc", "notSupported"}, mode: "saml", expected: false},
+	}
+
+	for _, tc := range cases {
+		template := ApplicationTemplate{SupportedSingleSignOnModes: tc.modes}
+		if got := template.SupportsSingleSignOnMode(tc.mode); got != tc.expected {
+			t.Fatalf("expected SupportsSingleSignOnMode(%q) to return %t for %v, got %t", tc.mode, tc.expected, tc.modes, got)
+		}
+	}
+}
@@ -0,0 +1,533 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestApplicationModify_retriesWhenModifiedConcurrently(t *testing.T) {
+	var mu sync.Mutex
+	version := 1
+	roles := []string{"existing"}
+	patches := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			appRoles := make([]msgraph.AppRole, 0)
+			for _, v := range roles {
+				appRoles = append(appRoles, msgraph.AppRole{Value: utils.String(v)})
+			}
+			w.Header().Set("ETag", fmt.Sprintf(`W/"%d"`, version))
+			_ = json.NewEncoder(w).Encode(msgraph.Application{ID: utils.String("app"), AppRoles: &appRoles})
+
+		case http.MethodPatch:
+			patches++
+
+			// simulate another writer updating the application before our first update is received
+			if patches == 1 {
+				roles = append(roles, "concurrent")
+				version++
+			}
+
+			if r.Header.Get("If-Match") != fmt.Sprintf(`W/"%d"`, version) {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_, _ = w.Write([]byte(`{"error":{"code":"Request_PreconditionFailed","message":"The ETag does not match."}}`))
+				return
+			}
+
+			var app msgraph.Application
+			body, _ := ioutil.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &app)
+			roles = make([]string, 0)
+			for _, role := range *app.AppRoles {
+				roles = append(roles, *role.Value)
+			}
+			version++
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewApplicationsClient("tenant")
+	client.BaseClient.Endpoint = environments.ApiEndpoint(server.URL)
+	client.BaseClient.HttpClient = &http.Client{Transport: common.NewCorrelationTransport(nil)}
+
+	_, err := ApplicationModify(context.Background(), client, "app", func(app *msgraph.Application) (*msgraph.Application, error) {
+		appRoles := append(*app.AppRoles, msgraph.AppRole{Value: utils.String("added")})
+		return &msgraph.Application{ID: app.ID, AppRoles: &appRoles}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if patches != 2 {
+		t.Fatalf("expected 2 update attempts, got %d", patches)
+	}
+	expected := []string{"existing", "concurrent", "added"}
+	if len(roles) != len(expected) {
+		t.Fatalf("expected roles %v, got %v", expected, roles)
+	}
+	for i := range expected {
+		if roles[i] != expected[i] {
+			t.Fatalf("expected roles %v, got %v", expected, roles)
+		}
+	}
+}
+
+func TestApplicationModify_preconditionFailed(t *testing.T) {
+	patches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `W/"1"`)
+			_ = json.NewEncoder(w).Encode(msgraph.Application{ID: utils.String("app")})
+
+		case http.MethodPatch:
+			patches++
+			if v := r.Header.Get("If-Match"); v != `W/"1"` {
+				t.Errorf("expected If-Match header %q, got %q", `W/"1"`, v)
+			}
+
+			// the application is always modified elsewhere before the update is received
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_PreconditionFailed","message":"The ETag does not match."}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewApplicationsClient("tenant")
+	client.BaseClient.Endpoint = environments.ApiEndpoint(server.URL)
+	client.BaseClient.HttpClient = &http.Client{Transport: common.NewCorrelationTransport(nil)}
+
+	status, err := ApplicationModify(context.Background(), client, "app", func(app *msgraph.Application) (*msgraph.Application, error) {
+		return &msgraph.Application{ID: app.ID, DisplayName: utils.String("updated")}, nil
+	})
+	if err == nil {
+		t.Fatalf("expected an error when the application is always modified concurrently")
+	}
+	if status != http.StatusPreconditionFailed {
+		t.Fatalf("expected status %d, got %d", http.StatusPreconditionFailed, status)
+	}
+	if patches != applicationModifyMaxAttempts {
+		t.Fatalf("expected %d update attempts, got %d", applicationModifyMaxAttempts, patches)
+	}
+}
+
+func TestApplicationModify_withoutEtag(t *testing.T) {
+	patches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patches++
+			if v := r.Header.Get("If-Match"); v != "" {
+				t.Errorf("expected no If-Match header, got %q", v)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(msgraph.Application{ID: utils.String("app")})
+	}))
+	defer server.Close()
+
+	client := msgraph.NewApplicationsClient("tenant")
+	client.BaseClient.Endpoint = environments.ApiEndpoint(server.URL)
+	client.BaseClient.HttpClient = &http.Client{Transport: common.NewCorrelationTransport(nil)}
+
+	// without an ETag the update cannot be guarded, but is still made
+	if _, err := ApplicationModify(context.Background(), client, "app", func(app *msgraph.Application) (*msgraph.Application, error) {
+		return &msgraph.Application{ID: app.ID, DisplayName: utils.String("updated")}, nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patches != 1 {
+		t.Fatalf("expected 1 update attempt, got %d", patches)
+	}
+}
+
+func TestApplicationModify_noChanges(t *testing.T) {
+	patches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patches++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(msgraph.Application{ID: utils.String("app")})
+	}))
+	defer server.Close()
+
+	client := msgraph.NewApplicationsClient("tenant")
+	client.BaseClient.Endpoint = environments.ApiEndpoint(server.URL)
+
+	_, err := ApplicationModify(context.Background(), client, "app", func(app *msgraph.Application) (*msgraph.Application, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patches != 0 {
+		t.Fatalf("expected no update to be made, got %d", patches)
+	}
+}
+
+func TestApplicationGetAndList_selectFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("$select"); got != "id,displayName" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1.0/tenant/applications/app1":
+			_, _ = w.Write([]byte(`{"id":"app1","displayName":"first"}`))
+		case "/v1.0/tenant/applications":
+			if got := r.URL.Query().Get("$filter"); got != "displayName eq 'second'" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"value":[{"id":"app2","displayName":"second"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist."}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+	selectFields := []string{"id", "displayName"}
+
+	app, _, err := ApplicationGet(context.Background(), client, "app1", selectFields)
+	if err != nil {
+		t.Fatalf("unexpected error retrieving application: %v", err)
+	}
+	if app.DisplayName == nil || *app.DisplayName != "first" {
+		t.Fatalf("unexpected application returned: %+v", app)
+	}
+
+	apps, _, err := ApplicationList(context.Background(), client, "displayName eq 'second'", selectFields)
+	if err != nil {
+		t.Fatalf("unexpected error listing applications: %v", err)
+	}
+	if apps == nil || len(*apps) != 1 || (*apps)[0].ID == nil || *(*apps)[0].ID != "app2" {
+		t.Fatalf("unexpected applications returned: %+v", apps)
+	}
+
+	if _, status, err := ApplicationGet(context.Background(), client, "missing", selectFields); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a 404 error retrieving a missing application, got status %d: %v", status, err)
+	}
+}
+
+func TestOAuth2PermissionScopesToDisable(t *testing.T) {
+	scope := func(id, value string, enabled bool) msgraph.PermissionScope {
+		return msgraph.PermissionScope{ID: utils.String(id), Value: utils.String(value), IsEnabled: utils.Bool(enabled)}
+	}
+
+	testCases := []struct {
+		name     string
+		existing *[]msgraph.PermissionScope
+		desired  *[]msgraph.PermissionScope
+		expected []string
+	}{
+		{
+			name:     "no existing scopes",
+			existing: nil,
+			desired:  &[]msgraph.PermissionScope{scope("1", "read", true)},
+			expected: []string{},
+		},
+		{
+			name:     "unchanged value",
+			existing: &[]msgraph.PermissionScope{scope("1", "read", true)},
+			desired:  &[]msgraph.PermissionScope{scope("1", "read", false)},
+			expected: []string{},
+		},
+		{
+			name:     "changed value",
+			existing: &[]msgraph.PermissionScope{scope("1", "read", true), scope("2", "write", true)},
+			desired:  &[]msgraph.PermissionScope{scope("1", "read.all", true), scope("2", "write", true)},
+			expected: []string{"1"},
+		},
+		{
+			name:     "removed scope",
+			existing: &[]msgraph.PermissionScope{scope("1", "read", true), scope("2", "write", true)},
+			desired:  &[]msgraph.PermissionScope{scope("2", "write", true)},
+			expected: []string{"1"},
+		},
+		{
+			name:     "removed scope already disabled",
+			existing: &[]msgraph.PermissionScope{scope("1", "read", false)},
+			desired:  nil,
+			expected: []string{},
+		},
+		{
+			name:     "IDs differing in case",
+			existing: &[]msgraph.PermissionScope{scope("ABC", "read", true)},
+			desired:  &[]msgraph.PermissionScope{scope("abc", "read", true)},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := OAuth2PermissionScopesToDisable(tc.existing, tc.desired)
+			if len(actual) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, actual)
+			}
+			for i := range tc.expected {
+				if actual[i] != tc.expected[i] {
+					t.Fatalf("expected %v, got %v", tc.expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestApplicationSetOAuth2PermissionScopes_disablesChangedScopesFirst(t *testing.T) {
+	var mu sync.Mutex
+	scopes := []msgraph.PermissionScope{
+		{ID: utils.String("1"), Value: utils.String("read"), IsEnabled: utils.Bool(true)},
+		{ID: utils.String("2"), Value: utils.String("write"), IsEnabled: utils.Bool(true)},
+	}
+	updates := make([][]msgraph.PermissionScope, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			current := make([]msgraph.PermissionScope, len(scopes))
+			copy(current, scopes)
+			_ = json.NewEncoder(w).Encode(msgraph.Application{
+				ID:  utils.String("app"),
+				Api: &msgraph.ApplicationApi{OAuth2PermissionScopes: &current},
+			})
+
+		case http.MethodPatch:
+			var app msgraph.Application
+			body, _ := ioutil.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &app)
+			for _, existing := range scopes {
+				for _, updated := range *app.Api.OAuth2PermissionScopes {
+					if *existing.IsEnabled && *updated.IsEnabled && *existing.ID == *updated.ID && *existing.Value != *updated.Value {
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(`{"error":{"code":"CannotDeleteOrUpdateEnabledEntitlement","message":"Permission (scope or role) cannot be deleted or updated unless disabled first."}}`))
+						return
+					}
+				}
+			}
+			scopes = *app.Api.OAuth2PermissionScopes
+			updates = append(updates, scopes)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewApplicationsClient("tenant")
+	client.BaseClient.Endpoint = environments.ApiEndpoint(server.URL)
+
+	desired := []msgraph.PermissionScope{
+		{ID: utils.String("1"), Value: utils.String("read.all"), IsEnabled: utils.Bool(true)},
+		{ID: utils.String("2"), Value: utils.String("write"), IsEnabled: utils.Bool(true)},
+	}
+
+	if err := ApplicationSetOAuth2PermissionScopes(context.Background(), client, &msgraph.Application{ID: utils.String("app")}, &desired); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(updates) != 2 {
+		t.Fatalf("expected 2 updates, got %d", len(updates))
+	}
+	if *updates[0][0].IsEnabled || !*updates[0][1].IsEnabled {
+		t.Fatalf("expected only the changed scope to be disabled by the first update, got %+v", updates[0])
+	}
+	if *updates[1][0].Value != "read.all" || !*updates[1][0].IsEnabled {
+		t.Fatalf("expected the changed scope to be updated and re-enabled by the second update, got %+v", updates[1])
+	}
+}
+
+func TestAppRolesToDisable(t *testing.T) {
+	role := func(id, value string, enabled bool, memberTypes ...msgraph.AppRoleAllowedMemberType) msgraph.AppRole {
+		return msgraph.AppRole{ID: utils.String(id), Value: utils.String(value), IsEnabled: utils.Bool(enabled), AllowedMemberTypes: &memberTypes}
+	}
+
+	testCases := []struct {
+		name     string
+		existing *[]msgraph.AppRole
+		desired  *[]msgraph.AppRole
+		expected []string
+	}{
+		{
+			name:     "no existing roles",
+			existing: nil,
+			desired:  &[]msgraph.AppRole{role("1", "admin", true, msgraph.AppRoleAllowedMemberTypeUser)},
+			expected: []string{},
+		},
+		{
+			name:     "unchanged",
+			existing: &[]msgraph.AppRole{role("1", "admin", true, msgraph.AppRoleAllowedMemberTypeUser, msgraph.AppRoleAllowedMemberTypeApplication)},
+			desired:  &[]msgraph.AppRole{role("1", "admin", true, msgraph.AppRoleAllowedMemberTypeApplication, msgraph.AppRoleAllowedMemberTypeUser)},
+			expected: []string{},
+		},
+		{
+			name:     "changed value",
+			existing: &[]msgraph.AppRole{role("1", "admin", true, msgraph.AppRoleAllowedMemberTypeUser), role("2", "reader", true, msgraph.AppRoleAllowedMemberTypeUser)},
+			desired:  &[]msgraph.AppRole{role("1", "administrator", true, msgraph.AppRoleAllowedMemberTypeUser), role("2", "reader", true, msgraph.AppRoleAllowedMemberTypeUser)},
+			expected: []string{"1"},
+		},
+		{
+			name:     "changed allowed member types",
+			existing: &[]msgraph.AppRole{role("1", "admin", true, msgraph.AppRoleAllowedMemberTypeUser)},
+			desired:  &[]msgraph.AppRole{role("1", "admin", true, msgraph.AppRoleAllowedMemberTypeApplication)},
+			expected: []string{"1"},
+		},
+		{
+			name:     "removed role",
+			existing: &[]msgraph.AppRole{role("1", "admin", true, msgraph.AppRoleAllowedMemberTypeUser)},
+			desired:  &[]msgraph.AppRole{},
+			expected: []string{"1"},
+		},
+		{
+			name:     "changed role already disabled",
+			existing: &[]msgraph.AppRole{role("1", "admin", false, msgraph.AppRoleAllowedMemberTypeUser)},
+			desired:  &[]msgraph.AppRole{role("1", "administrator", true, msgraph.AppRoleAllowedMemberTypeUser)},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := AppRolesToDisable(tc.existing, tc.desired)
+			if len(actual) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, actual)
+			}
+			for i := range tc.expected {
+				if actual[i] != tc.expected[i] {
+					t.Fatalf("expected %v, got %v", tc.expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestApplicationSetAppRoles_disablesChangedRolesFirst(t *testing.T) {
+	var mu sync.Mutex
+	roles := []msgraph.AppRole{
+		{ID: utils.String("1"), Value: utils.String("admin"), IsEnabled: utils.Bool(true)},
+		{ID: utils.String("2"), Value: utils.String("reader"), IsEnabled: utils.Bool(true)},
+	}
+	updates := make([][]msgraph.AppRole, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+			current := make([]msgraph.AppRole, len(roles))
+			copy(current, roles)
+			_ = json.NewEncoder(w).Encode(msgraph.Application{ID: utils.String("app"), AppRoles: &current})
+
+		case http.MethodPatch:
+			var app msgraph.Application
+			body, _ := ioutil.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &app)
+			for _, existing := range roles {
+				for _, updated := range *app.AppRoles {
+					if *existing.IsEnabled && *updated.IsEnabled && *existing.ID == *updated.ID && *existing.Value != *updated.Value {
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(`{"error":{"code":"CannotDeleteOrUpdateEnabledEntitlement","message":"Permission (scope or role) cannot be deleted or updated unless disabled first."}}`))
+						return
+					}
+				}
+			}
+			roles = *app.AppRoles
+			updates = append(updates, roles)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewApplicationsClient("tenant")
+	client.BaseClient.Endpoint = environments.ApiEndpoint(server.URL)
+
+	desired := []msgraph.AppRole{
+		{ID: utils.String("1"), Value: utils.String("administrator"), IsEnabled: utils.Bool(true)},
+		{ID: utils.String("2"), Value: utils.String("reader"), IsEnabled: utils.Bool(true)},
+	}
+
+	if err := ApplicationSetAppRoles(context.Background(), client, &msgraph.Application{ID: utils.String("app")}, &desired); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(updates) != 2 {
+		t.Fatalf("expected 2 updates, got %d", len(updates))
+	}
+	if *updates[0][0].IsEnabled || !*updates[0][1].IsEnabled {
+		t.Fatalf("expected only the changed role to be disabled by the first update, got %+v", updates[0])
+	}
+	if *updates[1][0].ID != "1" || *updates[1][0].Value != "administrator" || !*updates[1][0].IsEnabled {
+		t.Fatalf("expected the changed role to be updated and re-enabled by the second update, got %+v", updates[1])
+	}
+}
+
+func TestApplicationsOwnedBy(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.0/tenant/directoryObjects/user":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"@odata.type":"#microsoft.graph.user","id":"user","displayName":"User"}`))
+		case "/v1.0/tenant/directoryObjects/group":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"@odata.type":"#microsoft.graph.group","id":"group","displayName":"Group"}`))
+		case "/v1.0/tenant/users/user/ownedObjects/microsoft.graph.application":
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"value":[{"id":"app2","appId":"client2","displayName":"App 2"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"@odata.nextLink":"%s%s?page=2","value":[{"id":"app1","appId":"client1","displayName":"App 1"}]}`, server.URL, r.URL.Path)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	apps, _, err := ApplicationsOwnedBy(context.Background(), client, "user")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(apps) != 2 {
+		t.Fatalf("expected 2 applications, got %d", len(apps))
+	}
+	if v := apps[1].AppId; v == nil || *v != "client2" {
+		t.Fatalf("expected second application to have application ID \"client2\", got %v", v)
+	}
+
+	if _, _, err := ApplicationsOwnedBy(context.Background(), client, "group"); err == nil {
+		t.Fatal("expected an error for an owner which is not a user or service principal")
+	}
+
+	if _, status, err := ApplicationsOwnedBy(context.Background(), client, "missing"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
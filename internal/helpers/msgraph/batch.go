@@ -0,0 +1,274 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
+)
+
+// BatchMaxRequests is the maximum number of requests that Microsoft Graph accepts in a single JSON batch
+const BatchMaxRequests = 20
+
+// batchMaxAttempts limits how many times individually throttled requests within a batch are resubmitted
+const batchMaxAttempts = 5
+
+// batchGetterDelay is how long a BatchGetter waits for further requests before sending a partially filled batch
+const batchGetterDelay = 200 * time.Millisecond
+
+// batchGetterTimeout limits how long a batch sent by a BatchGetter may take, since it is shared between callers
+const batchGetterTimeout = 2 * time.Minute
+
+// BatchRequest is a single request within a JSON batch. The URL is relative to the API version, e.g. `/groups/{id}`.
+type BatchRequest struct {
+	ID      string            `json:"id"`
+	Method  string            `json:"method"`
+	Url     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    interface{}       `json:"body,omitempty"`
+}
+
+// BatchResponse is the response to a single request within a JSON batch
+type BatchResponse struct {
+	ID      string            `json:"id"`
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+// Error returns any OData error contained in the response body
+func (r BatchResponse) Error() *odata.Error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+	var o odata.OData
+	if err := json.Unmarshal(r.Body, &o); err != nil {
+		return nil
+	}
+	return o.Error
+}
+
+// Batch sends the specified requests using JSON batching, in batches of up to BatchMaxRequests requests. Requests which
+// are individually throttled are resubmitted after waiting for the period indicated by the API. A response is returned
+// for every request, keyed by request ID; callers are responsible for checking the status of each.
+func Batch(ctx context.Context, client msgraph.Client, requests []BatchRequest) (map[string]BatchResponse, error) {
+	result := make(map[string]BatchResponse, len(requests))
+
+	for i := 0; i < len(requests); i += BatchMaxRequests {
+		end := i + BatchMaxRequests
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		pending := requests[i:end]
+		for attempt := 1; len(pending) > 0; attempt++ {
+			responses, err := batchSend(ctx, client, pending)
+			if err != nil {
+				return nil, err
+			}
+
+			var throttled []BatchRequest
+			var wait time.Duration
+			for _, req := range pending {
+				resp, ok := responses[req.ID]
+				if !ok {
+					return nil, fmt.Errorf("no response returned for batch request %q", req.ID)
+				}
+				if (resp.Status == http.StatusTooManyRequests || resp.Status == http.StatusServiceUnavailable) && attempt < batchMaxAttempts {
+					throttled = append(throttled, req)
+					if d := batchRetryAfter(resp); d > wait {
+						wait = d
+					}
+					continue
+				}
+				result[req.ID] = resp
+			}
+
+			pending = throttled
+			if len(pending) > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, ctx.Err()
+				case <-timer.C:
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func batchSend(ctx context.Context, client msgraph.Client, requests []BatchRequest) (map[string]BatchResponse, error) {
+	body, err := json.Marshal(struct {
+		Requests []BatchRequest `json:"requests"`
+	}{
+		Requests: requests,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, _, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/$batch",
+			HasTenantId: false,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Responses []BatchResponse `json:"responses"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	responses := make(map[string]BatchResponse, len(data.Responses))
+	for _, r := range data.Responses {
+		responses[r.ID] = r
+	}
+
+	return responses, nil
+}
+
+func batchRetryAfter(resp BatchResponse) time.Duration {
+	for k, v := range resp.Headers {
+		if http.CanonicalHeaderKey(k) == "Retry-After" {
+			if secs, err := strconv.ParseFloat(v, 64); err == nil && secs > 0 {
+				return time.Duration(secs * float64(time.Second))
+			}
+		}
+	}
+	return time.Second
+}
+
+// BatchGetter coalesces GET requests made concurrently, such as by many resources being created in parallel, into
+// JSON batches so that fewer round trips are needed. Requests are sent once BatchMaxRequests have been queued, or
+// after a short delay, whichever comes first.
+type BatchGetter struct {
+	client msgraph.Client
+	delay  time.Duration
+
+	mu      sync.Mutex
+	nextId  int
+	pending []batchGetterItem
+	timer   *time.Timer
+}
+
+type batchGetterItem struct {
+	request BatchRequest
+	result  chan batchGetterResult
+}
+
+type batchGetterResult struct {
+	response BatchResponse
+	err      error
+}
+
+// NewBatchGetter returns a BatchGetter which sends batches using the specified client
+func NewBatchGetter(client msgraph.Client) *BatchGetter {
+	return &BatchGetter{
+		client: client,
+		delay:  batchGetterDelay,
+	}
+}
+
+// Get queues a GET request for the specified URL, which is relative to the API version, e.g. `/users/{id}`, and waits
+// for its response. The response body and status are returned, along with an error if the status was not successful.
+func (b *BatchGetter) Get(ctx context.Context, url string) (json.RawMessage, int, error) {
+	result := make(chan batchGetterResult, 1)
+
+	b.mu.Lock()
+	b.nextId++
+	b.pending = append(b.pending, batchGetterItem{
+		request: BatchRequest{
+			ID:     strconv.Itoa(b.nextId),
+			Method: http.MethodGet,
+			Url:    url,
+		},
+		result: result,
+	})
+	if len(b.pending) >= BatchMaxRequests {
+		b.flushLocked()
+	} else if b.timer == nil {
+		b.timer = time.AfterFunc(b.delay, b.flush)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	case r := <-result:
+		if r.err != nil {
+			return nil, 0, r.err
+		}
+		resp := r.response
+		if resp.Status < 200 || resp.Status >= 300 {
+			if e := resp.Error(); e != nil {
+				return resp.Body, resp.Status, fmt.Errorf("retrieving %q: unexpected status %d with OData error: %s", url, resp.Status, e)
+			}
+			return resp.Body, resp.Status, fmt.Errorf("retrieving %q: unexpected status %d", url, resp.Status)
+		}
+		return resp.Body, resp.Status, nil
+	}
+}
+
+func (b *BatchGetter) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.flushLocked()
+}
+
+// flushLocked sends all pending requests in the background. The caller must hold the lock.
+func (b *BatchGetter) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	if len(b.pending) == 0 {
+		return
+	}
+
+	items := b.pending
+	b.pending = nil
+	go b.send(items)
+}
+
+func (b *BatchGetter) send(items []batchGetterItem) {
+	// The batch serves several callers, so it must not be cancelled along with the context of any one of them
+	ctx, cancel := context.WithTimeout(context.Background(), batchGetterTimeout)
+	defer cancel()
+
+	requests := make([]BatchRequest, 0, len(items))
+	for _, item := range items {
+		requests = append(requests, item.request)
+	}
+
+	responses, err := Batch(ctx, b.client, requests)
+	for _, item := range items {
+		if err != nil {
+			item.result <- batchGetterResult{err: err}
+			continue
+		}
+		item.result <- batchGetterResult{response: responses[item.request.ID]}
+	}
+}
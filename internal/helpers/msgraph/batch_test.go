@@ -0,0 +1,104 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestBatchGetter(t *testing.T) {
+	var batches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1.0/$batch" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(&batches, 1)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		var data struct {
+			Requests []BatchRequest `json:"requests"`
+		}
+		_ = json.Unmarshal(body, &data)
+
+		responses := make([]map[string]interface{}, 0, len(data.Requests))
+		for _, req := range data.Requests {
+			if strings.HasSuffix(req.Url, "/missing") {
+				responses = append(responses, map[string]interface{}{
+					"id":     req.ID,
+					"status": http.StatusNotFound,
+					"body":   map[string]interface{}{"error": map[string]string{"code": "Request_ResourceNotFound", "message": "Resource does not exist."}},
+				})
+				continue
+			}
+			responses = append(responses, map[string]interface{}{
+				"id":     req.ID,
+				"status": http.StatusOK,
+				"body":   map[string]string{"id": strings.TrimPrefix(req.Url, "/users/")},
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		respBody, _ := json.Marshal(map[string]interface{}{"responses": responses})
+		_, _ = w.Write(respBody)
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	getter := NewBatchGetter(client)
+	getter.delay = 500 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ids := []string{"user1", "user2", "missing"}
+	statuses := make([]int, len(ids))
+	bodies := make([]string, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			body, status, err := getter.Get(ctx, "/users/"+id)
+			statuses[i], bodies[i], errs[i] = status, string(body), err
+		}(i, id)
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&batches); n != 1 {
+		t.Fatalf("expected requests to be sent in 1 batch, got %d", n)
+	}
+
+	for i, id := range ids[:2] {
+		if errs[i] != nil {
+			t.Fatalf("unexpected error retrieving %q: %v", id, errs[i])
+		}
+		if statuses[i] != http.StatusOK {
+			t.Fatalf("expected status 200 for %q, got %d", id, statuses[i])
+		}
+		if expected := `{"id":"` + id + `"}`; bodies[i] != expected {
+			t.Fatalf("expected body %s for %q, got %s", expected, id, bodies[i])
+		}
+	}
+
+	if errs[2] == nil {
+		t.Fatal("expected an error retrieving a missing object")
+	}
+	if statuses[2] != http.StatusNotFound {
+		t.Fatalf("expected status 404 for missing object, got %d", statuses[2])
+	}
+}
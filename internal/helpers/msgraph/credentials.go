@@ -22,7 +22,7 @@ func KeyCredentialForResource(d *schema.ResourceData) (*msgraph.KeyCredential, e
 	var encodedValue string
 	encoding := d.Get("encoding").(string)
 	switch encoding {
-	case "base64":
+	case "base64", "der":
 		der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode base64 certificate data")
@@ -54,6 +54,27 @@ func KeyCredentialForResource(d *schema.ResourceData) (*msgraph.KeyCredential, e
 		encodedValue = base64.StdEncoding.EncodeToString(pemVal)
 	case "pem":
 		encodedValue = base64.StdEncoding.EncodeToString([]byte(value))
+	case "pkcs12":
+		if keyType != "AsymmetricX509Cert" {
+			return nil, CredentialError{str: "`type` must be `AsymmetricX509Cert` when using `pkcs12` encoding", attr: "type"}
+		}
+		pfx, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 PKCS#12 data")
+		}
+		der, err := utils.CertificateFromPKCS12(pfx, d.Get("password").(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to extract certificate from PKCS#12 data: %v", err), attr: "value"}
+		}
+		block := pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: der,
+		}
+		pemVal := pem.EncodeToMemory(&block)
+		if pemVal == nil {
+			return nil, fmt.Errorf("failed to PEM-encode certificate")
+		}
+		encodedValue = base64.StdEncoding.EncodeToString(pemVal)
 	}
 
 	var keyId string
@@ -80,7 +101,7 @@ func KeyCredentialForResource(d *schema.ResourceData) (*msgraph.KeyCredential, e
 		if err != nil {
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
 		}
-		endDate = time.Now().Add(d)
+		endDate = utils.RelativeEndTime(d)
 	} else {
 		return nil, CredentialError{str: "One of `end_date` or `end_date_relative` must be specified", attr: "end_date"}
 	}
@@ -99,6 +120,13 @@ func KeyCredentialForResource(d *schema.ResourceData) (*msgraph.KeyCredential, e
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
 		}
 		credential.StartDateTime = &startDate
+	} else if v, ok := d.GetOk("start_date_relative"); ok && v.(string) != "" {
+		d, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `start_date_relative` (%q) as a duration", v), attr: "start_date_relative"}
+		}
+		startDate := utils.RelativeStartTime(d)
+		credential.StartDateTime = &startDate
 	}
 
 	return &credential, nil
@@ -120,7 +148,7 @@ func PasswordCredentialForResource(d *schema.ResourceData) (*msgraph.PasswordCre
 		if err != nil {
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
 		}
-		expiry := time.Now().Add(d)
+		expiry := utils.RelativeEndTime(d)
 		endDate = &expiry
 	}
 	if endDate != nil {
@@ -139,7 +167,92 @@ func PasswordCredentialForResource(d *schema.ResourceData) (*msgraph.PasswordCre
 			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
 		}
 		credential.StartDateTime = &startDate
+	} else if v, ok := d.GetOk("start_date_relative"); ok && v.(string) != "" {
+		d, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `start_date_relative` (%q) as a duration", v), attr: "start_date_relative"}
+		}
+		startDate := utils.RelativeStartTime(d)
+		credential.StartDateTime = &startDate
 	}
 
 	return &credential, nil
 }
+
+func KeyCredentialsFlatten(in *[]msgraph.KeyCredential) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make([]map[string]interface{}, 0, len(*in))
+	for _, cred := range *in {
+		displayName := ""
+		if cred.DisplayName != nil {
+			displayName = *cred.DisplayName
+		}
+		keyId := ""
+		if cred.KeyId != nil {
+			keyId = *cred.KeyId
+		}
+		startDate := ""
+		if cred.StartDateTime != nil {
+			startDate = cred.StartDateTime.Format(time.RFC3339)
+		}
+		endDate := ""
+		if cred.EndDateTime != nil {
+			endDate = cred.EndDateTime.Format(time.RFC3339)
+		}
+
+		result = append(result, map[string]interface{}{
+			"display_name": displayName,
+			"end_date":     endDate,
+			"key_id":       keyId,
+			"start_date":   startDate,
+			"thumbprint":   utils.CertificateThumbprintFromCustomKeyIdentifier(cred.CustomKeyIdentifier),
+			"type":         string(cred.Type),
+			"usage":        string(cred.Usage),
+		})
+	}
+
+	return result
+}
+
+func PasswordCredentialsFlatten(in *[]msgraph.PasswordCredential) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make([]map[string]interface{}, 0, len(*in))
+	for _, cred := range *in {
+		displayName := ""
+		if cred.DisplayName != nil {
+			displayName = *cred.DisplayName
+		}
+		hint := ""
+		if cred.Hint != nil {
+			hint = *cred.Hint
+		}
+		keyId := ""
+		if cred.KeyId != nil {
+			keyId = *cred.KeyId
+		}
+		startDate := ""
+		if cred.StartDateTime != nil {
+			startDate = cred.StartDateTime.Format(time.RFC3339)
+		}
+		endDate := ""
+		if cred.EndDateTime != nil {
+			endDate = cred.EndDateTime.Format(time.RFC3339)
+		}
+
+		result = append(result, map[string]interface{}{
+			"display_name": displayName,
+			"end_date":     endDate,
+			"hint":         hint,
+			"key_id":       keyId,
+			"start_date":   startDate,
+		})
+	}
+
+	return result
+}
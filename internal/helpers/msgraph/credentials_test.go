@@ -0,0 +1,82 @@
+package msgraph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestKeyCredentialsFlatten(t *testing.T) {
+	if v := KeyCredentialsFlatten(nil); len(v) != 0 {
+		t.Fatalf("expected no credentials for nil input, got %d", len(v))
+	}
+
+	endDate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	creds := []msgraph.KeyCredential{
+		{
+			CustomKeyIdentifier: utils.String("Dx0EVwh8G4AXdvyzZbZDgQmPIF0="),
+			DisplayName:         utils.String("CN=hashicorptest"),
+			EndDateTime:         &endDate,
+			KeyId:               utils.String("00000000-0000-0000-0000-000000000001"),
+			Type:                msgraph.KeyCredentialTypeAsymmetricX509Cert,
+			Usage:               msgraph.KeyCredentialUsageVerify,
+		},
+	}
+
+	result := KeyCredentialsFlatten(&creds)
+	if len(result) != 1 {
+		t.Fatalf("expected 1 credential, got %d", len(result))
+	}
+
+	expected := map[string]interface{}{
+		"display_name": "CN=hashicorptest",
+		"end_date":     "2022-01-01T00:00:00Z",
+		"key_id":       "00000000-0000-0000-0000-000000000001",
+		"start_date":   "",
+		"thumbprint":   "0F1D0457087C1B801776FCB365B64381098F205D",
+		"type":         "AsymmetricX509Cert",
+		"usage":        "Verify",
+	}
+	for k, v := range expected {
+		if result[0][k] != v {
+			t.Fatalf("expected %q to be %q, got %q", k, v, result[0][k])
+		}
+	}
+}
+
+func TestPasswordCredentialsFlatten(t *testing.T) {
+	if v := PasswordCredentialsFlatten(nil); len(v) != 0 {
+		t.Fatalf("expected no credentials for nil input, got %d", len(v))
+	}
+
+	startDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	creds := []msgraph.PasswordCredential{
+		{
+			DisplayName:   utils.String("rotation"),
+			Hint:          utils.String("abc"),
+			KeyId:         utils.String("00000000-0000-0000-0000-000000000002"),
+			StartDateTime: &startDate,
+		},
+	}
+
+	result := PasswordCredentialsFlatten(&creds)
+	if len(result) != 1 {
+		t.Fatalf("expected 1 credential, got %d", len(result))
+	}
+
+	expected := map[string]interface{}{
+		"display_name": "rotation",
+		"end_date":     "",
+		"hint":         "abc",
+		"key_id":       "00000000-0000-0000-0000-000000000002",
+		"start_date":   "2021-01-01T00:00:00Z",
+	}
+	for k, v := range expected {
+		if result[0][k] != v {
+			t.Fatalf("expected %q to be %q, got %q", k, v, result[0][k])
+		}
+	}
+}
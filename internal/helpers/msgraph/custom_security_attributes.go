@@ -0,0 +1,192 @@
+package msgraph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const customSecurityAttributeValueODataType = "#Microsoft.DirectoryServices.CustomSecurityAttributeValue"
+
+// CustomSecurityAttributesGet retrieves the custom security attribute values assigned to the directory object at the
+// specified entity path, e.g. `/users/{id}`, in the format of a `custom_security_attribute` block.
+func CustomSecurityAttributesGet(ctx context.Context, client msgraph.Client, entity string) ([]map[string]interface{}, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			Params:      url.Values{"$select": []string{"customSecurityAttributes"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		CustomSecurityAttributes map[string]map[string]interface{} `json:"customSecurityAttributes"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(respBody))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, status, fmt.Errorf("json.Decode(): %v", err)
+	}
+
+	result := make([]map[string]interface{}, 0)
+	for attributeSet, attributes := range data.CustomSecurityAttributes {
+		for name, raw := range attributes {
+			if strings.Contains(name, "@") || raw == nil {
+				continue
+			}
+
+			attributeType := ""
+			collection := false
+			values := make([]string, 0)
+
+			items := []interface{}{raw}
+			if v, ok := raw.([]interface{}); ok {
+				collection = true
+				items = v
+			}
+			for _, item := range items {
+				switch v := item.(type) {
+				case bool:
+					attributeType = "Boolean"
+					values = append(values, strconv.FormatBool(v))
+				case json.Number:
+					attributeType = "Integer"
+					values = append(values, v.String())
+				case string:
+					attributeType = "String"
+					values = append(values, v)
+				}
+			}
+
+			result = append(result, map[string]interface{}{
+				"attribute_set": attributeSet,
+				"collection":    collection,
+				"name":          name,
+				"type":          attributeType,
+				"values":        values,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return fmt.Sprintf("%s_%s", result[i]["attribute_set"], result[i]["name"]) < fmt.Sprintf("%s_%s", result[j]["attribute_set"], result[j]["name"])
+	})
+
+	return result, status, nil
+}
+
+// CustomSecurityAttributesUpdate assigns custom security attribute values to the directory object at the specified
+// entity path, e.g. `/users/{id}`. The old and new values are lists of `custom_security_attribute` blocks, and any
+// attributes present in old but not in new are cleared.
+func CustomSecurityAttributesUpdate(ctx context.Context, client msgraph.Client, entity string, old, new []interface{}) (int, error) {
+	var status int
+
+	attributeSets := make(map[string]map[string]interface{})
+	setValue := func(attributeSet, name string, value interface{}, odataType string) {
+		if _, ok := attributeSets[attributeSet]; !ok {
+			attributeSets[attributeSet] = map[string]interface{}{
+				"@odata.type": customSecurityAttributeValueODataType,
+			}
+		}
+		attributeSets[attributeSet][name] = value
+		if odataType != "" {
+			attributeSets[attributeSet][fmt.Sprintf("%s@odata.type", name)] = odataType
+		}
+	}
+
+	for _, raw := range old {
+		if raw == nil {
+			continue
+		}
+		attribute := raw.(map[string]interface{})
+		setValue(attribute["attribute_set"].(string), attribute["name"].(string), nil, "")
+	}
+
+	for _, raw := range new {
+		if raw == nil {
+			continue
+		}
+		attribute := raw.(map[string]interface{})
+		attributeSet := attribute["attribute_set"].(string)
+		name := attribute["name"].(string)
+		attributeType := attribute["type"].(string)
+		collection := attribute["collection"].(bool)
+
+		rawValues := attribute["values"].([]interface{})
+		if !collection && len(rawValues) != 1 {
+			return status, fmt.Errorf("exactly one value must be specified for custom security attribute %q in attribute set %q, unless it is a collection", name, attributeSet)
+		}
+
+		values := make([]interface{}, 0, len(rawValues))
+		for _, v := range rawValues {
+			value := v.(string)
+			switch attributeType {
+			case "Boolean":
+				b, err := strconv.ParseBool(value)
+				if err != nil {
+					return status, fmt.Errorf("invalid value %q for Boolean custom security attribute %q in attribute set %q", value, name, attributeSet)
+				}
+				values = append(values, b)
+			case "Integer":
+				i, err := strconv.ParseInt(value, 10, 32)
+				if err != nil {
+					return status, fmt.Errorf("invalid value %q for Integer custom security attribute %q in attribute set %q", value, name, attributeSet)
+				}
+				values = append(values, i)
+			default:
+				values = append(values, value)
+			}
+		}
+
+		switch {
+		case collection && attributeType == "Integer":
+			setValue(attributeSet, name, values, "#Collection(Int32)")
+		case collection:
+			setValue(attributeSet, name, values, "#Collection(String)")
+		case attributeType == "Integer":
+			setValue(attributeSet, name, values[0], "#Int32")
+		default:
+			setValue(attributeSet, name, values[0], "")
+		}
+	}
+
+	if len(attributeSets) == 0 {
+		return status, nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"customSecurityAttributes": attributeSets,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = client.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
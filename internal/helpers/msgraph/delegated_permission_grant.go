@@ -0,0 +1,154 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// DelegatedPermissionGrantConsentTypeAllPrincipals indicates that a delegated permission grant was consented to by an
+// administrator on behalf of all users in the tenant
+const DelegatedPermissionGrantConsentTypeAllPrincipals = "AllPrincipals"
+
+// DelegatedPermissionGrantConsentTypePrincipal indicates that a delegated permission grant applies to a single user
+const DelegatedPermissionGrantConsentTypePrincipal = "Principal"
+
+// DelegatedPermissionGrant describes an OAuth2 permission grant, which represents the delegated permissions that a
+// client service principal has been granted to access a resource service principal on behalf of a signed-in user
+type DelegatedPermissionGrant struct {
+	ID          *string `json:"id"`
+	ClientId    *string `json:"clientId"`
+	ConsentType *string `json:"consentType"`
+	PrincipalId *string `json:"principalId"`
+	ResourceId  *string `json:"resourceId"`
+	Scope       *string `json:"scope"`
+}
+
+// Scopes returns the individual claim values from the space-separated scope of the grant
+func (g DelegatedPermissionGrant) Scopes() []string {
+	if g.Scope == nil {
+		return []string{}
+	}
+	return strings.Fields(*g.Scope)
+}
+
+// ServicePrincipalDelegatedPermissionGrants returns the delegated permission grants for the client service principal
+// with the specified object ID. All pages of results are retrieved.
+func ServicePrincipalDelegatedPermissionGrants(ctx context.Context, client msgraph.Client, id string) ([]DelegatedPermissionGrant, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s/oauth2PermissionGrants", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Grants []DelegatedPermissionGrant `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return data.Grants, status, nil
+}
+
+// DelegatedPermissionGrantCreate creates a delegated permission grant for the client service principal to access the
+// resource service principal. When principalId is empty, the grant is created on behalf of all users in the tenant.
+func DelegatedPermissionGrantCreate(ctx context.Context, client msgraph.Client, clientId, resourceId, principalId string, scopes []string) (*DelegatedPermissionGrant, int, error) {
+	var status int
+	data := struct {
+		ClientId    string  `json:"clientId"`
+		ConsentType string  `json:"consentType"`
+		PrincipalId *string `json:"principalId,omitempty"`
+		ResourceId  string  `json:"resourceId"`
+		Scope       string  `json:"scope"`
+	}{
+		ClientId:    clientId,
+		ConsentType: DelegatedPermissionGrantConsentTypeAllPrincipals,
+		ResourceId:  resourceId,
+		Scope:       strings.Join(scopes, " "),
+	}
+	if principalId != "" {
+		data.ConsentType = DelegatedPermissionGrantConsentTypePrincipal
+		data.PrincipalId = &principalId
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/oauth2PermissionGrants",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var grant DelegatedPermissionGrant
+	if err := json.Unmarshal(respBody, &grant); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &grant, status, nil
+}
+
+// DelegatedPermissionGrantSetScopes replaces the scopes of the delegated permission grant with the specified ID
+func DelegatedPermissionGrantSetScopes(ctx context.Context, client msgraph.Client, id string, scopes []string) (int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		Scope string `json:"scope"`
+	}{
+		Scope: strings.Join(scopes, " "),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = client.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/oauth2PermissionGrants/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// DelegatedPermissionGrantDelete deletes the delegated permission grant with the specified ID
+func DelegatedPermissionGrantDelete(ctx context.Context, client msgraph.Client, id string) (int, error) {
+	_, status, _, err := client.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/oauth2PermissionGrants/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,124 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestDelegatedPermissionGrantScopes(t *testing.T) {
+	cases := []struct {
+		scope    *string
+		expected []string
+	}{
+		{nil, []string{}},
+		{utils.String(""), []string{}},
+		{utils.String("User.Read"), []string{"User.Read"}},
+		{utils.String(" openid  User.Read profile "), []string{"openid", "User.Read", "profile"}},
+	}
+
+	for _, c := range cases {
+		if v := (DelegatedPermissionGrant{Scope: c.scope}).Scopes(); !reflect.DeepEqual(v, c.expected) {
+			t.Fatalf("expected scopes %v, got %v", c.expected, v)
+		}
+	}
+}
+
+func TestServicePrincipalDelegatedPermissionGrants(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.0/tenant/servicePrincipals/client/oauth2PermissionGrants" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"value":[{"id":"grant2","clientId":"client","consentType":"Principal","principalId":"user1","resourceId":"resource","scope":"User.Read"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"@odata.nextLink":"%s%s?page=2","value":[{"id":"grant1","clientId":"client","consentType":"AllPrincipals","principalId":null,"resourceId":"resource","scope":"openid User.Read"}]}`, server.URL, r.URL.Path)))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	grants, _, err := ServicePrincipalDelegatedPermissionGrants(context.Background(), client, "client")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(grants) != 2 {
+		t.Fatalf("expected 2 grants, got %d", len(grants))
+	}
+	if grants[0].PrincipalId != nil {
+		t.Fatalf("expected first grant to have no principal, got %q", *grants[0].PrincipalId)
+	}
+	if v := grants[1].ConsentType; v == nil || *v != DelegatedPermissionGrantConsentTypePrincipal {
+		t.Fatalf("expected second grant to have consent type %q, got %v", DelegatedPermissionGrantConsentTypePrincipal, v)
+	}
+
+	if _, status, err := ServicePrincipalDelegatedPermissionGrants(context.Background(), client, "missing"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
+
+func TestDelegatedPermissionGrantLifecycle(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1.0/tenant/oauth2PermissionGrants":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"grant1","clientId":"client","consentType":"AllPrincipals","principalId":null,"resourceId":"resource","scope":"openid User.Read"}`))
+		case (r.Method == http.MethodPatch || r.Method == http.MethodDelete) && r.URL.Path == "/v1.0/tenant/oauth2PermissionGrants/grant1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist."}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+	ctx := context.Background()
+
+	grant, _, err := DelegatedPermissionGrantCreate(ctx, client, "client", "resource", "", []string{"openid", "User.Read"})
+	if err != nil {
+		t.Fatalf("unexpected error creating grant: %v", err)
+	}
+	if grant.ID == nil || *grant.ID != "grant1" {
+		t.Fatalf("expected grant ID %q, got %v", "grant1", grant.ID)
+	}
+	if _, err := DelegatedPermissionGrantSetScopes(ctx, client, "grant1", []string{"openid"}); err != nil {
+		t.Fatalf("unexpected error updating grant: %v", err)
+	}
+	if _, err := DelegatedPermissionGrantDelete(ctx, client, "grant1"); err != nil {
+		t.Fatalf("unexpected error deleting grant: %v", err)
+	}
+	if status, err := DelegatedPermissionGrantDelete(ctx, client, "missing"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+
+	expected := []string{
+		`POST /v1.0/tenant/oauth2PermissionGrants {"clientId":"client","consentType":"AllPrincipals","resourceId":"resource","scope":"openid User.Read"}`,
+		`PATCH /v1.0/tenant/oauth2PermissionGrants/grant1 {"scope":"openid"}`,
+		`DELETE /v1.0/tenant/oauth2PermissionGrants/grant1 `,
+		`DELETE /v1.0/tenant/oauth2PermissionGrants/missing `,
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected requests %q, got %q", expected, requests)
+	}
+}
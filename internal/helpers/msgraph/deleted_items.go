@@ -0,0 +1,137 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	DeletedItemTypeApplication = "application"
+	DeletedItemTypeGroup       = "group"
+	DeletedItemTypeUser        = "user"
+)
+
+// DeletedItem describes a directory object which has been deleted and can be restored until it is permanently deleted,
+// which happens automatically 30 days after deletion
+type DeletedItem struct {
+	ID              *string    `json:"id"`
+	DisplayName     *string    `json:"displayName"`
+	DeletedDateTime *time.Time `json:"deletedDateTime"`
+
+	// AppId is only returned for applications
+	AppId *string `json:"appId"`
+
+	// MailNickname is only returned for groups and users
+	MailNickname *string `json:"mailNickname"`
+
+	// GroupTypes and SecurityEnabled are only returned for groups
+	GroupTypes      *[]string `json:"groupTypes"`
+	SecurityEnabled *bool     `json:"securityEnabled"`
+
+	// UserPrincipalName is only returned for users
+	UserPrincipalName *string `json:"userPrincipalName"`
+
+	// Type is the type of the deleted object, e.g. `Application` or `User`
+	Type string `json:"-"`
+}
+
+// DeletedItemsList returns all deleted directory objects of the specified type, which must be one of `application`,
+// `group` or `user`. All pages of results are retrieved.
+func DeletedItemsList(ctx context.Context, client msgraph.Client, objectType string) ([]DeletedItem, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/deletedItems/microsoft.graph.%s", objectType),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Value []DeletedItem `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	for i := range data.Value {
+		data.Value[i].Type = directoryObjectTypeName(objectType)
+	}
+
+	return data.Value, status, nil
+}
+
+// DeletedItemGet retrieves the deleted directory object with the specified ID, along with its type
+func DeletedItemGet(ctx context.Context, client msgraph.Client, id string) (*DeletedItem, int, error) {
+	resp, status, o, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/deletedItems/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var item DeletedItem
+	if err := json.Unmarshal(respBody, &item); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	if o != nil && o.Type != nil {
+		item.Type = directoryObjectTypeName(*o.Type)
+	}
+
+	return &item, status, nil
+}
+
+// DeletedItemRestore restores the deleted directory object with the specified ID
+func DeletedItemRestore(ctx context.Context, client msgraph.Client, id string) (int, error) {
+	_, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/deletedItems/%s/restore", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// DeletedItemPermanentlyDelete permanently deletes the deleted directory object with the specified ID, after which it
+// can no longer be restored
+func DeletedItemPermanentlyDelete(ctx context.Context, client msgraph.Client, id string) (int, error) {
+	_, status, _, err := client.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/deletedItems/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,105 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestDeletedItemsList(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.0/tenant/directory/deletedItems/microsoft.graph.user" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"value":[{"id":"user2","displayName":"User 2","userPrincipalName":"user2@example.com","deletedDateTime":"2021-05-02T10:00:00Z"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"@odata.nextLink":"%s%s?page=2","value":[{"id":"user1","displayName":"User 1","userPrincipalName":"user1@example.com","deletedDateTime":"2021-05-01T10:00:00Z"}]}`, server.URL, r.URL.Path)))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	items, _, err := DeletedItemsList(context.Background(), client, DeletedItemTypeUser)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 deleted items, got %d", len(items))
+	}
+	for _, item := range items {
+		if item.Type != "User" {
+			t.Fatalf("expected type %q, got %q", "User", item.Type)
+		}
+		if item.DeletedDateTime == nil {
+			t.Fatalf("expected deleted date for %v", item.ID)
+		}
+	}
+	if v := items[1].UserPrincipalName; v == nil || *v != "user2@example.com" {
+		t.Fatalf("expected user principal name %q, got %v", "user2@example.com", v)
+	}
+
+	if _, status, err := DeletedItemsList(context.Background(), client, DeletedItemTypeGroup); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
+
+func TestDeletedItemActions(t *testing.T) {
+	deleted := map[string]bool{"app": true}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1.0/tenant/directory/deletedItems/app" && deleted["app"]:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"@odata.type":"#microsoft.graph.application","id":"app","appId":"client","displayName":"Example"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1.0/tenant/directory/deletedItems/app/restore" && deleted["app"]:
+			deleted["app"] = false
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"@odata.type":"#microsoft.graph.application","id":"app"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1.0/tenant/directory/deletedItems/app" && deleted["app"]:
+			deleted["app"] = false
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	item, _, err := DeletedItemGet(context.Background(), client, "app")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Type != "Application" {
+		t.Fatalf("expected type %q, got %q", "Application", item.Type)
+	}
+	if item.AppId == nil || *item.AppId != "client" {
+		t.Fatalf("expected app ID %q, got %v", "client", item.AppId)
+	}
+
+	if _, err := DeletedItemRestore(context.Background(), client, "app"); err != nil {
+		t.Fatalf("unexpected error restoring: %v", err)
+	}
+	if _, status, err := DeletedItemGet(context.Background(), client, "app"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected restored item to be not found, got status %d: %v", status, err)
+	}
+	if status, err := DeletedItemPermanentlyDelete(context.Background(), client, "app"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error when purging a restored item, got status %d: %v", status, err)
+	}
+
+	deleted["app"] = true
+	if _, err := DeletedItemPermanentlyDelete(context.Background(), client, "app"); err != nil {
+		t.Fatalf("unexpected error purging: %v", err)
+	}
+}
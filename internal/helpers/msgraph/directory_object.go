@@ -0,0 +1,258 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+// DirectoryObject describes any object in the directory, such as a user, group, service principal or device
+type DirectoryObject struct {
+	ID          *string
+	DisplayName *string
+
+	// Type is the type of the directory object, e.g. `User` or `ServicePrincipal`
+	Type string
+}
+
+// DirectoryObjectGet retrieves the directory object with the specified ID, along with its type
+func DirectoryObjectGet(ctx context.Context, client msgraph.Client, id string) (*DirectoryObject, int, error) {
+	resp, status, o, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directoryObjects/%s", id),
+			Params:      url.Values{"$select": []string{"id,displayName"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	if o == nil || o.Type == nil {
+		return nil, status, fmt.Errorf("API did not return the type of directory object with ID %q", id)
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		ID          *string `json:"id"`
+		DisplayName *string `json:"displayName"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &DirectoryObject{
+		ID:          data.ID,
+		DisplayName: data.DisplayName,
+		Type:        directoryObjectTypeName(*o.Type),
+	}, status, nil
+}
+
+// DirectoryObjectType returns the type of the directory object with the specified ID, e.g. `User` or `ServicePrincipal`
+func DirectoryObjectType(ctx context.Context, client msgraph.Client, id string) (string, int, error) {
+	object, status, err := DirectoryObjectGet(ctx, client, id)
+	if err != nil {
+		return "", status, err
+	}
+	return object.Type, status, nil
+}
+
+// directoryObjectTypeName converts an OData type such as `#microsoft.graph.servicePrincipal` to `ServicePrincipal`
+func directoryObjectTypeName(odataType string) string {
+	name := strings.TrimPrefix(odataType, "#microsoft.graph.")
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// DirectoryObjectGetByIdsLimit is the maximum number of IDs that can be resolved in a single getByIds request
+const DirectoryObjectGetByIdsLimit = 1000
+
+// DirectoryObjectGetMemberGroups returns the IDs of all groups the directory object with the specified ID is a member
+// of, including those it is a member of by way of nested group memberships.
+func DirectoryObjectGetMemberGroups(ctx context.Context, client msgraph.Client, id string, securityEnabledOnly bool) ([]string, int, error) {
+	return directoryObjectGetMemberIds(ctx, client, id, "getMemberGroups", securityEnabledOnly)
+}
+
+// DirectoryObjectGetMemberObjects returns the IDs of all groups, directory roles and administrative units the
+// directory object with the specified ID is a member of, including by way of nested group memberships.
+func DirectoryObjectGetMemberObjects(ctx context.Context, client msgraph.Client, id string, securityEnabledOnly bool) ([]string, int, error) {
+	return directoryObjectGetMemberIds(ctx, client, id, "getMemberObjects", securityEnabledOnly)
+}
+
+func directoryObjectGetMemberIds(ctx context.Context, client msgraph.Client, id, action string, securityEnabledOnly bool) ([]string, int, error) {
+	body, err := json.Marshal(struct {
+		SecurityEnabledOnly bool `json:"securityEnabledOnly"`
+	}{
+		SecurityEnabledOnly: securityEnabledOnly,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directoryObjects/%s/%s", id, action),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Value []string `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return data.Value, status, nil
+}
+
+// DirectoryObjectsGetByIds retrieves the directory objects with the specified IDs, optionally restricted to the
+// specified types, e.g. `group` or `directoryRole`. IDs which are not found, or which do not match one of the types,
+// are omitted from the results.
+func DirectoryObjectsGetByIds(ctx context.Context, client msgraph.Client, ids []string, types []string) ([]DirectoryObject, int, error) {
+	result := make([]DirectoryObject, 0, len(ids))
+	var status int
+
+	for i := 0; i < len(ids); i += DirectoryObjectGetByIdsLimit {
+		end := i + DirectoryObjectGetByIdsLimit
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		body, err := json.Marshal(struct {
+			Ids   []string `json:"ids"`
+			Types []string `json:"types,omitempty"`
+		}{
+			Ids:   ids[i:end],
+			Types: types,
+		})
+		if err != nil {
+			return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+		}
+
+		resp, s, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+			Body:             body,
+			ValidStatusCodes: []int{http.StatusOK},
+			Uri: msgraph.Uri{
+				Entity:      "/directoryObjects/getByIds",
+				Params:      url.Values{"$select": []string{"id,displayName"}},
+				HasTenantId: true,
+			},
+		})
+		status = s
+		if err != nil {
+			return nil, status, fmt.Errorf("BaseClient.Post(): %v", err)
+		}
+
+		respBody, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+		}
+		var data struct {
+			Value []struct {
+				ODataType   string  `json:"@odata.type"`
+				ID          *string `json:"id"`
+				DisplayName *string `json:"displayName"`
+			} `json:"value"`
+		}
+		if err := json.Unmarshal(respBody, &data); err != nil {
+			return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+		}
+
+		for _, v := range data.Value {
+			result = append(result, DirectoryObject{
+				ID:          v.ID,
+				DisplayName: v.DisplayName,
+				Type:        directoryObjectTypeName(v.ODataType),
+			})
+		}
+	}
+
+	return result, status, nil
+}
+
+// DirectoryObjectMemberships describes the groups and directory roles a directory object is a member of
+type DirectoryObjectMemberships struct {
+	GroupIds         []string
+	DirectoryRoleIds []string
+
+	// DisplayNames maps object IDs to display names. Directory roles are always included, groups only when requested.
+	DisplayNames map[string]string
+}
+
+// DirectoryObjectMemberOf returns the groups and directory roles the directory object with the specified ID is a member
+// of, including by way of nested group memberships.
+func DirectoryObjectMemberOf(ctx context.Context, client msgraph.Client, id string, securityEnabledOnly, includeGroupNames bool) (*DirectoryObjectMemberships, int, error) {
+	groupIds, status, err := DirectoryObjectGetMemberGroups(ctx, client, id, securityEnabledOnly)
+	if err != nil {
+		return nil, status, err
+	}
+
+	memberObjectIds, status, err := DirectoryObjectGetMemberObjects(ctx, client, id, false)
+	if err != nil {
+		return nil, status, err
+	}
+
+	result := DirectoryObjectMemberships{
+		GroupIds:         groupIds,
+		DirectoryRoleIds: make([]string, 0),
+		DisplayNames:     make(map[string]string),
+	}
+
+	// member objects also include groups and administrative units, so resolve the remainder to find directory roles
+	if otherIds := utils.Difference(memberObjectIds, groupIds); len(otherIds) > 0 {
+		roles, status, err := DirectoryObjectsGetByIds(ctx, client, otherIds, []string{"directoryRole"})
+		if err != nil {
+			return nil, status, err
+		}
+		for _, role := range roles {
+			if role.ID == nil {
+				continue
+			}
+			result.DirectoryRoleIds = append(result.DirectoryRoleIds, *role.ID)
+			if role.DisplayName != nil {
+				result.DisplayNames[*role.ID] = *role.DisplayName
+			}
+		}
+	}
+
+	if includeGroupNames && len(groupIds) > 0 {
+		groups, status, err := DirectoryObjectsGetByIds(ctx, client, groupIds, []string{"group"})
+		if err != nil {
+			return nil, status, err
+		}
+		for _, group := range groups {
+			if group.ID != nil && group.DisplayName != nil {
+				result.DisplayNames[*group.ID] = *group.DisplayName
+			}
+		}
+	}
+
+	return &result, status, nil
+}
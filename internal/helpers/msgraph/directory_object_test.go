@@ -0,0 +1,122 @@
+package msgraph
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestDirectoryObjectTypeName(t *testing.T) {
+	cases := map[string]string{
+		"#microsoft.graph.user":             "User",
+		"#microsoft.graph.servicePrincipal": "ServicePrincipal",
+		"#microsoft.graph.group":            "Group",
+		"":                                  "",
+	}
+
+	for input, expected := range cases {
+		if v := directoryObjectTypeName(input); v != expected {
+			t.Fatalf("expected %q for %q, got %q", expected, input, v)
+		}
+	}
+}
+
+func TestDirectoryObjectGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.0/tenant/directoryObjects/11111111-1111-1111-1111-111111111111" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"@odata.type":"#microsoft.graph.group","id":"11111111-1111-1111-1111-111111111111","displayName":"Example Group"}`))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	object, _, err := DirectoryObjectGet(context.Background(), client, "11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if object.Type != "Group" {
+		t.Fatalf("expected type %q, got %q", "Group", object.Type)
+	}
+	if object.DisplayName == nil || *object.DisplayName != "Example Group" {
+		t.Fatalf("expected display name %q, got %v", "Example Group", object.DisplayName)
+	}
+
+	_, status, err := DirectoryObjectGet(context.Background(), client, "22222222-2222-2222-2222-222222222222")
+	if err == nil {
+		t.Fatal("expected an error for a missing object")
+	}
+	if status != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, status)
+	}
+}
+
+func TestDirectoryObjectMemberships(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v1.0/tenant/directoryObjects/user/getMemberGroups":
+			if !strings.Contains(string(body), `"securityEnabledOnly":true`) {
+				_, _ = w.Write([]byte(`{"value":["group1","group2"]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"value":["group1"]}`))
+		case "/v1.0/tenant/directoryObjects/user/getMemberObjects":
+			_, _ = w.Write([]byte(`{"value":["group1","group2","role1"]}`))
+		case "/v1.0/tenant/directoryObjects/getByIds":
+			if !strings.Contains(string(body), `"types":["directoryRole"]`) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`{"value":[{"@odata.type":"#microsoft.graph.directoryRole","id":"role1","displayName":"Global Reader"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	groups, _, err := DirectoryObjectGetMemberGroups(context.Background(), client, "user", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"group1"}; !reflect.DeepEqual(groups, expected) {
+		t.Fatalf("expected groups %v, got %v", expected, groups)
+	}
+
+	objects, _, err := DirectoryObjectGetMemberObjects(context.Background(), client, "user", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"group1", "group2", "role1"}; !reflect.DeepEqual(objects, expected) {
+		t.Fatalf("expected objects %v, got %v", expected, objects)
+	}
+
+	roles, _, err := DirectoryObjectsGetByIds(context.Background(), client, objects, []string{"directoryRole"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roles) != 1 || roles[0].Type != "DirectoryRole" || roles[0].DisplayName == nil || *roles[0].DisplayName != "Global Reader" {
+		t.Fatalf("unexpected directory roles returned: %+v", roles)
+	}
+}
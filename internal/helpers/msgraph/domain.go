@@ -0,0 +1,121 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// DomainDnsRecord describes a DNS record which must be published in order to verify or configure a Domain.
+// TXT records populate Text, MX records populate MailExchange and Preference.
+type DomainDnsRecord struct {
+	ID               *string `json:"id,omitempty"`
+	IsOptional       *bool   `json:"isOptional,omitempty"`
+	Label            *string `json:"label,omitempty"`
+	RecordType       *string `json:"recordType,omitempty"`
+	SupportedService *string `json:"supportedService,omitempty"`
+	Ttl              *int    `json:"ttl,omitempty"`
+
+	MailExchange *string `json:"mailExchange,omitempty"`
+	Preference   *int    `json:"preference,omitempty"`
+	Text         *string `json:"text,omitempty"`
+}
+
+// DomainCreate adds an unverified Domain to the tenant.
+func DomainCreate(ctx context.Context, client *msgraph.DomainsClient, domainName string) (*msgraph.Domain, int, error) {
+	var status int
+	body, err := json.Marshal(msgraph.Domain{ID: &domainName})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := client.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/domains",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DomainsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var domain msgraph.Domain
+	if err := json.Unmarshal(respBody, &domain); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &domain, status, nil
+}
+
+// DomainDelete removes a Domain from the tenant. Any objects still referencing the domain will block deletion.
+func DomainDelete(ctx context.Context, client *msgraph.DomainsClient, domainName string) (int, error) {
+	_, status, _, err := client.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/domains/%s", domainName),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("DomainsClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
+
+// DomainListVerificationDnsRecords retrieves the DNS records which must be published to verify ownership of a Domain.
+func DomainListVerificationDnsRecords(ctx context.Context, client *msgraph.DomainsClient, domainName string) (*[]DomainDnsRecord, int, error) {
+	resp, status, _, err := client.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/domains/%s/verificationDnsRecords", domainName),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DomainsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Records []DomainDnsRecord `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &data.Records, status, nil
+}
+
+// DomainVerify requests verification of a Domain, which succeeds once the verification DNS records have been published.
+func DomainVerify(ctx context.Context, client *msgraph.DomainsClient, domainName string) (*msgraph.Domain, int, error) {
+	resp, status, _, err := client.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/domains/%s/verify", domainName),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DomainsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var domain msgraph.Domain
+	if err := json.Unmarshal(respBody, &domain); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &domain, status, nil
+}
@@ -0,0 +1,106 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// ExtensionAttributeNameRegex matches the names of directory extension attributes, which are in the format
+// `extension_{appIdWithoutHyphens}_{name}`
+var ExtensionAttributeNameRegex = regexp.MustCompile(`^extension_[0-9a-fA-F]{32}_[A-Za-z0-9]+$`)
+
+// ExtensionAttributesGet retrieves the values of the named directory extension attributes for the directory object at
+// the specified entity path, e.g. `/users/{id}`. Attributes which have no value are omitted from the result.
+func ExtensionAttributesGet(ctx context.Context, client msgraph.Client, entity string, names []string) (map[string]string, int, error) {
+	result := make(map[string]string)
+	if len(names) == 0 {
+		return result, http.StatusOK, nil
+	}
+
+	selectNames := make([]string, len(names))
+	copy(selectNames, names)
+	sort.Strings(selectNames)
+
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			Params:      url.Values{"$select": []string{strings.Join(selectNames, ",")}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	for _, name := range names {
+		if v, ok := data[name]; ok && v != nil {
+			result[name] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return result, status, nil
+}
+
+// ExtensionAttributesUpdate sets the values of directory extension attributes for the directory object at the
+// specified entity path, e.g. `/users/{id}`. Attributes with a nil value are cleared.
+func ExtensionAttributesUpdate(ctx context.Context, client msgraph.Client, entity string, values map[string]*string) (int, error) {
+	var status int
+	if len(values) == 0 {
+		return status, nil
+	}
+
+	body, err := json.Marshal(values)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = client.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// ExtensionAttributesExpand returns the changes to directory extension attributes between the old and new values of
+// an `extension_attributes` map, with a nil value for any attribute which has been removed.
+func ExtensionAttributesExpand(old, new map[string]interface{}) map[string]*string {
+	result := make(map[string]*string)
+	for k := range old {
+		if _, ok := new[k]; !ok {
+			result[k] = nil
+		}
+	}
+	for k, v := range new {
+		value := v.(string)
+		if o, ok := old[k]; ok && o.(string) == value {
+			continue
+		}
+		result[k] = &value
+	}
+	return result
+}
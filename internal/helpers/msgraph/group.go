@@ -2,13 +2,31 @@ package msgraph
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/manicminer/hamilton/msgraph"
+	"github.com/manicminer/hamilton/odata"
 )
 
-func GroupCheckNameAvailability(ctx context.Context, client *msgraph.GroupsClient, displayName string, existingID *string) (*string, error) {
+const (
+	// GroupDuplicateNamesScopeAll considers all groups when checking for duplicate names
+	GroupDuplicateNamesScopeAll = "all"
+
+	// GroupDuplicateNamesScopeSecurity considers only security groups which are not unified groups
+	GroupDuplicateNamesScopeSecurity = "security"
+
+	// GroupDuplicateNamesScopeUnified considers only unified (Microsoft 365) groups
+	GroupDuplicateNamesScopeUnified = "unified"
+)
+
+// GroupCheckNameAvailability returns the ID of an existing group with the specified display name, ignoring the group
+// with existingID if specified. Only groups within the specified scope are considered, and display names are compared
+// case-insensitively unless caseSensitive is true.
+func GroupCheckNameAvailability(ctx context.Context, client *msgraph.GroupsClient, displayName string, existingID *string, scope string, caseSensitive bool) (*string, error) {
 	filter := fmt.Sprintf("displayName eq '%s'", displayName)
 	result, _, err := client.List(ctx, filter)
 	if err != nil {
@@ -22,10 +40,258 @@ func GroupCheckNameAvailability(ctx context.Context, client *msgraph.GroupsClien
 		if existingID != nil && *existingID == *r.ID {
 			continue
 		}
-		if r.DisplayName != nil && strings.EqualFold(displayName, *r.DisplayName) {
+		if groupIsDuplicate(r, displayName, scope, caseSensitive) {
 			return r.ID, nil
 		}
 	}
 
 	return nil, nil
 }
+
+func groupIsDuplicate(group msgraph.Group, displayName string, scope string, caseSensitive bool) bool {
+	if group.DisplayName == nil {
+		return false
+	}
+	if caseSensitive && *group.DisplayName != displayName {
+		return false
+	}
+	if !caseSensitive && !strings.EqualFold(*group.DisplayName, displayName) {
+		return false
+	}
+
+	unified := false
+	if group.GroupTypes != nil {
+		for _, t := range *group.GroupTypes {
+			if strings.EqualFold(t, "Unified") {
+				unified = true
+			}
+		}
+	}
+
+	switch scope {
+	case GroupDuplicateNamesScopeSecurity:
+		return !unified && group.SecurityEnabled != nil && *group.SecurityEnabled
+	case GroupDuplicateNamesScopeUnified:
+		return unified
+	}
+
+	return true
+}
+
+// GroupMailNicknameMaxLength is the maximum length of a group mail nickname
+const GroupMailNicknameMaxLength = 64
+
+// GroupDefaultMailNickname returns a lower case slug of the provided display name for use as a mail nickname, where
+// any sequence of characters other than ASCII letters, numbers, hyphens and underscores is replaced with a hyphen.
+// An empty string is returned when the display name contains no suitable characters.
+func GroupDefaultMailNickname(displayName string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, c := range strings.ToLower(displayName) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '_' {
+			b.WriteRune(c)
+			hyphen = false
+		} else if !hyphen && b.Len() > 0 {
+			b.WriteRune('-')
+			hyphen = true
+		}
+	}
+
+	nickname := b.String()
+	if len(nickname) > GroupMailNicknameMaxLength {
+		nickname = nickname[:GroupMailNicknameMaxLength]
+	}
+
+	return strings.TrimRight(nickname, "-")
+}
+
+// GroupMembersCreateLimit is the maximum number of members or owners that can be specified when creating a group
+const GroupMembersCreateLimit = 20
+
+// GroupAddMembers adds the specified members to a group, binding up to BatchMaxRequests members per request. Members
+// that are already present are skipped.
+func GroupAddMembers(ctx context.Context, client *msgraph.GroupsClient, groupId string, memberIds []string) error {
+	for i := 0; i < len(memberIds); i += BatchMaxRequests {
+		end := i + BatchMaxRequests
+		if end > len(memberIds) {
+			end = len(memberIds)
+		}
+		chunk := memberIds[i:end]
+
+		members := make([]string, 0, len(chunk))
+		for _, id := range chunk {
+			members = append(members, groupDirectoryObjectUri(client, id))
+		}
+
+		body, err := json.Marshal(struct {
+			Members []string `json:"members@odata.bind"`
+		}{
+			Members: members,
+		})
+		if err != nil {
+			return fmt.Errorf("json.Marshal(): %v", err)
+		}
+
+		// When any member in the request already exists, none of the members are added
+		alreadyExists := false
+		checkMemberAlreadyExists := func(resp *http.Response, o *odata.OData) bool {
+			if resp.StatusCode == http.StatusBadRequest && o != nil && o.Error != nil && o.Error.Match(odata.ErrorAddedObjectReferencesAlreadyExist) {
+				alreadyExists = true
+				return true
+			}
+			return false
+		}
+
+		if _, _, _, err = client.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+			Body:             body,
+			ValidStatusCodes: []int{http.StatusNoContent},
+			ValidStatusFunc:  checkMemberAlreadyExists,
+			Uri: msgraph.Uri{
+				Entity:      fmt.Sprintf("/groups/%s", groupId),
+				HasTenantId: true,
+			},
+		}); err != nil {
+			return fmt.Errorf("GroupsClient.BaseClient.Patch(): %v", err)
+		}
+
+		if alreadyExists {
+			if err := groupAddMembersIndividually(ctx, client, groupId, chunk); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// groupAddMembersIndividually adds members using a separate request for each member within a batch, so that members
+// which already exist do not prevent the others from being added
+func groupAddMembersIndividually(ctx context.Context, client *msgraph.GroupsClient, groupId string, memberIds []string) error {
+	requests := make([]BatchRequest, 0, len(memberIds))
+	for i, id := range memberIds {
+		requests = append(requests, BatchRequest{
+			ID:      strconv.Itoa(i),
+			Method:  http.MethodPost,
+			Url:     fmt.Sprintf("/groups/%s/members/$ref", groupId),
+			Headers: map[string]string{"Content-Type": "application/json"},
+			Body: map[string]string{
+				"@odata.id": groupDirectoryObjectUri(client, id),
+			},
+		})
+	}
+
+	responses, err := Batch(ctx, client.BaseClient, requests)
+	if err != nil {
+		return err
+	}
+
+	for i, id := range memberIds {
+		resp := responses[strconv.Itoa(i)]
+		if resp.Status == http.StatusNoContent {
+			continue
+		}
+		if e := resp.Error(); e != nil {
+			if resp.Status == http.StatusBadRequest && e.Match(odata.ErrorAddedObjectReferencesAlreadyExist) {
+				continue
+			}
+			return fmt.Errorf("adding member %q to group %q: unexpected status %d with OData error: %s", id, groupId, resp.Status, e)
+		}
+		return fmt.Errorf("adding member %q to group %q: unexpected status %d", id, groupId, resp.Status)
+	}
+
+	return nil
+}
+
+// GroupRemoveMembers removes the specified members from a group, using JSON batching to remove up to
+// BatchMaxRequests members per request. Members that are not present are ignored.
+func GroupRemoveMembers(ctx context.Context, client *msgraph.GroupsClient, groupId string, memberIds []string) error {
+	requests := make([]BatchRequest, 0, len(memberIds))
+	for i, id := range memberIds {
+		requests = append(requests, BatchRequest{
+			ID:     strconv.Itoa(i),
+			Method: http.MethodDelete,
+			Url:    fmt.Sprintf("/groups/%s/members/%s/$ref", groupId, id),
+		})
+	}
+
+	responses, err := Batch(ctx, client.BaseClient, requests)
+	if err != nil {
+		return err
+	}
+
+	for i, id := range memberIds {
+		resp := responses[strconv.Itoa(i)]
+		if resp.Status == http.StatusNoContent || resp.Status == http.StatusNotFound {
+			continue
+		}
+		if e := resp.Error(); e != nil {
+			if resp.Status == http.StatusBadRequest && e.Match(odata.ErrorRemovedObjectReferencesDoNotExist) {
+				continue
+			}
+			return fmt.Errorf("removing member %q from group %q: unexpected status %d with OData error: %s", id, groupId, resp.Status, e)
+		}
+		return fmt.Errorf("removing member %q from group %q: unexpected status %d", id, groupId, resp.Status)
+	}
+
+	return nil
+}
+
+func groupDirectoryObjectUri(client *msgraph.GroupsClient, id string) string {
+	return fmt.Sprintf("%s/%s/directoryObjects/%s", client.BaseClient.Endpoint, client.BaseClient.ApiVersion, id)
+}
+
+// GroupFindDeleted returns the deleted group with the specified display name, mail nickname, group types and security
+// setting, or nil if no such group can be restored. Names and group types are compared case-insensitively. An error is
+// returned when more than one deleted group matches, since it cannot be determined which of them should be restored.
+func GroupFindDeleted(ctx context.Context, client msgraph.Client, displayName, mailNickname string, groupTypes []string, securityEnabled bool) (*DeletedItem, error) {
+	items, _, err := DeletedItemsList(ctx, client, DeletedItemTypeGroup)
+	if err != nil {
+		return nil, fmt.Errorf("listing deleted groups: %v", err)
+	}
+
+	var found *DeletedItem
+	for i, item := range items {
+		if item.ID == nil || item.DisplayName == nil || !strings.EqualFold(*item.DisplayName, displayName) {
+			continue
+		}
+		if item.MailNickname == nil || !strings.EqualFold(*item.MailNickname, mailNickname) {
+			continue
+		}
+		if item.SecurityEnabled == nil || *item.SecurityEnabled != securityEnabled {
+			continue
+		}
+		var itemGroupTypes []string
+		if item.GroupTypes != nil {
+			itemGroupTypes = *item.GroupTypes
+		}
+		if !groupTypesEqual(itemGroupTypes, groupTypes) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("found more than one deleted group with display name %q and mail nickname %q (%s, %s)", displayName, mailNickname, *found.ID, *item.ID)
+		}
+		found = &items[i]
+	}
+
+	return found, nil
+}
+
+// groupTypesEqual returns whether two sets of group types contain the same values, ignoring order and case
+func groupTypesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, x := range a {
+		matched := false
+		for _, y := range b {
+			if strings.EqualFold(x, y) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
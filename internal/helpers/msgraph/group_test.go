@@ -0,0 +1,133 @@
+package msgraph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestGroupIsDuplicate(t *testing.T) {
+	securityGroup := msgraph.Group{
+		DisplayName:     utils.String("Example Group"),
+		SecurityEnabled: utils.Bool(true),
+	}
+	unifiedGroup := msgraph.Group{
+		DisplayName:     utils.String("Example Group"),
+		GroupTypes:      &[]string{"Unified"},
+		SecurityEnabled: utils.Bool(false),
+	}
+
+	cases := []struct {
+		TestName      string
+		Group         msgraph.Group
+		DisplayName   string
+		Scope         string
+		CaseSensitive bool
+		Expected      bool
+	}{
+		{"AllSecurity", securityGroup, "Example Group", GroupDuplicateNamesScopeAll, false, true},
+		{"AllUnified", unifiedGroup, "Example Group", GroupDuplicateNamesScopeAll, false, true},
+		{"AllDifferentName", securityGroup, "Other Group", GroupDuplicateNamesScopeAll, false, false},
+		{"AllCaseInsensitive", securityGroup, "example group", GroupDuplicateNamesScopeAll, false, true},
+		{"AllCaseSensitive", securityGroup, "example group", GroupDuplicateNamesScopeAll, true, false},
+		{"AllCaseSensitiveMatch", securityGroup, "Example Group", GroupDuplicateNamesScopeAll, true, true},
+		{"SecuritySecurity", securityGroup, "Example Group", GroupDuplicateNamesScopeSecurity, false, true},
+		{"SecurityUnified", unifiedGroup, "Example Group", GroupDuplicateNamesScopeSecurity, false, false},
+		{"UnifiedSecurity", securityGroup, "Example Group", GroupDuplicateNamesScopeUnified, false, false},
+		{"UnifiedUnified", unifiedGroup, "Example Group", GroupDuplicateNamesScopeUnified, false, true},
+		{"NilDisplayName", msgraph.Group{}, "Example Group", GroupDuplicateNamesScopeAll, false, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			if actual := groupIsDuplicate(tc.Group, tc.DisplayName, tc.Scope, tc.CaseSensitive); actual != tc.Expected {
+				t.Fatalf("expected %t, got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestGroupDefaultMailNickname(t *testing.T) {
+	cases := []struct {
+		DisplayName string
+		Expected    string
+	}{
+		{"Example", "example"},
+		{"Example Group", "example-group"},
+		{"  Sales & Marketing (EMEA)  ", "sales-marketing-emea"},
+		{"team_alpha-2", "team_alpha-2"},
+		{"Ünïcode Grüppe", "n-code-gr-ppe"},
+		{"!!!", ""},
+		{strings.Repeat("a", 70), strings.Repeat("a", 64)},
+		{strings.Repeat("a", 63) + " b", strings.Repeat("a", 63)},
+	}
+
+	for _, tc := range cases {
+		if actual := GroupDefaultMailNickname(tc.DisplayName); actual != tc.Expected {
+			t.Errorf("GroupDefaultMailNickname(%q): expected %q, got %q", tc.DisplayName, tc.Expected, actual)
+		}
+	}
+}
+
+func TestGroupFindDeleted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value":[
+			{"id":"security","displayName":"Team","mailNickname":"team","groupTypes":[],"securityEnabled":true},
+			{"id":"unified","displayName":"Team","mailNickname":"team","groupTypes":["Unified"],"securityEnabled":false},
+			{"id":"other-nickname","displayName":"Team","mailNickname":"team-2","groupTypes":[],"securityEnabled":true},
+			{"id":"duplicate1","displayName":"Shared","mailNickname":"shared","groupTypes":[],"securityEnabled":true},
+			{"id":"duplicate2","displayName":"shared","mailNickname":"SHARED","groupTypes":[],"securityEnabled":true}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+	ctx := context.Background()
+
+	cases := []struct {
+		DisplayName     string
+		MailNickname    string
+		GroupTypes      []string
+		SecurityEnabled bool
+		Expected        string
+		ExpectError     bool
+	}{
+		{DisplayName: "team", MailNickname: "team", GroupTypes: []string{}, SecurityEnabled: true, Expected: "security"},
+		{DisplayName: "Team", MailNickname: "team", GroupTypes: []string{"unified"}, SecurityEnabled: false, Expected: "unified"},
+		{DisplayName: "Team", MailNickname: "team-2", GroupTypes: []string{}, SecurityEnabled: true, Expected: "other-nickname"},
+		{DisplayName: "Team", MailNickname: "team-3", GroupTypes: []string{}, SecurityEnabled: true},
+		{DisplayName: "Team", MailNickname: "team", GroupTypes: []string{"Unified"}, SecurityEnabled: true},
+		{DisplayName: "Shared", MailNickname: "shared", GroupTypes: []string{}, SecurityEnabled: true, ExpectError: true},
+	}
+
+	for _, tc := range cases {
+		found, err := GroupFindDeleted(ctx, client, tc.DisplayName, tc.MailNickname, tc.GroupTypes, tc.SecurityEnabled)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error for %q/%q, got group %v", tc.DisplayName, tc.MailNickname, found)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %q/%q: %v", tc.DisplayName, tc.MailNickname, err)
+		}
+		if tc.Expected == "" {
+			if found != nil {
+				t.Fatalf("expected no match for %q/%q, got %q", tc.DisplayName, tc.MailNickname, *found.ID)
+			}
+			continue
+		}
+		if found == nil || *found.ID != tc.Expected {
+			t.Fatalf("expected %q for %q/%q, got %v", tc.Expected, tc.DisplayName, tc.MailNickname, found)
+		}
+	}
+}
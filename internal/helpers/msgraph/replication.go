@@ -3,12 +3,30 @@ package msgraph
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+// parentReplicationTimeout limits how long requests are retried whilst their parent object cannot be found, so that a
+// parent which genuinely does not exist is still reported promptly
+const parentReplicationTimeout = 2 * time.Minute
+
 func WaitForCreationReplication(ctx context.Context, f func() (interface{}, int, error)) (interface{}, error) {
+	return waitForCreation(ctx, 2, f)
+}
+
+// WaitForCreationConfirmation waits until a newly created object has been found once, rather than on consecutive
+// attempts as with WaitForCreationReplication. This is faster when creating many objects, at the risk that subsequent
+// requests are handled by an instance of the directory to which the object has not yet replicated.
+func WaitForCreationConfirmation(ctx context.Context, f func() (interface{}, int, error)) (interface{}, error) {
+	return waitForCreation(ctx, 1, f)
+}
+
+func waitForCreation(ctx context.Context, occurrences int, f func() (interface{}, int, error)) (interface{}, error) {
 	deadline, ok := ctx.Deadline()
 	if !ok {
 		return nil, fmt.Errorf("context has no deadline")
@@ -19,7 +37,7 @@ func WaitForCreationReplication(ctx context.Context, f func() (interface{}, int,
 		Target:                    []string{"Found"},
 		Timeout:                   timeout,
 		MinTimeout:                1 * time.Second,
-		ContinuousTargetOccurence: 2,
+		ContinuousTargetOccurence: occurrences,
 		Refresh: func() (interface{}, string, error) {
 			i, status, err := f()
 
@@ -98,3 +116,65 @@ func WaitForListRemove(ctx context.Context, item string, f func() ([]string, err
 		},
 	}).WaitForStateContext(ctx)
 }
+
+// referenceNotReplicatedRegex matches errors returned when a request refers to a directory object that has been
+// created recently, but has not yet replicated to the instance of the directory handling the request
+var referenceNotReplicatedRegex = regexp.MustCompile(`does not exist or one of its queried reference-property objects are not present|does not reference a valid application object`)
+
+// IsReferenceNotReplicated determines whether a failed request refers to a directory object which does not exist yet,
+// and can therefore be retried once replication has caught up
+func IsReferenceNotReplicated(status int, err error) bool {
+	if err == nil {
+		return false
+	}
+	return status == http.StatusNotFound || referenceNotReplicatedRegex.MatchString(err.Error())
+}
+
+// WaitForReferenceReplication retries a write which refers to other directory objects, such as adding members or owners,
+// whilst the API reports that those objects do not exist. Objects which were created moments earlier are commonly not
+// yet visible, so rather than failing immediately, the write is retried until the context deadline, which is governed by
+// the timeouts of the calling resource.
+func WaitForReferenceReplication(ctx context.Context, f func() (int, error)) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context has no deadline")
+	}
+	return resource.RetryContext(ctx, time.Until(deadline), func() *resource.RetryError {
+		status, err := f()
+		if err == nil {
+			return nil
+		}
+		if IsReferenceNotReplicated(status, err) {
+			log.Printf("[DEBUG] Referenced directory object(s) not yet replicated, retrying: %v", err)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+}
+
+// WaitForParentReplication retries a request concerning a sub-resource of the parent object with the specified ID, such
+// as adding a credential to an application, whilst the API reports that the parent does not exist. A parent which was
+// created moments earlier in the same apply is commonly not yet visible. Retries are limited to
+// parentReplicationTimeout, and the status of the final attempt is returned so that callers can report a missing parent.
+func WaitForParentReplication(ctx context.Context, parentId string, f func() (int, error)) (int, error) {
+	timeout := parentReplicationTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+
+	var status int
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		status, err = f()
+		if err == nil {
+			return nil
+		}
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Parent object with ID %q was not found, it may not have replicated yet, retrying: %v", parentId, err)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+
+	return status, err
+}
@@ -0,0 +1,61 @@
+package msgraph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitForParentReplication_retriesNotFound(t *testing.T) {
+	attempts := 0
+	status, err := WaitForParentReplication(context.Background(), "parent", func() (int, error) {
+		attempts++
+		if attempts < 2 {
+			return http.StatusNotFound, errors.New("not found")
+		}
+		return http.StatusOK, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestWaitForParentReplication_otherErrors(t *testing.T) {
+	attempts := 0
+	status, err := WaitForParentReplication(context.Background(), "parent", func() (int, error) {
+		attempts++
+		return http.StatusBadRequest, errors.New("bad request")
+	})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if status != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", status)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestWaitForParentReplication_notFoundUntilDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	status, err := WaitForParentReplication(ctx, "parent", func() (int, error) {
+		return http.StatusNotFound, errors.New("not found")
+	})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if status != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", status)
+	}
+}
@@ -0,0 +1,107 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// SignInActivity describes the most recent sign-in of a particular kind.
+type SignInActivity struct {
+	LastSignInDateTime  *time.Time `json:"lastSignInDateTime,omitempty"`
+	LastSignInRequestId *string    `json:"lastSignInRequestId,omitempty"`
+}
+
+// ServicePrincipalSignInActivity summarises the most recent sign-ins for a service principal. These reports are only
+// available with the beta API.
+type ServicePrincipalSignInActivity struct {
+	ID                                            *string         `json:"id,omitempty"`
+	AppId                                         *string         `json:"appId,omitempty"`
+	ApplicationAuthenticationClientSignInActivity *SignInActivity `json:"applicationAuthenticationClientSignInActivity,omitempty"`
+	DelegatedClientSignInActivity                 *SignInActivity `json:"delegatedClientSignInActivity,omitempty"`
+	LastSignInActivity                            *SignInActivity `json:"lastSignInActivity,omitempty"`
+}
+
+// ApplicationSignInSummary describes the number of sign-ins for an application over a reporting period. These reports
+// are only available with the beta API.
+type ApplicationSignInSummary struct {
+	ID                    *string  `json:"id,omitempty"`
+	AppDisplayName        *string  `json:"appDisplayName,omitempty"`
+	FailedSignInCount     *int64   `json:"failedSignInCount,omitempty"`
+	SuccessPercentage     *float64 `json:"successPercentage,omitempty"`
+	SuccessfulSignInCount *int64   `json:"successfulSignInCount,omitempty"`
+}
+
+// ServicePrincipalSignInActivityGet retrieves the sign-in activity for the service principal of the application with
+// the specified application ID. When no sign-in activity has been recorded, a nil result is returned.
+func ServicePrincipalSignInActivityGet(ctx context.Context, client msgraph.Client, appId string) (*ServicePrincipalSignInActivity, int, error) {
+	params := url.Values{}
+	params.Add("$filter", fmt.Sprintf("appId eq '%s'", appId))
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/reports/servicePrincipalSignInActivities",
+			Params:      params,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		SignInActivities []ServicePrincipalSignInActivity `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	for _, activity := range data.SignInActivities {
+		if activity.AppId != nil && *activity.AppId == appId {
+			return &activity, status, nil
+		}
+	}
+	return nil, status, nil
+}
+
+// ApplicationSignInSummaryGet retrieves the sign-in summary for the application with the specified application ID over
+// the specified reporting period, which must be one of `D1`, `D7` or `D30`. When the application has no sign-ins during
+// the reporting period, a nil result is returned.
+func ApplicationSignInSummaryGet(ctx context.Context, client msgraph.Client, appId, period string) (*ApplicationSignInSummary, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/reports/getAzureADApplicationSignInSummary(period='%s')", period),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Summaries []ApplicationSignInSummary `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	for _, summary := range data.Summaries {
+		if summary.ID != nil && *summary.ID == appId {
+			return &summary, status, nil
+		}
+	}
+	return nil, status, nil
+}
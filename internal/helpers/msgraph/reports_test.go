@@ -0,0 +1,91 @@
+package msgraph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestServicePrincipalSignInActivityGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/beta/tenant/reports/servicePrincipalSignInActivities" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist."}}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("$filter") != "appId eq 'active'" {
+			_, _ = w.Write([]byte(`{"value":[]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"value":[{"id":"sp","appId":"active","lastSignInActivity":{"lastSignInDateTime":"2021-06-01T12:00:00Z"}}]}`))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.VersionBeta, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	activity, _, err := ServicePrincipalSignInActivityGet(context.Background(), client, "active")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if activity == nil || activity.LastSignInActivity == nil || activity.LastSignInActivity.LastSignInDateTime == nil {
+		t.Fatalf("expected sign-in activity, got %+v", activity)
+	}
+	if got := activity.LastSignInActivity.LastSignInDateTime.Format("2006-01-02"); got != "2021-06-01" {
+		t.Fatalf("expected last sign-in date %q, got %q", "2021-06-01", got)
+	}
+
+	activity, _, err = ServicePrincipalSignInActivityGet(context.Background(), client, "inactive")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if activity != nil {
+		t.Fatalf("expected no sign-in activity, got %+v", activity)
+	}
+}
+
+func TestApplicationSignInSummaryGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/beta/tenant/reports/getAzureADApplicationSignInSummary(period='D7')" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist."}}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value":[{"id":"other","successfulSignInCount":1},{"id":"app","successfulSignInCount":10,"failedSignInCount":2,"successPercentage":83.33}]}`))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.VersionBeta, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	summary, _, err := ApplicationSignInSummaryGet(context.Background(), client, "app", "D7")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary == nil || summary.SuccessfulSignInCount == nil || *summary.SuccessfulSignInCount != 10 {
+		t.Fatalf("expected 10 successful sign-ins, got %+v", summary)
+	}
+	if summary.FailedSignInCount == nil || *summary.FailedSignInCount != 2 {
+		t.Fatalf("expected 2 failed sign-ins, got %+v", summary.FailedSignInCount)
+	}
+
+	summary, _, err = ApplicationSignInSummaryGet(context.Background(), client, "missing", "D7")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary != nil {
+		t.Fatalf("expected no sign-in summary, got %+v", summary)
+	}
+
+	if _, status, err := ApplicationSignInSummaryGet(context.Background(), client, "app", "D30"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected not found error, got status %d and error %v", status, err)
+	}
+}
@@ -0,0 +1,264 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// ServicePrincipalGetPreferredTokenSigningKeyThumbprint retrieves the thumbprint of the certificate which the service
+// principal with the specified object ID uses to sign SAML tokens, if any
+func ServicePrincipalGetPreferredTokenSigningKeyThumbprint(ctx context.Context, client msgraph.Client, id string) (*string, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s", id),
+			Params:      url.Values{"$select": []string{"preferredTokenSigningKeyThumbprint"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		PreferredTokenSigningKeyThumbprint *string `json:"preferredTokenSigningKeyThumbprint"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return data.PreferredTokenSigningKeyThumbprint, status, nil
+}
+
+// ServicePrincipalSetPreferredTokenSigningKeyThumbprint sets the thumbprint of the certificate which the service
+// principal with the specified object ID should use to sign SAML tokens. The certificate must already be present in
+// the key credentials for the service principal. An empty thumbprint clears the preference.
+func ServicePrincipalSetPreferredTokenSigningKeyThumbprint(ctx context.Context, client msgraph.Client, id, thumbprint string) (int, error) {
+	var status int
+	data := struct {
+		PreferredTokenSigningKeyThumbprint *string `json:"preferredTokenSigningKeyThumbprint"`
+	}{}
+	if thumbprint != "" {
+		data.PreferredTokenSigningKeyThumbprint = &thumbprint
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = client.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// ServicePrincipalAppRoleAssignedTo returns the app role assignments granted for the resource service principal with
+// the specified object ID, i.e. the users, groups and service principals that have been assigned an app role exposed
+// by the service principal. All pages of results are retrieved.
+func ServicePrincipalAppRoleAssignedTo(ctx context.Context, client msgraph.Client, id string) ([]msgraph.AppRoleAssignment, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		AppRoleAssignments []msgraph.AppRoleAssignment `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return data.AppRoleAssignments, status, nil
+}
+
+// ServicePrincipalAppRoleAssignments returns the app role assignments granted to the service principal with the
+// specified object ID, i.e. the app roles exposed by other service principals which it has been assigned. All pages of
+// results are retrieved.
+func ServicePrincipalAppRoleAssignments(ctx context.Context, client msgraph.Client, id string) ([]msgraph.AppRoleAssignment, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s/appRoleAssignments", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		AppRoleAssignments []msgraph.AppRoleAssignment `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return data.AppRoleAssignments, status, nil
+}
+
+// ServicePrincipalAssignAppRole assigns the app role with the specified ID, exposed by the resource service principal,
+// to the service principal with the specified object ID
+func ServicePrincipalAssignAppRole(ctx context.Context, client msgraph.Client, id, resourceId, appRoleId string) (*msgraph.AppRoleAssignment, int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		AppRoleId   string `json:"appRoleId"`
+		PrincipalId string `json:"principalId"`
+		ResourceId  string `json:"resourceId"`
+	}{
+		AppRoleId:   appRoleId,
+		PrincipalId: id,
+		ResourceId:  resourceId,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s/appRoleAssignments", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var assignment msgraph.AppRoleAssignment
+	if err := json.Unmarshal(respBody, &assignment); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &assignment, status, nil
+}
+
+// ServicePrincipalRemoveAppRoleAssignment removes the app role assignment with the specified ID from the service
+// principal with the specified object ID
+func ServicePrincipalRemoveAppRoleAssignment(ctx context.Context, client msgraph.Client, id, assignmentId string) (int, error) {
+	_, status, _, err := client.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s/appRoleAssignments/%s", id, assignmentId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
+
+// ServicePrincipalRemoveAppRoleAssignedTo removes the app role assignment with the specified ID from the resource
+// service principal with the specified object ID. Unlike ServicePrincipalRemoveAppRoleAssignment, this can be used
+// to remove assignments for any type of principal.
+func ServicePrincipalRemoveAppRoleAssignedTo(ctx context.Context, client msgraph.Client, resourceId, assignmentId string) (int, error) {
+	_, status, _, err := client.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/servicePrincipals/%s/appRoleAssignedTo/%s", resourceId, assignmentId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
+
+// AppRoleAssignmentsForAppRoles returns the assignments from the provided slice which are for any of the app roles
+// with the specified IDs
+func AppRoleAssignmentsForAppRoles(in []msgraph.AppRoleAssignment, appRoleIds []string) []msgraph.AppRoleAssignment {
+	result := make([]msgraph.AppRoleAssignment, 0)
+	for _, assignment := range in {
+		if assignment.AppRoleId == nil {
+			continue
+		}
+		for _, appRoleId := range appRoleIds {
+			if strings.EqualFold(*assignment.AppRoleId, appRoleId) {
+				result = append(result, assignment)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func AppRoleAssignmentsFlatten(in []msgraph.AppRoleAssignment) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(in))
+	for _, assignment := range in {
+		id := ""
+		if assignment.Id != nil {
+			id = *assignment.Id
+		}
+		appRoleId := ""
+		if assignment.AppRoleId != nil {
+			appRoleId = *assignment.AppRoleId
+		}
+		createdDate := ""
+		if assignment.CreatedDateTime != nil {
+			createdDate = assignment.CreatedDateTime.Format(time.RFC3339)
+		}
+		principalDisplayName := ""
+		if assignment.PrincipalDisplayName != nil {
+			principalDisplayName = *assignment.PrincipalDisplayName
+		}
+		principalId := ""
+		if assignment.PrincipalId != nil {
+			principalId = *assignment.PrincipalId
+		}
+		principalType := ""
+		if assignment.PrincipalType != nil {
+			principalType = *assignment.PrincipalType
+		}
+
+		result = append(result, map[string]interface{}{
+			"app_role_id":            appRoleId,
+			"created_date":           createdDate,
+			"id":                     id,
+			"principal_display_name": principalDisplayName,
+			"principal_object_id":    principalId,
+			"principal_type":         principalType,
+		})
+	}
+
+	return result
+}
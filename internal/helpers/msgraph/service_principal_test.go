@@ -0,0 +1,222 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestServicePrincipalPreferredTokenSigningKeyThumbprint(t *testing.T) {
+	thumbprint := "null"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"preferredTokenSigningKeyThumbprint":` + thumbprint + `}`))
+		case http.MethodPatch:
+			body, _ := ioutil.ReadAll(r.Body)
+			thumbprint = string(body[len(`{"preferredTokenSigningKeyThumbprint":`) : len(body)-1])
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	v, _, err := ServicePrincipalGetPreferredTokenSigningKeyThumbprint(context.Background(), client, "sp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v != nil {
+		t.Fatalf("expected no thumbprint, got %q", *v)
+	}
+
+	if _, err := ServicePrincipalSetPreferredTokenSigningKeyThumbprint(context.Background(), client, "sp", "ABCDEF0123456789ABCDEF0123456789ABCDEF01"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	v, _, err = ServicePrincipalGetPreferredTokenSigningKeyThumbprint(context.Background(), client, "sp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v == nil || *v != "ABCDEF0123456789ABCDEF0123456789ABCDEF01" {
+		t.Fatalf("expected thumbprint to be set, got %v", v)
+	}
+
+	if _, err := ServicePrincipalSetPreferredTokenSigningKeyThumbprint(context.Background(), client, "sp", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if thumbprint != "null" {
+		t.Fatalf("expected thumbprint to be cleared, got %s", thumbprint)
+	}
+}
+
+func TestServicePrincipalAppRoleAssignedTo(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1.0/tenant/servicePrincipals/sp/appRoleAssignedTo" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"value":[{"id":"assignment2","appRoleId":"00000000-0000-0000-0000-000000000000","principalId":"group1","principalType":"Group","principalDisplayName":"Group 1"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"@odata.nextLink":"%s%s?page=2","value":[{"id":"assignment1","appRoleId":"role1","principalId":"user1","principalType":"User","principalDisplayName":"User 1"}]}`, server.URL, r.URL.Path)))
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	assignments, _, err := ServicePrincipalAppRoleAssignedTo(context.Background(), client, "sp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(assignments) != 2 {
+		t.Fatalf("expected 2 assignments, got %d", len(assignments))
+	}
+	if v := assignments[0].PrincipalType; v == nil || *v != "User" {
+		t.Fatalf("expected first assignment to have principal type User, got %v", v)
+	}
+	if v := assignments[1].PrincipalDisplayName; v == nil || *v != "Group 1" {
+		t.Fatalf("expected second assignment to have principal display name \"Group 1\", got %v", v)
+	}
+
+	if _, status, err := ServicePrincipalAppRoleAssignedTo(context.Background(), client, "missing"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
+
+func TestServicePrincipalAppRoleAssignments(t *testing.T) {
+	assignments := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const path = "/v1.0/tenant/servicePrincipals/sp/appRoleAssignments"
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == path:
+			body, _ := ioutil.ReadAll(r.Body)
+			var data struct {
+				AppRoleId   string `json:"appRoleId"`
+				PrincipalId string `json:"principalId"`
+				ResourceId  string `json:"resourceId"`
+			}
+			_ = json.Unmarshal(body, &data)
+			if data.PrincipalId != "sp" || data.ResourceId != "resource" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code":"Request_BadRequest","message":"Invalid principal or resource."}}`))
+				return
+			}
+			id := fmt.Sprintf("assignment%d", len(assignments)+1)
+			assignments[id] = data.AppRoleId
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"id":%q,"appRoleId":%q,"principalId":"sp","resourceId":"resource"}`, id, data.AppRoleId)))
+		case r.Method == http.MethodGet && r.URL.Path == path:
+			values := make([]string, 0)
+			for id, appRoleId := range assignments {
+				values = append(values, fmt.Sprintf(`{"id":%q,"appRoleId":%q,"principalId":"sp","resourceId":"resource"}`, id, appRoleId))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"value":[%s]}`, strings.Join(values, ","))))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, path+"/"):
+			id := strings.TrimPrefix(r.URL.Path, path+"/")
+			if _, ok := assignments[id]; !ok {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist."}}`))
+				return
+			}
+			delete(assignments, id)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist."}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+	ctx := context.Background()
+
+	assignment, _, err := ServicePrincipalAssignAppRole(ctx, client, "sp", "resource", "role1")
+	if err != nil {
+		t.Fatalf("unexpected error assigning app role: %v", err)
+	}
+	if assignment.Id == nil || *assignment.Id != "assignment1" {
+		t.Fatalf("expected assignment ID %q, got %v", "assignment1", assignment.Id)
+	}
+
+	result, _, err := ServicePrincipalAppRoleAssignments(ctx, client, "sp")
+	if err != nil {
+		t.Fatalf("unexpected error listing app role assignments: %v", err)
+	}
+	if len(result) != 1 || result[0].AppRoleId == nil || *result[0].AppRoleId != "role1" {
+		t.Fatalf("expected a single assignment for role1, got %+v", result)
+	}
+
+	if _, err := ServicePrincipalRemoveAppRoleAssignment(ctx, client, "sp", "assignment1"); err != nil {
+		t.Fatalf("unexpected error removing app role assignment: %v", err)
+	}
+	if status, err := ServicePrincipalRemoveAppRoleAssignment(ctx, client, "sp", "assignment1"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
+
+func TestServicePrincipalRemoveAppRoleAssignedTo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/v1.0/tenant/servicePrincipals/sp/appRoleAssignedTo/assignment1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	if _, err := ServicePrincipalRemoveAppRoleAssignedTo(context.Background(), client, "sp", "assignment1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status, err := ServicePrincipalRemoveAppRoleAssignedTo(context.Background(), client, "sp", "missing"); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
+
+func TestAppRoleAssignmentsForAppRoles(t *testing.T) {
+	role1, role2, role3 := "00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002", "00000000-0000-0000-0000-000000000003"
+	assignments := []msgraph.AppRoleAssignment{
+		{Id: utils.String("assignment1"), AppRoleId: utils.String(role1)},
+		{Id: utils.String("assignment2"), AppRoleId: utils.String(role2)},
+		{Id: utils.String("assignment3"), AppRoleId: utils.String(strings.ToUpper(role1))},
+		{Id: utils.String("assignment4")},
+	}
+
+	result := AppRoleAssignmentsForAppRoles(assignments, []string{role1, role3})
+	if len(result) != 2 {
+		t.Fatalf("expected 2 assignments, got %d", len(result))
+	}
+	if *result[0].Id != "assignment1" || *result[1].Id != "assignment3" {
+		t.Fatalf("expected assignments assignment1 and assignment3, got %q and %q", *result[0].Id, *result[1].Id)
+	}
+
+	if result := AppRoleAssignmentsForAppRoles(assignments, []string{role3}); len(result) != 0 {
+		t.Fatalf("expected no assignments, got %d", len(result))
+	}
+}
@@ -0,0 +1,182 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// UserGroupMembershipIds returns the object IDs of the groups the specified user is a member of. When `transitive` is
+// true, groups the user is a member of by way of nested group memberships are also included. Directory roles and
+// administrative units are excluded. All pages of results are retrieved.
+func UserGroupMembershipIds(ctx context.Context, client msgraph.Client, id string, transitive bool) ([]string, int, error) {
+	relationship := "memberOf"
+	if transitive {
+		relationship = "transitiveMemberOf"
+	}
+
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/users/%s/%s/microsoft.graph.group", id, relationship),
+			Params:      url.Values{"$select": []string{"id"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Value []struct {
+			ID *string `json:"id"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	ids := make([]string, 0, len(data.Value))
+	for _, v := range data.Value {
+		if v.ID != nil {
+			ids = append(ids, *v.ID)
+		}
+	}
+
+	return ids, status, nil
+}
+
+// UserSponsorIds returns the object IDs of the users and groups that are sponsors of the user with the specified ID.
+// All pages of results are retrieved.
+func UserSponsorIds(ctx context.Context, client msgraph.Client, id string) ([]string, int, error) {
+	resp, status, _, err := client.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/users/%s/sponsors", id),
+			Params:      url.Values{"$select": []string{"id"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Value []struct {
+			ID *string `json:"id"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	ids := make([]string, 0, len(data.Value))
+	for _, v := range data.Value {
+		if v.ID != nil {
+			ids = append(ids, *v.ID)
+		}
+	}
+
+	return ids, status, nil
+}
+
+// UserAddSponsor adds the user or group with the specified ID as a sponsor of a user
+func UserAddSponsor(ctx context.Context, client msgraph.Client, id, sponsorId string) (int, error) {
+	body, err := json.Marshal(struct {
+		Sponsor string `json:"@odata.id"`
+	}{
+		Sponsor: fmt.Sprintf("%s/%s/directoryObjects/%s", client.Endpoint, client.ApiVersion, sponsorId),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/users/%s/sponsors/$ref", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// UserRemoveSponsor removes the user or group with the specified ID from the sponsors of a user
+func UserRemoveSponsor(ctx context.Context, client msgraph.Client, id, sponsorId string) (int, error) {
+	_, status, _, err := client.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/users/%s/sponsors/%s/$ref", id, sponsorId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// UserPrincipalNamePrefixMaxLength is the maximum length of the part of a user principal name preceding the domain
+const UserPrincipalNamePrefixMaxLength = 64
+
+var (
+	userPrincipalNameWhitespaceRegex   = regexp.MustCompile(`\s+`)
+	userPrincipalNameInvalidCharsRegex = regexp.MustCompile(`[^a-z0-9'._\-!#^~]`)
+	userPrincipalNameRepeatedDotsRegex = regexp.MustCompile(`\.{2,}`)
+)
+
+// UserNormalizePrincipalNamePrefix converts the specified prefix into one which is valid for both the user principal
+// name and mail nickname of a user. The prefix is lower-cased, whitespace is replaced with periods, unsupported
+// characters are removed and periods are not repeated or placed at the start or end. The result is truncated to
+// UserPrincipalNamePrefixMaxLength characters, and may be empty if the prefix contained no valid characters.
+func UserNormalizePrincipalNamePrefix(prefix string) string {
+	v := strings.ToLower(strings.TrimSpace(prefix))
+	v = userPrincipalNameWhitespaceRegex.ReplaceAllString(v, ".")
+	v = userPrincipalNameInvalidCharsRegex.ReplaceAllString(v, "")
+	v = userPrincipalNameRepeatedDotsRegex.ReplaceAllString(v, ".")
+	v = strings.Trim(v, ".")
+	if len(v) > UserPrincipalNamePrefixMaxLength {
+		v = strings.TrimRight(v[:UserPrincipalNamePrefixMaxLength], ".")
+	}
+	return v
+}
+
+// UserRevokeSignInSessions invalidates the refresh tokens and session cookies issued to a user, so that they must
+// sign in again
+func UserRevokeSignInSessions(ctx context.Context, client msgraph.Client, id string) (int, error) {
+	_, status, _, err := client.Post(ctx, msgraph.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/users/%s/revokeSignInSessions", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
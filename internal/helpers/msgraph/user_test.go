@@ -0,0 +1,137 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manicminer/hamilton/environments"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestUserGroupMembershipIds(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1.0/tenant/users/user/memberOf/microsoft.graph.group":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"value":[{"id":"group1"}]}`))
+		case "/v1.0/tenant/users/user/transitiveMemberOf/microsoft.graph.group":
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"value":[{"id":"group3"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"@odata.nextLink":"%s%s?page=2","value":[{"id":"group1"},{"id":"group2"}]}`, server.URL, r.URL.Path)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	direct, _, err := UserGroupMembershipIds(context.Background(), client, "user", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"group1"}; !reflect.DeepEqual(direct, expected) {
+		t.Fatalf("expected direct memberships %v, got %v", expected, direct)
+	}
+
+	transitive, _, err := UserGroupMembershipIds(context.Background(), client, "user", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"group1", "group2", "group3"}; !reflect.DeepEqual(transitive, expected) {
+		t.Fatalf("expected transitive memberships %v, got %v", expected, transitive)
+	}
+
+	if _, status, err := UserGroupMembershipIds(context.Background(), client, "missing", false); err == nil || status != http.StatusNotFound {
+		t.Fatalf("expected a not found error, got status %d: %v", status, err)
+	}
+}
+
+func TestUserSponsors(t *testing.T) {
+	sponsors := []string{"sponsor1"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1.0/tenant/users/user/sponsors":
+			value := make([]map[string]string, 0, len(sponsors))
+			for _, s := range sponsors {
+				value = append(value, map[string]string{"id": s})
+			}
+			body, _ := json.Marshal(map[string]interface{}{"value": value})
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1.0/tenant/users/user/sponsors/$ref":
+			body, _ := ioutil.ReadAll(r.Body)
+			var ref map[string]string
+			_ = json.Unmarshal(body, &ref)
+			if ref["@odata.id"] != fmt.Sprintf("%s/v1.0/directoryObjects/group1", "http://"+r.Host) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			sponsors = append(sponsors, "group1")
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1.0/tenant/users/user/sponsors/sponsor1/$ref":
+			sponsors = sponsors[1:]
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := msgraph.NewClient(msgraph.Version10, "tenant")
+	client.Endpoint = environments.ApiEndpoint(server.URL)
+
+	if _, err := UserAddSponsor(context.Background(), client, "user", "group1"); err != nil {
+		t.Fatalf("unexpected error adding sponsor: %v", err)
+	}
+	if _, err := UserRemoveSponsor(context.Background(), client, "user", "sponsor1"); err != nil {
+		t.Fatalf("unexpected error removing sponsor: %v", err)
+	}
+
+	ids, _, err := UserSponsorIds(context.Background(), client, "user")
+	if err != nil {
+		t.Fatalf("unexpected error listing sponsors: %v", err)
+	}
+	if expected := []string{"group1"}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected sponsors %v, got %v", expected, ids)
+	}
+
+	if _, err := UserRemoveSponsor(context.Background(), client, "user", "missing"); err == nil {
+		t.Fatal("expected an error removing a sponsor which is not present")
+	}
+}
+
+func TestUserNormalizePrincipalNamePrefix(t *testing.T) {
+	cases := []struct {
+		prefix   string
+		expected string
+	}{
+		{prefix: "alice", expected: "alice"},
+		{prefix: "  Alice Smith ", expected: "alice.smith"},
+		{prefix: "Mary  Jane\tWatson", expected: "mary.jane.watson"},
+		{prefix: "o'brien", expected: "o'brien"},
+		{prefix: "bob@contoso.com", expected: "bobcontoso.com"},
+		{prefix: ".john..doe.", expected: "john.doe"},
+		{prefix: "josé (ext)", expected: "jos.ext"},
+		{prefix: "@@@", expected: ""},
+		{prefix: strings.Repeat("a", 63) + ".b", expected: strings.Repeat("a", 63)},
+	}
+
+	for _, c := range cases {
+		if v := UserNormalizePrincipalNamePrefix(c.prefix); v != c.expected {
+			t.Errorf("normalizing %q: expected %q, got %q", c.prefix, c.expected, v)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/pkcs12"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+// decodeClientCertificate decodes a base64-encoded PKCS#12 bundle, as provided in the `client_certificate` property
+func decodeClientCertificate(encoded string) ([]byte, error) {
+	encoded = strings.Join(strings.Fields(encoded), "")
+	pfx, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("the client certificate could not be decoded, it should be a base64-encoded PKCS#12 (PFX) bundle: %v", err)
+	}
+	return pfx, nil
+}
+
+// parseClientCertificate decodes a PKCS#12 bundle in memory and checks that it can be used for client certificate
+// authentication, returning an error describing what is wrong with it. This is checked up front so that users receive an
+// actionable error rather than an opaque failure when a token is first requested.
+func parseClientCertificate(pfx []byte, password string) (*clients.ClientCertificate, error) {
+	key, cert, err := pkcs12.Decode(pfx, password)
+	if err != nil {
+		if err == pkcs12.ErrIncorrectPassword {
+			return nil, fmt.Errorf("the client certificate could not be decrypted, please check that `client_certificate_password` is correct")
+		}
+
+		// pkcs12.Decode only supports bundles containing a single certificate, so check whether a chain was included
+		if blocks, perr := pkcs12.ToPEM(pfx, password); perr == nil {
+			certs := 0
+			for _, block := range blocks {
+				if block.Type == "CERTIFICATE" {
+					certs++
+				}
+			}
+			if certs > 1 {
+				return nil, fmt.Errorf("the client certificate bundle contains a chain of %d certificates, only a single certificate and its private key are supported - please export the certificate without its chain", certs)
+			}
+		}
+
+		return nil, fmt.Errorf("the client certificate could not be decoded, it should be a PKCS#12 (PFX) bundle containing a single certificate and its private key: %v", err)
+	}
+
+	thumbprint := clientCertificateThumbprint(cert.Raw)
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the private key for the client certificate with thumbprint %q is of unsupported type %T, only RSA keys are supported", thumbprint, key)
+	}
+
+	now := time.Now()
+	if now.Before(cert.NotBefore) {
+		return nil, fmt.Errorf("the client certificate with thumbprint %q is not valid until %s", thumbprint, cert.NotBefore.Format(time.RFC3339))
+	}
+	if now.After(cert.NotAfter) {
+		return nil, fmt.Errorf("the client certificate with thumbprint %q expired on %s", thumbprint, cert.NotAfter.Format(time.RFC3339))
+	}
+
+	return &clients.ClientCertificate{
+		Certificate: cert,
+		PrivateKey:  privateKey,
+	}, nil
+}
+
+func clientCertificateThumbprint(der []byte) string {
+	sum := sha1.Sum(der)
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
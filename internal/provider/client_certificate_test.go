@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestDecodeClientCertificate(t *testing.T) {
+	expected := []byte("not really a certificate")
+	encoded := base64.StdEncoding.EncodeToString(expected)
+
+	cases := []struct {
+		Input string
+		Error bool
+	}{
+		{Input: encoded},
+		{Input: encoded[:8] + "\n" + encoded[8:16] + " \r\n" + encoded[16:]},
+		{Input: "not-base64!", Error: true},
+	}
+
+	for _, tc := range cases {
+		pfx, err := decodeClientCertificate(tc.Input)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("expected an error decoding %q", tc.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error decoding %q: %v", tc.Input, err)
+		}
+		if string(pfx) != string(expected) {
+			t.Fatalf("expected %q, got %q", expected, pfx)
+		}
+	}
+}
+
+func TestParseClientCertificate_invalid(t *testing.T) {
+	_, err := parseClientCertificate([]byte("not really a certificate"), "")
+	if err == nil {
+		t.Fatal("expected an error validating an invalid certificate")
+	}
+	if !strings.Contains(err.Error(), "PKCS#12") {
+		t.Fatalf("expected error to describe the expected format, got: %v", err)
+	}
+}
+
+func TestClientCertificateThumbprint(t *testing.T) {
+	// SHA-1 digest of the empty input
+	if v := clientCertificateThumbprint([]byte{}); v != "DA39A3EE5E6B4B0D3255BFEF95601890AFD80709" {
+		t.Fatalf("unexpected thumbprint: %s", v)
+	}
+}
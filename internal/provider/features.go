@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func featuresSchema() *schema.Schema {
+	toggle := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: description,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Opt in to features which are not enabled by default.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"applications": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_deleted_on_destroy": toggle("Permanently delete applications when they are destroyed, instead of leaving them in the deleted items container from which they can be restored."),
+						},
+					},
+				},
+
+				"beta_api_services": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The services for which the Microsoft Graph beta API should be used instead of v1.0, to support properties which are only available in beta.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(clients.BetaApiServices, false),
+					},
+				},
+
+				"credentials": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"expiry_warning_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      0,
+								Description:  "Show a warning when an application or service principal certificate or password managed by the provider expires within this number of days. Set to 0 to disable warnings.",
+								ValidateFunc: validation.IntAtLeast(0),
+							},
+						},
+					},
+				},
+
+				"groups": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"recover_soft_deleted": toggle("Restore a deleted group with the same display name and mail nickname when creating a group, instead of creating a new group."),
+						},
+					},
+				},
+
+				"users": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"revoke_sessions_on_delete": toggle("Revoke the refresh tokens and session cookies issued to users before they are deleted."),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandFeatures returns the behaviours configured in the `features` block, all of which are disabled by default
+func expandFeatures(raw []interface{}) (features clients.Features) {
+	if len(raw) == 0 || raw[0] == nil {
+		return
+	}
+	in := raw[0].(map[string]interface{})
+
+	if v, ok := in["beta_api_services"].(*schema.Set); ok && v.Len() > 0 {
+		features.BetaApiServices = *tf.ExpandStringSlicePtr(v.List())
+		sort.Strings(features.BetaApiServices)
+	}
+
+	if block := featuresBlock(in, "applications"); block != nil {
+		features.Applications.PurgeSoftDeletedOnDestroy = block["purge_soft_deleted_on_destroy"].(bool)
+	}
+	if block := featuresBlock(in, "credentials"); block != nil {
+		features.Credentials.ExpiryWarningWindow = time.Duration(block["expiry_warning_days"].(int)) * 24 * time.Hour
+	}
+	if block := featuresBlock(in, "groups"); block != nil {
+		features.Groups.RecoverSoftDeleted = block["recover_soft_deleted"].(bool)
+	}
+	if block := featuresBlock(in, "users"); block != nil {
+		features.Users.RevokeSessionsOnDelete = block["revoke_sessions_on_delete"].(bool)
+	}
+
+	return
+}
+
+func featuresBlock(in map[string]interface{}, name string) map[string]interface{} {
+	if v, ok := in[name].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+// addPermissionChecks ensures that, when `validate_permissions` is enabled, the permissions required by each resource
+// are checked when planning, and those required by each data source are checked before it is read. Since data sources
+// are read during planning, missing permissions are reported before any changes are applied.
+func addPermissionChecks(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		name := name
+		customizeDiff := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if client, ok := meta.(*clients.Client); ok {
+				if err := client.CheckPermissions(name); err != nil {
+					return err
+				}
+			}
+			if customizeDiff != nil {
+				return customizeDiff(ctx, diff, meta)
+			}
+			return nil
+		}
+	}
+
+	for name, ds := range p.DataSourcesMap {
+		name := name
+		if ds.ReadContext == nil {
+			continue
+		}
+		read := ds.ReadContext
+		ds.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if client, ok := meta.(*clients.Client); ok {
+				if err := client.CheckPermissions("data." + name); err != nil {
+					return tf.ErrorDiagF(err, "Insufficient permissions to read %s", name)
+				}
+			}
+			return read(ctx, d, meta)
+		}
+	}
+}
@@ -3,10 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,12 +18,16 @@ import (
 	"github.com/manicminer/hamilton/environments"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
 // Microsoft’s Terraform Partner ID is this specific GUID
 const terraformPartnerId = "222c6c49-1b0a-5959-a213-6608f9eb8820"
 
+// Partner IDs are sometimes supplied in the same format as they appear in the user agent
+var partnerIdPrefixedRegex = regexp.MustCompile(`^pid-[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 type ServiceRegistration interface {
 	// Name is the name of this Service
 	Name() string
@@ -77,7 +85,7 @@ func AzureADProvider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_ID", ""),
-				Description: "The Client ID which should be used for service principal authentication.",
+				Description: "The Client ID which should be used for service principal authentication, or the Client ID of a user-assigned identity when using Managed Identity.",
 			},
 
 			"tenant_id": {
@@ -102,25 +110,38 @@ func AzureADProvider() *schema.Provider {
 			},
 
 			// Client Certificate specific fields
+			"client_certificate": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE", ""),
+				ConflictsWith: []string{"client_certificate_path"},
+				Description:   "Base64 encoded PKCS#12 certificate bundle to use when authenticating as a Service Principal using a Client Certificate.",
+			},
+
 			"client_certificate_password": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+				Description: "The password to decrypt the Client Certificate. For use when authenticating as a Service Principal using a Client Certificate.",
 			},
 
 			"client_certificate_path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
-				Description: "The path to the Client Certificate associated with the Service Principal for use when authenticating as a Service Principal using a Client Certificate.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+				ConflictsWith: []string{"client_certificate"},
+				Description:   "The path to the Client Certificate associated with the Service Principal for use when authenticating as a Service Principal using a Client Certificate.",
 			},
 
 			// Client Secret specific fields
 			"client_secret": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
-				Description: "The password to decrypt the Client Certificate. For use when authenticating as a Service Principal using a Client Certificate",
+				Description: "The Client Secret which should be used. For use when authenticating as a Service Principal using a Client Secret.",
 			},
 
 			// CLI authentication specific fields
@@ -146,11 +167,44 @@ func AzureADProvider() *schema.Provider {
 				Description: "The path to a custom endpoint for Managed Identity - in most circumstances this should be detected automatically. ",
 			},
 
+			"custom_ca_certificates": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CUSTOM_CA_CERTIFICATES", ""),
+				Description: "One or more PEM-encoded CA certificates to trust in addition to the system root certificates when connecting to Microsoft Graph, Azure Active Directory Graph and the token endpoints, for example when using a TLS-intercepting proxy.",
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", common.DefaultMaxRetries),
+				ValidateFunc: validation.IntBetween(0, 50),
+				Description:  "The maximum number of times a request should be retried when it is throttled or the service is temporarily unavailable.",
+			},
+
+			"default_timeouts": defaultTimeoutsSchema(),
+
+			"features": featuresSchema(),
+
+			"fast_user_creation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_FAST_USER_CREATION", false),
+				Description: "Confirm the creation of users with a single, batched request instead of waiting for them to replicate, to speed up applies which create many users.",
+			},
+
+			"validate_permissions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_VALIDATE_PERMISSIONS", false),
+				Description: "Check that the access token contains the Microsoft Graph permissions required by each resource and data source when planning, instead of failing when changes are applied.",
+			},
+
 			// Managed Tracking GUID for User-agent
 			"partner_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.Any(validation.IsUUID, validation.StringIsEmpty),
+				ValidateFunc: validation.Any(validation.IsUUID, validation.StringMatch(partnerIdPrefixedRegex, "expected a UUID, optionally prefixed with `pid-`"), validation.StringIsEmpty),
 				DefaultFunc:  schema.EnvDefaultFunc("ARM_PARTNER_ID", ""),
 				Description:  "A GUID/UUID that is registered with Microsoft to facilitate partner resource usage attribution.",
 			},
@@ -176,6 +230,8 @@ func AzureADProvider() *schema.Provider {
 		DataSourcesMap: dataSources,
 	}
 
+	addPermissionChecks(p)
+
 	p.ConfigureContextFunc = providerConfigure(p)
 
 	return p
@@ -185,19 +241,56 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		environment, aadEnvironment := environment(d.Get("environment").(string))
 
+		if err := applyDefaultTimeouts(p, d.Get("default_timeouts").([]interface{})); err != nil {
+			return nil, tf.ErrorDiagPathF(err, "default_timeouts", "Invalid default timeouts")
+		}
+
 		// Microsoft Graph beta opt-in
 		enableMsGraph := d.Get("use_microsoft_graph").(bool)
 
+		clientCertPassword := d.Get("client_certificate_password").(string)
+		clientCertPath := d.Get("client_certificate_path").(string)
+
+		// Validate the client certificate up front, so that problems are reported before any API requests are attempted.
+		// The certificate is decoded in memory and used for the lifetime of the provider, so that the private key is
+		// never written to disk, and so that token requests use the transport of this provider instance.
+		var clientCertificate *clients.ClientCertificate
+		if clientCertPath != "" {
+			pfx, err := ioutil.ReadFile(clientCertPath)
+			if err != nil {
+				return nil, tf.ErrorDiagPathF(err, "client_certificate_path", "Could not read client certificate at %q", clientCertPath)
+			}
+			if clientCertificate, err = parseClientCertificate(pfx, clientCertPassword); err != nil {
+				return nil, tf.ErrorDiagPathF(err, "client_certificate_path", "Invalid client certificate at %q", clientCertPath)
+			}
+		} else if encoded := d.Get("client_certificate").(string); encoded != "" {
+			pfx, err := decodeClientCertificate(encoded)
+			if err != nil {
+				return nil, tf.ErrorDiagPathF(err, "client_certificate", "Invalid client certificate")
+			}
+			if clientCertificate, err = parseClientCertificate(pfx, clientCertPassword); err != nil {
+				return nil, tf.ErrorDiagPathF(err, "client_certificate", "Invalid client certificate")
+			}
+		}
+		if clientCertificate != nil && (d.Get("tenant_id").(string) == "" || d.Get("client_id").(string) == "") {
+			return nil, tf.ErrorDiagF(fmt.Errorf("`tenant_id` and `client_id` must be specified when authenticating with a client certificate"), "Invalid client certificate configuration")
+		}
+
+		// Validate any custom CA certificates up front, so that they can be reported against the provider argument
+		caCertificates := d.Get("custom_ca_certificates").(string)
+		if caCertificates != "" {
+			if _, err := common.CertPoolWithCertificates(caCertificates); err != nil {
+				return nil, tf.ErrorDiagPathF(err, "custom_ca_certificates", "Invalid custom CA certificates")
+			}
+		}
+
 		var authConfig *auth.Config
 		if enableMsGraph {
 			authConfig = &auth.Config{
 				Environment:            environment,
 				TenantID:               d.Get("tenant_id").(string),
 				ClientID:               d.Get("client_id").(string),
-				ClientCertPassword:     d.Get("client_certificate_password").(string),
-				ClientCertPath:         d.Get("client_certificate_path").(string),
 				ClientSecret:           d.Get("client_secret").(string),
-				EnableClientCertAuth:   true,
 				EnableClientSecretAuth: true,
 				EnableAzureCliToken:    d.Get("use_cli").(bool),
 				EnableMsiAuth:          d.Get("use_msi").(bool),
@@ -206,17 +299,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		}
 
 		aadBuilder := &authentication.Builder{
-			ClientID:           d.Get("client_id").(string),
-			ClientSecret:       d.Get("client_secret").(string),
-			TenantID:           d.Get("tenant_id").(string),
-			MetadataHost:       d.Get("metadata_host").(string),
-			Environment:        aadEnvironment,
-			MsiEndpoint:        d.Get("msi_endpoint").(string),
-			ClientCertPassword: d.Get("client_certificate_password").(string),
-			ClientCertPath:     d.Get("client_certificate_path").(string),
+			ClientID:     d.Get("client_id").(string),
+			ClientSecret: d.Get("client_secret").(string),
+			TenantID:     d.Get("tenant_id").(string),
+			MetadataHost: d.Get("metadata_host").(string),
+			Environment:  aadEnvironment,
+			MsiEndpoint:  d.Get("msi_endpoint").(string),
 
 			// Feature Toggles
-			SupportsClientCertAuth:         true,
 			SupportsClientSecretAuth:       true,
 			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
 			SupportsAzureCliToken:          d.Get("use_cli").(bool),
@@ -231,23 +321,99 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			partnerId = terraformPartnerId
 		}
 
-		return buildClient(ctx, p, authConfig, aadBuilder, partnerId, enableMsGraph)
+		features := expandFeatures(d.Get("features").([]interface{}))
+
+		client, diags := buildClient(ctx, p, authConfig, aadBuilder, clientCertificate, partnerId, caCertificates, features, d.Get("max_retries").(int), enableMsGraph)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if betaApiServices := features.BetaApiServices; len(betaApiServices) > 0 {
+			summary := "Microsoft Graph beta API enabled"
+			detail := fmt.Sprintf("The Microsoft Graph beta API will be used for the following services: %s. APIs in beta are subject to change and are not supported for production use, so resources managed by these services may be affected by breaking changes without notice.", strings.Join(betaApiServices, ", "))
+			if !enableMsGraph {
+				summary = "Microsoft Graph beta API is not available"
+				detail = "The Microsoft Graph beta API can only be used when using Microsoft Graph, please set `use_microsoft_graph = true` in the provider block."
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       summary,
+				Detail:        detail,
+				AttributePath: cty.Path{cty.GetAttrStep{Name: "features"}},
+			})
+		}
+
+		if !enableMsGraph && (features.Applications.PurgeSoftDeletedOnDestroy || features.Groups.RecoverSoftDeleted || features.Users.RevokeSessionsOnDelete) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Features are not available",
+				Detail:        "The `applications`, `groups` and `users` features are only supported when using Microsoft Graph, please set `use_microsoft_graph = true` in the provider block.",
+				AttributePath: cty.Path{cty.GetAttrStep{Name: "features"}},
+			})
+		}
+
+		if d.Get("fast_user_creation").(bool) {
+			if !enableMsGraph {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       "Fast user creation is not available",
+					Detail:        "Fast user creation is only supported when using Microsoft Graph, please set `use_microsoft_graph = true` in the provider block.",
+					AttributePath: cty.Path{cty.GetAttrStep{Name: "fast_user_creation"}},
+				})
+			} else {
+				client.FastUserCreation = true
+			}
+		}
+
+		if d.Get("validate_permissions").(bool) {
+			if !enableMsGraph {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       "Permissions cannot be validated",
+					Detail:        "Permissions can only be validated when using Microsoft Graph, please set `use_microsoft_graph = true` in the provider block.",
+					AttributePath: cty.Path{cty.GetAttrStep{Name: "validate_permissions"}},
+				})
+			} else if len(client.Claims.Roles) == 0 && client.Claims.Scopes == "" {
+				return nil, tf.ErrorDiagPathF(fmt.Errorf("the access token for the authenticated principal (object ID %q) does not contain any Microsoft Graph application roles or delegated scopes, please check that the required API permissions have been assigned and that admin consent has been granted", client.Claims.ObjectId), "validate_permissions", "Insufficient permissions")
+			}
+			client.ValidatePermissions = true
+		}
+
+		return client, diags
 	}
 }
 
 // TODO: v2.0 pull out authentication.Builder and derived configuration
-func buildClient(ctx context.Context, p *schema.Provider, authConfig *auth.Config, b *authentication.Builder, partnerId string, enableMsGraph bool) (*clients.Client, diag.Diagnostics) {
-	aadConfig, err := b.Build()
-	if err != nil {
-		return nil, tf.ErrorDiagF(err, "Building AzureAD Client")
+func buildClient(ctx context.Context, p *schema.Provider, authConfig *auth.Config, b *authentication.Builder, clientCertificate *clients.ClientCertificate, partnerId, caCertificates string, features clients.Features, maxRetries int, enableMsGraph bool) (*clients.Client, diag.Diagnostics) {
+	var aadConfig *authentication.Config
+	if clientCertificate != nil {
+		// go-azure-helpers can only read a client certificate from a file, so the authorizer for a certificate held in
+		// memory is built by the client builder
+		aadConfig = &authentication.Config{
+			ClientID:                         b.ClientID,
+			TenantID:                         b.TenantID,
+			Environment:                      b.Environment,
+			MetadataHost:                     b.MetadataHost,
+			AuthenticatedAsAServicePrincipal: true,
+		}
+	} else {
+		var err error
+		aadConfig, err = b.Build()
+		if err != nil {
+			return nil, tf.ErrorDiagF(err, "Building AzureAD Client")
+		}
 	}
 
 	clientBuilder := clients.ClientBuilder{
-		AuthConfig:       authConfig,
-		AadAuthConfig:    aadConfig,
-		EnableMsGraph:    enableMsGraph,
-		PartnerID:        partnerId,
-		TerraformVersion: p.TerraformVersion,
+		AuthConfig:           authConfig,
+		AadAuthConfig:        aadConfig,
+		ClientCertificate:    clientCertificate,
+		CustomCaCertificates: caCertificates,
+		EnableMsGraph:        enableMsGraph,
+		Features:             features,
+		MaxRetries:           maxRetries,
+		PartnerID:            partnerId,
+		TerraformVersion:     p.TerraformVersion,
 	}
 
 	stopCtx, ok := schema.StopContext(ctx) //nolint:SA1019
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,6 +15,7 @@ import (
 	"github.com/manicminer/hamilton/auth"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 func TestProvider(t *testing.T) {
@@ -52,7 +55,7 @@ func TestAccProvider_cliAuth(t *testing.T) {
 			EnableAzureCliToken: true,
 		}
 
-		return buildClient(ctx, provider, authConfig, aadBuilder, "", true)
+		return buildClient(ctx, provider, authConfig, aadBuilder, nil, "", "", clients.Features{}, common.DefaultMaxRetries, true)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -79,28 +82,29 @@ func TestAccProvider_clientCertificateAuth(t *testing.T) {
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		environment, aadEnvironment := environment(d.Get("environment").(string))
 
+		pfx, err := ioutil.ReadFile(d.Get("client_certificate_path").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		clientCertificate, err := parseClientCertificate(pfx, d.Get("client_certificate_password").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
 		aadBuilder := &authentication.Builder{
 			Environment: aadEnvironment,
 			TenantID:    d.Get("tenant_id").(string),
 			ClientID:    d.Get("client_id").(string),
 			TenantOnly:  true,
-
-			SupportsClientCertAuth: true,
-			ClientCertPassword:     d.Get("client_certificate_password").(string),
-			ClientCertPath:         d.Get("client_certificate_path").(string),
 		}
 
 		authConfig := &auth.Config{
 			Environment: environment,
 			TenantID:    d.Get("tenant_id").(string),
 			ClientID:    d.Get("client_id").(string),
-
-			EnableClientCertAuth: true,
-			ClientCertPath:       d.Get("client_certificate_path").(string),
-			ClientCertPassword:   d.Get("client_certificate_password").(string),
 		}
 
-		return buildClient(ctx, provider, authConfig, aadBuilder, "", true)
+		return buildClient(ctx, provider, authConfig, aadBuilder, clientCertificate, "", "", clients.Features{}, common.DefaultMaxRetries, true)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -146,7 +150,7 @@ func TestAccProvider_clientSecretAuth(t *testing.T) {
 			ClientSecret:           d.Get("client_secret").(string),
 		}
 
-		return buildClient(ctx, provider, authConfig, aadBuilder, "", true)
+		return buildClient(ctx, provider, authConfig, aadBuilder, nil, "", "", clients.Features{}, common.DefaultMaxRetries, true)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -190,3 +194,65 @@ func testCheckProvider(provider *schema.Provider) (errs []error) {
 
 	return
 }
+
+func TestProvider_defaultTimeouts(t *testing.T) {
+	provider := AzureADProvider()
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"create": "30m",
+			"read":   "",
+			"update": "45m",
+			"delete": "1h",
+		},
+	}
+	if err := applyDefaultTimeouts(provider, raw); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	group := provider.ResourcesMap["azuread_group"].Timeouts
+	if *group.Create != 30*time.Minute {
+		t.Fatalf("expected create timeout to be 30m, got %s", *group.Create)
+	}
+	if *group.Read != 5*time.Minute {
+		t.Fatalf("expected read timeout to be unchanged, got %s", *group.Read)
+	}
+	if *group.Update != 45*time.Minute {
+		t.Fatalf("expected update timeout to be 45m, got %s", *group.Update)
+	}
+	if *group.Delete != time.Hour {
+		t.Fatalf("expected delete timeout to be 1h, got %s", *group.Delete)
+	}
+
+	// resources without an update operation should not gain an update timeout
+	if v := provider.ResourcesMap["azuread_group_member"].Timeouts.Update; v != nil {
+		t.Fatalf("expected no update timeout for azuread_group_member, got %s", *v)
+	}
+
+	// other provider instances should be unaffected
+	if v := *AzureADProvider().ResourcesMap["azuread_group"].Timeouts.Create; v != 5*time.Minute {
+		t.Fatalf("expected create timeout for a new provider instance to be 5m, got %s", v)
+	}
+}
+
+func TestProvider_requiredPermissions(t *testing.T) {
+	client := &clients.Client{
+		EnableMsGraphBeta:   true,
+		ValidatePermissions: true,
+	}
+
+	// with no permissions in the token, every resource and data source which has known requirements should fail
+	for name := range AzureADProvider().ResourcesMap {
+		if err := client.CheckPermissions(name); err == nil {
+			t.Fatalf("expected required permissions to be defined for resource %s", name)
+		}
+	}
+	for name := range AzureADProvider().DataSourcesMap {
+		if name == "azuread_client_config" {
+			continue
+		}
+		if err := client.CheckPermissions("data." + name); err == nil {
+			t.Fatalf("expected required permissions to be defined for data source %s", name)
+		}
+	}
+}
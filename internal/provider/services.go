@@ -2,8 +2,14 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/customsecurityattributes"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryobjects"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/domains"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/externalidentities"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/users"
 )
@@ -11,8 +17,14 @@ import (
 func SupportedServices() []ServiceRegistration {
 	return []ServiceRegistration{
 		applications.Registration{},
+		customsecurityattributes.Registration{},
+		directoryobjects.Registration{},
+		directoryroles.Registration{},
 		domains.Registration{},
+		externalidentities.Registration{},
 		groups.Registration{},
+		identitygovernance.Registration{},
+		policies.Registration{},
 		serviceprincipals.Registration{},
 		users.Registration{},
 	}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func defaultTimeoutsSchema() *schema.Schema {
+	timeout := func(operation string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTimeout,
+			Description:  fmt.Sprintf("The default timeout for %s operations, e.g. `30m`.", operation),
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Default timeouts for all resources and data sources, which can be overridden with a `timeouts` block for individual resources.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				schema.TimeoutCreate: timeout("create"),
+				schema.TimeoutRead:   timeout("read"),
+				schema.TimeoutUpdate: timeout("update"),
+				schema.TimeoutDelete: timeout("delete"),
+			},
+		},
+	}
+}
+
+func validateTimeout(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid duration, e.g. `30m`: %v", k, err))
+		return
+	}
+	if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration", k))
+	}
+
+	return
+}
+
+// applyDefaultTimeouts overrides the default timeouts declared by each resource and data source with those configured
+// in the `default_timeouts` block. Timeout defaults are read when a plan is created, which happens after the provider
+// is configured, so these apply to every resource managed by this provider instance unless a resource specifies its
+// own `timeouts` block. Only operations for which a resource already declares a timeout are overridden.
+func applyDefaultTimeouts(p *schema.Provider, raw []interface{}) error {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	config := raw[0].(map[string]interface{})
+
+	parse := func(key string) (*time.Duration, error) {
+		v, ok := config[key].(string)
+		if !ok || v == "" {
+			return nil, nil
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q timeout: %v", key, err)
+		}
+		return &d, nil
+	}
+
+	create, err := parse(schema.TimeoutCreate)
+	if err != nil {
+		return err
+	}
+	read, err := parse(schema.TimeoutRead)
+	if err != nil {
+		return err
+	}
+	update, err := parse(schema.TimeoutUpdate)
+	if err != nil {
+		return err
+	}
+	del, err := parse(schema.TimeoutDelete)
+	if err != nil {
+		return err
+	}
+
+	override := func(current **time.Duration, v *time.Duration) {
+		if *current != nil && v != nil {
+			*current = schema.DefaultTimeout(*v)
+		}
+	}
+
+	for _, r := range p.ResourcesMap {
+		if r.Timeouts == nil {
+			continue
+		}
+		override(&r.Timeouts.Create, create)
+		override(&r.Timeouts.Read, read)
+		override(&r.Timeouts.Update, update)
+		override(&r.Timeouts.Delete, del)
+	}
+
+	for _, ds := range p.DataSourcesMap {
+		if ds.Timeouts == nil {
+			continue
+		}
+		override(&ds.Timeouts.Read, read)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package applications
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,13 @@ func applicationAppRoleResource() *schema.Resource {
 		ReadContext:   applicationAppRoleResourceRead,
 		DeleteContext: applicationAppRoleResourceDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
 			_, err := parse.AppRoleID(id)
 			return err
@@ -65,6 +73,12 @@ func applicationAppRoleResource() *schema.Resource {
 				Default:  true,
 			},
 
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// TODO: v2.0 remove this
 			"is_enabled": {
 				Type:       schema.TypeBool,
@@ -64,40 +64,48 @@ func applicationAppRoleResourceCreateUpdateMsGraph(ctx context.Context, d *schem
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	app, status, err := client.Get(ctx, id.ObjectId)
-	if err != nil {
-		if status == http.StatusNotFound {
-			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+	// The value and allowed member types of a role cannot be changed whilst it is enabled, so disable it first. The
+	// subsequent update re-enables it when it should remain enabled.
+	if !d.IsNewResource() && d.HasChanges("allowed_member_types", "value") {
+		log.Printf("[DEBUG] Disabling App Role %q for Application %q prior to updating it", id.RoleId, id.ObjectId)
+		if status, err := helpers.ApplicationDisableAppRoles(ctx, client, id.ObjectId, []string{id.RoleId}); err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+			}
+			return tf.ErrorDiagF(err, "Disabling App Role with ID %q", id.RoleId)
 		}
-		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
 	}
 
-	if d.IsNewResource() {
-		if err := app.AppendAppRole(role); err != nil {
-			if _, ok := err.(*grapherrors.AlreadyExistsError); ok {
-				return tf.ImportAsExistsDiag("azuread_application_app_role", id.String())
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		if d.IsNewResource() {
+			if err := app.AppendAppRole(role); err != nil {
+				return nil, err
+			}
+		} else {
+			existing, err := helpers.AppRoleFindById(app, id.RoleId)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving App Role with ID %q for Application %q: %+v", id.RoleId, id.ObjectId, err)
+			}
+			if existing == nil {
+				return nil, fmt.Errorf("App Role with ID %q was not found for Application %q", id.RoleId, id.ObjectId)
+			}
+			if err := app.UpdateAppRole(role); err != nil {
+				return nil, fmt.Errorf("updating App Role with ID %q: %+v", *role.ID, err)
 			}
-			return tf.ErrorDiagF(err, "Failed to add App Role")
-		}
-	} else {
-		existing, err := helpers.AppRoleFindById(app, id.RoleId)
-		if err != nil {
-			return tf.ErrorDiagPathF(nil, "role_id", "retrieving App Role with ID %q for Application %q: %+v", id.RoleId, id.ObjectId, err)
-		}
-		if existing == nil {
-			return tf.ErrorDiagPathF(nil, "role_id", "App Role with ID %q was not found for Application %q", id.RoleId, id.ObjectId)
 		}
 
-		if app.UpdateAppRole(role) != nil {
-			return tf.ErrorDiagF(err, "Updating App Role with ID %q", *role.ID)
+		return &msgraph.Application{
+			ID:       app.ID,
+			AppRoles: app.AppRoles,
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		if _, ok := err.(*grapherrors.AlreadyExistsError); ok {
+			return tf.ImportAsExistsDiag("azuread_application_app_role", id.String())
 		}
-	}
-
-	properties := msgraph.Application{
-		ID:       app.ID,
-		AppRoles: app.AppRoles,
-	}
-	if _, err := client.Update(ctx, properties); err != nil {
 		return tf.ErrorDiagF(err, "Updating Application with ID %q", id.ObjectId)
 	}
 
@@ -166,39 +174,86 @@ func applicationAppRoleResourceDeleteMsGraph(ctx context.Context, d *schema.Reso
 		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with ID %q", id.ObjectId)
 	}
 
-	role, err := helpers.AppRoleFindById(app, id.RoleId)
-	if err != nil {
-		return tf.ErrorDiagF(err, "Identifying App Role")
-	}
+	if app.AppId != nil {
+		servicePrincipalsClient := meta.(*clients.Client).ServicePrincipals.MsClient
+		servicePrincipalId, assignments, err := applicationAppRoleAssignments(ctx, servicePrincipalsClient, *app.AppId, []string{id.RoleId})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Checking for assignments of App Role with ID %q", id.RoleId)
+		}
 
-	if role == nil {
-		log.Printf("[DEBUG] App Role %q (ID %q) was not found - removing from state!", id.RoleId, id.ObjectId)
-		d.SetId("")
-		return nil
+		if len(assignments) > 0 {
+			if !d.Get("force").(bool) {
+				return applicationAppRoleAssignmentsDiag(assignments, "force", "Remove these assignments before deleting the app role, or set `force = true` to have them removed automatically.")
+			}
+
+			for _, assignment := range assignments {
+				if assignment.Id == nil {
+					continue
+				}
+				log.Printf("[DEBUG] Removing assignment %q of App Role %q for Application %q", *assignment.Id, id.RoleId, id.ObjectId)
+				if status, err := helpers.ServicePrincipalRemoveAppRoleAssignedTo(ctx, servicePrincipalsClient.BaseClient, *servicePrincipalId, *assignment.Id); err != nil && status != http.StatusNotFound {
+					return tf.ErrorDiagF(err, "Removing assignment %q of App Role with ID %q", *assignment.Id, id.RoleId)
+				}
+			}
+		}
 	}
 
+	roleFound := true
+
 	log.Printf("[DEBUG] Disabling App Role %q for Application %q prior to removal", id.RoleId, id.ObjectId)
-	role.IsEnabled = utils.Bool(false)
-	if app.UpdateAppRole(*role) != nil {
-		return tf.ErrorDiagF(err, "Disabling App Role with ID %q", *role.ID)
-	}
+	status, err = helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		role, err := helpers.AppRoleFindById(app, id.RoleId)
+		if err != nil {
+			return nil, fmt.Errorf("identifying App Role: %+v", err)
+		}
+		if role == nil {
+			roleFound = false
+			return nil, nil
+		}
 
-	properties := msgraph.Application{
-		ID:       app.ID,
-		AppRoles: app.AppRoles,
+		role.IsEnabled = utils.Bool(false)
+		if err := app.UpdateAppRole(*role); err != nil {
+			return nil, err
+		}
+
+		return &msgraph.Application{
+			ID:       app.ID,
+			AppRoles: app.AppRoles,
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "application_object_id", "Retrieving Application with ID %q", id.ObjectId)
+		}
+		return tf.ErrorDiagF(err, "Disabling App Role with ID %q", id.RoleId)
 	}
-	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Disabling App Role with ID %q", *role.ID)
+
+	if !roleFound {
+		log.Printf("[DEBUG] App Role %q (ID %q) was not found - removing from state!", id.RoleId, id.ObjectId)
+		d.SetId("")
+		return nil
 	}
 
 	log.Printf("[DEBUG] Removing App Role %q from Application %q", id.RoleId, id.ObjectId)
-	if app.RemoveAppRole(*role) != nil {
-		return tf.ErrorDiagF(err, "Removing App Role with ID %q", *role.ID)
-	}
+	if _, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		role, err := helpers.AppRoleFindById(app, id.RoleId)
+		if err != nil {
+			return nil, fmt.Errorf("identifying App Role: %+v", err)
+		}
+		if role == nil {
+			return nil, nil
+		}
+
+		if err := app.RemoveAppRole(*role); err != nil {
+			return nil, err
+		}
 
-	properties.AppRoles = app.AppRoles
-	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Updating application to remove App Role with ID %q", *role.ID)
+		return &msgraph.Application{
+			ID:       app.ID,
+			AppRoles: app.AppRoles,
+		}, nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Updating application to remove App Role with ID %q", id.RoleId)
 	}
 
 	return nil
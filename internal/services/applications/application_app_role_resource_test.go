@@ -32,7 +32,7 @@ func TestAccApplicationAppRole_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("role_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("force"),
 	})
 }
 
@@ -48,7 +48,7 @@ func TestAccApplicationAppRole_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("role_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("force"),
 	})
 }
 
@@ -64,7 +64,7 @@ func TestAccApplicationAppRole_update(t *testing.T) {
 				check.That(data.ResourceName).Key("role_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("force"),
 		{
 			Config: r.update(data),
 			Check: resource.ComposeTestCheckFunc(
@@ -72,7 +72,7 @@ func TestAccApplicationAppRole_update(t *testing.T) {
 				check.That(data.ResourceName).Key("role_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("force"),
 		{
 			Config: r.complete(data),
 			Check: resource.ComposeTestCheckFunc(
@@ -80,7 +80,7 @@ func TestAccApplicationAppRole_update(t *testing.T) {
 				check.That(data.ResourceName).Key("role_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("force"),
 	})
 }
 
@@ -171,6 +171,7 @@ resource "azuread_application_app_role" "test" {
   allowed_member_types  = ["User"]
   description           = "Admins can manage roles and perform all task actions"
   display_name          = "Admin"
+  force                 = true
   is_enabled            = true
   role_id               = "%[2]s"
   value                 = "administer"
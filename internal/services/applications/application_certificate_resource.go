@@ -2,6 +2,7 @@ package applications
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
@@ -17,8 +19,18 @@ func applicationCertificateResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: applicationCertificateResourceCreate,
 		ReadContext:   applicationCertificateResourceRead,
+		UpdateContext: applicationCertificateResourceUpdate,
 		DeleteContext: applicationCertificateResourceDelete,
 
+		CustomizeDiff: applicationCertificateResourceCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
 			_, err := parse.CertificateID(id)
 			return err
@@ -57,11 +69,20 @@ func applicationCertificateResource() *schema.Resource {
 				Default:  "pem",
 				ValidateFunc: validation.StringInSlice([]string{
 					"base64",
+					"der",
 					"hex",
 					"pem",
+					"pkcs12",
 				}, false),
 			},
 
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
 			"value": {
 				Type:      schema.TypeString,
 				Required:  true,
@@ -70,18 +91,24 @@ func applicationCertificateResource() *schema.Resource {
 			},
 
 			"start_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"start_date_relative"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"start_date_relative": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"start_date"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
 			"end_date": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"end_date_relative"},
 				ValidateFunc:  validation.IsRFC3339Time,
 			},
@@ -89,10 +116,35 @@ func applicationCertificateResource() *schema.Resource {
 			"end_date_relative": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
 				ConflictsWith:    []string{"end_date"},
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
+
+			"rotation_overlap": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"lifecycle_hints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiry_warning_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -105,10 +157,88 @@ func applicationCertificateResourceCreate(ctx context.Context, d *schema.Resourc
 }
 
 func applicationCertificateResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if meta.(*clients.Client).EnableMsGraphBeta {
+		diags = applicationCertificateResourceReadMsGraph(ctx, d, meta)
+	} else {
+		diags = applicationCertificateResourceReadAadGraph(ctx, d, meta)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, meta.(*clients.Client).CredentialExpiryDiags(d, "Application certificate")...)
+}
+
+func applicationCertificateResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// `rotation_overlap` is only used when the certificate is deleted, so only the validity dates need to be patched
+	if !d.HasChanges("start_date", "start_date_relative", "end_date", "end_date_relative") {
+		return applicationCertificateResourceRead(ctx, d, meta)
+	}
 	if meta.(*clients.Client).EnableMsGraphBeta {
-		return applicationCertificateResourceReadMsGraph(ctx, d, meta)
+		return applicationCertificateResourceUpdateMsGraph(ctx, d, meta)
+	}
+	return applicationCertificateResourceUpdateAadGraph(ctx, d, meta)
+}
+
+func applicationCertificateResourceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
 	}
-	return applicationCertificateResourceReadAadGraph(ctx, d, meta)
+
+	// relative dates are resolved when applied, so the resulting absolute dates are not known until then
+	if diff.HasChange("start_date_relative") && diff.Get("start_date_relative").(string) != "" {
+		if err := diff.SetNewComputed("start_date"); err != nil {
+			return err
+		}
+	}
+	if diff.HasChange("end_date_relative") && diff.Get("end_date_relative").(string) != "" {
+		if err := diff.SetNewComputed("end_date"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applicationCertificateResourceChangedDates returns the new start and end dates for an existing certificate
+// credential. A nil value is returned for a date which has not changed. Relative dates take precedence, since the
+// corresponding absolute date retains its prior computed value when a relative date is configured.
+func applicationCertificateResourceChangedDates(d *schema.ResourceData) (startDate *time.Time, endDate *time.Time, diags diag.Diagnostics) {
+	if d.HasChanges("start_date", "start_date_relative") {
+		if v := d.Get("start_date_relative").(string); v != "" {
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, nil, tf.ErrorDiagPathF(err, "start_date_relative", "Unable to parse `start_date_relative` (%q) as a duration", v)
+			}
+			t := utils.RelativeStartTime(duration)
+			startDate = &t
+		} else if v := d.Get("start_date").(string); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, nil, tf.ErrorDiagPathF(err, "start_date", "Unable to parse the provided start date %q", v)
+			}
+			startDate = &t
+		}
+	}
+
+	if d.HasChanges("end_date", "end_date_relative") {
+		if v := d.Get("end_date_relative").(string); v != "" {
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, nil, tf.ErrorDiagPathF(err, "end_date_relative", "Unable to parse `end_date_relative` (%q) as a duration", v)
+			}
+			t := utils.RelativeEndTime(duration)
+			endDate = &t
+		} else if v := d.Get("end_date").(string); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, nil, tf.ErrorDiagPathF(err, "end_date", "Unable to parse the provided end date %q", v)
+			}
+			endDate = &t
+		}
+	}
+
+	return
 }
 
 func applicationCertificateResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
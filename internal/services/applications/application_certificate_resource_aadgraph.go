@@ -60,6 +60,11 @@ func applicationCertificateResourceCreateAadGraph(ctx context.Context, d *schema
 
 	d.SetId(id.String())
 
+	// the thumbprint is calculated from the uploaded certificate, in case it's not returned by the API
+	if v := cred.Value; v != nil {
+		tf.Set(d, "thumbprint", utils.CertificateThumbprintFromEncodedPEM(*v))
+	}
+
 	return applicationCertificateResourceReadAadGraph(ctx, d, meta)
 }
 
@@ -111,9 +116,46 @@ func applicationCertificateResourceReadAadGraph(ctx context.Context, d *schema.R
 	}
 	tf.Set(d, "end_date", endDate)
 
+	if v := utils.CertificateThumbprintFromCustomKeyIdentifier(credential.CustomKeyIdentifier); v != "" {
+		tf.Set(d, "thumbprint", v)
+	}
+
 	return nil
 }
 
+func applicationCertificateResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.AadClient
+
+	id, err := parse.CertificateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing certificate credential with ID %q", d.Id())
+	}
+
+	startDate, endDate, diags := applicationCertificateResourceChangedDates(d)
+	if diags != nil {
+		return diags
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	existing, err := client.ListKeyCredentials(ctx, id.ObjectId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "application_object_id", "Listing certificate credentials for application with object ID %q", id.ObjectId)
+	}
+
+	newCreds, err := aadgraph.KeyCredentialResultUpdateDatesByKeyId(existing, id.KeyId, startDate, endDate)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Updating certificate credential %q for application with object ID %q", id.KeyId, id.ObjectId)
+	}
+
+	if _, err = client.UpdateKeyCredentials(ctx, id.ObjectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
+		return tf.ErrorDiagF(err, "Updating certificate credential %q for application with object ID %q", id.KeyId, id.ObjectId)
+	}
+
+	return applicationCertificateResourceReadAadGraph(ctx, d, meta)
+}
+
 func applicationCertificateResourceDeleteAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Applications.AadClient
 
@@ -141,6 +183,22 @@ func applicationCertificateResourceDeleteAadGraph(ctx context.Context, d *schema
 		return tf.ErrorDiagF(err, "Listing certificate credential for application with object ID %q", id.ObjectId)
 	}
 
+	if overlap := d.Get("rotation_overlap").(int); overlap > 0 {
+		endDate := time.Now().UTC().Add(time.Duration(overlap) * 24 * time.Hour)
+		log.Printf("[DEBUG] Expiring certificate credential %q for application with object ID %q at %s instead of removing it", id.KeyId, id.ObjectId, endDate.Format(time.RFC3339))
+
+		newCreds, err := aadgraph.KeyCredentialResultExpireByKeyId(existing, id.KeyId, endDate)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Expiring certificate credential %q for application with object ID %q", id.KeyId, id.ObjectId)
+		}
+
+		if _, err = client.UpdateKeyCredentials(ctx, id.ObjectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
+			return tf.ErrorDiagF(err, "Expiring certificate credential %q for application with object ID %q", id.KeyId, id.ObjectId)
+		}
+
+		return nil
+	}
+
 	newCreds, err := aadgraph.KeyCredentialResultRemoveByKeyId(existing, id.KeyId)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Removing certificate credential %q from application with object ID %q", id.KeyId, id.ObjectId)
@@ -16,6 +16,7 @@ import (
 	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
 func applicationCertificateResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -39,36 +40,47 @@ func applicationCertificateResourceCreateMsGraph(ctx context.Context, d *schema.
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	app, status, err := client.Get(ctx, id.ObjectId)
+	alreadyExists := false
+
+	// The application may have been created moments earlier in the same apply, so wait for it to become visible
+	status, err := helpers.WaitForParentReplication(ctx, id.ObjectId, func() (int, error) {
+		return helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+			newCredentials := make([]msgraph.KeyCredential, 0)
+			if app.KeyCredentials != nil {
+				for _, cred := range *app.KeyCredentials {
+					if cred.KeyId != nil && *cred.KeyId == *credential.KeyId {
+						alreadyExists = true
+						return nil, nil
+					}
+					newCredentials = append(newCredentials, cred)
+				}
+			}
+
+			newCredentials = append(newCredentials, *credential)
+
+			return &msgraph.Application{
+				ID:             &id.ObjectId,
+				KeyCredentials: &newCredentials,
+			}, nil
+		})
+	})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
 		}
-		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+		return tf.ErrorDiagF(err, "Adding certificate for application with object ID %q", id.ObjectId)
 	}
-
-	newCredentials := make([]msgraph.KeyCredential, 0)
-	if app.KeyCredentials != nil {
-		for _, cred := range *app.KeyCredentials {
-			if cred.KeyId != nil && *cred.KeyId == *credential.KeyId {
-				return tf.ImportAsExistsDiag("azuread_application_certificate", id.String())
-			}
-			newCredentials = append(newCredentials, cred)
-		}
+	if alreadyExists {
+		return tf.ImportAsExistsDiag("azuread_application_certificate", id.String())
 	}
 
-	newCredentials = append(newCredentials, *credential)
+	d.SetId(id.String())
 
-	properties := msgraph.Application{
-		ID:             &id.ObjectId,
-		KeyCredentials: &newCredentials,
-	}
-	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Adding certificate for application with object ID %q", id.ObjectId)
+	// the thumbprint is calculated from the uploaded certificate, in case it's not returned by the API
+	if v := credential.Key; v != nil {
+		tf.Set(d, "thumbprint", utils.CertificateThumbprintFromEncodedPEM(*v))
 	}
 
-	d.SetId(id.String())
-
 	return applicationCertificateResourceReadMsGraph(ctx, d, meta)
 }
 
@@ -122,10 +134,14 @@ func applicationCertificateResourceReadMsGraph(ctx context.Context, d *schema.Re
 	}
 	tf.Set(d, "end_date", endDate)
 
+	if v := utils.CertificateThumbprintFromCustomKeyIdentifier(credential.CustomKeyIdentifier); v != "" {
+		tf.Set(d, "thumbprint", v)
+	}
+
 	return nil
 }
 
-func applicationCertificateResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func applicationCertificateResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Applications.MsClient
 
 	id, err := parse.CertificateID(d.Id())
@@ -133,31 +149,103 @@ func applicationCertificateResourceDeleteMsGraph(ctx context.Context, d *schema.
 		return tf.ErrorDiagPathF(err, "id", "Parsing certificate credential with ID %q", d.Id())
 	}
 
+	startDate, endDate, diags := applicationCertificateResourceChangedDates(d)
+	if diags != nil {
+		return diags
+	}
+
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	app, status, err := client.Get(ctx, id.ObjectId)
+	// existing credentials are returned without their key, and are preserved by the API using their key ID and
+	// customKeyIdentifier, so the credential can be patched without re-uploading the certificate
+	found := false
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		found = false
+		newCredentials := make([]msgraph.KeyCredential, 0)
+		if app.KeyCredentials != nil {
+			for _, cred := range *app.KeyCredentials {
+				if cred.KeyId != nil && *cred.KeyId == id.KeyId {
+					found = true
+					if startDate != nil {
+						cred.StartDateTime = startDate
+					}
+					if endDate != nil {
+						cred.EndDateTime = endDate
+					}
+				}
+				newCredentials = append(newCredentials, cred)
+			}
+		}
+		if !found {
+			return nil, nil
+		}
+
+		return &msgraph.Application{
+			ID:             &id.ObjectId,
+			KeyCredentials: &newCredentials,
+		}, nil
+	})
 	if err != nil {
 		if status == http.StatusNotFound {
-			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "application_object_id", "Retrieving Application with ID %q", id.ObjectId)
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
 		}
-		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+		return tf.ErrorDiagF(err, "Updating certificate credential %q for application with object ID %q", id.KeyId, id.ObjectId)
+	}
+	if !found {
+		return tf.ErrorDiagF(fmt.Errorf("certificate credential was not found"), "Updating certificate credential %q for application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
-	newCredentials := make([]msgraph.KeyCredential, 0)
-	if app.KeyCredentials != nil {
-		for _, cred := range *app.KeyCredentials {
-			if cred.KeyId != nil && *cred.KeyId != id.KeyId {
+	return applicationCertificateResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationCertificateResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id, err := parse.CertificateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing certificate credential with ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	// when a rotation overlap is configured, the credential is left to expire rather than being removed immediately
+	var expiry *time.Time
+	if overlap := d.Get("rotation_overlap").(int); overlap > 0 {
+		endDate := time.Now().UTC().Add(time.Duration(overlap) * 24 * time.Hour)
+		expiry = &endDate
+		log.Printf("[DEBUG] Expiring certificate credential %q for application with object ID %q at %s instead of removing it", id.KeyId, id.ObjectId, endDate.Format(time.RFC3339))
+	}
+
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		newCredentials := make([]msgraph.KeyCredential, 0)
+		if app.KeyCredentials != nil {
+			for _, cred := range *app.KeyCredentials {
+				if cred.KeyId == nil {
+					continue
+				}
+				if *cred.KeyId == id.KeyId {
+					if expiry == nil {
+						continue
+					}
+					if cred.EndDateTime == nil || cred.EndDateTime.After(*expiry) {
+						cred.EndDateTime = expiry
+					}
+				}
 				newCredentials = append(newCredentials, cred)
 			}
 		}
-	}
 
-	properties := msgraph.Application{
-		ID:             &id.ObjectId,
-		KeyCredentials: &newCredentials,
-	}
-	if _, err := client.Update(ctx, properties); err != nil {
+		return &msgraph.Application{
+			ID:             &id.ObjectId,
+			KeyCredentials: &newCredentials,
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "application_object_id", "Retrieving Application with ID %q", id.ObjectId)
+		}
 		return tf.ErrorDiagF(err, "Removing certificate credential %q from application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
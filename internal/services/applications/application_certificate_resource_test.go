@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
@@ -22,6 +23,8 @@ import (
 // openssl req -subj '/CN=hashicorptest/O=HashiCorp, Inc./ST=CA/C=US' -new -newkey rsa:2048 -sha256 -days 3650 -nodes -x509 -keyout server.key -out server.crt
 // grep -v \\----- server.crt >server.b64
 // cat server.b64 | base64 -d | xxd -p
+// openssl pkcs12 -export -in server.crt -inkey server.key -out server.pfx -passout pass:hashicorp -keypbe PBE-SHA1-3DES -certpbe PBE-SHA1-3DES -macalg sha1
+// base64 -w64 server.pfx
 
 const applicationCertificatePem string = `-----BEGIN CERTIFICATE-----
 MIIDFDCCAfwCCQCvHp+vopfOOTANBgkqhkiG9w0BAQsFADBMMRYwFAYDVQQDDA1o
@@ -64,6 +67,61 @@ GLNs0/0Ayfjh6JllWqW482dIIqMErl6s5DuK`
 
 const applicationCertificateHex string = `30820314308201fc020900af1e9fafa297ce39300d06092a864886f70d01010b0500304c3116301406035504030c0d6861736869636f72707465737431183016060355040a0c0f4861736869436f72702c20496e632e310b300906035504080c024341310b3009060355040613025553301e170d3231303330393131303231335a170d3331303330373131303231335a304c3116301406035504030c0d6861736869636f72707465737431183016060355040a0c0f4861736869436f72702c20496e632e310b300906035504080c024341310b300906035504061302555330820122300d06092a864886f70d01010105000382010f003082010a028201010095599be699a8012bd9e69c43e8210188f62a0036fbb5e087579e11470bf56898d27a23d45bfd56350a28210174334cb315d1e6c31dc74a8c42910c30c553003ecbaa14955a6ecfde02be35369c500a771b8bebca95b99a0166da21d89dc5e51ed635c1d8dd185d10a0ecfb3c206034528721bd11b2a5f722cb893aff111faeb40f165acf78379abab3548c9e08a2d4c12f358d017f674f51cacef96360d8380343f0bb33cd3a6831512a407a23db0e84280ff61c414296cc1956f2ab7d667b91306362e95d9f9d07932db95fb2179a6af5f23f5d6cc89f68c33ea60df7d910b1dd4980bb8f610ea86f8f9dd779e4c2ce69cf1c1780fd63dd4f90b28a8cd22b5b0203010001300d06092a864886f70d01010b05000382010100655492e7d7b0459a281ba92f09231d3f4536c6a244682ca760ed26404095a7db48c3b6e9d2a3eb29673b99e2e4bc59b819f92143d6bad0cb7b3417d1ecb19141c031b29f7d73fec1a39305a9a003fae6fd4309a373980b33c4c3628e16254a1bfad60d8810ef50f7c6e0056f4b3709894469c6c73d2e7f3799b94afd215f448dea0908a3c86f024295a6f44f2fe4442d772603611ed345c185702ba343b78e8846c8f1a9643a6d56a4ac444e95fba863cb31523ba78fe841daf65391929a3dcd2c16f5108cd1f11d7e2331e4f00a8a500a8faa17794f75ef86ec2d4c35fdf490366592cfbe18b36cd3fd00c9f8e1e899655aa5b8f3674822a304ae5eace43b8a`
 
+const applicationCertificatePkcs12 string = `MIIJuQIBAzCCCX8GCSqGSIb3DQEHAaCCCXAEgglsMIIJaDCCBB8GCSqGSIb3DQEH
+BqCCBBAwggQMAgEAMIIEBQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQMwDgQIWLEo
+o+y+OYUCAggAgIID2OViu7wtzCUZZFehcSni5zAffOjFr+4qDSjGA5By40iCoZ3r
+rAnwUIGlJyVKI/nPHWrFWAsVb8OzuFvHxURDRz3WW47/633YKskgear8H9hk4p+K
+5C9ltfnlu1Yuz5HmLNIB6lAlYOWc/c86X4l0idgHRUZcCbGa+LYnKNVUag6eEFKU
+ONYUtmKaQwW3aUx4J7b7OQwS8P/+TJoXEpJZsLEyHZ5GrdEBhvElnRawDeTQxaBf
+HFc30aUpcRvzNHw7HjqURwyutGd8GuHYYVaNhd4Zjtyd6YahSCZcGzqOGaNcRTro
+5Vd7q0k1BQFlBUbCFD2FPaMkTY4qF5wReOpQY52lNE3OMy/VEgY+13zISnLHECD2
+1HedI0PvwCPJYZJu2pPXrzc5nrD9oi2Blt/AkGMYZvKzBrmPQpUnOYqhyiafLhqw
+XZdBlHsgyh0Jp1ABCeYvkoURx3I/rcZOIqNsOPMk/5SuXy+gHDIegtZx5iQaVYgF
+tViKbXHjbfJM/SmgPRPo7IhJxaOyA3oBhnltkbbB0G19ghxE10ptNM2pxB6t+UsU
+BkR4pndZoyQ48AV2kjeqh+N4RB+0/7m1PVL7mCyuO/JHZoKJkSw/alAgS3u6Bjko
+tvFjJTGlRvPprn3Yb4vxCA9bCaOgIa42Gq52b33Tox/PYDhpwLwgZ5W5SOxXtVB8
+O1Pcf6fqWzW9hNC+gIR1rDHei5j0kxP67KQa2dLuGHNwuxg3xZyHgLh394gXXKWW
+7Y8Tfm1224zOxgsQHE2IzKd7iQB6v7t+/qaoNjn0WMcUVU+SfYtnTAgm7Vz60j0X
+loVXESP+LQ1s9WFcW9BrbR2PjmT16Sk1OVS+46xS/Piub6uHsboeFxQ56edZe293
+9mkcgHxljS+0o28HYbVWvplmKWygEYYAbWJGWDgWbMHwKfMj1WJhknQNzA6ZL5zN
+wkivGZMHmQM6wly9wrqg278Sam90fJ+u3nMDFhMBy1vmQBVj9/0wxAPePksI1XdV
+MGPUT76j0tbbst1czJkeEDVpl7Lt5ErYHq3EjakwxJyHIz5pd2uPc0fifdeLiDzc
+AGd99LRGbeQdmt0y+GqRBkzjorRih5GWb3924ndrZlsyvV0h9OENffQ2vJKcHNXD
+Va80A+qtvwYFOszLOfsbEaeI48+S+up2++Ul9SK2kDxPCJaDwRiMU3oUwLp7r2RH
+9et5hLT2xKmgjbhEnsSg2dSRxlPP+vA3jzLhG7pfhGPJWiNoEsqVk377W/shq1YO
+AkqW+duJvsWnRlOPn4nT3YYgQ2WWq0QDohI6MtXSB0Pu3x6RGTCCBUEGCSqGSIb3
+DQEHAaCCBTIEggUuMIIFKjCCBSYGCyqGSIb3DQEMCgECoIIE7jCCBOowHAYKKoZI
+hvcNAQwBAzAOBAiCrXIcqBpVHgICCAAEggTI3OWauvqvgkfbizAXS49XBeZZ6Rn2
+jwHm8qCYMa7OChEmWemtiKsBuBM/mkVT6BDk9FTfZ8NS7PsMn9Ib3aSu+OQn83Xm
+CblTCM01Y9f92x73UzJV0ZPwR2Ks8TbYG3w4PDGVJaJz+m6LIckyiADMtYxE4a8p
+RjmcbNe1k9UISpqa6OcfhaDdI9OXV4MAaIfacKXBmWPll3qLJBOOxcJOj/MpLJ9H
+C0QuZaGIdeZXx87ULh/tgZt76QmdAv2CmGpfL2M9hRGlmPrX/9wSzUSAFqlOk78B
+w+WIg/BUn6DBNWNKfMsYD8BzqHD6Mx6BFESsPz14oZ9iUhG1rYCtpXh8OOzRIpCi
+8C+pum39Q/p/7ffmVm3ZhC2JtDuRaJAWVTh19NEN4+XyuHkSAJIKuUS2vcMJp1/M
+gioN8CXZUQt5oiHl5DneChCHVeCDaNzg1PJybQ3BDHLEi/MJV8GjZ0ZziWsDBjlk
+xWqc+6pk1dWZ9CRz3ht5PSniCf3GIPfaok66AxTK9Z4yII2zUU8V9h9IV5eeW+h5
+ornvJvwL5Bo2CjrgSfI5zhAZ2RWWulsOKpk6ZzJHPtXeWBELznL2Es15nA7pFtkC
+W13XDOajQjsGWU7byvb27CKjumctjgN+EvW54I44irlUT758vrhNSnY5XZ60MVKl
+rRa4IKOGfPSvVLcADj568giotIxPAEEHnkRh6mlTYK9ArhtWhN/YP/4Kv6E5lMdt
+dPuC1kFFe7lWFsb6L/q1WXp7CyQBY1EV6r8FL5T4o2oM1rC+dPbrT+3HJQ4vUvLW
++Q9ELnqYqODFPGiAoVSB+LOW+mUv0Mjz1QSz/Wtawgf04eBmXHS9MagNLplJ9Aru
+fB0L8fLRv6z11Yy3a5fC6hNeURtWzeu86+eLb1mwQ2lkz2yD/NdUL2tnLCpTKSVX
+YjHcREQ+fqGTgnKn/KJKFPLlc688knc+A5QCIVWJSneJPb4Xd2aA1qp6IcldAG8+
+6wk7OtCytYc79IinKm3kYcuu4dN7n99CdSed8w8DeQVzqwTwwB0bh/46hDc3I/WW
+PnXFp2t6lBlJZR6eXWQBoYdcJnSZpTRF5c0Wtve+EfXixL6HnSaNZydL16Qg+xkp
+Dt3sjDFVbRjJkbTWN09nXTDk5A/r0+oQv+GrKUZ3DpMwZPJ7FF1MOEuZFW5sIjC0
+DTvqJyWMP28NandvnKnMQsRPDOIvA32IE6LsM5jOUcuyaAmqlKj91g/VACKM59ZW
+r7TEjHJI5AlUxfsyabIJ59vqM7OQ00AmXbySkdb7qRqBM5igJaZUYyJyZikrwB1v
+Ocq1lxj5fg6ha5g3cIcX3ZaHHU4X5DYuHKQhOgk8UHMSGYFk0JNQV8jVt7r4bcJd
+YSGaJgq35II2Hgpi8Z1m9+g4XPAAN3m1S3zXhe9puUgifQpNgW6x1rtZZ8VGbcJa
+GDAeqfRdaVOvZbEeUedw1NUEk5VDaWvWgL1mVdhNMO/GwRUxDhbUOQSY7oJVGXHF
+tqztQxDNPLn3LRUfHHz1wzk3WhkwEZRPkpRhNNypOSkF5e4VdAg77ECt2GHb/ScG
+jsG41cgzZkKJ7JN0vOAqV1ULSzczsuXkSinnaijI9OcvKhO6Zcgie9w9Ibv6oINk
+7Mq9MSUwIwYJKoZIhvcNAQkVMRYEFGDES2THb+SY2hASKWUH8Zu3gSEJMDEwITAJ
+BgUrDgMCGgUABBTtA1VA9wU411+MJvE31h8n9b/KMAQIp1s8XhCsscsCAggA`
+
+const applicationCertificatePkcs12Password = "hashicorp"
+
 type ApplicationCertificateResource struct{}
 
 func TestAccApplicationCertificate_basic(t *testing.T) {
@@ -77,6 +135,7 @@ func TestAccApplicationCertificate_basic(t *testing.T) {
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("thumbprint").HasValue("B29066877F3CA826A4A597D1D3572AE7AD11765D"),
 			),
 		},
 		data.ImportStep("encoding", "end_date_relative", "value"),
@@ -101,6 +160,38 @@ func TestAccApplicationCertificate_complete(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_updateDates(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	startDate := time.Now().AddDate(0, 0, 7).UTC().Format(time.RFC3339)
+	endDate := time.Now().AddDate(0, 2, 0).UTC().Format(time.RFC3339)
+	newStartDate := time.Now().AddDate(0, 0, 1).UTC().Format(time.RFC3339)
+	newEndDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data, startDate, endDate),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").HasValue(data.RandomID),
+				check.That(data.ResourceName).Key("end_date").HasValue(endDate),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "value"),
+		{
+			Config: r.complete(data, newStartDate, newEndDate),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").HasValue(data.RandomID),
+				check.That(data.ResourceName).Key("start_date").HasValue(newStartDate),
+				check.That(data.ResourceName).Key("end_date").HasValue(newEndDate),
+				check.That(data.ResourceName).Key("thumbprint").HasValue("B29066877F3CA826A4A597D1D3572AE7AD11765D"),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "value"),
+	})
+}
+
 func TestAccApplicationCertificate_base64Cert(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
 	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
@@ -135,6 +226,40 @@ func TestAccApplicationCertificate_hexCert(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_derCert(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.derCert(data, endDate),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "value"),
+	})
+}
+
+func TestAccApplicationCertificate_pkcs12Cert(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.pkcs12Cert(data, endDate),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "password", "value"),
+	})
+}
+
 func TestAccApplicationCertificate_relativeEndDate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
 	r := ApplicationCertificateResource{}
@@ -152,6 +277,29 @@ func TestAccApplicationCertificate_relativeEndDate(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_relativeDatesWithRotationOverlap(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.relativeEndDate(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.relativeDatesWithRotationOverlap(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_overlap").HasValue("7"),
+				check.That(data.ResourceName).Key("end_date").MatchesRegex(regexp.MustCompile("T00:00:00Z$")),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "rotation_overlap", "value"),
+	})
+}
+
 func TestAccApplicationCertificate_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
 	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
@@ -287,6 +435,39 @@ EOT
 `, r.template(data), endDate, applicationCertificateHex)
 }
 
+func (r ApplicationCertificateResource) derCert(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_certificate" "test" {
+  application_object_id = azuread_application.test.id
+  type                  = "AsymmetricX509Cert"
+  encoding              = "der"
+  end_date              = "%[2]s"
+  value                 = <<EOT
+%[3]s
+EOT
+}
+`, r.template(data), endDate, applicationCertificateBase64)
+}
+
+func (r ApplicationCertificateResource) pkcs12Cert(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_certificate" "test" {
+  application_object_id = azuread_application.test.id
+  type                  = "AsymmetricX509Cert"
+  encoding              = "pkcs12"
+  end_date              = "%[2]s"
+  password              = "%[4]s"
+  value                 = <<EOT
+%[3]s
+EOT
+}
+`, r.template(data), endDate, applicationCertificatePkcs12, applicationCertificatePkcs12Password)
+}
+
 func (r ApplicationCertificateResource) relativeEndDate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -302,6 +483,22 @@ EOT
 `, r.template(data), applicationCertificatePem)
 }
 
+func (r ApplicationCertificateResource) relativeDatesWithRotationOverlap(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_certificate" "test" {
+  application_object_id = azuread_application.test.id
+  end_date_relative     = "2280h"
+  rotation_overlap      = 7
+  type                  = "AsymmetricX509Cert"
+  value                 = <<EOT
+%[2]s
+EOT
+}
+`, r.template(data), applicationCertificatePem)
+}
+
 func (r ApplicationCertificateResource) requiresImport(data acceptance.TestData, endDate string) string {
 	return fmt.Sprintf(`
 %[1]s
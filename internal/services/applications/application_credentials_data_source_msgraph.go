@@ -0,0 +1,34 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func applicationCredentialsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+	objectId := d.Get("application_object_id").(string)
+
+	app, status, err := client.Get(ctx, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Application with object ID %q was not found", objectId), "application_object_id", "Retrieving application")
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", objectId)
+	}
+
+	d.SetId(objectId)
+
+	tf.Set(d, "certificates", helpers.KeyCredentialsFlatten(app.KeyCredentials))
+	tf.Set(d, "passwords", helpers.PasswordCredentialsFlatten(app.PasswordCredentials))
+
+	return nil
+}
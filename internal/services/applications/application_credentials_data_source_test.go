@@ -0,0 +1,67 @@
+package applications_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationCredentialsDataSource struct{}
+
+func TestAccApplicationCredentialsDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_application_credentials", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ApplicationCredentialsDataSource{}.basic(data, endDate),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("certificates.#").HasValue("1"),
+				check.That(data.ResourceName).Key("certificates.0.key_id").MatchesOtherKey(check.That("azuread_application_certificate.test").Key("key_id")),
+				check.That(data.ResourceName).Key("certificates.0.thumbprint").HasValue("B29066877F3CA826A4A597D1D3572AE7AD11765D"),
+				check.That(data.ResourceName).Key("certificates.0.type").HasValue("AsymmetricX509Cert"),
+				check.That(data.ResourceName).Key("passwords.#").HasValue("1"),
+				check.That(data.ResourceName).Key("passwords.0.key_id").MatchesOtherKey(check.That("azuread_application_password.test").Key("key_id")),
+				check.That(data.ResourceName).Key("passwords.0.display_name").HasValue(fmt.Sprintf("acctestAppPassword-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("passwords.0.end_date").Exists(),
+				check.That(data.ResourceName).Key("passwords.0.hint").Exists(),
+			),
+		},
+	})
+}
+
+func (ApplicationCredentialsDataSource) basic(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestAppCredentials-%[1]d"
+}
+
+resource "azuread_application_certificate" "test" {
+  application_object_id = azuread_application.test.id
+  type                  = "AsymmetricX509Cert"
+  end_date              = "%[2]s"
+  value                 = <<EOT
+%[3]s
+EOT
+}
+
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application_certificate.test.application_object_id
+  display_name          = "acctestAppPassword-%[1]d"
+}
+
+data "azuread_application_credentials" "test" {
+  application_object_id = azuread_application_password.test.application_object_id
+}
+`, data.RandomInteger, endDate, applicationCertificatePem)
+}
@@ -14,6 +14,23 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
+// applicationDataSourceSelectFields are the application properties exported by the data source, which are the only
+// properties requested from the API
+var applicationDataSourceSelectFields = []string{
+	"api",
+	"appId",
+	"appRoles",
+	"displayName",
+	"groupMembershipClaims",
+	"id",
+	"identifierUris",
+	"isFallbackPublicClient",
+	"optionalClaims",
+	"requiredResourceAccess",
+	"signInAudience",
+	"web",
+}
+
 func applicationDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Applications.MsClient
 
@@ -22,7 +39,7 @@ func applicationDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceDat
 	if objectId, ok := d.Get("object_id").(string); ok && objectId != "" {
 		var status int
 		var err error
-		app, status, err = client.Get(ctx, objectId)
+		app, status, err = helpers.ApplicationGet(ctx, client.BaseClient, objectId, applicationDataSourceSelectFields)
 		if err != nil {
 			if status == http.StatusNotFound {
 				return tf.ErrorDiagPathF(nil, "object_id", "Application with object ID %q was not found", objectId)
@@ -47,7 +64,7 @@ func applicationDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceDat
 
 		filter := fmt.Sprintf("%s eq '%s'", fieldName, fieldValue)
 
-		result, _, err := client.List(ctx, filter)
+		result, _, err := helpers.ApplicationList(ctx, client.BaseClient, filter, applicationDataSourceSelectFields)
 		if err != nil {
 			return tf.ErrorDiagF(err, "Listing applications for filter %q", filter)
 		}
@@ -0,0 +1,120 @@
+package applications
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const applicationExtensionPropertyResourceName = "azuread_application_extension_property"
+
+func applicationExtensionPropertyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationExtensionPropertyResourceCreate,
+		ReadContext:   applicationExtensionPropertyResourceRead,
+		DeleteContext: applicationExtensionPropertyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ExtensionPropertyID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+$`),
+					"name must contain only alphanumeric characters",
+				),
+			},
+
+			"data_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  client.ExtensionPropertyDataTypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.ExtensionPropertyDataTypeBinary,
+					client.ExtensionPropertyDataTypeBoolean,
+					client.ExtensionPropertyDataTypeDateTime,
+					client.ExtensionPropertyDataTypeInteger,
+					client.ExtensionPropertyDataTypeLargeInteger,
+					client.ExtensionPropertyDataTypeString,
+				}, false),
+			},
+
+			"target_objects": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						client.ExtensionPropertyTargetObjectApplication,
+						client.ExtensionPropertyTargetObjectDevice,
+						client.ExtensionPropertyTargetObjectGroup,
+						client.ExtensionPropertyTargetObjectOrganization,
+						client.ExtensionPropertyTargetObjectUser,
+					}, false),
+				},
+			},
+
+			"attribute_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"extension_property_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationExtensionPropertyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationExtensionPropertyResourceName); diags != nil {
+		return diags
+	}
+	return applicationExtensionPropertyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func applicationExtensionPropertyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationExtensionPropertyResourceName); diags != nil {
+		return diags
+	}
+	return applicationExtensionPropertyResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationExtensionPropertyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationExtensionPropertyResourceName); diags != nil {
+		return diags
+	}
+	return applicationExtensionPropertyResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,119 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func applicationExtensionPropertyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Applications.ExtensionPropertiesClient
+	objectId := d.Get("application_object_id").(string)
+	name := d.Get("name").(string)
+
+	targetObjects := make([]string, 0)
+	for _, v := range d.Get("target_objects").(*schema.Set).List() {
+		targetObjects = append(targetObjects, v.(string))
+	}
+
+	properties := client.ExtensionProperty{
+		DataType:      utils.String(d.Get("data_type").(string)),
+		Name:          utils.String(name),
+		TargetObjects: &targetObjects,
+	}
+
+	property, status, err := c.Create(ctx, objectId, properties)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagF(err, "Creating extension property %q for application with object ID %q", name, objectId)
+	}
+
+	if property.ID == nil || *property.ID == "" {
+		return tf.ErrorDiagF(errors.New("API returned extension property with nil ID"), "Bad API Response")
+	}
+
+	id := parse.NewExtensionPropertyID(objectId, *property.ID)
+	d.SetId(id.String())
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, id.ObjectId, id.ExtensionPropertyId)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for extension property with ID %q", id.ExtensionPropertyId)
+	}
+
+	return applicationExtensionPropertyResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationExtensionPropertyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Applications.ExtensionPropertiesClient
+
+	id, err := parse.ExtensionPropertyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing extension property with ID %q", d.Id())
+	}
+
+	property, status, err := c.Get(ctx, id.ObjectId, id.ExtensionPropertyId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Extension property %q for application with object ID %q was not found - removing from state", id.ExtensionPropertyId, id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving extension property %q for application with object ID %q", id.ExtensionPropertyId, id.ObjectId)
+	}
+
+	// The full attribute name is in the format `extension_{appIdWithoutHyphens}_{name}`
+	var name string
+	if property.Name != nil {
+		if parts := strings.SplitN(*property.Name, "_", 3); len(parts) == 3 {
+			name = parts[2]
+		}
+	}
+
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "attribute_name", property.Name)
+	tf.Set(d, "data_type", property.DataType)
+	tf.Set(d, "extension_property_id", id.ExtensionPropertyId)
+	tf.Set(d, "name", name)
+	tf.Set(d, "target_objects", property.TargetObjects)
+
+	return nil
+}
+
+func applicationExtensionPropertyResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Applications.ExtensionPropertiesClient
+
+	id, err := parse.ExtensionPropertyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing extension property with ID %q", d.Id())
+	}
+
+	if _, status, err := c.Get(ctx, id.ObjectId, id.ExtensionPropertyId); err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Extension property was not found"), "id", "Retrieving extension property %q for application with object ID %q", id.ExtensionPropertyId, id.ObjectId)
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving extension property %q for application with object ID %q", id.ExtensionPropertyId, id.ObjectId)
+	}
+
+	if _, err := c.Delete(ctx, id.ObjectId, id.ExtensionPropertyId); err != nil {
+		return tf.ErrorDiagF(err, "Deleting extension property %q for application with object ID %q", id.ExtensionPropertyId, id.ObjectId)
+	}
+
+	return nil
+}
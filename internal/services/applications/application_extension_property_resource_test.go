@@ -0,0 +1,103 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationExtensionPropertyResource struct{}
+
+func TestAccApplicationExtensionProperty_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_extension_property", "test")
+	r := ApplicationExtensionPropertyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("attribute_name").Exists(),
+				check.That(data.ResourceName).Key("data_type").HasValue("String"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationExtensionProperty_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_extension_property", "test")
+	r := ApplicationExtensionPropertyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_type").HasValue("Integer"),
+				check.That(data.ResourceName).Key("target_objects.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationExtensionPropertyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ExtensionPropertyID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Extension Property ID: %v", err)
+	}
+
+	property, status, err := clients.Applications.ExtensionPropertiesClient.Get(ctx, id.ObjectId, id.ExtensionPropertyId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Extension Property %q for Application %q does not exist", id.ExtensionPropertyId, id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Extension Property %q for Application %q: %+v", id.ExtensionPropertyId, id.ObjectId, err)
+	}
+
+	return utils.Bool(property.ID != nil && *property.ID == id.ExtensionPropertyId), nil
+}
+
+func (ApplicationExtensionPropertyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestAppExtensionProperty-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationExtensionPropertyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_extension_property" "test" {
+  application_object_id = azuread_application.test.object_id
+  name                  = "acctest%[2]s"
+  target_objects        = ["User"]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ApplicationExtensionPropertyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_extension_property" "test" {
+  application_object_id = azuread_application.test.object_id
+  name                  = "acctest%[2]s"
+  data_type             = "Integer"
+  target_objects        = ["Group", "User"]
+}
+`, r.template(data), data.RandomString)
+}
@@ -0,0 +1,72 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const applicationIdentifierUriResourceName = "azuread_application_identifier_uri"
+
+func applicationIdentifierUriResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationIdentifierUriResourceCreate,
+		ReadContext:   applicationIdentifierUriResourceRead,
+		DeleteContext: applicationIdentifierUriResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.IdentifierUriID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"identifier_uri": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.IsAppURI,
+			},
+		},
+	}
+}
+
+func applicationIdentifierUriResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationIdentifierUriResourceName); diags != nil {
+		return diags
+	}
+	return applicationIdentifierUriResourceCreateMsGraph(ctx, d, meta)
+}
+
+func applicationIdentifierUriResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationIdentifierUriResourceName); diags != nil {
+		return diags
+	}
+	return applicationIdentifierUriResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationIdentifierUriResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationIdentifierUriResourceName); diags != nil {
+		return diags
+	}
+	return applicationIdentifierUriResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,140 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func applicationIdentifierUriResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id := parse.NewIdentifierUriID(d.Get("application_object_id").(string), d.Get("identifier_uri").(string))
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	alreadyExists := false
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		if applicationIdentifierUriIndex(app, id.IdentifierUri) >= 0 {
+			alreadyExists = true
+			return nil, nil
+		}
+
+		identifierUris := make([]string, 0)
+		if app.IdentifierUris != nil {
+			identifierUris = append(identifierUris, *app.IdentifierUris...)
+		}
+		identifierUris = append(identifierUris, id.IdentifierUri)
+
+		return &msgraph.Application{
+			ID:             app.ID,
+			IdentifierUris: &identifierUris,
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		return tf.ErrorDiagF(err, "Adding identifier URI %q to application with object ID %q", id.IdentifierUri, id.ObjectId)
+	}
+
+	if alreadyExists {
+		return tf.ImportAsExistsDiag(applicationIdentifierUriResourceName, id.String())
+	}
+
+	d.SetId(id.String())
+
+	return applicationIdentifierUriResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationIdentifierUriResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id, err := parse.IdentifierUriID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing identifier URI ID %q", d.Id())
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with object ID %q was not found - removing from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+	}
+
+	if applicationIdentifierUriIndex(app, id.IdentifierUri) < 0 {
+		log.Printf("[DEBUG] Identifier URI %q was not found for application with object ID %q - removing from state!", id.IdentifierUri, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "identifier_uri", id.IdentifierUri)
+
+	return nil
+}
+
+func applicationIdentifierUriResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id, err := parse.IdentifierUriID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing identifier URI ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		i := applicationIdentifierUriIndex(app, id.IdentifierUri)
+		if i < 0 {
+			log.Printf("[DEBUG] Identifier URI %q was not found for application with object ID %q", id.IdentifierUri, id.ObjectId)
+			return nil, nil
+		}
+
+		identifierUris := make([]string, 0)
+		identifierUris = append(identifierUris, (*app.IdentifierUris)[:i]...)
+		identifierUris = append(identifierUris, (*app.IdentifierUris)[i+1:]...)
+
+		return &msgraph.Application{
+			ID:             app.ID,
+			IdentifierUris: &identifierUris,
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+		}
+		return tf.ErrorDiagF(err, "Removing identifier URI %q from application with object ID %q", id.IdentifierUri, id.ObjectId)
+	}
+
+	return nil
+}
+
+// applicationIdentifierUriIndex returns the position of the specified identifier URI for an application, or -1 when
+// the application does not have the identifier URI.
+func applicationIdentifierUriIndex(app *msgraph.Application, identifierUri string) int {
+	if app == nil || app.IdentifierUris == nil {
+		return -1
+	}
+	for i, v := range *app.IdentifierUris {
+		if v == identifierUri {
+			return i
+		}
+	}
+	return -1
+}
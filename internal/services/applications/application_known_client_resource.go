@@ -0,0 +1,83 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const applicationKnownClientResourceName = "azuread_application_known_client"
+
+func applicationKnownClientResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationKnownClientResourceCreateUpdate,
+		UpdateContext: applicationKnownClientResourceCreateUpdate,
+		ReadContext:   applicationKnownClientResourceRead,
+		DeleteContext: applicationKnownClientResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.KnownClientID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"client_application_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"pre_authorized_scope_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+		},
+	}
+}
+
+func applicationKnownClientResourceCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationKnownClientResourceName); diags != nil {
+		return diags
+	}
+	return applicationKnownClientResourceCreateUpdateMsGraph(ctx, d, meta)
+}
+
+func applicationKnownClientResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationKnownClientResourceName); diags != nil {
+		return diags
+	}
+	return applicationKnownClientResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationKnownClientResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationKnownClientResourceName); diags != nil {
+		return diags
+	}
+	return applicationKnownClientResourceDeleteMsGraph(ctx, d, meta)
+}
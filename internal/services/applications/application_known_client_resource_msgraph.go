@@ -0,0 +1,208 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func applicationKnownClientResourceCreateUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id := parse.NewKnownClientID(d.Get("application_object_id").(string), d.Get("client_application_id").(string))
+	scopeIds := *tf.ExpandStringSlicePtr(d.Get("pre_authorized_scope_ids").(*schema.Set).List())
+
+	// An existing pre-authorization is only removed when it was previously managed by this resource
+	oldScopeIds, _ := d.GetChange("pre_authorized_scope_ids")
+	managePreAuthorization := len(scopeIds) > 0 || (!d.IsNewResource() && oldScopeIds.(*schema.Set).Len() > 0)
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	alreadyExists := false
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		i := applicationKnownClientIndex(app, id.ClientAppId)
+
+		knownClients := make([]string, 0)
+		if app.Api != nil && app.Api.KnownClientApplications != nil {
+			knownClients = append(knownClients, *app.Api.KnownClientApplications...)
+		}
+
+		if d.IsNewResource() {
+			if i >= 0 {
+				alreadyExists = true
+				return nil, nil
+			}
+			knownClients = append(knownClients, id.ClientAppId)
+		} else if i < 0 {
+			return nil, fmt.Errorf("known client application %q was not found", id.ClientAppId)
+		}
+
+		api := msgraph.ApplicationApi{
+			KnownClientApplications: &knownClients,
+		}
+		if managePreAuthorization {
+			var existing *[]msgraph.ApiPreAuthorizedApplication
+			if app.Api != nil {
+				existing = app.Api.PreAuthorizedApplications
+			}
+			api.PreAuthorizedApplications = applicationPreAuthorizedApplicationsWith(existing, id.ClientAppId, scopeIds)
+		}
+
+		return &msgraph.Application{
+			ID:  app.ID,
+			Api: &api,
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		return tf.ErrorDiagF(err, "Setting known client application %q for application with object ID %q", id.ClientAppId, id.ObjectId)
+	}
+
+	if alreadyExists {
+		return tf.ImportAsExistsDiag(applicationKnownClientResourceName, id.String())
+	}
+
+	d.SetId(id.String())
+
+	return applicationKnownClientResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationKnownClientResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id, err := parse.KnownClientID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing known client ID %q", d.Id())
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with object ID %q was not found - removing from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+	}
+
+	if applicationKnownClientIndex(app, id.ClientAppId) < 0 {
+		log.Printf("[DEBUG] Known client application %q was not found for application with object ID %q - removing from state!", id.ClientAppId, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	scopeIds := make([]string, 0)
+	if app.Api != nil && app.Api.PreAuthorizedApplications != nil {
+		for _, p := range *app.Api.PreAuthorizedApplications {
+			if p.AppId != nil && strings.EqualFold(*p.AppId, id.ClientAppId) && p.PermissionIds != nil {
+				scopeIds = append(scopeIds, *p.PermissionIds...)
+			}
+		}
+	}
+
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "client_application_id", id.ClientAppId)
+	tf.Set(d, "pre_authorized_scope_ids", scopeIds)
+
+	return nil
+}
+
+func applicationKnownClientResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id, err := parse.KnownClientID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing known client ID %q", d.Id())
+	}
+
+	managePreAuthorization := d.Get("pre_authorized_scope_ids").(*schema.Set).Len() > 0
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		i := applicationKnownClientIndex(app, id.ClientAppId)
+		if i < 0 {
+			log.Printf("[DEBUG] Known client application %q was not found for application with object ID %q", id.ClientAppId, id.ObjectId)
+			return nil, nil
+		}
+
+		knownClients := make([]string, 0)
+		knownClients = append(knownClients, (*app.Api.KnownClientApplications)[:i]...)
+		knownClients = append(knownClients, (*app.Api.KnownClientApplications)[i+1:]...)
+
+		api := msgraph.ApplicationApi{
+			KnownClientApplications: &knownClients,
+		}
+		if managePreAuthorization {
+			api.PreAuthorizedApplications = applicationPreAuthorizedApplicationsWith(app.Api.PreAuthorizedApplications, id.ClientAppId, nil)
+		}
+
+		return &msgraph.Application{
+			ID:  app.ID,
+			Api: &api,
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+		}
+		return tf.ErrorDiagF(err, "Removing known client application %q from application with object ID %q", id.ClientAppId, id.ObjectId)
+	}
+
+	return nil
+}
+
+// applicationKnownClientIndex returns the position of the specified client application ID in the known client
+// applications for an application, or -1 when it is not a known client application.
+func applicationKnownClientIndex(app *msgraph.Application, clientAppId string) int {
+	if app == nil || app.Api == nil || app.Api.KnownClientApplications == nil {
+		return -1
+	}
+	for i, v := range *app.Api.KnownClientApplications {
+		if strings.EqualFold(v, clientAppId) {
+			return i
+		}
+	}
+	return -1
+}
+
+// applicationPreAuthorizedApplicationsWith returns a copy of the pre-authorized applications for an application, in
+// which the specified client application is pre-authorized for exactly the specified scopes. When no scopes are
+// specified, the client application is removed from the pre-authorized applications.
+func applicationPreAuthorizedApplicationsWith(existing *[]msgraph.ApiPreAuthorizedApplication, clientAppId string, scopeIds []string) *[]msgraph.ApiPreAuthorizedApplication {
+	result := make([]msgraph.ApiPreAuthorizedApplication, 0)
+	if existing != nil {
+		for _, p := range *existing {
+			if p.AppId != nil && strings.EqualFold(*p.AppId, clientAppId) {
+				continue
+			}
+			result = append(result, p)
+		}
+	}
+
+	if len(scopeIds) > 0 {
+		permissionIds := append([]string{}, scopeIds...)
+		result = append(result, msgraph.ApiPreAuthorizedApplication{
+			AppId:         utils.String(clientAppId),
+			PermissionIds: &permissionIds,
+		})
+	}
+
+	return &result
+}
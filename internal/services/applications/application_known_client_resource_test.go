@@ -0,0 +1,179 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationKnownClientResource struct{}
+
+func TestAccApplicationKnownClient_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_known_client", "test")
+	r := ApplicationKnownClientResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_authorized_scope_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationKnownClient_preAuthorized(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_known_client", "test")
+	r := ApplicationKnownClientResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.preAuthorized(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_authorized_scope_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationKnownClient_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_known_client", "test")
+	r := ApplicationKnownClientResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.preAuthorized(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_authorized_scope_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_authorized_scope_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationKnownClient_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_known_client", "test")
+	r := ApplicationKnownClientResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r ApplicationKnownClientResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.KnownClientID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Known Client ID: %v", err)
+	}
+
+	app, status, err := clients.Applications.MsClient.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Application with object ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	if app.Api != nil && app.Api.KnownClientApplications != nil {
+		for _, v := range *app.Api.KnownClientApplications {
+			if strings.EqualFold(v, id.ClientAppId) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Known Client Application %q was not found for Application %q", id.ClientAppId, id.ObjectId)
+}
+
+func (ApplicationKnownClientResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestAppKnownClient-%[1]d"
+}
+
+resource "azuread_application_oauth2_permission_scope" "test" {
+  application_object_id      = azuread_application.test.id
+  admin_consent_description  = "Access the application"
+  admin_consent_display_name = "Access"
+  type                       = "User"
+  user_consent_description   = "Access the application"
+  user_consent_display_name  = "Access"
+  value                      = "access"
+}
+
+resource "azuread_application" "client" {
+  name = "acctestAppKnownClient-%[1]d-Client"
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationKnownClientResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_known_client" "test" {
+  application_object_id = azuread_application.test.object_id
+  client_application_id = azuread_application.client.application_id
+}
+`, r.template(data))
+}
+
+func (r ApplicationKnownClientResource) preAuthorized(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_known_client" "test" {
+  application_object_id    = azuread_application.test.object_id
+  client_application_id    = azuread_application.client.application_id
+  pre_authorized_scope_ids = [azuread_application_oauth2_permission_scope.test.scope_id]
+}
+`, r.template(data))
+}
+
+func (r ApplicationKnownClientResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_known_client" "import" {
+  application_object_id = azuread_application_known_client.test.application_object_id
+  client_application_id = azuread_application_known_client.test.client_application_id
+}
+`, r.basic(data))
+}
@@ -3,6 +3,7 @@ package applications
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
@@ -18,6 +19,13 @@ func applicationOAuth2PermissionResource() *schema.Resource {
 		ReadContext:   applicationOAuth2PermissionScopeResourceRead,
 		DeleteContext: applicationOAuth2PermissionScopeResourceDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		DeprecationMessage: "[NOTE] The `azuread_application_oauth2_permission` resource has been renamed to `azuread_application_oauth2_permission` and will be removed in version 2.0 of the provider",
 
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
@@ -62,45 +62,54 @@ func applicationOAuth2PermissionResourceCreateUpdateMsGraph(ctx context.Context,
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	app, status, err := client.Get(ctx, id.ObjectId)
-	if err != nil {
-		if status == http.StatusNotFound {
-			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+	// The value of a scope cannot be changed whilst it is enabled, so disable it first. The subsequent update
+	// re-enables it when it should remain enabled.
+	if !d.IsNewResource() && d.HasChange("value") {
+		log.Printf("[DEBUG] Disabling OAuth2 Permission %q for Application %q prior to changing its value", id.ScopeId, id.ObjectId)
+		if status, err := helpers.ApplicationDisableOAuth2PermissionScopes(ctx, client, id.ObjectId, []string{id.ScopeId}); err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+			}
+			return tf.ErrorDiagF(err, "Disabling OAuth2 Permission with ID %q", id.ScopeId)
 		}
-		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
 	}
 
-	if d.IsNewResource() {
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
 		if app.Api == nil {
 			app.Api = &msgraph.ApplicationApi{}
 		}
-		if err := app.Api.AppendOAuth2PermissionScope(scope); err != nil {
-			if _, ok := err.(*grapherrors.AlreadyExistsError); ok {
-				return tf.ImportAsExistsDiag("azuread_application_oauth2_permission_scope", id.String())
+
+		if d.IsNewResource() {
+			if err := app.Api.AppendOAuth2PermissionScope(scope); err != nil {
+				return nil, err
+			}
+		} else {
+			existing, err := helpers.OAuth2PermissionFindById(app, id.ScopeId)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving OAuth2 Permission with ID %q for Application %q: %+v", id.ScopeId, id.ObjectId, err)
+			}
+			if existing == nil {
+				return nil, fmt.Errorf("OAuth2 Permission with ID %q was not found for Application %q", id.ScopeId, id.ObjectId)
+			}
+			if err := app.Api.UpdateOAuth2PermissionScope(scope); err != nil {
+				return nil, fmt.Errorf("updating OAuth2 Permission with ID %q: %+v", *scope.ID, err)
 			}
-			return tf.ErrorDiagF(err, "Failed to add OAuth2 Permission")
-		}
-	} else {
-		existing, _ := helpers.OAuth2PermissionFindById(app, id.ScopeId)
-		if err != nil {
-			return tf.ErrorDiagPathF(nil, "scope_id", "retrieving OAuth2 Permission with ID %q for Application %q: %+v", id.ScopeId, id.ObjectId, err)
-		}
-		if existing == nil {
-			return tf.ErrorDiagPathF(nil, "scope_id", "OAuth2 Permission with ID %q was not found for Application %q", id.ScopeId, id.ObjectId)
 		}
 
-		if app.Api.UpdateOAuth2PermissionScope(scope) != nil {
-			return tf.ErrorDiagF(err, "Updating OAuth2 Permission with ID %q", *scope.ID)
+		return &msgraph.Application{
+			ID: app.ID,
+			Api: &msgraph.ApplicationApi{
+				OAuth2PermissionScopes: app.Api.OAuth2PermissionScopes,
+			},
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		if _, ok := err.(*grapherrors.AlreadyExistsError); ok {
+			return tf.ImportAsExistsDiag("azuread_application_oauth2_permission_scope", id.String())
 		}
-	}
-
-	properties := msgraph.Application{
-		ID: app.ID,
-		Api: &msgraph.ApplicationApi{
-			OAuth2PermissionScopes: app.Api.OAuth2PermissionScopes,
-		},
-	}
-	if _, err := client.Update(ctx, properties); err != nil {
 		return tf.ErrorDiagF(err, "Updating Application with ID %q", id.ObjectId)
 	}
 
@@ -164,49 +173,66 @@ func applicationOAuth2PermissionResourceDeleteMsGraph(ctx context.Context, d *sc
 	tf.LockByName(applicationResourceName, id.ObjectId)
 	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
 
-	app, status, err := client.Get(ctx, id.ObjectId)
+	scopeFound := true
+
+	log.Printf("[DEBUG] Disabling OAuth2 Permission %q for Application %q prior to removal", id.ScopeId, id.ObjectId)
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		scope, err := helpers.OAuth2PermissionFindById(app, id.ScopeId)
+		if err != nil {
+			return nil, fmt.Errorf("identifying OAuth2 Permission: %+v", err)
+		}
+		if scope == nil {
+			scopeFound = false
+			return nil, nil
+		}
+
+		scope.IsEnabled = utils.Bool(false)
+		if err := app.Api.UpdateOAuth2PermissionScope(*scope); err != nil {
+			return nil, err
+		}
+
+		return &msgraph.Application{
+			ID: app.ID,
+			Api: &msgraph.ApplicationApi{
+				OAuth2PermissionScopes: app.Api.OAuth2PermissionScopes,
+			},
+		}, nil
+	})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "application_object_id", "Retrieving Application with ID %q", id.ObjectId)
 		}
-		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with ID %q", id.ObjectId)
-	}
-
-	scope, err := helpers.OAuth2PermissionFindById(app, id.ScopeId)
-	if err != nil {
-		return tf.ErrorDiagF(err, "Identifying OAuth2 Permission")
+		return tf.ErrorDiagF(err, "Disabling OAuth2 Permission with ID %q", id.ScopeId)
 	}
 
-	if scope == nil {
+	if !scopeFound {
 		log.Printf("[DEBUG] OAuth2 Permission %q (ID %q) was not found - removing from state!", id.ScopeId, id.ObjectId)
 		d.SetId("")
 		return nil
 	}
 
-	log.Printf("[DEBUG] Disabling OAuth2 Permission %q for Application %q prior to removal", id.ScopeId, id.ObjectId)
-	scope.IsEnabled = utils.Bool(false)
-	if app.Api.UpdateOAuth2PermissionScope(*scope) != nil {
-		return tf.ErrorDiagF(err, "Disabling OAuth2 Permission with ID %q", *scope.ID)
-	}
-
-	properties := msgraph.Application{
-		ID: app.ID,
-		Api: &msgraph.ApplicationApi{
-			OAuth2PermissionScopes: app.Api.OAuth2PermissionScopes,
-		},
-	}
-	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Disabling OAuth2 Permission with ID %q", *scope.ID)
-	}
-
 	log.Printf("[DEBUG] Removing OAuth2 Permission %q for Application %q", id.ScopeId, id.ObjectId)
-	if app.Api.RemoveOAuth2PermissionScope(*scope) != nil {
-		return tf.ErrorDiagF(err, "Removing OAuth2 Permission with ID %q", *scope.ID)
-	}
+	if _, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		scope, err := helpers.OAuth2PermissionFindById(app, id.ScopeId)
+		if err != nil {
+			return nil, fmt.Errorf("identifying OAuth2 Permission: %+v", err)
+		}
+		if scope == nil {
+			return nil, nil
+		}
+
+		if err := app.Api.RemoveOAuth2PermissionScope(*scope); err != nil {
+			return nil, err
+		}
 
-	properties.Api.OAuth2PermissionScopes = app.Api.OAuth2PermissionScopes
-	if _, err := client.Update(ctx, properties); err != nil {
-		return tf.ErrorDiagF(err, "Disabling OAuth2 Permission with ID %q", *scope.ID)
+		return &msgraph.Application{
+			ID: app.ID,
+			Api: &msgraph.ApplicationApi{
+				OAuth2PermissionScopes: app.Api.OAuth2PermissionScopes,
+			},
+		}, nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Removing OAuth2 Permission with ID %q", id.ScopeId)
 	}
 
 	return nil
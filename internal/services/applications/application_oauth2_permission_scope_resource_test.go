@@ -102,6 +102,8 @@ func TestAccApplicationOAuth2PermissionScope_update(t *testing.T) {
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("scope_id").Exists(),
+				check.That(data.ResourceName).Key("value").HasValue("administrate"),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
 			),
 		},
 		data.ImportStep(),
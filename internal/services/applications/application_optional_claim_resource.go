@@ -0,0 +1,138 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	applicationsValidate "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/validate"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const applicationOptionalClaimResourceName = "azuread_application_optional_claim"
+
+const (
+	optionalClaimTokenTypeAccessToken = "access_token"
+	optionalClaimTokenTypeIdToken     = "id_token"
+	optionalClaimTokenTypeSaml2Token  = "saml2_token"
+)
+
+func applicationOptionalClaimResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationOptionalClaimResourceCreateUpdate,
+		UpdateContext: applicationOptionalClaimResourceCreateUpdate,
+		ReadContext:   applicationOptionalClaimResourceRead,
+		DeleteContext: applicationOptionalClaimResourceDelete,
+
+		CustomizeDiff: applicationOptionalClaimResourceCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.OptionalClaimID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"token_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					optionalClaimTokenTypeAccessToken,
+					optionalClaimTokenTypeIdToken,
+					optionalClaimTokenTypeSaml2Token,
+				}, false),
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{"user"},
+					false,
+				),
+			},
+
+			"essential": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"additional_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func applicationOptionalClaimResourceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// Unsupported additional properties are accepted by the API, but result in incorrectly issued tokens
+	name := diff.Get("name").(string)
+	if name == "" {
+		return nil
+	}
+	properties := make([]string, 0)
+	for _, p := range diff.Get("additional_properties").([]interface{}) {
+		if property, ok := p.(string); ok && property != "" {
+			properties = append(properties, property)
+		}
+	}
+	if err := applicationsValidate.OptionalClaimAdditionalProperties(name, properties); err != nil {
+		return fmt.Errorf("validating `additional_properties`: %+v", err)
+	}
+	return nil
+}
+
+func applicationOptionalClaimResourceCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationOptionalClaimResourceName); diags != nil {
+		return diags
+	}
+	return applicationOptionalClaimResourceCreateUpdateMsGraph(ctx, d, meta)
+}
+
+func applicationOptionalClaimResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationOptionalClaimResourceName); diags != nil {
+		return diags
+	}
+	return applicationOptionalClaimResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationOptionalClaimResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationOptionalClaimResourceName); diags != nil {
+		return diags
+	}
+	return applicationOptionalClaimResourceDeleteMsGraph(ctx, d, meta)
+}
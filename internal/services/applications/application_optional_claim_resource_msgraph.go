@@ -0,0 +1,221 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func applicationOptionalClaimResourceCreateUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id := parse.NewOptionalClaimID(d.Get("application_object_id").(string), d.Get("token_type").(string), d.Get("name").(string))
+
+	claim := msgraph.OptionalClaim{
+		AdditionalProperties: tf.ExpandStringSlicePtr(d.Get("additional_properties").([]interface{})),
+		Essential:            utils.Bool(d.Get("essential").(bool)),
+		Name:                 utils.String(id.Name),
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		claim.Source = utils.String(v.(string))
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	alreadyExists := false
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		optionalClaims := applicationOptionalClaimsForTokenType(app.OptionalClaims, id.TokenType)
+		i := applicationOptionalClaimIndex(optionalClaims, id.Name)
+
+		if d.IsNewResource() {
+			if i >= 0 {
+				alreadyExists = true
+				return nil, nil
+			}
+			optionalClaims = append(optionalClaims, claim)
+		} else {
+			if i < 0 {
+				return nil, fmt.Errorf("optional claim %q was not found for token type %q", id.Name, id.TokenType)
+			}
+			optionalClaims[i] = claim
+		}
+
+		return &msgraph.Application{
+			ID:             app.ID,
+			OptionalClaims: applicationOptionalClaimsWithTokenType(app.OptionalClaims, id.TokenType, optionalClaims),
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		return tf.ErrorDiagF(err, "Setting optional claim %q for application with object ID %q", id.Name, id.ObjectId)
+	}
+
+	if alreadyExists {
+		return tf.ImportAsExistsDiag(applicationOptionalClaimResourceName, id.String())
+	}
+
+	d.SetId(id.String())
+
+	return applicationOptionalClaimResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationOptionalClaimResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id, err := parse.OptionalClaimID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing optional claim ID %q", d.Id())
+	}
+
+	app, status, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with object ID %q was not found - removing from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+	}
+
+	optionalClaims := applicationOptionalClaimsForTokenType(app.OptionalClaims, id.TokenType)
+	i := applicationOptionalClaimIndex(optionalClaims, id.Name)
+	if i < 0 {
+		log.Printf("[DEBUG] Optional claim %q for token type %q was not found for application with object ID %q - removing from state!", id.Name, id.TokenType, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+	claim := optionalClaims[i]
+
+	source := ""
+	if claim.Source != nil {
+		source = *claim.Source
+	}
+
+	tf.Set(d, "additional_properties", tf.FlattenStringSlicePtr(claim.AdditionalProperties))
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "essential", claim.Essential != nil && *claim.Essential)
+	tf.Set(d, "name", id.Name)
+	tf.Set(d, "source", source)
+	tf.Set(d, "token_type", id.TokenType)
+
+	return nil
+}
+
+func applicationOptionalClaimResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	id, err := parse.OptionalClaimID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing optional claim ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ObjectId)
+	defer tf.UnlockByName(applicationResourceName, id.ObjectId)
+
+	status, err := helpers.ApplicationModify(ctx, client, id.ObjectId, func(app *msgraph.Application) (*msgraph.Application, error) {
+		optionalClaims := applicationOptionalClaimsForTokenType(app.OptionalClaims, id.TokenType)
+		i := applicationOptionalClaimIndex(optionalClaims, id.Name)
+		if i < 0 {
+			log.Printf("[DEBUG] Optional claim %q for token type %q was not found for application with object ID %q", id.Name, id.TokenType, id.ObjectId)
+			return nil, nil
+		}
+
+		remaining := make([]msgraph.OptionalClaim, 0)
+		remaining = append(remaining, optionalClaims[:i]...)
+		remaining = append(remaining, optionalClaims[i+1:]...)
+
+		return &msgraph.Application{
+			ID:             app.ID,
+			OptionalClaims: applicationOptionalClaimsWithTokenType(app.OptionalClaims, id.TokenType, remaining),
+		}, nil
+	})
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Application was not found"), "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
+		}
+		return tf.ErrorDiagF(err, "Removing optional claim %q from application with object ID %q", id.Name, id.ObjectId)
+	}
+
+	return nil
+}
+
+// applicationOptionalClaimsForTokenType returns a copy of the optional claims configured for the specified token type.
+func applicationOptionalClaimsForTokenType(in *msgraph.OptionalClaims, tokenType string) []msgraph.OptionalClaim {
+	result := make([]msgraph.OptionalClaim, 0)
+	if in == nil {
+		return result
+	}
+
+	var claims *[]msgraph.OptionalClaim
+	switch tokenType {
+	case optionalClaimTokenTypeAccessToken:
+		claims = in.AccessToken
+	case optionalClaimTokenTypeIdToken:
+		claims = in.IdToken
+	case optionalClaimTokenTypeSaml2Token:
+		claims = in.Saml2Token
+	}
+
+	if claims != nil {
+		result = append(result, *claims...)
+	}
+	return result
+}
+
+// applicationOptionalClaimsWithTokenType returns the complete optional claims for an application with the claims for
+// the specified token type replaced, since the API replaces all optional claims on update.
+func applicationOptionalClaimsWithTokenType(in *msgraph.OptionalClaims, tokenType string, claims []msgraph.OptionalClaim) *msgraph.OptionalClaims {
+	result := msgraph.OptionalClaims{
+		AccessToken: &[]msgraph.OptionalClaim{},
+		IdToken:     &[]msgraph.OptionalClaim{},
+		Saml2Token:  &[]msgraph.OptionalClaim{},
+	}
+	if in != nil {
+		if in.AccessToken != nil {
+			result.AccessToken = in.AccessToken
+		}
+		if in.IdToken != nil {
+			result.IdToken = in.IdToken
+		}
+		if in.Saml2Token != nil {
+			result.Saml2Token = in.Saml2Token
+		}
+	}
+
+	switch tokenType {
+	case optionalClaimTokenTypeAccessToken:
+		result.AccessToken = &claims
+	case optionalClaimTokenTypeIdToken:
+		result.IdToken = &claims
+	case optionalClaimTokenTypeSaml2Token:
+		result.Saml2Token = &claims
+	}
+
+	return &result
+}
+
+// applicationOptionalClaimIndex returns the position of the optional claim with the specified name, or -1 when no such
+// claim is present.
+func applicationOptionalClaimIndex(claims []msgraph.OptionalClaim, name string) int {
+	for i, claim := range claims {
+		if claim.Name != nil && *claim.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,171 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationOptionalClaimResource struct{}
+
+func TestAccApplicationOptionalClaim_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_optional_claim", "test")
+	r := ApplicationOptionalClaimResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("essential").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationOptionalClaim_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_optional_claim", "test")
+	r := ApplicationOptionalClaimResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("essential").HasValue("true"),
+				check.That(data.ResourceName).Key("additional_properties.#").HasValue("1"),
+				check.That("azuread_application_optional_claim.upn").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationOptionalClaim_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_optional_claim", "test")
+	r := ApplicationOptionalClaimResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r ApplicationOptionalClaimResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.OptionalClaimID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Optional Claim ID: %v", err)
+	}
+
+	app, status, err := clients.Applications.MsClient.Get(ctx, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Application with object ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	if app.OptionalClaims != nil {
+		var claims *[]msgraph.OptionalClaim
+		switch id.TokenType {
+		case "access_token":
+			claims = app.OptionalClaims.AccessToken
+		case "id_token":
+			claims = app.OptionalClaims.IdToken
+		case "saml2_token":
+			claims = app.OptionalClaims.Saml2Token
+		}
+		if claims != nil {
+			for _, claim := range *claims {
+				if claim.Name != nil && *claim.Name == id.Name {
+					return utils.Bool(true), nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Optional Claim %q for token type %q was not found for Application %q", id.Name, id.TokenType, id.ObjectId)
+}
+
+func (ApplicationOptionalClaimResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestAppOptionalClaim-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationOptionalClaimResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_optional_claim" "test" {
+  application_object_id = azuread_application.test.object_id
+  token_type            = "id_token"
+  name                  = "groups"
+}
+`, r.template(data))
+}
+
+func (r ApplicationOptionalClaimResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_optional_claim" "test" {
+  application_object_id = azuread_application.test.object_id
+  token_type            = "id_token"
+  name                  = "groups"
+  essential             = true
+  additional_properties = ["emit_as_roles"]
+}
+
+resource "azuread_application_optional_claim" "upn" {
+  application_object_id = azuread_application.test.object_id
+  token_type            = "access_token"
+  name                  = "upn"
+}
+`, r.template(data))
+}
+
+func (r ApplicationOptionalClaimResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_optional_claim" "import" {
+  application_object_id = azuread_application_optional_claim.test.application_object_id
+  token_type            = azuread_application_optional_claim.test.token_type
+  name                  = azuread_application_optional_claim.test.name
+}
+`, r.basic(data))
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
@@ -18,8 +22,23 @@ func applicationPasswordResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: applicationPasswordResourceCreate,
 		ReadContext:   applicationPasswordResourceRead,
+		UpdateContext: applicationPasswordResourceUpdate,
 		DeleteContext: applicationPasswordResourceDelete,
 
+		CustomizeDiff: applicationPasswordResourceCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImportThen(func(id string) error {
+			_, err := applicationPasswordImportID(id)
+			return err
+		}, applicationPasswordResourceImport),
+
 		Schema: map[string]*schema.Schema{
 			"application_object_id": {
 				Type:             schema.TypeString,
@@ -65,11 +84,20 @@ func applicationPasswordResource() *schema.Resource {
 			},
 
 			"start_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_date_relative"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"start_date_relative": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"start_date"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
 			"end_date": {
@@ -88,6 +116,32 @@ func applicationPasswordResource() *schema.Resource {
 				ConflictsWith:    []string{"end_date"},
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
+
+			"rotation_overlap": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"lifecycle_hints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiry_warning_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"hint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 
 		SchemaVersion: 1,
@@ -109,10 +163,75 @@ func applicationPasswordResourceCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func applicationPasswordResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if meta.(*clients.Client).EnableMsGraphBeta {
+		diags = applicationPasswordResourceReadMsGraph(ctx, d, meta)
+	} else {
+		diags = applicationPasswordResourceReadAadGraph(ctx, d, meta)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, meta.(*clients.Client).CredentialExpiryDiags(d, "Application password")...)
+}
+
+// applicationPasswordImportValueEnvVar can be set to the known value of a password when it is imported, since the value
+// of an existing password cannot be retrieved from the API
+const applicationPasswordImportValueEnvVar = "ARM_APPLICATION_PASSWORD_IMPORT_VALUE"
+
+// applicationPasswordImportID parses the ID of a password being imported, which may be specified in the legacy format
+// `{objectId}/{keyId}` as well as the current format `{objectId}/password/{keyId}`
+func applicationPasswordImportID(id string) (*parse.CredentialId, error) {
+	if strings.Count(id, "/") == 1 {
+		return parse.OldPasswordID(id)
+	}
+	return parse.PasswordID(id)
+}
+
+func applicationPasswordResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := applicationPasswordImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id.String())
+
+	value := os.Getenv(applicationPasswordImportValueEnvVar)
+	if value == "" {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	// Read the password so that the value can be checked against its hint, which comprises the first few characters
+	if diags := applicationPasswordResourceRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("retrieving password credential %q for application with object ID %q: %s", id.KeyId, id.ObjectId, diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("password credential %q was not found for application with object ID %q", id.KeyId, id.ObjectId)
+	}
+	if hint := d.Get("hint").(string); hint != "" && !strings.HasPrefix(value, hint) {
+		return nil, fmt.Errorf("the value specified in the %s environment variable does not match the hint %q for password credential %q", applicationPasswordImportValueEnvVar, hint, id.KeyId)
+	}
+
+	log.Printf("[DEBUG] Setting value for imported password credential %q from the %s environment variable", id.KeyId, applicationPasswordImportValueEnvVar)
+	tf.Set(d, "value", value)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func applicationPasswordResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only `rotation_overlap` can be updated in-place, which is used when the password is deleted
+	if meta.(*clients.Client).EnableMsGraphBeta {
+		if val, ok := d.GetOk("rotation_overlap"); ok && val.(int) > 0 {
+			return tf.ErrorDiagPathF(fmt.Errorf("`rotation_overlap` is not supported when using Microsoft Graph, since existing passwords cannot be modified. Please remove the `rotation_overlap` field from your configuration"), "rotation_overlap", "Updating application password")
+		}
+	}
+	return applicationPasswordResourceRead(ctx, d, meta)
+}
+
+func applicationPasswordResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	if meta.(*clients.Client).EnableMsGraphBeta {
-		return applicationPasswordResourceReadMsGraph(ctx, d, meta)
+		return applicationPasswordResourceCustomizeDiffMsGraph(ctx, diff, meta)
 	}
-	return applicationPasswordResourceReadAadGraph(ctx, d, meta)
+	return nil
 }
 
 func applicationPasswordResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -161,6 +161,22 @@ func applicationPasswordResourceDeleteAadGraph(ctx context.Context, d *schema.Re
 		return tf.ErrorDiagF(err, "Listing password credentials for application with object ID %q", id.ObjectId)
 	}
 
+	if overlap := d.Get("rotation_overlap").(int); overlap > 0 {
+		endDate := time.Now().UTC().Add(time.Duration(overlap) * 24 * time.Hour)
+		log.Printf("[DEBUG] Expiring password credential %q for application with object ID %q at %s instead of removing it", id.KeyId, id.ObjectId, endDate.Format(time.RFC3339))
+
+		newCreds, err := aadgraph.PasswordCredentialResultExpireByKeyId(existing, id.KeyId, endDate)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Expiring password credential %q for application with object ID %q", id.KeyId, id.ObjectId)
+		}
+
+		if _, err = client.UpdatePasswordCredentials(ctx, id.ObjectId, graphrbac.PasswordCredentialsUpdateParameters{Value: newCreds}); err != nil {
+			return tf.ErrorDiagF(err, "Expiring password credential %q for application with object ID %q", id.KeyId, id.ObjectId)
+		}
+
+		return nil
+	}
+
 	newCreds, err := aadgraph.PasswordCredentialResultRemoveByKeyId(existing, id.KeyId)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Removing password credential %q from application with object ID %q", id.KeyId, id.ObjectId)
@@ -15,21 +15,124 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
+	policies "github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
-func applicationPasswordResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint
-	client := meta.(*clients.Client).Applications.MsClient
-	objectId := d.Get("application_object_id").(string)
+// applicationPasswordDefaultLifetime is the validity period of passwords added using Microsoft Graph, for which an end
+// date cannot be specified
+const applicationPasswordDefaultLifetime = 2 * 365 * 24 * time.Hour
+
+// applicationPasswordResourceCustomizeDiffMsGraph checks that a new password would be permitted by the app management
+// policy in effect for the application, which otherwise rejects it with an unhelpful error when applying. The check
+// is best-effort, since reading policies requires additional permissions.
+func applicationPasswordResourceCustomizeDiffMsGraph(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// only `display_name` and the application can be configured for passwords with Microsoft Graph, so a new password
+	// is added when creating the resource or when either of these changes
+	if diff.Id() != "" && !diff.HasChange("application_object_id") && !diff.HasChange("display_name") && !diff.HasChange("description") {
+		return nil
+	}
 
-	if val, ok := d.GetOk("description"); ok && val.(string) != "" {
-		return tf.ErrorDiagPathF(fmt.Errorf("`description` is a read-only field when using Microsoft Graph. Please remove the `description` field from your configuration"), "description", "Creating application password")
+	objectId := diff.Get("application_object_id").(string)
+	if objectId == "" {
+		// the application is not yet known
+		return nil
 	}
 
-	if val, ok := d.GetOk("display_name"); ok && val.(string) != "" {
-		return tf.ErrorDiagPathF(fmt.Errorf("`display_name` is a read-only field when using Microsoft Graph. Please remove the `display_name` field from your configuration"), "display_name", "Creating application password")
+	maxLifetime, policyName, err := applicationPasswordMaxLifetime(ctx, meta, objectId)
+	if err != nil {
+		log.Printf("[WARN] Unable to determine app management policy restrictions for application with object ID %q, skipping validation: %v", objectId, err)
+		return nil
+	}
+	if maxLifetime == "" {
+		return nil
+	}
+
+	lifetime, err := utils.ApproximateISO8601Duration(maxLifetime)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse maximum password lifetime %q from app management policy %q, skipping validation: %v", maxLifetime, policyName, err)
+		return nil
 	}
 
+	if applicationPasswordDefaultLifetime > lifetime {
+		return fmt.Errorf("passwords added to application with object ID %q using Microsoft Graph are valid for 2 years, but the app management policy %q restricts passwords to a maximum lifetime of %s. The policy must be relaxed, or the application exempted from it, before a password can be added", objectId, policyName, maxLifetime)
+	}
+
+	return nil
+}
+
+// applicationPasswordMaxLifetime returns the maximum password lifetime enforced for the application with the specified
+// object ID, along with the name of the app management policy enforcing it. Policies assigned to the application take
+// precedence over the tenant default policy. An empty lifetime is returned when passwords are not restricted.
+func applicationPasswordMaxLifetime(ctx context.Context, meta interface{}, objectId string) (string, string, error) {
+	appClient := meta.(*clients.Client).Applications.MsClient
+	policyClient := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	app, status, err := helpers.ApplicationGet(ctx, appClient.BaseClient, objectId, []string{"id", "createdDateTime"})
+	if err != nil {
+		if status == http.StatusNotFound {
+			// reported when the password is created
+			return "", "", nil
+		}
+		return "", "", err
+	}
+
+	assigned, _, err := policyClient.ListForObject(ctx, policies.AppManagementPolicyObjectTypeApplication, objectId)
+	if err != nil {
+		return "", "", err
+	}
+	for _, policy := range *assigned {
+		if policy.IsEnabled == nil || !*policy.IsEnabled || policy.Restrictions == nil {
+			continue
+		}
+		if maxLifetime := appManagementPasswordLifetime(policy.Restrictions.PasswordCredentials, app.CreatedDateTime); maxLifetime != "" {
+			name := ""
+			if policy.DisplayName != nil {
+				name = *policy.DisplayName
+			}
+			return maxLifetime, name, nil
+		}
+	}
+
+	tenantPolicy, _, err := policyClient.GetDefault(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if tenantPolicy.IsEnabled == nil || !*tenantPolicy.IsEnabled || tenantPolicy.ApplicationRestrictions == nil {
+		return "", "", nil
+	}
+	name := "tenant default"
+	if tenantPolicy.DisplayName != nil {
+		name = *tenantPolicy.DisplayName
+	}
+	return appManagementPasswordLifetime(tenantPolicy.ApplicationRestrictions.PasswordCredentials, app.CreatedDateTime), name, nil
+}
+
+// appManagementPasswordLifetime returns the maximum lifetime from a `passwordLifetime` restriction which applies to an
+// application created at the specified time, or an empty string if there is none.
+func appManagementPasswordLifetime(restrictions *[]policies.CredentialConfiguration, createdDateTime *time.Time) string {
+	if restrictions == nil {
+		return ""
+	}
+	for _, r := range *restrictions {
+		if r.RestrictionType == nil || *r.RestrictionType != "passwordLifetime" || r.MaxLifetime == nil {
+			continue
+		}
+		if r.RestrictForAppsCreatedAfterDateTime != nil && createdDateTime != nil {
+			if after, err := time.Parse(time.RFC3339, *r.RestrictForAppsCreatedAfterDateTime); err == nil && createdDateTime.Before(after) {
+				continue
+			}
+		}
+		return *r.MaxLifetime
+	}
+	return ""
+}
+
+func applicationPasswordResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint
+	client := meta.(*clients.Client).Applications.MsClient
+	objectId := d.Get("application_object_id").(string)
+
 	if val, ok := d.GetOk("end_date"); ok && val.(string) != "" {
 		return tf.ErrorDiagPathF(fmt.Errorf("`end_date` is a read-only field when using Microsoft Graph. Please remove the `end_date` field from your configuration"), "end_date", "Creating application password")
 	}
@@ -46,6 +149,14 @@ func applicationPasswordResourceCreateMsGraph(ctx context.Context, d *schema.Res
 		return tf.ErrorDiagPathF(fmt.Errorf("`start_date` is a read-only field when using Microsoft Graph. Please remove the `start_date` field from your configuration"), "start_date", "Creating application password")
 	}
 
+	if val, ok := d.GetOk("start_date_relative"); ok && val.(string) != "" {
+		return tf.ErrorDiagPathF(fmt.Errorf("`start_date_relative` is a read-only field when using Microsoft Graph. Please remove the `start_date_relative` field from your configuration"), "start_date_relative", "Creating application password")
+	}
+
+	if val, ok := d.GetOk("rotation_overlap"); ok && val.(int) > 0 {
+		return tf.ErrorDiagPathF(fmt.Errorf("`rotation_overlap` is not supported when using Microsoft Graph, since existing passwords cannot be modified. Please remove the `rotation_overlap` field from your configuration"), "rotation_overlap", "Creating application password")
+	}
+
 	if val, ok := d.GetOk("value"); ok && val.(string) != "" {
 		return tf.ErrorDiagPathF(fmt.Errorf("`value` is a read-only field when using Microsoft Graph. Please remove the `value` field from your configuration"), "value", "Creating application password")
 	}
@@ -65,7 +176,12 @@ func applicationPasswordResourceCreateMsGraph(ctx context.Context, d *schema.Res
 	tf.LockByName(applicationResourceName, objectId)
 	defer tf.UnlockByName(applicationResourceName, objectId)
 
-	app, status, err := client.Get(ctx, objectId)
+	// The application may have been created moments earlier in the same apply, so wait for it to become visible
+	var app *msgraph.Application
+	status, err := helpers.WaitForParentReplication(ctx, objectId, func() (status int, err error) {
+		app, status, err = client.Get(ctx, objectId)
+		return
+	})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", objectId)
@@ -76,7 +192,11 @@ func applicationPasswordResourceCreateMsGraph(ctx context.Context, d *schema.Res
 		return tf.ErrorDiagF(errors.New("nil application or application with nil ID was returned"), "API error retrieving application with object ID %q", objectId)
 	}
 
-	newCredential, _, err := client.AddPassword(ctx, *app.ID, *credential)
+	var newCredential *msgraph.PasswordCredential
+	_, err = helpers.WaitForParentReplication(ctx, *app.ID, func() (status int, err error) {
+		newCredential, status, err = client.AddPassword(ctx, *app.ID, *credential)
+		return
+	})
 	if err != nil {
 		return tf.ErrorDiagF(err, "Adding password for application with object ID %q", *app.ID)
 	}
@@ -94,7 +214,18 @@ func applicationPasswordResourceCreateMsGraph(ctx context.Context, d *schema.Res
 	d.SetId(id.String())
 	d.Set("value", newCredential.SecretText)
 
-	return applicationPasswordResourceReadMsGraph(ctx, d, meta)
+	diags := applicationPasswordResourceReadMsGraph(ctx, d, meta)
+
+	// Client secrets are accepted for public clients, but cannot be used when authenticating as one
+	if app.IsFallbackPublicClient != nil && *app.IsFallbackPublicClient {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Password added to a public client",
+			Detail:   fmt.Sprintf("The application with object ID %q is configured as a public client (`fallback_public_client_enabled` is `true`). Public clients cannot securely store secrets, and tokens requested using this password may be rejected. Consider using a confidential client application instead.", *app.ID),
+		})
+	}
+
+	return diags
 }
 
 func applicationPasswordResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint
@@ -134,6 +265,7 @@ func applicationPasswordResourceReadMsGraph(ctx context.Context, d *schema.Resou
 	tf.Set(d, "application_object_id", id.ObjectId)
 	tf.Set(d, "description", credential.DisplayName)
 	tf.Set(d, "display_name", credential.DisplayName)
+	tf.Set(d, "hint", credential.Hint)
 	tf.Set(d, "key_id", id.KeyId)
 
 	startDate := ""
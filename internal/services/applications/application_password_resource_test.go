@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -39,6 +40,114 @@ func TestAccApplicationPassword_basic(t *testing.T) {
 	})
 }
 
+func TestAccApplicationPassword_displayName(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_password", "test")
+	r := ApplicationPasswordResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.displayName(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("hint").Exists(),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+		data.ImportStep("value"),
+	})
+}
+
+func TestAccApplicationPassword_lifecycleHints(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_password", "test")
+	r := ApplicationPasswordResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lifecycle_hints.#").HasValue("0"),
+			),
+		},
+		{
+			Config: r.lifecycleHints(data, 14),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lifecycle_hints.0.expiry_warning_days").HasValue("14"),
+			),
+		},
+		{
+			Config: r.lifecycleHints(data, 0),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lifecycle_hints.0.expiry_warning_days").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationPassword_importWithValue(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_password", "test")
+	r := ApplicationPasswordResource{}
+
+	// the secret must not leak into other tests, including when this one fails. t.Setenv cannot be used because
+	// acceptance tests run in parallel.
+	defer os.Unsetenv("ARM_APPLICATION_PASSWORD_IMPORT_VALUE")
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.displayName(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: func(s *terraform.State) (string, error) {
+				rs, ok := s.RootModule().Resources[data.ResourceName]
+				if !ok {
+					return "", fmt.Errorf("resource %q not found in state", data.ResourceName)
+				}
+
+				// The value is injected from the environment, and the legacy ID format is also accepted
+				os.Setenv("ARM_APPLICATION_PASSWORD_IMPORT_VALUE", rs.Primary.Attributes["value"])
+				return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application_object_id"], rs.Primary.Attributes["key_id"]), nil
+			},
+		},
+	})
+}
+
+func TestAccApplicationPassword_restrictedByAppManagementPolicy(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_password", "test")
+	r := ApplicationPasswordResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.appManagementPolicyTemplate(data),
+		},
+		{
+			Config:      r.appManagementPolicy(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("restricts passwords to a maximum lifetime of P90D"),
+		},
+	})
+}
+
 func TestAccApplicationPassword_updateDeprecated(t *testing.T) {
 	// TODO: remove this test in v2.0
 	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v != "" {
@@ -145,6 +254,28 @@ func TestAccApplicationPassword_relativeEndDateAadGraph(t *testing.T) {
 	})
 }
 
+func TestAccApplicationPassword_relativeDatesWithRotationOverlapAadGraph(t *testing.T) {
+	// TODO: remove this test in v2.0
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v != "" {
+		t.Skipf("Test skipped when using MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_password", "test")
+	r := ApplicationPasswordResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.relativeDatesWithRotationOverlapAadGraph(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("start_date").MatchesRegex(regexp.MustCompile("T00:00:00Z$")),
+				check.That(data.ResourceName).Key("end_date").MatchesRegex(regexp.MustCompile("T00:00:00Z$")),
+				check.That(data.ResourceName).Key("rotation_overlap").HasValue("7"),
+			),
+		},
+	})
+}
+
 func (r ApplicationPasswordResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	id, err := parse.PasswordID(state.ID)
 	if err != nil {
@@ -208,6 +339,64 @@ resource "azuread_application_password" "test" {
 `, r.template(data))
 }
 
+func (r ApplicationPasswordResource) displayName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application.test.object_id
+  display_name          = "acctest-%[2]d"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationPasswordResource) lifecycleHints(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application.test.object_id
+
+  lifecycle_hints {
+    expiry_warning_days = %[2]d
+  }
+}
+`, r.template(data), days)
+}
+
+func (r ApplicationPasswordResource) appManagementPolicyTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctestAppPassword-%[2]d"
+  description  = "Acceptance test app management policy"
+
+  restrictions {
+    password_credential {
+      restriction_type = "passwordLifetime"
+      max_lifetime     = "P90D"
+    }
+  }
+}
+
+resource "azuread_app_management_policy_assignment" "test" {
+  policy_id = azuread_app_management_policy.test.id
+  object_id = azuread_application.test.object_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationPasswordResource) appManagementPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application.test.object_id
+}
+`, r.appManagementPolicyTemplate(data))
+}
+
 func (r ApplicationPasswordResource) basicAadGraph(data acceptance.TestData, endDate string) string {
 	// TODO: remove this config in v2.0
 	return fmt.Sprintf(`
@@ -249,3 +438,18 @@ resource "azuread_application_password" "test" {
 }
 `, r.template(data), data.RandomPassword)
 }
+
+func (r ApplicationPasswordResource) relativeDatesWithRotationOverlapAadGraph(data acceptance.TestData) string {
+	// TODO: remove this config in v2.0
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_password" "test" {
+  application_object_id = azuread_application.test.id
+  value                 = "%[2]s"
+  start_date_relative   = "-24h"
+  end_date_relative     = "8760h"
+  rotation_overlap      = 7
+}
+`, r.template(data), data.RandomPassword)
+}
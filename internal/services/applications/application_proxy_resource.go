@@ -0,0 +1,149 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const applicationProxyResourceName = "azuread_application_proxy"
+
+func applicationProxyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationProxyResourceCreate,
+		ReadContext:   applicationProxyResourceRead,
+		UpdateContext: applicationProxyResourceUpdate,
+		DeleteContext: applicationProxyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"external_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.IsHTTPSURL,
+			},
+
+			"internal_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.IsHTTPOrHTTPSURL,
+			},
+
+			"application_server_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  client.ApplicationProxyServerTimeoutDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.ApplicationProxyServerTimeoutDefault,
+					client.ApplicationProxyServerTimeoutLong,
+				}, false),
+			},
+
+			"connector_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"http_only_cookie_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"persistent_cookie_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"pre_authentication_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  client.ApplicationProxyAuthenticationTypeAadPreAuthentication,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.ApplicationProxyAuthenticationTypeAadPreAuthentication,
+					client.ApplicationProxyAuthenticationTypePassthru,
+				}, false),
+			},
+
+			"secure_cookie_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"translate_host_header_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"translate_links_in_body_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"alternate_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationProxyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationProxyResourceName); diags != nil {
+		return diags
+	}
+	return applicationProxyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func applicationProxyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationProxyResourceName); diags != nil {
+		return diags
+	}
+	return applicationProxyResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationProxyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationProxyResourceName); diags != nil {
+		return diags
+	}
+	return applicationProxyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func applicationProxyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationProxyResourceName); diags != nil {
+		return diags
+	}
+	return applicationProxyResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,126 @@
+package applications
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func applicationProxyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Applications.ApplicationProxyClient
+	objectId := d.Get("application_object_id").(string)
+
+	if _, status, err := c.Get(ctx, objectId); err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", objectId)
+	}
+
+	if _, err := c.Update(ctx, objectId, expandApplicationProxy(d)); err != nil {
+		return tf.ErrorDiagF(err, "Configuring application proxy for application with object ID %q", objectId)
+	}
+
+	d.SetId(objectId)
+
+	if v, ok := d.GetOk("connector_group_id"); ok {
+		if _, err := c.AssignConnectorGroup(ctx, objectId, v.(string)); err != nil {
+			return tf.ErrorDiagPathF(err, "connector_group_id", "Assigning connector group %q to application with object ID %q", v.(string), objectId)
+		}
+	}
+
+	return applicationProxyResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationProxyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Applications.ApplicationProxyClient
+	objectId := d.Id()
+
+	if _, err := c.Update(ctx, objectId, expandApplicationProxy(d)); err != nil {
+		return tf.ErrorDiagF(err, "Updating application proxy for application with object ID %q", objectId)
+	}
+
+	if d.HasChange("connector_group_id") {
+		if v, ok := d.GetOk("connector_group_id"); ok {
+			if _, err := c.AssignConnectorGroup(ctx, objectId, v.(string)); err != nil {
+				return tf.ErrorDiagPathF(err, "connector_group_id", "Assigning connector group %q to application with object ID %q", v.(string), objectId)
+			}
+		}
+	}
+
+	return applicationProxyResourceReadMsGraph(ctx, d, meta)
+}
+
+func applicationProxyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Applications.ApplicationProxyClient
+	objectId := d.Id()
+
+	settings, status, err := c.Get(ctx, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Application with object ID %q was not found - removing from state", objectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving application proxy for application with object ID %q", objectId)
+	}
+	if settings == nil || settings.ExternalUrl == nil {
+		log.Printf("[DEBUG] Application proxy is not configured for application with object ID %q - removing from state", objectId)
+		d.SetId("")
+		return nil
+	}
+
+	connectorGroupId := ""
+	connectorGroup, status, err := c.GetConnectorGroup(ctx, objectId)
+	if err != nil {
+		if status != http.StatusNotFound {
+			return tf.ErrorDiagF(err, "Retrieving connector group for application with object ID %q", objectId)
+		}
+	} else if connectorGroup.ID != nil {
+		connectorGroupId = *connectorGroup.ID
+	}
+
+	tf.Set(d, "alternate_url", settings.AlternateUrl)
+	tf.Set(d, "application_object_id", objectId)
+	tf.Set(d, "application_server_timeout", settings.ApplicationServerTimeout)
+	tf.Set(d, "connector_group_id", connectorGroupId)
+	tf.Set(d, "external_url", settings.ExternalUrl)
+	tf.Set(d, "http_only_cookie_enabled", settings.IsHttpOnlyCookieEnabled != nil && *settings.IsHttpOnlyCookieEnabled)
+	tf.Set(d, "internal_url", settings.InternalUrl)
+	tf.Set(d, "persistent_cookie_enabled", settings.IsPersistentCookieEnabled != nil && *settings.IsPersistentCookieEnabled)
+	tf.Set(d, "pre_authentication_type", settings.ExternalAuthenticationType)
+	tf.Set(d, "secure_cookie_enabled", settings.IsSecureCookieEnabled != nil && *settings.IsSecureCookieEnabled)
+	tf.Set(d, "translate_host_header_enabled", settings.IsTranslateHostHeaderEnabled != nil && *settings.IsTranslateHostHeaderEnabled)
+	tf.Set(d, "translate_links_in_body_enabled", settings.IsTranslateLinksInBodyEnabled != nil && *settings.IsTranslateLinksInBodyEnabled)
+
+	return nil
+}
+
+func applicationProxyResourceDeleteMsGraph(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// On-premises publishing cannot be disabled for an application once configured, so the settings are left in place
+	// and the application must be deleted in order to stop publishing it
+	log.Printf("[DEBUG] Application proxy settings for application with object ID %q will remain until the application is deleted", d.Id())
+	return nil
+}
+
+func expandApplicationProxy(d *schema.ResourceData) client.OnPremisesPublishing {
+	return client.OnPremisesPublishing{
+		ApplicationServerTimeout:      utils.String(d.Get("application_server_timeout").(string)),
+		ExternalAuthenticationType:    utils.String(d.Get("pre_authentication_type").(string)),
+		ExternalUrl:                   utils.String(d.Get("external_url").(string)),
+		InternalUrl:                   utils.String(d.Get("internal_url").(string)),
+		IsHttpOnlyCookieEnabled:       utils.Bool(d.Get("http_only_cookie_enabled").(bool)),
+		IsPersistentCookieEnabled:     utils.Bool(d.Get("persistent_cookie_enabled").(bool)),
+		IsSecureCookieEnabled:         utils.Bool(d.Get("secure_cookie_enabled").(bool)),
+		IsTranslateHostHeaderEnabled:  utils.Bool(d.Get("translate_host_header_enabled").(bool)),
+		IsTranslateLinksInBodyEnabled: utils.Bool(d.Get("translate_links_in_body_enabled").(bool)),
+	}
+}
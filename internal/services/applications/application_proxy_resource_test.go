@@ -0,0 +1,137 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationProxyResource struct{}
+
+func TestAccApplicationProxy_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_proxy", "test")
+	r := ApplicationProxyResource{}
+	objectId := applicationProxyTestObjectId(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(objectId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_authentication_type").HasValue("aadPreAuthentication"),
+				check.That(data.ResourceName).Key("translate_host_header_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationProxy_update(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application_proxy", "test")
+	r := ApplicationProxyResource{}
+	objectId := applicationProxyTestObjectId(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(objectId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(objectId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pre_authentication_type").HasValue("passthru"),
+				check.That(data.ResourceName).Key("application_server_timeout").HasValue("Long"),
+				check.That(data.ResourceName).Key("secure_cookie_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(objectId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+// Application Proxy can only be configured for applications instantiated from the on-premises application template,
+// so the object ID of such an application is supplied via the environment
+func applicationProxyTestObjectId(t *testing.T) string {
+	objectId := os.Getenv("ARM_TEST_APPLICATION_PROXY_OBJECT_ID")
+	if objectId == "" {
+		t.Skip("Skipping as ARM_TEST_APPLICATION_PROXY_OBJECT_ID is not specified")
+	}
+	return objectId
+}
+
+func (r ApplicationProxyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	settings, status, err := clients.Applications.ApplicationProxyClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Application with object ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(settings != nil && settings.ExternalUrl != nil), nil
+}
+
+func (ApplicationProxyResource) template() string {
+	return `
+data "azuread_tenant" "test" {}
+`
+}
+
+func (r ApplicationProxyResource) basic(objectId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_proxy" "test" {
+  application_object_id = "%[2]s"
+  external_url          = "https://acctest-${split(".", data.azuread_tenant.test.initial_domain)[0]}.msappproxy.net/"
+  internal_url          = "http://acctest.internal/"
+}
+`, r.template(), objectId)
+}
+
+func (r ApplicationProxyResource) complete(objectId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_proxy" "test" {
+  application_object_id           = "%[2]s"
+  external_url                    = "https://acctest-${split(".", data.azuread_tenant.test.initial_domain)[0]}.msappproxy.net/"
+  internal_url                    = "https://acctest.internal/app/"
+  application_server_timeout      = "Long"
+  http_only_cookie_enabled        = true
+  persistent_cookie_enabled       = true
+  pre_authentication_type         = "passthru"
+  secure_cookie_enabled           = true
+  translate_host_header_enabled   = false
+  translate_links_in_body_enabled = true
+}
+`, r.template(), objectId)
+}
@@ -3,6 +3,9 @@ package applications
 import (
 	"context"
 	"fmt"
+	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-uuid"
@@ -14,11 +17,17 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	applicationsValidate "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/validate"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
 const applicationResourceName = "azuread_application"
 
+const (
+	requiredResourceAccessModeAuthoritative = "authoritative"
+	requiredResourceAccessModeMerge         = "merge"
+)
+
 func applicationResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: applicationResourceCreate,
@@ -26,6 +35,8 @@ func applicationResource() *schema.Resource {
 		UpdateContext: applicationResourceUpdate,
 		DeleteContext: applicationResourceDelete,
 
+		CustomizeDiff: applicationResourceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
@@ -239,9 +250,17 @@ func applicationResource() *schema.Resource {
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: validate.IsAppURI,
+					DiffSuppressFunc: tf.SuppressURIDiff,
 				},
 			},
 
+			"set_default_identifier_uri": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"identifier_uris"},
+			},
+
 			// TODO: v2.0 remove this
 			"logout_url": {
 				Type:             schema.TypeString,
@@ -349,6 +368,18 @@ func applicationResource() *schema.Resource {
 				},
 			},
 
+			"ignore_unmanaged_owners": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"retain_caller_as_owner": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			// TODO: v2.0 remove this
 			"public_client": {
 				Type:          schema.TypeBool,
@@ -363,14 +394,26 @@ func applicationResource() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Computed:      true,
+				MaxItems:      applicationsValidate.RedirectURIsMaxCount,
 				ConflictsWith: []string{"web.0.redirect_uris"},
+				Set:           tf.HashURI,
 				Deprecated:    "[NOTE] This attribute will be replaced by a new attribute `redirect_uris` in the `web` block in version 2.0 of the AzureAD provider",
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					ValidateDiagFunc: validate.NoEmptyStrings,
+					ValidateDiagFunc: applicationsValidate.WebRedirectURI,
 				},
 			},
 
+			"required_resource_access_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  requiredResourceAccessModeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{
+					requiredResourceAccessModeAuthoritative,
+					requiredResourceAccessModeMerge,
+				}, false),
+			},
+
 			"required_resource_access": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -455,10 +498,12 @@ func applicationResource() *schema.Resource {
 						"redirect_uris": {
 							Type:          schema.TypeSet,
 							Optional:      true,
+							MaxItems:      applicationsValidate.RedirectURIsMaxCount,
 							ConflictsWith: []string{"reply_urls"},
+							Set:           tf.HashURI,
 							Elem: &schema.Schema{
 								Type:             schema.TypeString,
-								ValidateDiagFunc: validate.NoEmptyStrings,
+								ValidateDiagFunc: applicationsValidate.WebRedirectURI,
 							},
 						},
 
@@ -501,18 +546,294 @@ func applicationResource() *schema.Resource {
 	}
 }
 
+func applicationResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Warnings cannot be returned when planning, so these are logged here and returned as diagnostics when applying
+	for _, warning := range applicationPublicClientWarnings(diff.Get) {
+		log.Printf("[WARN] %s", warning)
+	}
+
+	if err := tf.RetainCallerAsOwnerDiff(diff, meta.(*clients.Client).ObjectID); err != nil {
+		return err
+	}
+
+	// Unsupported additional properties are accepted by the API, but result in incorrectly issued tokens
+	for _, tokenType := range []string{"access_token", "id_token"} {
+		claims, ok := diff.Get(fmt.Sprintf("optional_claims.0.%s", tokenType)).([]interface{})
+		if !ok {
+			continue
+		}
+		for i, raw := range claims {
+			claim, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := claim["name"].(string)
+			if name == "" {
+				continue
+			}
+			properties := make([]string, 0)
+			if v, ok := claim["additional_properties"].([]interface{}); ok {
+				for _, p := range v {
+					if property, ok := p.(string); ok && property != "" {
+						properties = append(properties, property)
+					}
+				}
+			}
+			if err := applicationsValidate.OptionalClaimAdditionalProperties(name, properties); err != nil {
+				return fmt.Errorf("validating `optional_claims.0.%s.%d`: %+v", tokenType, i, err)
+			}
+		}
+	}
+
+	// Wildcard redirect URIs are only accepted for domains which have been verified in the tenant
+	for _, k := range []string{"web.0.redirect_uris", "reply_urls"} { // TODO: v2.0 remove `reply_urls`
+		if !diff.NewValueKnown(k) {
+			continue
+		}
+		v, ok := diff.Get(k).(*schema.Set)
+		if !ok || v == nil {
+			continue
+		}
+
+		var verifiedDomains []string
+		for _, raw := range v.List() {
+			uri, _ := raw.(string)
+			domain, ok := applicationsValidate.RedirectURIWildcardDomain(uri)
+			if !ok {
+				continue
+			}
+
+			if verifiedDomains == nil {
+				var err error
+				if verifiedDomains, err = applicationVerifiedDomains(ctx, meta.(*clients.Client)); err != nil {
+					// Listing domains requires additional permissions, so this validation is best effort
+					log.Printf("[WARN] Unable to validate wildcard redirect URIs, could not list domains: %v", err)
+					return nil
+				}
+			}
+
+			if !applicationDomainIsVerified(domain, verifiedDomains) {
+				return fmt.Errorf("validating `%s`: the wildcard redirect URI %q must be for a verified domain in the tenant", k, uri)
+			}
+		}
+	}
+
+	return nil
+}
+
+// applicationVerifiedDomains returns the names of verified domains in the tenant
+func applicationVerifiedDomains(ctx context.Context, client *clients.Client) ([]string, error) {
+	verifiedDomains := make([]string, 0)
+
+	if client.EnableMsGraphBeta {
+		domains, ok := client.Domains.Cache.Get()
+		if !ok {
+			var err error
+			if domains, _, err = client.Domains.MsClient.List(ctx); err != nil {
+				return nil, err
+			}
+			client.Domains.Cache.Set(domains)
+		}
+		if domains != nil {
+			for _, domain := range *domains {
+				if domain.ID != nil && domain.IsVerified != nil && *domain.IsVerified {
+					verifiedDomains = append(verifiedDomains, *domain.ID)
+				}
+			}
+		}
+	} else {
+		result, err := client.Domains.AadClient.List(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		if result.Value != nil {
+			for _, domain := range *result.Value {
+				if domain.Name != nil && domain.IsVerified != nil && *domain.IsVerified {
+					verifiedDomains = append(verifiedDomains, *domain.Name)
+				}
+			}
+		}
+	}
+
+	return verifiedDomains, nil
+}
+
+// applicationDomainIsVerified returns whether the provided domain is, or is a subdomain of, one of the verified domains
+func applicationDomainIsVerified(domain string, verifiedDomains []string) bool {
+	for _, verified := range verifiedDomains {
+		if strings.EqualFold(domain, verified) || strings.HasSuffix(strings.ToLower(domain), "."+strings.ToLower(verified)) {
+			return true
+		}
+	}
+	return false
+}
+
+// applicationManagedOwners returns the owners which may be removed from an application when reconciling its owners, or
+// nil when any owner not present in the configuration should be removed. When `ignore_unmanaged_owners` is set, only
+// owners previously recorded in state are considered to be managed by Terraform.
+func applicationManagedOwners(d *schema.ResourceData) *[]string {
+	if !d.Get("ignore_unmanaged_owners").(bool) {
+		return nil
+	}
+	old, _ := d.GetChange("owners")
+	return tf.ExpandStringSlicePtr(old.(*schema.Set).List())
+}
+
+// applicationRetainCallerAsOwner returns the configured `retain_caller_as_owner`, which is not known when importing
+// an application, in which case the default is assumed.
+func applicationRetainCallerAsOwner(d *schema.ResourceData) bool {
+	if v, ok := d.GetOkExists("retain_caller_as_owner"); ok { //nolint:SA1019
+		return v.(bool)
+	}
+	return true
+}
+
+// applicationTrackedOwners returns the owners which should be recorded in state. When `ignore_unmanaged_owners` is set,
+// owners added outside of Terraform are omitted so that they do not show up as a diff.
+func applicationTrackedOwners(d *schema.ResourceData, owners []string) []string {
+	if !d.Get("ignore_unmanaged_owners").(bool) {
+		return owners
+	}
+	return utils.Intersection(owners, *tf.ExpandStringSlicePtr(d.Get("owners").(*schema.Set).List()))
+}
+
+// applicationRequiredResourceAccessMode returns the configured `required_resource_access_mode`, which is not known when
+// importing an application.
+func applicationRequiredResourceAccessMode(d *schema.ResourceData) string {
+	if v := d.Get("required_resource_access_mode").(string); v != "" {
+		return v
+	}
+	return requiredResourceAccessModeAuthoritative
+}
+
+type applicationResourceAccessKey struct {
+	resourceAppId string
+	id            string
+	accessType    string
+}
+
+// applicationRequiredResourceAccessKeys returns the set of individual permissions present in the provided
+// `required_resource_access` values.
+func applicationRequiredResourceAccessKeys(in []interface{}) map[applicationResourceAccessKey]bool {
+	result := make(map[applicationResourceAccessKey]bool)
+	for _, raw := range in {
+		requiredResourceAccess := raw.(map[string]interface{})
+		resourceAppId, _ := requiredResourceAccess["resource_app_id"].(string)
+		accesses, _ := requiredResourceAccess["resource_access"].([]interface{})
+		for _, accessRaw := range accesses {
+			access := accessRaw.(map[string]interface{})
+			id, _ := access["id"].(string)
+			accessType, _ := access["type"].(string)
+			result[applicationResourceAccessKey{resourceAppId, id, accessType}] = true
+		}
+	}
+	return result
+}
+
+// applicationFilterRequiredResourceAccess returns the `required_resource_access` values containing only the permissions
+// for which include returns true. Resources left without any permissions are omitted.
+func applicationFilterRequiredResourceAccess(in []map[string]interface{}, include func(applicationResourceAccessKey) bool) []interface{} {
+	result := make([]interface{}, 0)
+	for _, requiredResourceAccess := range in {
+		resourceAppId, _ := requiredResourceAccess["resource_app_id"].(string)
+		accesses, _ := requiredResourceAccess["resource_access"].([]interface{})
+		filtered := make([]interface{}, 0)
+		for _, accessRaw := range accesses {
+			access := accessRaw.(map[string]interface{})
+			id, _ := access["id"].(string)
+			accessType, _ := access["type"].(string)
+			if include(applicationResourceAccessKey{resourceAppId, id, accessType}) {
+				filtered = append(filtered, access)
+			}
+		}
+		if len(filtered) > 0 {
+			result = append(result, map[string]interface{}{
+				"resource_app_id": resourceAppId,
+				"resource_access": filtered,
+			})
+		}
+	}
+	return result
+}
+
+// applicationDesiredRequiredResourceAccess returns the `required_resource_access` values which should be set for an
+// application having the specified current values. When `required_resource_access_mode` is `merge`, permissions which
+// were not previously recorded in state are considered to be managed outside of Terraform and are retained.
+func applicationDesiredRequiredResourceAccess(d *schema.ResourceData, current []map[string]interface{}) []interface{} {
+	desired := d.Get("required_resource_access").(*schema.Set).List()
+	if applicationRequiredResourceAccessMode(d) != requiredResourceAccessModeMerge {
+		return desired
+	}
+
+	old, _ := d.GetChange("required_resource_access")
+	managed := applicationRequiredResourceAccessKeys(old.(*schema.Set).List())
+	configured := applicationRequiredResourceAccessKeys(desired)
+	unmanaged := applicationFilterRequiredResourceAccess(current, func(k applicationResourceAccessKey) bool {
+		return !managed[k] && !configured[k]
+	})
+
+	result := make([]interface{}, 0, len(desired)+len(unmanaged))
+	indexes := make(map[string]int)
+	for _, raw := range desired {
+		requiredResourceAccess := raw.(map[string]interface{})
+		resourceAppId := requiredResourceAccess["resource_app_id"].(string)
+		if i, ok := indexes[resourceAppId]; ok {
+			existing := result[i].(map[string]interface{})
+			existing["resource_access"] = append(existing["resource_access"].([]interface{}), requiredResourceAccess["resource_access"].([]interface{})...)
+			continue
+		}
+		indexes[resourceAppId] = len(result)
+		result = append(result, map[string]interface{}{
+			"resource_app_id": resourceAppId,
+			"resource_access": append([]interface{}{}, requiredResourceAccess["resource_access"].([]interface{})...),
+		})
+	}
+	for _, raw := range unmanaged {
+		requiredResourceAccess := raw.(map[string]interface{})
+		resourceAppId := requiredResourceAccess["resource_app_id"].(string)
+		if i, ok := indexes[resourceAppId]; ok {
+			existing := result[i].(map[string]interface{})
+			existing["resource_access"] = append(existing["resource_access"].([]interface{}), requiredResourceAccess["resource_access"].([]interface{})...)
+			continue
+		}
+		indexes[resourceAppId] = len(result)
+		result = append(result, requiredResourceAccess)
+	}
+
+	return result
+}
+
+// applicationTrackedRequiredResourceAccess returns the `required_resource_access` values which should be recorded in
+// state. When `required_resource_access_mode` is `merge`, permissions added outside of Terraform are omitted so that
+// they do not show up as a diff.
+func applicationTrackedRequiredResourceAccess(d *schema.ResourceData, current []map[string]interface{}) interface{} {
+	if applicationRequiredResourceAccessMode(d) != requiredResourceAccessModeMerge {
+		return current
+	}
+	configured := applicationRequiredResourceAccessKeys(d.Get("required_resource_access").(*schema.Set).List())
+	return applicationFilterRequiredResourceAccess(current, func(k applicationResourceAccessKey) bool {
+		return configured[k]
+	})
+}
+
 func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if meta.(*clients.Client).EnableMsGraphBeta {
-		return applicationResourceCreateMsGraph(ctx, d, meta)
+		diags = applicationResourceCreateMsGraph(ctx, d, meta)
+	} else {
+		diags = applicationResourceCreateAadGraph(ctx, d, meta)
 	}
-	return applicationResourceCreateAadGraph(ctx, d, meta)
+	return append(diags, applicationPublicClientDiags(d)...)
 }
 
 func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if meta.(*clients.Client).EnableMsGraphBeta {
-		return applicationResourceUpdateMsGraph(ctx, d, meta)
+		diags = applicationResourceUpdateMsGraph(ctx, d, meta)
+	} else {
+		diags = applicationResourceUpdateAadGraph(ctx, d, meta)
 	}
-	return applicationResourceUpdateAadGraph(ctx, d, meta)
+	return append(diags, applicationPublicClientDiags(d)...)
 }
 
 func applicationResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -558,3 +879,49 @@ func applicationValidateRolesScopes(appRoles, oauth2Permissions []interface{}) e
 
 	return nil
 }
+
+// applicationPublicClientWarnings returns a description of any configuration which is accepted by the API but which
+// is incompatible with the application being a public client, and would cause sign-ins to fail at runtime
+func applicationPublicClientWarnings(get func(string) interface{}) []string {
+	publicClient := false
+	for _, k := range []string{"fallback_public_client_enabled", "public_client"} { // TODO: v2.0 remove `public_client`
+		if v, ok := get(k).(bool); ok && v {
+			publicClient = true
+		}
+	}
+	if !publicClient {
+		return nil
+	}
+
+	var redirectUris []interface{}
+	for _, k := range []string{"web.0.redirect_uris", "reply_urls"} { // TODO: v2.0 remove `reply_urls`
+		if v, ok := get(k).(*schema.Set); ok && v != nil {
+			redirectUris = append(redirectUris, v.List()...)
+		}
+	}
+
+	warnings := make([]string, 0)
+	for _, raw := range redirectUris {
+		uri, ok := raw.(string)
+		if !ok || uri == "" {
+			continue
+		}
+		if u, err := url.Parse(uri); err == nil && u.Scheme != "" && !strings.EqualFold(u.Scheme, "http") && !strings.EqualFold(u.Scheme, "https") {
+			warnings = append(warnings, fmt.Sprintf("The redirect URI %q uses a custom scheme, which is not supported for web applications. Public clients such as mobile and desktop applications should register custom scheme redirect URIs using the public client platform instead, otherwise sign-ins will fail.", uri))
+		}
+	}
+
+	return warnings
+}
+
+func applicationPublicClientDiags(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, warning := range applicationPublicClientWarnings(d.Get) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Incompatible public client configuration",
+			Detail:   warning,
+		})
+	}
+	return diags
+}
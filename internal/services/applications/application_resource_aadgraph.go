@@ -21,6 +21,10 @@ import (
 func applicationResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Applications.AadClient
 
+	if d.Get("set_default_identifier_uri").(bool) {
+		return tf.ErrorDiagPathF(errors.New("setting a default identifier URI is only supported when using Microsoft Graph"), "set_default_identifier_uri", "Could not create application")
+	}
+
 	var name string
 	if v, ok := d.GetOk("display_name"); ok {
 		name = v.(string)
@@ -64,7 +68,7 @@ func applicationResourceCreateAadGraph(ctx context.Context, d *schema.ResourceDa
 	properties := graphrbac.ApplicationCreateParameters{
 		DisplayName:            &name,
 		IdentifierUris:         tf.ExpandStringSlicePtr(identUrls.([]interface{})),
-		RequiredResourceAccess: expandApplicationRequiredResourceAccessAad(d),
+		RequiredResourceAccess: expandApplicationRequiredResourceAccessAad(d.Get("required_resource_access").(*schema.Set).List()),
 		OptionalClaims:         expandApplicationOptionalClaimsAad(d),
 	}
 
@@ -160,7 +164,7 @@ func applicationResourceCreateAadGraph(ctx context.Context, d *schema.ResourceDa
 
 	if v, ok := d.GetOk("owners"); ok {
 		desiredOwners := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
-		if err := aadgraph.ApplicationSetOwnersTo(ctx, client, *app.ObjectID, desiredOwners); err != nil {
+		if err := aadgraph.ApplicationSetOwnersTo(ctx, client, *app.ObjectID, desiredOwners, applicationManagedOwners(d)); err != nil {
 			return tf.ErrorDiagPathF(err, "owners", "Could not set Owners")
 		}
 	}
@@ -261,7 +265,15 @@ func applicationResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if d.HasChange("required_resource_access") {
-		properties.RequiredResourceAccess = expandApplicationRequiredResourceAccessAad(d)
+		var currentRequiredResourceAccess []map[string]interface{}
+		if applicationRequiredResourceAccessMode(d) == requiredResourceAccessModeMerge {
+			app, err := client.Get(ctx, d.Id())
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "required_resource_access", "Retrieving Application with object ID %q", d.Id())
+			}
+			currentRequiredResourceAccess = flattenApplicationRequiredResourceAccessAad(app.RequiredResourceAccess)
+		}
+		properties.RequiredResourceAccess = expandApplicationRequiredResourceAccessAad(applicationDesiredRequiredResourceAccess(d, currentRequiredResourceAccess))
 	}
 
 	if d.HasChange("optional_claims") {
@@ -318,7 +330,7 @@ func applicationResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceDa
 
 	if d.HasChange("owners") {
 		desiredOwners := *tf.ExpandStringSlicePtr(d.Get("owners").(*schema.Set).List())
-		if err := aadgraph.ApplicationSetOwnersTo(ctx, client, d.Id(), desiredOwners); err != nil {
+		if err := aadgraph.ApplicationSetOwnersTo(ctx, client, d.Id(), desiredOwners, applicationManagedOwners(d)); err != nil {
 			return tf.ErrorDiagPathF(err, "owners", "Could not set Owners")
 		}
 	}
@@ -362,7 +374,7 @@ func applicationResourceReadAadGraph(ctx context.Context, d *schema.ResourceData
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaimsAad(app.OptionalClaims))
 	tf.Set(d, "public_client", app.PublicClient)
 	tf.Set(d, "reply_urls", tf.FlattenStringSlicePtr(app.ReplyUrls))
-	tf.Set(d, "required_resource_access", flattenApplicationRequiredResourceAccessAad(app.RequiredResourceAccess))
+	tf.Set(d, "required_resource_access", applicationTrackedRequiredResourceAccess(d, flattenApplicationRequiredResourceAccessAad(app.RequiredResourceAccess)))
 
 	signInAudience := msgraph.SignInAudienceAzureADMyOrg
 	if app.AvailableToOtherTenants != nil && *app.AvailableToOtherTenants {
@@ -410,13 +422,16 @@ func applicationResourceReadAadGraph(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for application with object ID %q", *app.ObjectID)
 	}
-	tf.Set(d, "owners", owners)
+	tf.Set(d, "owners", applicationTrackedOwners(d, owners))
 
 	preventDuplicates := false
 	if v := d.Get("prevent_duplicate_names").(bool); v {
 		preventDuplicates = v
 	}
 	tf.Set(d, "prevent_duplicate_names", preventDuplicates)
+	tf.Set(d, "ignore_unmanaged_owners", d.Get("ignore_unmanaged_owners").(bool))
+	tf.Set(d, "retain_caller_as_owner", applicationRetainCallerAsOwner(d))
+	tf.Set(d, "required_resource_access_mode", applicationRequiredResourceAccessMode(d))
 
 	return nil
 }
@@ -447,11 +462,10 @@ func applicationResourceDeleteAadGraph(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func expandApplicationRequiredResourceAccessAad(d *schema.ResourceData) *[]graphrbac.RequiredResourceAccess {
-	requiredResourcesAccesses := d.Get("required_resource_access").(*schema.Set).List()
+func expandApplicationRequiredResourceAccessAad(in []interface{}) *[]graphrbac.RequiredResourceAccess {
 	result := make([]graphrbac.RequiredResourceAccess, 0)
 
-	for _, raw := range requiredResourcesAccesses {
+	for _, raw := range in {
 		requiredResourceAccess := raw.(map[string]interface{})
 		resource_app_id := requiredResourceAccess["resource_app_id"].(string)
 
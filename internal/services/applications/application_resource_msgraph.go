@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -59,6 +61,9 @@ func applicationResourceCreateMsGraph(ctx context.Context, d *schema.ResourceDat
 	if appType == "native" && hasIdentifierUris {
 		return tf.ErrorDiagPathF(nil, "identifier_uris", "`identifier_uris` is not required for a native application")
 	}
+	if appType == "native" && d.Get("set_default_identifier_uri").(bool) {
+		return tf.ErrorDiagPathF(nil, "set_default_identifier_uri", "`set_default_identifier_uri` is not supported for a native application")
+	}
 
 	properties := msgraph.Application{
 		Api:                    &msgraph.ApplicationApi{},
@@ -168,11 +173,26 @@ func applicationResourceCreateMsGraph(ctx context.Context, d *schema.ResourceDat
 
 	if v, ok := d.GetOk("owners"); ok {
 		owners := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
-		if err := helpers.ApplicationSetOwners(ctx, client, app, owners); err != nil {
+		if err := helpers.ApplicationSetOwners(ctx, client, app, owners, applicationManagedOwners(d)); err != nil {
 			return tf.ErrorDiagPathF(err, "owners", "Could not set owners for application with object ID: %q", *app.ID)
 		}
 	}
 
+	// The default identifier URI is derived from the application ID, so can only be set once the application exists
+	if d.Get("set_default_identifier_uri").(bool) {
+		if app.AppId == nil || *app.AppId == "" {
+			return tf.ErrorDiagF(errors.New("Bad API response"), "Application ID returned for application is nil/empty")
+		}
+
+		properties := msgraph.Application{
+			ID:             app.ID,
+			IdentifierUris: &[]string{fmt.Sprintf("api://%s", *app.AppId)},
+		}
+		if _, err := client.Update(ctx, properties); err != nil {
+			return tf.ErrorDiagPathF(err, "set_default_identifier_uri", "Could not set default identifier URI for application with object ID: %q", *app.ID)
+		}
+	}
+
 	return applicationResourceReadMsGraph(ctx, d, meta)
 }
 
@@ -228,13 +248,22 @@ func applicationResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceDat
 		return tf.ErrorDiagPathF(nil, "identifier_uris", "`identifier_uris` is not required for a native application")
 	}
 
+	var currentRequiredResourceAccess []map[string]interface{}
+	if applicationRequiredResourceAccessMode(d) == requiredResourceAccessModeMerge {
+		app, _, err := client.Get(ctx, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "required_resource_access", "Retrieving application with object ID %q", d.Id())
+		}
+		currentRequiredResourceAccess = flattenApplicationRequiredResourceAccess(app.RequiredResourceAccess)
+	}
+
 	properties := msgraph.Application{
 		ID:                     utils.String(d.Id()),
 		Api:                    &msgraph.ApplicationApi{},
 		DisplayName:            utils.String(displayName),
 		IdentifierUris:         tf.ExpandStringSlicePtr(identifierUris.([]interface{})),
 		OptionalClaims:         expandApplicationOptionalClaims(d.Get("optional_claims").([]interface{})),
-		RequiredResourceAccess: expandApplicationRequiredResourceAccess(d.Get("required_resource_access").(*schema.Set).List()),
+		RequiredResourceAccess: expandApplicationRequiredResourceAccess(applicationDesiredRequiredResourceAccess(d, currentRequiredResourceAccess)),
 		Web: &msgraph.ApplicationWeb{
 			ImplicitGrantSettings: &msgraph.ImplicitGrantSettings{},
 		},
@@ -324,7 +353,21 @@ func applicationResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if d.HasChange("app_role") {
-		if err := helpers.ApplicationSetAppRoles(ctx, client, &properties, expandApplicationAppRoles(d.Get("app_role").(*schema.Set).List())); err != nil {
+		oldRoles, newRoles := d.GetChange("app_role")
+		roles := applicationAppRolesWithExistingIds(oldRoles.(*schema.Set).List(), newRoles.(*schema.Set).List())
+
+		if removedIds := applicationAppRoleIdsRemoved(oldRoles.(*schema.Set).List(), roles); len(removedIds) > 0 {
+			servicePrincipalsClient := meta.(*clients.Client).ServicePrincipals.MsClient
+			_, assignments, err := applicationAppRoleAssignments(ctx, servicePrincipalsClient, d.Get("application_id").(string), removedIds)
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "app_role", "Checking for assignments of removed App Roles")
+			}
+			if len(assignments) > 0 {
+				return applicationAppRoleAssignmentsDiag(assignments, "app_role", "These assignments must be removed before the app roles can be removed from the application.")
+			}
+		}
+
+		if err := helpers.ApplicationSetAppRoles(ctx, client, &properties, expandApplicationAppRoles(roles)); err != nil {
 			return tf.ErrorDiagPathF(err, "app_role", "Could not set App Roles")
 		}
 	}
@@ -346,7 +389,7 @@ func applicationResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceDat
 
 	if d.HasChange("owners") {
 		owners := *tf.ExpandStringSlicePtr(d.Get("owners").(*schema.Set).List())
-		if err := helpers.ApplicationSetOwners(ctx, client, &properties, owners); err != nil {
+		if err := helpers.ApplicationSetOwners(ctx, client, &properties, owners, applicationManagedOwners(d)); err != nil {
 			return tf.ErrorDiagPathF(err, "owners", "Could not set owners for application with object ID: %q", d.Id())
 		}
 	}
@@ -380,7 +423,7 @@ func applicationResourceReadMsGraph(ctx context.Context, d *schema.ResourceData,
 	tf.Set(d, "object_id", app.ID)
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaims(app.OptionalClaims))
 	tf.Set(d, "public_client", app.IsFallbackPublicClient) // TODO: v2.0 remove this
-	tf.Set(d, "required_resource_access", flattenApplicationRequiredResourceAccess(app.RequiredResourceAccess))
+	tf.Set(d, "required_resource_access", applicationTrackedRequiredResourceAccess(d, flattenApplicationRequiredResourceAccess(app.RequiredResourceAccess)))
 	tf.Set(d, "sign_in_audience", string(app.SignInAudience))
 	tf.Set(d, "web", helpers.ApplicationFlattenWeb(app.Web))
 
@@ -421,12 +464,15 @@ func applicationResourceReadMsGraph(ctx context.Context, d *schema.ResourceData,
 		preventDuplicates = v
 	}
 	tf.Set(d, "prevent_duplicate_names", preventDuplicates)
+	tf.Set(d, "ignore_unmanaged_owners", d.Get("ignore_unmanaged_owners").(bool))
+	tf.Set(d, "retain_caller_as_owner", applicationRetainCallerAsOwner(d))
+	tf.Set(d, "required_resource_access_mode", applicationRequiredResourceAccessMode(d))
 
 	owners, _, err := client.ListOwners(ctx, *app.ID)
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for application with object ID %q", *app.ID)
 	}
-	tf.Set(d, "owners", owners)
+	tf.Set(d, "owners", applicationTrackedOwners(d, *owners))
 
 	return nil
 }
@@ -448,9 +494,87 @@ func applicationResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceDat
 		return tf.ErrorDiagPathF(err, "id", "Deleting application with object ID %q, got status %d", d.Id(), status)
 	}
 
+	if meta.(*clients.Client).Features.Applications.PurgeSoftDeletedOnDestroy {
+		// The deleted application may not appear in the deleted items container straight away
+		if _, err := helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+			return helpers.DeletedItemGet(ctx, client.BaseClient, d.Id())
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for deleted application with object ID %q", d.Id())
+		}
+
+		if _, err := helpers.DeletedItemPermanentlyDelete(ctx, client.BaseClient, d.Id()); err != nil {
+			return tf.ErrorDiagF(err, "Permanently deleting application with object ID %q", d.Id())
+		}
+	}
+
 	return nil
 }
 
+// applicationAppRolesWithExistingIds returns the desired app_role blocks, where any block without an ID is assigned the
+// ID of a replaced role having the same value or, failing that, the same display name. Changing any property of a role
+// results in a new set element without an ID, so this ensures the role retains its ID rather than being recreated.
+func applicationAppRolesWithExistingIds(oldRoles, newRoles []interface{}) []interface{} {
+	used := make(map[string]bool)
+	for _, raw := range newRoles {
+		if role, ok := raw.(map[string]interface{}); ok {
+			if id, _ := role["id"].(string); id != "" {
+				used[strings.ToLower(id)] = true
+			}
+		}
+	}
+
+	candidates := make([]map[string]interface{}, 0)
+	for _, raw := range oldRoles {
+		if role, ok := raw.(map[string]interface{}); ok {
+			if id, _ := role["id"].(string); id != "" && !used[strings.ToLower(id)] {
+				candidates = append(candidates, role)
+			}
+		}
+	}
+
+	result := make([]interface{}, 0, len(newRoles))
+	for _, raw := range newRoles {
+		role, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if id, _ := role["id"].(string); id == "" {
+			matched := false
+			for _, key := range []string{"value", "display_name"} {
+				v, _ := role[key].(string)
+				if v == "" {
+					continue
+				}
+				for _, candidate := range candidates {
+					candidateId := candidate["id"].(string)
+					if used[strings.ToLower(candidateId)] {
+						continue
+					}
+					if cv, _ := candidate[key].(string); cv == v {
+						updated := make(map[string]interface{}, len(role))
+						for k, val := range role {
+							updated[k] = val
+						}
+						updated["id"] = candidateId
+						role = updated
+						used[strings.ToLower(candidateId)] = true
+						matched = true
+						break
+					}
+				}
+				if matched {
+					break
+				}
+			}
+		}
+
+		result = append(result, role)
+	}
+
+	return result
+}
+
 func expandApplicationAppRoles(input []interface{}) *[]msgraph.AppRole {
 	if len(input) == 0 {
 		return nil
@@ -465,7 +589,10 @@ func expandApplicationAppRoles(input []interface{}) *[]msgraph.AppRole {
 			allowedMemberTypes = append(allowedMemberTypes, msgraph.AppRoleAllowedMemberType(allowedMemberType.(string)))
 		}
 
-		id, _ := uuid.GenerateUUID() // TODO: don't autogenerate a UUID in v2.0
+		id, _ := appRole["id"].(string)
+		if id == "" {
+			id, _ = uuid.GenerateUUID() // TODO: don't autogenerate a UUID in v2.0
+		}
 
 		var enabled bool
 		if v, ok := appRole["is_enabled"]; ok {
@@ -714,3 +841,70 @@ func flattenApplicationResourceAccess(in *[]msgraph.ResourceAccess) []interface{
 
 	return accesses
 }
+
+// applicationAppRoleIdsRemoved returns the IDs of any app roles in oldRoles that are not present in newRoles
+func applicationAppRoleIdsRemoved(oldRoles, newRoles []interface{}) []string {
+	desired := make(map[string]bool)
+	for _, raw := range newRoles {
+		if role, ok := raw.(map[string]interface{}); ok {
+			if id, _ := role["id"].(string); id != "" {
+				desired[strings.ToLower(id)] = true
+			}
+		}
+	}
+
+	result := make([]string, 0)
+	for _, raw := range oldRoles {
+		if role, ok := raw.(map[string]interface{}); ok {
+			if id, _ := role["id"].(string); id != "" && !desired[strings.ToLower(id)] {
+				result = append(result, id)
+			}
+		}
+	}
+	return result
+}
+
+// applicationAppRoleAssignments returns the object ID of the service principal for the application with the specified
+// application (client) ID, along with any assignments of the specified app roles which are exposed by it. When the
+// application has no service principal, its app roles cannot have been assigned and a nil object ID is returned.
+func applicationAppRoleAssignments(ctx context.Context, client *msgraph.ServicePrincipalsClient, appId string, appRoleIds []string) (*string, []msgraph.AppRoleAssignment, error) {
+	if appId == "" {
+		return nil, nil, nil
+	}
+
+	servicePrincipals, _, err := client.List(ctx, fmt.Sprintf("appId eq '%s'", appId))
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing service principals for application with application ID %q: %+v", appId, err)
+	}
+	if servicePrincipals == nil || len(*servicePrincipals) == 0 {
+		return nil, nil, nil
+	}
+
+	servicePrincipalId := (*servicePrincipals)[0].ID
+	if servicePrincipalId == nil {
+		return nil, nil, errors.New("service principal returned with nil object ID")
+	}
+
+	assignments, _, err := helpers.ServicePrincipalAppRoleAssignedTo(ctx, client.BaseClient, *servicePrincipalId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving app role assignments for service principal with object ID %q: %+v", *servicePrincipalId, err)
+	}
+
+	return servicePrincipalId, helpers.AppRoleAssignmentsForAppRoles(assignments, appRoleIds), nil
+}
+
+// applicationAppRoleAssignmentsDiag returns an error diagnostic listing the principals which are still assigned an app
+// role that is about to be removed, since the API error returned in this case does not identify them
+func applicationAppRoleAssignmentsDiag(assignments []msgraph.AppRoleAssignment, attr, resolution string) diag.Diagnostics {
+	lines := make([]string, 0, len(assignments))
+	for _, a := range helpers.AppRoleAssignmentsFlatten(assignments) {
+		lines = append(lines, fmt.Sprintf("  - %s %q (object ID: %s) is assigned the app role with ID %q", a["principal_type"], a["principal_display_name"], a["principal_object_id"], a["app_role_id"]))
+	}
+
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       "App Role is still assigned to one or more principals",
+		Detail:        fmt.Sprintf("The following app role assignments were found:\n\n%s\n\n%s", strings.Join(lines, "\n"), resolution),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: attr}},
+	}}
+}
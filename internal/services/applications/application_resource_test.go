@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/fakegraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/provider"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
@@ -159,6 +164,55 @@ func TestAccApplication_publicClientDeprecated(t *testing.T) {
 	})
 }
 
+func TestAccApplication_publicClientCustomSchemeRedirectUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.publicClientCustomSchemeRedirectUri(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fallback_public_client_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_optionalClaimsUnsupportedAdditionalProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.optionalClaimsUnsupportedAdditionalProperties(data),
+			ExpectError: regexp.MustCompile(`additional property "sam_account_name" is not supported by the "upn" claim`),
+		},
+	})
+}
+
+func TestAccApplication_invalidRedirectUris(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.redirectUris(data, "http://example.com/auth"),
+			ExpectError: regexp.MustCompile(`Redirect URI must use the https scheme unless the host is localhost`),
+		},
+		{
+			Config:      r.redirectUris(data, "https://example.com/auth#fragment"),
+			ExpectError: regexp.MustCompile(`Redirect URI must not contain a fragment`),
+		},
+		{
+			Config:      r.redirectUris(data, fmt.Sprintf("https://*.not-a-verified-domain-%d.net/auth", data.RandomInteger)),
+			ExpectError: regexp.MustCompile(`must be for a verified domain in the tenant`),
+		},
+	})
+}
+
 func TestAccApplication_appRoles(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -525,6 +579,133 @@ func TestAccApplication_ownersUpdate(t *testing.T) {
 	})
 }
 
+func TestAccApplication_retainCallerAsOwner(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the principal running Terraform was added as an owner on creation, and is retained
+			Config: r.retainCallerAsOwner(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_ignoreUnmanagedOwners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.ignoreUnmanagedOwners(data, "testA"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.ignoreUnmanagedOwners(data, "testB"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				check.That(data.ResourceName).Key("owners.0").MatchesOtherKey(check.That("azuread_user.testB").Key("object_id")),
+			),
+		},
+	})
+}
+
+func TestAccApplication_requiredResourceAccessMerge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.requiredResourceAccessMerge(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("required_resource_access.0.resource_access.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("required_resource_access_mode"),
+		{
+			Config: r.requiredResourceAccessMerge(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("required_resource_access.0.resource_access.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.requiredResourceAccessMerge(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("required_resource_access.#").HasValue("1"),
+				check.That(data.ResourceName).Key("required_resource_access.0.resource_access.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccApplication_setDefaultIdentifierUri(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.setDefaultIdentifierUri(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("identifier_uris.0").MatchesRegex(regexp.MustCompile(`^api://[0-9a-f-]{36}$`)),
+			),
+		},
+		data.ImportStep("set_default_identifier_uri"),
+	})
+}
+
+func TestAccApplication_purgeSoftDeletedOnDestroy(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.purgeSoftDeletedOnDestroy(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// Destroying the application should not leave it in the deleted items container
+			Config: r.purgeSoftDeletedOnDestroyDeleted(data),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckOutput("deleted_count", "0"),
+			),
+		},
+	})
+}
+
 func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	var id *string
 
@@ -581,6 +762,46 @@ resource "azuread_application" "test" {
 `, data.RandomInteger)
 }
 
+func (ApplicationResource) publicClientCustomSchemeRedirectUri(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name                   = "acctest-APP-%[1]d"
+  fallback_public_client_enabled = true
+
+  web {
+    redirect_uris = ["myapp://auth"]
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) optionalClaimsUnsupportedAdditionalProperties(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  optional_claims {
+    id_token {
+      name                  = "upn"
+      additional_properties = ["sam_account_name"]
+    }
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) redirectUris(data acceptance.TestData, redirectUri string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  web {
+    redirect_uris = ["%[2]s"]
+  }
+}
+`, data.RandomInteger, redirectUri)
+}
+
 func (ApplicationResource) withGroupMembershipClaimsDirectoryRole(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -610,12 +831,10 @@ resource "azuread_application" "test" {
 
 func (ApplicationResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[6]s
 
 resource "azuread_user" "test" {
-  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[5]s"
   display_name        = "acctestUser-%[1]d"
   password            = "%[2]s"
 }
@@ -721,17 +940,15 @@ resource "azuread_application" "test" {
 
   owners = [azuread_user.test.object_id]
 }
-`, data.RandomInteger, data.RandomPassword, data.UUID(), data.UUID())
+`, data.RandomInteger, data.RandomPassword, data.UUID(), data.UUID(), data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
 }
 
 func (ApplicationResource) completeDeprecated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[4]s
 
 resource "azuread_user" "test" {
-  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[3]s"
   display_name        = "acctestUser-%[1]d"
   password            = "%[2]s"
 }
@@ -812,7 +1029,7 @@ resource "azuread_application" "test" {
 
   owners = [azuread_user.test.object_id]
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
 }
 
 func (ApplicationResource) appRoles(data acceptance.TestData) string {
@@ -1073,29 +1290,27 @@ resource "azuread_application" "test" {
 
 func (ApplicationResource) templateThreeUsers(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[7]s
 
 resource "azuread_user" "testA" {
-  user_principal_name = "acctestUser.%[1]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[3]s"
   display_name        = "acctestUser-%[1]d-A"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testB" {
-  user_principal_name = "acctestUser.%[1]d.B@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[4]s"
   display_name        = "acctestUser-%[1]d-B"
-  mail_nickname       = "acctestUser-%[1]d-B"
+  mail_nickname       = "%[6]s"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testC" {
-  user_principal_name = "acctestUser.%[1]d.C@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[5]s"
   display_name        = "acctestUser-%[1]d-C"
   password            = "%[2]s"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser.A"), data.UserPrincipalName("acctestUser.B"), data.UserPrincipalName("acctestUser.C"), data.MailNickname("acctestUser-B"), data.DefaultDomainTemplate())
 }
 
 func (r ApplicationResource) singleOwner(data acceptance.TestData) string {
@@ -1111,6 +1326,18 @@ resource "azuread_application" "test" {
 `, r.templateThreeUsers(data), data.RandomInteger)
 }
 
+func (r ApplicationResource) ignoreUnmanagedOwners(data acceptance.TestData, owner string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "test" {
+  display_name            = "acctest-APP-%[2]d"
+  owners                  = [azuread_user.%[3]s.object_id]
+  ignore_unmanaged_owners = true
+}
+`, r.templateThreeUsers(data), data.RandomInteger, owner)
+}
+
 func (r ApplicationResource) threeOwners(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -1130,9 +1357,140 @@ func (r ApplicationResource) removeOwners(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
 
+resource "azuread_application" "test" {
+  display_name           = "acctest-APP-%[2]d"
+  owners                 = []
+  retain_caller_as_owner = false
+}
+`, r.templateThreeUsers(data), data.RandomInteger)
+}
+
+func (r ApplicationResource) retainCallerAsOwner(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
 resource "azuread_application" "test" {
   display_name = "acctest-APP-%[2]d"
-  owners       = []
+  owners       = [azuread_user.testA.object_id]
 }
 `, r.templateThreeUsers(data), data.RandomInteger)
 }
+
+func (ApplicationResource) requiredResourceAccessMerge(data acceptance.TestData, additionalScope bool) string {
+	scope := ""
+	if additionalScope {
+		scope = `
+    resource_access {
+      id   = "06da0dbc-49e2-44d2-8312-53f166ab848a"
+      type = "Scope"
+    }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name                  = "acctest-APP-%[1]d"
+  required_resource_access_mode = "merge"
+
+  required_resource_access {
+    resource_app_id = "00000003-0000-0000-c000-000000000000"
+
+    resource_access {
+      id   = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
+      type = "Scope"
+    }
+%[2]s
+  }
+}
+`, data.RandomInteger, scope)
+}
+
+func (ApplicationResource) setDefaultIdentifierUri(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name               = "acctest-APP-%[1]d"
+  set_default_identifier_uri = true
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) purgeSoftDeletedOnDestroy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {
+  features {
+    applications {
+      purge_soft_deleted_on_destroy = true
+    }
+  }
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) purgeSoftDeletedOnDestroyDeleted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {
+  features {
+    applications {
+      purge_soft_deleted_on_destroy = true
+    }
+  }
+}
+
+data "azuread_deleted_applications" "test" {}
+
+output "deleted_count" {
+  value = length([for a in data.azuread_deleted_applications.test.applications : a.object_id if a.display_name == "acctest-APP-%[1]d"])
+}
+`, data.RandomInteger)
+}
+
+func TestApplicationResource_fakeGraph(t *testing.T) {
+	// resource functions expect a deadline, which is usually set by the plugin SDK
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	server := fakegraph.NewServer(t)
+
+	meta, err := clients.NewMsGraphClient(ctx, server.ClientOptions(), clients.Features{})
+	if err != nil {
+		t.Fatalf("building client: %v", err)
+	}
+
+	resource := provider.AzureADProvider().ResourcesMap["azuread_application"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name": "acctest-app",
+	})
+
+	if diags := resource.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("creating application: %+v", diags)
+	}
+	object := server.Object(d.Id())
+	if object == nil || object["displayName"] != "acctest-app" {
+		t.Fatalf("expected application to be created, got %v", object)
+	}
+
+	if diags := resource.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("reading application: %+v", diags)
+	}
+	if v := d.Get("application_id").(string); v == "" || v != object["appId"] {
+		t.Fatalf("expected application_id %q, got %q", object["appId"], v)
+	}
+
+	if diags := resource.DeleteContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("deleting application: %+v", diags)
+	}
+	if server.Object(d.Id()) != nil || server.DeletedObject(d.Id()) == nil {
+		t.Fatalf("expected application to be deleted")
+	}
+
+	// the application is removed from state when it no longer exists
+	if diags := resource.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("reading deleted application: %+v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected deleted application to be removed from state")
+	}
+}
@@ -0,0 +1,77 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const applicationSignInActivityDataSourceName = "azuread_application_sign_in_activity"
+
+func applicationSignInActivityDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: applicationSignInActivityDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"period_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntInSlice([]int{1, 7, 30}),
+			},
+
+			"last_sign_in_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_application_sign_in_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_delegated_sign_in_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"successful_sign_in_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"failed_sign_in_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"success_percentage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationSignInActivityDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationSignInActivityDataSourceName); diags != nil {
+		return diags
+	}
+	return applicationSignInActivityDataSourceReadMsGraph(ctx, d, meta)
+}
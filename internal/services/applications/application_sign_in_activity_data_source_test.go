@@ -0,0 +1,47 @@
+package applications_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationSignInActivityDataSource struct{}
+
+func TestAccApplicationSignInActivityDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_application_sign_in_activity", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ApplicationSignInActivityDataSource{}.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("period_in_days").HasValue("7"),
+				check.That(data.ResourceName).Key("last_sign_in_date_time").HasValue(""),
+				check.That(data.ResourceName).Key("successful_sign_in_count").HasValue("0"),
+				check.That(data.ResourceName).Key("failed_sign_in_count").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (ApplicationSignInActivityDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+}
+
+data "azuread_application_sign_in_activity" "test" {
+  application_id = azuread_application.test.application_id
+  period_in_days = 7
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,93 @@
+package applications
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const applicationTemplateDataSourceName = "azuread_application_template"
+
+func applicationTemplateDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: applicationTemplateDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "template_id"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"template_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "template_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"categories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"homepage_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"logo_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"supported_provisioning_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"supported_single_sign_on_modes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func applicationTemplateDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(applicationTemplateDataSourceName); diags != nil {
+		return diags
+	}
+	return applicationTemplateDataSourceReadMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,71 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func applicationTemplateDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	var template *helpers.ApplicationTemplate
+
+	if templateId, ok := d.GetOk("template_id"); ok && templateId.(string) != "" {
+		var status int
+		var err error
+		template, status, err = helpers.ApplicationTemplateGet(ctx, client.BaseClient, templateId.(string))
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "template_id", "No application template found with ID: %q", templateId)
+			}
+			return tf.ErrorDiagPathF(err, "template_id", "Retrieving application template with ID: %q", templateId)
+		}
+	} else {
+		displayName := d.Get("display_name").(string)
+		filter := fmt.Sprintf("displayName eq '%s'", strings.ReplaceAll(displayName, "'", "''"))
+		templates, _, err := helpers.ApplicationTemplateList(ctx, client.BaseClient, filter)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "display_name", "Listing application templates for filter %q", filter)
+		}
+
+		switch {
+		case len(templates) == 0:
+			return tf.ErrorDiagPathF(nil, "display_name", "No application template found matching filter: %q", filter)
+		case len(templates) > 1:
+			return tf.ErrorDiagPathF(nil, "display_name", "More than one application template found matching filter: %q", filter)
+		}
+
+		template = &templates[0]
+	}
+
+	if template == nil {
+		return tf.ErrorDiagF(errors.New("API returned nil application template"), "Bad API response")
+	}
+	if template.ID == nil {
+		return tf.ErrorDiagF(errors.New("API returned application template with nil ID"), "Bad API response")
+	}
+
+	d.SetId(*template.ID)
+
+	tf.Set(d, "categories", tf.FlattenStringSlicePtr(template.Categories))
+	tf.Set(d, "description", template.Description)
+	tf.Set(d, "display_name", template.DisplayName)
+	tf.Set(d, "homepage_url", template.HomePageUrl)
+	tf.Set(d, "logo_url", template.LogoUrl)
+	tf.Set(d, "publisher", template.Publisher)
+	tf.Set(d, "supported_provisioning_types", tf.FlattenStringSlicePtr(template.SupportedProvisioningTypes))
+	tf.Set(d, "supported_single_sign_on_modes", tf.FlattenStringSlicePtr(template.SupportedSingleSignOnModes))
+	tf.Set(d, "template_id", template.ID)
+
+	return nil
+}
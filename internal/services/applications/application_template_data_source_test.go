@@ -0,0 +1,68 @@
+package applications_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationTemplateDataSource struct{}
+
+func TestAccApplicationTemplateDataSource_byDisplayName(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_application_template", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ApplicationTemplateDataSource{}.byDisplayName(data),
+			Check:  ApplicationTemplateDataSource{}.testCheckFunc(data),
+		},
+	})
+}
+
+func TestAccApplicationTemplateDataSource_byTemplateId(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_application_template", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ApplicationTemplateDataSource{}.byTemplateId(data),
+			Check:  ApplicationTemplateDataSource{}.testCheckFunc(data),
+		},
+	})
+}
+
+func (ApplicationTemplateDataSource) testCheckFunc(data acceptance.TestData) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		check.That(data.ResourceName).Key("display_name").HasValue("AWS Single-Account Access"),
+		check.That(data.ResourceName).Key("template_id").HasValue("8b1025e4-1dd2-430b-a150-2ef79cd700f5"),
+		check.That(data.ResourceName).Key("publisher").Exists(),
+		check.That(data.ResourceName).Key("supported_single_sign_on_modes.#").Exists(),
+	)
+}
+
+func (ApplicationTemplateDataSource) byDisplayName(_ acceptance.TestData) string {
+	return `
+data "azuread_application_template" "test" {
+  display_name = "AWS Single-Account Access"
+}
+`
+}
+
+func (ApplicationTemplateDataSource) byTemplateId(_ acceptance.TestData) string {
+	return `
+data "azuread_application_template" "test" {
+  template_id = "8b1025e4-1dd2-430b-a150-2ef79cd700f5"
+}
+`
+}
@@ -0,0 +1,75 @@
+package applications
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func applicationsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	var apps []msgraph.Application
+	var query string
+
+	if ownerId, ok := d.GetOk("owner_object_id"); ok {
+		query = ownerId.(string)
+		result, status, err := helpers.ApplicationsOwnedBy(ctx, client.BaseClient, query)
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "owner_object_id", "Owner with object ID %q was not found", query)
+			}
+			return tf.ErrorDiagPathF(err, "owner_object_id", "Listing applications owned by object ID %q", query)
+		}
+		apps = result
+	} else {
+		query = d.Get("search").(string)
+		result, _, err := helpers.ApplicationsSearch(ctx, client.BaseClient, query)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "search", "Searching for applications matching: %q", query)
+		}
+		apps = result
+	}
+
+	applicationIds := make([]string, 0, len(apps))
+	displayNames := make([]string, 0, len(apps))
+	objectIds := make([]string, 0, len(apps))
+	for _, app := range apps {
+		if app.ID == nil || app.AppId == nil {
+			return tf.ErrorDiagF(errors.New("API returned application with nil object ID or application ID"), "Bad API response")
+		}
+
+		applicationIds = append(applicationIds, *app.AppId)
+		objectIds = append(objectIds, *app.ID)
+
+		displayName := ""
+		if app.DisplayName != nil {
+			displayName = *app.DisplayName
+		}
+		displayNames = append(displayNames, displayName)
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(query + "/" + strings.Join(objectIds, "-"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("applications#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "application_ids", applicationIds)
+	tf.Set(d, "display_names", displayNames)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
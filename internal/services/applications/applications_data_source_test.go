@@ -0,0 +1,105 @@
+package applications_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationsDataSource struct{}
+
+func TestAccApplicationsDataSource_bySearch(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ApplicationsDataSource{}.template(data),
+		},
+		{
+			Config: ApplicationsDataSource{}.bySearch(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationsDataSource_byOwner(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_applications", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ApplicationsDataSource{}.byOwner(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (ApplicationsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "testA" {
+  display_name = "acctest-APP-A-%[1]d"
+}
+
+resource "azuread_application" "testB" {
+  display_name = "acctest-APP-B-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationsDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_applications" "test" {
+  search = "displayName:%[2]d"
+}
+`, ApplicationsDataSource{}.template(data), data.RandomInteger)
+}
+
+func (ApplicationsDataSource) byOwner(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[4]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[3]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_application" "testA" {
+  display_name = "acctest-APP-A-%[1]d"
+  owners       = [azuread_user.test.object_id]
+}
+
+resource "azuread_application" "testB" {
+  display_name = "acctest-APP-B-%[1]d"
+  owners       = [azuread_user.test.object_id]
+}
+
+data "azuread_applications" "test" {
+  owner_object_id = azuread_user.test.object_id
+
+  depends_on = [azuread_application.testA, azuread_application.testB]
+}
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
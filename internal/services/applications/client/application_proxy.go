@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// OnPremisesPublishing describes the Application Proxy settings for publishing an on-premises application.
+type OnPremisesPublishing struct {
+	AlternateUrl                  *string `json:"alternateUrl,omitempty"`
+	ApplicationServerTimeout      *string `json:"applicationServerTimeout,omitempty"`
+	ExternalAuthenticationType    *string `json:"externalAuthenticationType,omitempty"`
+	ExternalUrl                   *string `json:"externalUrl,omitempty"`
+	InternalUrl                   *string `json:"internalUrl,omitempty"`
+	IsHttpOnlyCookieEnabled       *bool   `json:"isHttpOnlyCookieEnabled,omitempty"`
+	IsOnPremPublishingEnabled     *bool   `json:"isOnPremPublishingEnabled,omitempty"`
+	IsPersistentCookieEnabled     *bool   `json:"isPersistentCookieEnabled,omitempty"`
+	IsSecureCookieEnabled         *bool   `json:"isSecureCookieEnabled,omitempty"`
+	IsTranslateHostHeaderEnabled  *bool   `json:"isTranslateHostHeaderEnabled,omitempty"`
+	IsTranslateLinksInBodyEnabled *bool   `json:"isTranslateLinksInBodyEnabled,omitempty"`
+}
+
+// ConnectorGroup describes a group of Application Proxy connectors.
+type ConnectorGroup struct {
+	ID   *string `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+const (
+	ApplicationProxyAuthenticationTypeAadPreAuthentication = "aadPreAuthentication"
+	ApplicationProxyAuthenticationTypePassthru             = "passthru"
+)
+
+const (
+	ApplicationProxyServerTimeoutDefault = "Default"
+	ApplicationProxyServerTimeoutLong    = "Long"
+)
+
+// ApplicationProxyClient performs operations on the Application Proxy settings of Applications. These are only
+// available in the beta API.
+type ApplicationProxyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewApplicationProxyClient returns a new ApplicationProxyClient.
+func NewApplicationProxyClient(tenantId string) *ApplicationProxyClient {
+	return &ApplicationProxyClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta, tenantId),
+	}
+}
+
+// Get retrieves the on-premises publishing settings for an Application.
+func (c *ApplicationProxyClient) Get(ctx context.Context, applicationId string) (*OnPremisesPublishing, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s", applicationId),
+			Params:      url.Values{"$select": []string{"id,onPremisesPublishing"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ApplicationProxyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var application struct {
+		OnPremisesPublishing *OnPremisesPublishing `json:"onPremisesPublishing"`
+	}
+	if err := json.Unmarshal(respBody, &application); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return application.OnPremisesPublishing, status, nil
+}
+
+// Update amends the on-premises publishing settings for an Application.
+func (c *ApplicationProxyClient) Update(ctx context.Context, applicationId string, settings OnPremisesPublishing) (int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		OnPremisesPublishing OnPremisesPublishing `json:"onPremisesPublishing"`
+	}{
+		OnPremisesPublishing: settings,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ApplicationProxyClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// GetConnectorGroup retrieves the connector group assigned to an Application.
+func (c *ApplicationProxyClient) GetConnectorGroup(ctx context.Context, applicationId string) (*ConnectorGroup, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/connectorGroup", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ApplicationProxyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var connectorGroup ConnectorGroup
+	if err := json.Unmarshal(respBody, &connectorGroup); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &connectorGroup, status, nil
+}
+
+// AssignConnectorGroup assigns a connector group to an Application, replacing any existing assignment.
+func (c *ApplicationProxyClient) AssignConnectorGroup(ctx context.Context, applicationId, connectorGroupId string) (int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		ConnectorGroup string `json:"@odata.id"`
+	}{
+		ConnectorGroup: fmt.Sprintf("%s/%s/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, connectorGroupId),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Put(ctx, msgraph.PutHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/connectorGroup/$ref", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ApplicationProxyClient.BaseClient.Put(): %v", err)
+	}
+	return status, nil
+}
@@ -8,8 +8,11 @@ import (
 )
 
 type Client struct {
-	AadClient *graphrbac.ApplicationsClient
-	MsClient  *msgraph.ApplicationsClient
+	AadClient                 *graphrbac.ApplicationsClient
+	MsClient                  *msgraph.ApplicationsClient
+	ApplicationProxyClient    *ApplicationProxyClient
+	ExtensionPropertiesClient *ExtensionPropertiesClient
+	ReportsClient             *ReportsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,8 +20,20 @@ func NewClient(o *common.ClientOptions) *Client {
 	msClient := msgraph.NewApplicationsClient(o.TenantID)
 	o.ConfigureClient(&msClient.BaseClient, &aadClient.Client)
 
+	applicationProxyClient := NewApplicationProxyClient(o.TenantID)
+	o.ConfigureMsGraphBetaClient(&applicationProxyClient.BaseClient)
+
+	extensionPropertiesClient := NewExtensionPropertiesClient(o.TenantID)
+	o.ConfigureMsGraphClient(&extensionPropertiesClient.BaseClient)
+
+	reportsClient := NewReportsClient(o.TenantID)
+	o.ConfigureMsGraphBetaClient(&reportsClient.BaseClient)
+
 	return &Client{
-		AadClient: &aadClient,
-		MsClient:  msClient,
+		AadClient:                 &aadClient,
+		MsClient:                  msClient,
+		ApplicationProxyClient:    applicationProxyClient,
+		ExtensionPropertiesClient: extensionPropertiesClient,
+		ReportsClient:             reportsClient,
 	}
 }
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// ExtensionProperty describes a directory extension property registered on an Application.
+type ExtensionProperty struct {
+	ID                     *string   `json:"id,omitempty"`
+	AppDisplayName         *string   `json:"appDisplayName,omitempty"`
+	DataType               *string   `json:"dataType,omitempty"`
+	IsSyncedFromOnPremises *bool     `json:"isSyncedFromOnPremises,omitempty"`
+	Name                   *string   `json:"name,omitempty"`
+	TargetObjects          *[]string `json:"targetObjects,omitempty"`
+}
+
+const (
+	ExtensionPropertyDataTypeBinary       = "Binary"
+	ExtensionPropertyDataTypeBoolean      = "Boolean"
+	ExtensionPropertyDataTypeDateTime     = "DateTime"
+	ExtensionPropertyDataTypeInteger      = "Integer"
+	ExtensionPropertyDataTypeLargeInteger = "LargeInteger"
+	ExtensionPropertyDataTypeString       = "String"
+)
+
+const (
+	ExtensionPropertyTargetObjectApplication  = "Application"
+	ExtensionPropertyTargetObjectDevice       = "Device"
+	ExtensionPropertyTargetObjectGroup        = "Group"
+	ExtensionPropertyTargetObjectOrganization = "Organization"
+	ExtensionPropertyTargetObjectUser         = "User"
+)
+
+// ExtensionPropertiesClient performs operations on the directory extension properties of Applications.
+type ExtensionPropertiesClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewExtensionPropertiesClient returns a new ExtensionPropertiesClient.
+func NewExtensionPropertiesClient(tenantId string) *ExtensionPropertiesClient {
+	return &ExtensionPropertiesClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create registers a new extension property on an Application.
+func (c *ExtensionPropertiesClient) Create(ctx context.Context, applicationId string, property ExtensionProperty) (*ExtensionProperty, int, error) {
+	var status int
+	body, err := json.Marshal(property)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/extensionProperties", applicationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ExtensionPropertiesClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newProperty ExtensionProperty
+	if err := json.Unmarshal(respBody, &newProperty); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newProperty, status, nil
+}
+
+// Get retrieves an extension property registered on an Application.
+func (c *ExtensionPropertiesClient) Get(ctx context.Context, applicationId, id string) (*ExtensionProperty, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationId, id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ExtensionPropertiesClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var property ExtensionProperty
+	if err := json.Unmarshal(respBody, &property); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &property, status, nil
+}
+
+// Delete removes an extension property from an Application.
+func (c *ExtensionPropertiesClient) Delete(ctx context.Context, applicationId, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/applications/%s/extensionProperties/%s", applicationId, id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ExtensionPropertiesClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
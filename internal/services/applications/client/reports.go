@@ -0,0 +1,17 @@
+package client
+
+import (
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// ReportsClient is used to retrieve sign-in reports for Applications. These are only available in the beta API.
+type ReportsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewReportsClient returns a new ReportsClient.
+func NewReportsClient(tenantId string) *ReportsClient {
+	return &ReportsClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta, tenantId),
+	}
+}
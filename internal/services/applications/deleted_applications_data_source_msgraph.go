@@ -0,0 +1,68 @@
+package applications
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func deletedApplicationsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.MsClient
+
+	items, _, err := helpers.DeletedItemsList(ctx, client.BaseClient, helpers.DeletedItemTypeApplication)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve deleted applications")
+	}
+
+	result := make([]map[string]interface{}, 0, len(items))
+	objectIds := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.ID == nil {
+			return tf.ErrorDiagF(errors.New("API returned deleted application with nil object ID"), "Bad API response")
+		}
+		objectId := *item.ID
+		objectIds = append(objectIds, objectId)
+
+		applicationId := ""
+		if item.AppId != nil {
+			applicationId = *item.AppId
+		}
+		deletedDate := ""
+		if item.DeletedDateTime != nil {
+			deletedDate = item.DeletedDateTime.Format(time.RFC3339)
+		}
+		displayName := ""
+		if item.DisplayName != nil {
+			displayName = *item.DisplayName
+		}
+
+		result = append(result, map[string]interface{}{
+			"application_id": applicationId,
+			"deleted_date":   deletedDate,
+			"display_name":   displayName,
+			"object_id":      objectId,
+		})
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(objectIds, "-"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("deletedApplications#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "applications", result)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package parse
+
+import "fmt"
+
+type ExtensionPropertyId struct {
+	ObjectId            string
+	ExtensionPropertyId string
+}
+
+func NewExtensionPropertyID(objectId, extensionPropertyId string) ExtensionPropertyId {
+	return ExtensionPropertyId{
+		ObjectId:            objectId,
+		ExtensionPropertyId: extensionPropertyId,
+	}
+}
+
+func (id ExtensionPropertyId) String() string {
+	return id.ObjectId + "/extensionProperty/" + id.ExtensionPropertyId
+}
+
+func ExtensionPropertyID(idString string) (*ExtensionPropertyId, error) {
+	id, err := ObjectSubResourceID(idString, "extensionProperty")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Extension Property ID: %v", err)
+	}
+
+	return &ExtensionPropertyId{
+		ObjectId:            id.objectId,
+		ExtensionPropertyId: id.subId,
+	}, nil
+}
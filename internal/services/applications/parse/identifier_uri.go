@@ -0,0 +1,50 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type IdentifierUriId struct {
+	ObjectId      string
+	IdentifierUri string
+}
+
+func NewIdentifierUriID(objectId, identifierUri string) IdentifierUriId {
+	return IdentifierUriId{
+		ObjectId:      objectId,
+		IdentifierUri: identifierUri,
+	}
+}
+
+func (id IdentifierUriId) String() string {
+	return id.ObjectId + "/identifierUri/" + id.IdentifierUri
+}
+
+// IdentifierUriID parses an identifier URI ID. Since identifier URIs commonly contain slashes, everything following
+// the type segment is treated as the URI.
+func IdentifierUriID(idString string) (*IdentifierUriId, error) {
+	parts := strings.SplitN(idString, "/", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Identifier URI ID should be in the format {objectId}/identifierUri/{identifierUri} - but got %q", idString)
+	}
+
+	if _, err := uuid.ParseUUID(parts[0]); err != nil {
+		return nil, fmt.Errorf("Object ID isn't a valid UUID (%q): %+v", parts[0], err)
+	}
+
+	if parts[1] != "identifierUri" {
+		return nil, fmt.Errorf("Type in {objectID}/{type}/{identifierUri} was expected to be identifierUri, got %s", parts[1])
+	}
+
+	if parts[2] == "" {
+		return nil, fmt.Errorf("Identifier URI in {objectID}/identifierUri/{identifierUri} should not be empty")
+	}
+
+	return &IdentifierUriId{
+		ObjectId:      parts[0],
+		IdentifierUri: parts[2],
+	}, nil
+}
@@ -0,0 +1,31 @@
+package parse
+
+import "fmt"
+
+type KnownClientId struct {
+	ObjectId    string
+	ClientAppId string
+}
+
+func NewKnownClientID(objectId, clientAppId string) KnownClientId {
+	return KnownClientId{
+		ObjectId:    objectId,
+		ClientAppId: clientAppId,
+	}
+}
+
+func (id KnownClientId) String() string {
+	return id.ObjectId + "/knownClient/" + id.ClientAppId
+}
+
+func KnownClientID(idString string) (*KnownClientId, error) {
+	id, err := ObjectSubResourceID(idString, "knownClient")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Known Client ID: %v", err)
+	}
+
+	return &KnownClientId{
+		ObjectId:    id.objectId,
+		ClientAppId: id.subId,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type OptionalClaimId struct {
+	ObjectId  string
+	TokenType string
+	Name      string
+}
+
+func NewOptionalClaimID(objectId, tokenType, name string) OptionalClaimId {
+	return OptionalClaimId{
+		ObjectId:  objectId,
+		TokenType: tokenType,
+		Name:      name,
+	}
+}
+
+func (id OptionalClaimId) String() string {
+	return id.ObjectId + "/optionalClaim/" + id.TokenType + "/" + id.Name
+}
+
+func OptionalClaimID(idString string) (*OptionalClaimId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("Optional Claim ID should be in the format {objectId}/optionalClaim/{tokenType}/{name} - but got %q", idString)
+	}
+
+	if _, err := uuid.ParseUUID(parts[0]); err != nil {
+		return nil, fmt.Errorf("Object ID isn't a valid UUID (%q): %+v", parts[0], err)
+	}
+
+	if parts[1] != "optionalClaim" {
+		return nil, fmt.Errorf("Type in {objectID}/{type}/{tokenType}/{name} was expected to be optionalClaim, got %s", parts[1])
+	}
+
+	if parts[2] == "" {
+		return nil, fmt.Errorf("Token type in {objectID}/optionalClaim/{tokenType}/{name} should not be empty")
+	}
+
+	if parts[3] == "" {
+		return nil, fmt.Errorf("Name in {objectID}/optionalClaim/{tokenType}/{name} should not be empty")
+	}
+
+	return &OptionalClaimId{
+		ObjectId:  parts[0],
+		TokenType: parts[2],
+		Name:      parts[3],
+	}, nil
+}
@@ -21,7 +21,12 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_application": applicationDataSource(),
+		"azuread_application":                  applicationDataSource(),
+		"azuread_application_credentials":      applicationCredentialsDataSource(),
+		"azuread_application_sign_in_activity": applicationSignInActivityDataSource(),
+		"azuread_application_template":         applicationTemplateDataSource(),
+		"azuread_applications":                 applicationsDataSource(),
+		"azuread_deleted_applications":         deletedApplicationsDataSource(),
 	}
 }
 
@@ -31,8 +36,13 @@ func (r Registration) SupportedResources() map[string]*schema.Resource {
 		"azuread_application":                         applicationResource(),
 		"azuread_application_app_role":                applicationAppRoleResource(),
 		"azuread_application_certificate":             applicationCertificateResource(),
+		"azuread_application_extension_property":      applicationExtensionPropertyResource(),
+		"azuread_application_identifier_uri":          applicationIdentifierUriResource(),
+		"azuread_application_known_client":            applicationKnownClientResource(),
 		"azuread_application_oauth2_permission":       applicationOAuth2PermissionResource(), // TODO: v2.0 remove this resource
 		"azuread_application_oauth2_permission_scope": applicationOAuth2PermissionScopeResource(),
+		"azuread_application_optional_claim":          applicationOptionalClaimResource(),
 		"azuread_application_password":                applicationPasswordResource(),
+		"azuread_application_proxy":                   applicationProxyResource(),
 	}
 }
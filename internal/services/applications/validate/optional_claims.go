@@ -0,0 +1,75 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// optionalClaimAdditionalProperties lists the additional properties documented for each optional claim which supports
+// them. See https://docs.microsoft.com/en-us/azure/active-directory/develop/active-directory-optional-claims#additional-properties-of-optional-claims
+var optionalClaimAdditionalProperties = map[string][]string{
+	"aud": {
+		"use_guid",
+	},
+	"groups": {
+		"cloud_displayname",
+		"dns_domain_and_sam_account_name",
+		"emit_as_roles",
+		"netbios_domain_and_sam_account_name",
+		"sam_account_name",
+	},
+	"upn": {
+		"include_externally_authenticated_upn",
+		"include_externally_authenticated_upn_without_hash",
+	},
+}
+
+// groupsClaimFormats are the mutually exclusive formats in which on-premises group names can be emitted.
+var groupsClaimFormats = []string{
+	"dns_domain_and_sam_account_name",
+	"netbios_domain_and_sam_account_name",
+	"sam_account_name",
+}
+
+// OptionalClaimAdditionalProperties checks that the additional properties specified for one of the documented optional
+// claims do not include a property documented for a different claim, nor more than one format for on-premises group
+// names, since the API accepts these but tokens are then issued incorrectly. Other claims and properties, such as
+// `emit_as_roles` for directory extension claims, are accepted as-is.
+func OptionalClaimAdditionalProperties(name string, properties []string) error {
+	supported, ok := optionalClaimAdditionalProperties[name]
+	if !ok || len(properties) == 0 {
+		return nil
+	}
+
+	formats := make([]string, 0)
+	for _, property := range properties {
+		if !contains(supported, property) {
+			for claim, v := range optionalClaimAdditionalProperties {
+				if claim != name && contains(v, property) {
+					return fmt.Errorf("additional property %q is not supported by the %q claim, it is only supported by the %q claim", property, name, claim)
+				}
+			}
+		}
+
+		if contains(groupsClaimFormats, property) {
+			formats = append(formats, property)
+		}
+	}
+
+	if len(formats) > 1 {
+		sort.Strings(formats)
+		return fmt.Errorf("only one of %s can be specified for the %q claim", strings.Join(formats, ", "), name)
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestOptionalClaimAdditionalProperties(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Properties []string
+		TestName   string
+		Error      bool
+	}{
+		{
+			Name:     "email",
+			TestName: "Valid_NoProperties",
+			Error:    false,
+		},
+		{
+			Name:       "groups",
+			Properties: []string{"sam_account_name", "emit_as_roles"},
+			TestName:   "Valid_Groups",
+			Error:      false,
+		},
+		{
+			Name:       "upn",
+			Properties: []string{"include_externally_authenticated_upn"},
+			TestName:   "Valid_Upn",
+			Error:      false,
+		},
+		{
+			Name:       "aud",
+			Properties: []string{"use_guid"},
+			TestName:   "Valid_Aud",
+			Error:      false,
+		},
+		{
+			Name:       "groups",
+			Properties: []string{"cloud_displayname"},
+			TestName:   "Valid_GroupsCloudDisplayName",
+			Error:      false,
+		},
+		{
+			Name:       "userclaim",
+			Properties: []string{"emit_as_roles"},
+			TestName:   "Valid_OtherClaim",
+			Error:      false,
+		},
+		{
+			Name:       "groups",
+			Properties: []string{"some_new_property"},
+			TestName:   "Valid_UndocumentedProperty",
+			Error:      false,
+		},
+		{
+			Name:       "aud",
+			Properties: []string{"include_externally_authenticated_upn"},
+			TestName:   "Invalid_PropertyOfOtherClaim",
+			Error:      true,
+		},
+		{
+			Name:       "upn",
+			Properties: []string{"emit_as_roles"},
+			TestName:   "Invalid_UnsupportedProperty",
+			Error:      true,
+		},
+		{
+			Name:       "groups",
+			Properties: []string{"sam_account_name", "netbios_domain_and_sam_account_name"},
+			TestName:   "Invalid_MultipleGroupFormats",
+			Error:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := OptionalClaimAdditionalProperties(tc.Name, tc.Properties)
+			if tc.Error && err == nil {
+				t.Fatalf("Expected an error for claim %q with properties %v", tc.Name, tc.Properties)
+			}
+			if !tc.Error && err != nil {
+				t.Fatalf("Expected no error for claim %q with properties %v, got: %v", tc.Name, tc.Properties, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// RedirectURIsMaxCount is the maximum number of redirect URIs which can be registered for an application.
+// See https://docs.microsoft.com/en-us/azure/active-directory/develop/reply-url
+const RedirectURIsMaxCount = 256
+
+// RedirectURIMaxLength is the maximum length of a single redirect URI
+const RedirectURIMaxLength = 256
+
+// WebRedirectURI checks whether a value is valid for use as a redirect URI for the web platform. Redirect URIs must be
+// absolute, must not contain a fragment, and may only use the http scheme when the host is localhost. Wildcards are
+// only permitted as the leftmost label of the host.
+func WebRedirectURI(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if v == "" {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Redirect URI must not be empty",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if len(v) > RedirectURIMaxLength {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Redirect URI must not be longer than %d characters", RedirectURIMaxLength),
+			AttributePath: path,
+		})
+	}
+
+	if strings.Contains(v, "#") {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Redirect URI must not contain a fragment",
+			AttributePath: path,
+		})
+	}
+
+	u, err := url.Parse(v)
+	if err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Redirect URI is in an invalid format",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+		return
+	}
+
+	if !u.IsAbs() {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Redirect URI must be an absolute URI",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if strings.EqualFold(u.Scheme, "http") && !redirectURIIsLocalhost(u.Hostname()) {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Redirect URI must use the https scheme unless the host is localhost",
+			AttributePath: path,
+		})
+	}
+
+	if strings.Contains(v, "*") {
+		if _, ok := RedirectURIWildcardDomain(v); !ok {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Wildcards in redirect URIs are only supported as the leftmost label of the host, e.g. https://*.example.com",
+				AttributePath: path,
+			})
+		}
+	}
+
+	return
+}
+
+// RedirectURIWildcardDomain returns the domain following a wildcard host label in the provided redirect URI. It returns
+// false when the redirect URI does not contain a wildcard, or when the wildcard is not the leftmost label of the host.
+func RedirectURIWildcardDomain(uri string) (string, bool) {
+	if strings.Count(uri, "*") != 1 {
+		return "", false
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil || !strings.HasPrefix(u.Hostname(), "*.") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(u.Hostname(), "*."), true
+}
+
+func redirectURIIsLocalhost(host string) bool {
+	return strings.EqualFold(host, "localhost") || host == "127.0.0.1" || host == "::1"
+}
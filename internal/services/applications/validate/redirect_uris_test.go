@@ -0,0 +1,114 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestWebRedirectURI(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "https://example.com/auth",
+			TestName: "Valid_HTTPS",
+			ErrCount: 0,
+		},
+		{
+			Value:    "http://localhost:8080/auth",
+			TestName: "Valid_HTTPLocalhost",
+			ErrCount: 0,
+		},
+		{
+			Value:    "http://127.0.0.1/auth",
+			TestName: "Valid_HTTPLoopback",
+			ErrCount: 0,
+		},
+		{
+			Value:    "https://*.example.com/auth",
+			TestName: "Valid_Wildcard",
+			ErrCount: 0,
+		},
+		{
+			Value:    "myapp://auth",
+			TestName: "Valid_CustomScheme",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "/auth",
+			TestName: "Invalid_Relative",
+			ErrCount: 1,
+		},
+		{
+			Value:    "http://example.com/auth",
+			TestName: "Invalid_HTTP",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://example.com/auth#fragment",
+			TestName: "Invalid_Fragment",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://example.com/*/auth",
+			TestName: "Invalid_WildcardPath",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://app.*.example.com/auth",
+			TestName: "Invalid_WildcardLabel",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://example.com/" + strings.Repeat("a", 250),
+			TestName: "Invalid_TooLong",
+			ErrCount: 1,
+		},
+		{
+			Value:    "http://example.com/*#fragment",
+			TestName: "Invalid_Multiple",
+			ErrCount: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := WebRedirectURI(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected WebRedirectURI to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.Value)
+			}
+		})
+	}
+}
+
+func TestRedirectURIWildcardDomain(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Domain   string
+		Wildcard bool
+	}{
+		{"https://*.example.com/auth", "example.com", true},
+		{"https://*.app.example.com", "app.example.com", true},
+		{"https://example.com/auth", "", false},
+		{"https://example.com/*", "", false},
+		{"https://app.*.example.com", "", false},
+		{"https://*.*.example.com", "", false},
+	}
+
+	for _, tc := range cases {
+		domain, ok := RedirectURIWildcardDomain(tc.Value)
+		if ok != tc.Wildcard || domain != tc.Domain {
+			t.Errorf("RedirectURIWildcardDomain(%q): expected (%q, %t), got (%q, %t)", tc.Value, tc.Domain, tc.Wildcard, domain, ok)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package customsecurityattributes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+const attributeSetResourceName = "azuread_attribute_set"
+
+func attributeSetResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: attributeSetResourceCreate,
+		ReadContext:   attributeSetResourceRead,
+		UpdateContext: attributeSetResourceUpdate,
+		DeleteContext: attributeSetResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if !attributeNameRegex.MatchString(id) {
+				return fmt.Errorf("specified ID (%q) is not a valid attribute set name", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(attributeNameRegex, "name must contain only alphanumeric characters and be at most 32 characters long"),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+
+			"max_attributes_per_set": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+		},
+	}
+}
+
+// Attribute sets and custom security attribute definitions share the same naming restrictions
+var attributeNameRegex = regexp.MustCompile(`^[A-Za-z0-9]{1,32}$`)
+
+func attributeSetResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(attributeSetResourceName); diags != nil {
+		return diags
+	}
+	return attributeSetResourceCreateMsGraph(ctx, d, meta)
+}
+
+func attributeSetResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(attributeSetResourceName); diags != nil {
+		return diags
+	}
+	return attributeSetResourceReadMsGraph(ctx, d, meta)
+}
+
+func attributeSetResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(attributeSetResourceName); diags != nil {
+		return diags
+	}
+	return attributeSetResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func attributeSetResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(attributeSetResourceName); diags != nil {
+		return diags
+	}
+	return attributeSetResourceDeleteMsGraph(ctx, d, meta)
+}
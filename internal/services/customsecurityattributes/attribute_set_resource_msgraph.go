@@ -0,0 +1,96 @@
+package customsecurityattributes
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/customsecurityattributes/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func attributeSetResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).CustomSecurityAttributes.AttributeSetsClient
+	name := d.Get("name").(string)
+
+	existing, status, err := c.Get(ctx, name)
+	if err != nil && status != http.StatusNotFound {
+		return tf.ErrorDiagPathF(err, "name", "Checking for existing attribute set %q", name)
+	}
+	if err == nil && existing != nil {
+		return tf.ImportAsExistsDiag(attributeSetResourceName, name)
+	}
+
+	properties := client.AttributeSet{
+		ID:          utils.String(name),
+		Description: utils.String(d.Get("description").(string)),
+	}
+	if v, ok := d.GetOk("max_attributes_per_set"); ok {
+		properties.MaxAttributesPerSet = utils.Int32(int32(v.(int)))
+	}
+
+	if _, _, err := c.Create(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Creating attribute set %q", name)
+	}
+
+	d.SetId(name)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, name)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for attribute set %q", name)
+	}
+
+	return attributeSetResourceReadMsGraph(ctx, d, meta)
+}
+
+func attributeSetResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).CustomSecurityAttributes.AttributeSetsClient
+
+	attributeSet, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Attribute set %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving attribute set %q", d.Id())
+	}
+
+	tf.Set(d, "description", attributeSet.Description)
+	tf.Set(d, "max_attributes_per_set", attributeSet.MaxAttributesPerSet)
+	tf.Set(d, "name", attributeSet.ID)
+
+	return nil
+}
+
+func attributeSetResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).CustomSecurityAttributes.AttributeSetsClient
+
+	properties := client.AttributeSet{
+		ID:          utils.String(d.Id()),
+		Description: utils.String(d.Get("description").(string)),
+	}
+	if d.HasChange("max_attributes_per_set") {
+		properties.MaxAttributesPerSet = utils.Int32(int32(d.Get("max_attributes_per_set").(int)))
+	}
+
+	if _, err := c.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating attribute set %q", d.Id())
+	}
+
+	return attributeSetResourceReadMsGraph(ctx, d, meta)
+}
+
+func attributeSetResourceDeleteMsGraph(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Attribute sets cannot be deleted, so we simply remove the resource from state
+	log.Printf("[DEBUG] Attribute set %q cannot be deleted and will remain in the tenant - removing from state", d.Id())
+	return nil
+}
@@ -0,0 +1,89 @@
+package customsecurityattributes_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AttributeSetResource struct{}
+
+// Attribute sets cannot be deleted, so these tests are unable to check for destruction
+
+func TestAccAttributeSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_attribute_set", "test")
+	r := AttributeSetResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAttributeSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_attribute_set", "test")
+	r := AttributeSetResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("max_attributes_per_set").HasValue("50"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AttributeSetResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	attributeSet, status, err := clients.CustomSecurityAttributes.AttributeSetsClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Attribute Set %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Attribute Set %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(attributeSet.ID != nil && *attributeSet.ID == state.ID), nil
+}
+
+func (AttributeSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name        = "acctest%[1]s"
+  description = "Acceptance test attribute set"
+}
+`, data.RandomString)
+}
+
+func (AttributeSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name                   = "acctest%[1]s"
+  description            = "Acceptance test attribute set (updated)"
+  max_attributes_per_set = 50
+}
+`, data.RandomString)
+}
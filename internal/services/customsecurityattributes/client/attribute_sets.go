@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// AttributeSet describes a collection of related custom security attribute definitions.
+type AttributeSet struct {
+	ID                  *string `json:"id,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	MaxAttributesPerSet *int32  `json:"maxAttributesPerSet,omitempty"`
+}
+
+// AttributeSetsClient performs operations on Attribute Sets.
+type AttributeSetsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAttributeSetsClient returns a new AttributeSetsClient.
+func NewAttributeSetsClient(tenantId string) *AttributeSetsClient {
+	return &AttributeSetsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create creates a new Attribute Set.
+func (c *AttributeSetsClient) Create(ctx context.Context, attributeSet AttributeSet) (*AttributeSet, int, error) {
+	var status int
+	body, err := json.Marshal(attributeSet)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/directory/attributeSets",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AttributeSetsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newAttributeSet AttributeSet
+	if err := json.Unmarshal(respBody, &newAttributeSet); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newAttributeSet, status, nil
+}
+
+// Get retrieves an Attribute Set.
+func (c *AttributeSetsClient) Get(ctx context.Context, id string) (*AttributeSet, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/attributeSets/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AttributeSetsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var attributeSet AttributeSet
+	if err := json.Unmarshal(respBody, &attributeSet); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &attributeSet, status, nil
+}
+
+// Update amends an existing Attribute Set.
+func (c *AttributeSetsClient) Update(ctx context.Context, attributeSet AttributeSet) (int, error) {
+	var status int
+	if attributeSet.ID == nil {
+		return status, fmt.Errorf("cannot update attribute set with nil ID")
+	}
+	id := *attributeSet.ID
+	attributeSet.ID = nil
+	body, err := json.Marshal(attributeSet)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/attributeSets/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AttributeSetsClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	AttributeSetsClient                      *AttributeSetsClient
+	CustomSecurityAttributeDefinitionsClient *CustomSecurityAttributeDefinitionsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	attributeSetsClient := NewAttributeSetsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&attributeSetsClient.BaseClient)
+
+	customSecurityAttributeDefinitionsClient := NewCustomSecurityAttributeDefinitionsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&customSecurityAttributeDefinitionsClient.BaseClient)
+
+	return &Client{
+		AttributeSetsClient:                      attributeSetsClient,
+		CustomSecurityAttributeDefinitionsClient: customSecurityAttributeDefinitionsClient,
+	}
+}
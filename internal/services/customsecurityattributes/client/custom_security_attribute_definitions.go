@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// CustomSecurityAttributeDefinition describes a custom security attribute which can be assigned to directory objects.
+type CustomSecurityAttributeDefinition struct {
+	ID                      *string `json:"id,omitempty"`
+	AttributeSet            *string `json:"attributeSet,omitempty"`
+	Description             *string `json:"description,omitempty"`
+	IsCollection            *bool   `json:"isCollection,omitempty"`
+	IsSearchable            *bool   `json:"isSearchable,omitempty"`
+	Name                    *string `json:"name,omitempty"`
+	Status                  *string `json:"status,omitempty"`
+	Type                    *string `json:"type,omitempty"`
+	UsePreDefinedValuesOnly *bool   `json:"usePreDefinedValuesOnly,omitempty"`
+}
+
+const (
+	CustomSecurityAttributeDefinitionStatusAvailable  = "Available"
+	CustomSecurityAttributeDefinitionStatusDeprecated = "Deprecated"
+)
+
+const (
+	CustomSecurityAttributeTypeBoolean = "Boolean"
+	CustomSecurityAttributeTypeInteger = "Integer"
+	CustomSecurityAttributeTypeString  = "String"
+)
+
+// CustomSecurityAttributeDefinitionsClient performs operations on Custom Security Attribute Definitions.
+type CustomSecurityAttributeDefinitionsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewCustomSecurityAttributeDefinitionsClient returns a new CustomSecurityAttributeDefinitionsClient.
+func NewCustomSecurityAttributeDefinitionsClient(tenantId string) *CustomSecurityAttributeDefinitionsClient {
+	return &CustomSecurityAttributeDefinitionsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create creates a new Custom Security Attribute Definition.
+func (c *CustomSecurityAttributeDefinitionsClient) Create(ctx context.Context, definition CustomSecurityAttributeDefinition) (*CustomSecurityAttributeDefinition, int, error) {
+	var status int
+	body, err := json.Marshal(definition)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/directory/customSecurityAttributeDefinitions",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CustomSecurityAttributeDefinitionsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newDefinition CustomSecurityAttributeDefinition
+	if err := json.Unmarshal(respBody, &newDefinition); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newDefinition, status, nil
+}
+
+// Get retrieves a Custom Security Attribute Definition.
+func (c *CustomSecurityAttributeDefinitionsClient) Get(ctx context.Context, id string) (*CustomSecurityAttributeDefinition, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CustomSecurityAttributeDefinitionsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var definition CustomSecurityAttributeDefinition
+	if err := json.Unmarshal(respBody, &definition); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &definition, status, nil
+}
+
+// Update amends an existing Custom Security Attribute Definition.
+func (c *CustomSecurityAttributeDefinitionsClient) Update(ctx context.Context, definition CustomSecurityAttributeDefinition) (int, error) {
+	var status int
+	if definition.ID == nil {
+		return status, fmt.Errorf("cannot update custom security attribute definition with nil ID")
+	}
+	id := *definition.ID
+	definition.ID = nil
+	body, err := json.Marshal(definition)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/directory/customSecurityAttributeDefinitions/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CustomSecurityAttributeDefinitionsClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,133 @@
+package customsecurityattributes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/customsecurityattributes/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+const customSecurityAttributeDefinitionResourceName = "azuread_custom_security_attribute_definition"
+
+func customSecurityAttributeDefinitionResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: customSecurityAttributeDefinitionResourceCreate,
+		ReadContext:   customSecurityAttributeDefinitionResourceRead,
+		UpdateContext: customSecurityAttributeDefinitionResourceUpdate,
+		DeleteContext: customSecurityAttributeDefinitionResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			parts := strings.Split(id, "_")
+			if len(parts) != 2 || !attributeNameRegex.MatchString(parts[0]) || !attributeNameRegex.MatchString(parts[1]) {
+				return fmt.Errorf("specified ID (%q) is not valid, expected the format {attributeSet}_{name}", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"attribute_set": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(attributeNameRegex, "attribute_set must contain only alphanumeric characters and be at most 32 characters long"),
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(attributeNameRegex, "name must contain only alphanumeric characters and be at most 32 characters long"),
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.CustomSecurityAttributeTypeBoolean,
+					client.CustomSecurityAttributeTypeInteger,
+					client.CustomSecurityAttributeTypeString,
+				}, false),
+			},
+
+			"collection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+
+			"predefined_values_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"searchable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  client.CustomSecurityAttributeDefinitionStatusAvailable,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.CustomSecurityAttributeDefinitionStatusAvailable,
+					client.CustomSecurityAttributeDefinitionStatusDeprecated,
+				}, false),
+			},
+		},
+	}
+}
+
+func customSecurityAttributeDefinitionResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(customSecurityAttributeDefinitionResourceName); diags != nil {
+		return diags
+	}
+	return customSecurityAttributeDefinitionResourceCreateMsGraph(ctx, d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(customSecurityAttributeDefinitionResourceName); diags != nil {
+		return diags
+	}
+	return customSecurityAttributeDefinitionResourceReadMsGraph(ctx, d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(customSecurityAttributeDefinitionResourceName); diags != nil {
+		return diags
+	}
+	return customSecurityAttributeDefinitionResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(customSecurityAttributeDefinitionResourceName); diags != nil {
+		return diags
+	}
+	return customSecurityAttributeDefinitionResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,141 @@
+package customsecurityattributes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/customsecurityattributes/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func customSecurityAttributeDefinitionResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).CustomSecurityAttributes.CustomSecurityAttributeDefinitionsClient
+	attributeSet := d.Get("attribute_set").(string)
+	name := d.Get("name").(string)
+	id := fmt.Sprintf("%s_%s", attributeSet, name)
+
+	if d.Get("predefined_values_only").(bool) && d.Get("type").(string) != client.CustomSecurityAttributeTypeString {
+		return tf.ErrorDiagPathF(nil, "predefined_values_only", "Predefined values are only supported for attributes of type %q", client.CustomSecurityAttributeTypeString)
+	}
+
+	if d.Get("collection").(bool) && d.Get("type").(string) == client.CustomSecurityAttributeTypeBoolean {
+		return tf.ErrorDiagPathF(nil, "collection", "Attributes of type %q cannot be collections", client.CustomSecurityAttributeTypeBoolean)
+	}
+
+	existing, status, err := c.Get(ctx, id)
+	if err != nil && status != http.StatusNotFound {
+		return tf.ErrorDiagF(err, "Checking for existing custom security attribute definition %q", id)
+	}
+	if err == nil && existing != nil {
+		return tf.ImportAsExistsDiag(customSecurityAttributeDefinitionResourceName, id)
+	}
+
+	properties := client.CustomSecurityAttributeDefinition{
+		AttributeSet:            utils.String(attributeSet),
+		Description:             utils.String(d.Get("description").(string)),
+		IsCollection:            utils.Bool(d.Get("collection").(bool)),
+		IsSearchable:            utils.Bool(d.Get("searchable").(bool)),
+		Name:                    utils.String(name),
+		Status:                  utils.String(d.Get("status").(string)),
+		Type:                    utils.String(d.Get("type").(string)),
+		UsePreDefinedValuesOnly: utils.Bool(d.Get("predefined_values_only").(bool)),
+	}
+
+	definition, _, err := c.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating custom security attribute definition %q", id)
+	}
+
+	if definition.ID == nil || *definition.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned"), "Bad API response for custom security attribute definition %q", id)
+	}
+
+	d.SetId(*definition.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, *definition.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for custom security attribute definition %q", *definition.ID)
+	}
+
+	return customSecurityAttributeDefinitionResourceReadMsGraph(ctx, d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).CustomSecurityAttributes.CustomSecurityAttributeDefinitionsClient
+
+	definition, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Custom security attribute definition %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving custom security attribute definition %q", d.Id())
+	}
+
+	tf.Set(d, "attribute_set", definition.AttributeSet)
+	tf.Set(d, "collection", definition.IsCollection)
+	tf.Set(d, "description", definition.Description)
+	tf.Set(d, "name", definition.Name)
+	tf.Set(d, "predefined_values_only", definition.UsePreDefinedValuesOnly)
+	tf.Set(d, "searchable", definition.IsSearchable)
+	tf.Set(d, "status", definition.Status)
+	tf.Set(d, "type", definition.Type)
+
+	return nil
+}
+
+func customSecurityAttributeDefinitionResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).CustomSecurityAttributes.CustomSecurityAttributeDefinitionsClient
+
+	if d.Get("predefined_values_only").(bool) && d.Get("type").(string) != client.CustomSecurityAttributeTypeString {
+		return tf.ErrorDiagPathF(nil, "predefined_values_only", "Predefined values are only supported for attributes of type %q", client.CustomSecurityAttributeTypeString)
+	}
+
+	properties := client.CustomSecurityAttributeDefinition{
+		ID:                      utils.String(d.Id()),
+		Description:             utils.String(d.Get("description").(string)),
+		Status:                  utils.String(d.Get("status").(string)),
+		UsePreDefinedValuesOnly: utils.Bool(d.Get("predefined_values_only").(bool)),
+	}
+
+	if _, err := c.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating custom security attribute definition %q", d.Id())
+	}
+
+	return customSecurityAttributeDefinitionResourceReadMsGraph(ctx, d, meta)
+}
+
+func customSecurityAttributeDefinitionResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).CustomSecurityAttributes.CustomSecurityAttributeDefinitionsClient
+
+	_, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Custom security attribute definition was not found"), "id", "Retrieving custom security attribute definition %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving custom security attribute definition %q", d.Id())
+	}
+
+	// Custom security attribute definitions cannot be deleted, so deactivate the attribute instead
+	properties := client.CustomSecurityAttributeDefinition{
+		ID:     utils.String(d.Id()),
+		Status: utils.String(client.CustomSecurityAttributeDefinitionStatusDeprecated),
+	}
+
+	if _, err := c.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Deactivating custom security attribute definition %q", d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package customsecurityattributes_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type CustomSecurityAttributeDefinitionResource struct{}
+
+// Custom security attribute definitions are deactivated rather than deleted, so these tests are unable to check for destruction
+
+func TestAccCustomSecurityAttributeDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_security_attribute_definition", "test")
+	r := CustomSecurityAttributeDefinitionResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Available"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomSecurityAttributeDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_custom_security_attribute_definition", "test")
+	r := CustomSecurityAttributeDefinitionResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Deprecated"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CustomSecurityAttributeDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	definition, status, err := clients.CustomSecurityAttributes.CustomSecurityAttributeDefinitionsClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Custom Security Attribute Definition %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Custom Security Attribute Definition %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(definition.ID != nil && *definition.ID == state.ID), nil
+}
+
+func (CustomSecurityAttributeDefinitionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name        = "acctest%[1]s"
+  description = "Acceptance test attribute set"
+}
+`, data.RandomString)
+}
+
+func (r CustomSecurityAttributeDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "acctestDefinition"
+  type          = "String"
+}
+`, r.template(data))
+}
+
+func (r CustomSecurityAttributeDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set          = azuread_attribute_set.test.name
+  name                   = "acctestDefinition"
+  type                   = "String"
+  description            = "Acceptance test definition"
+  predefined_values_only = false
+  status                 = "Deprecated"
+}
+`, r.template(data))
+}
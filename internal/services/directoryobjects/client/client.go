@@ -0,0 +1,20 @@
+package client
+
+import (
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	MsClient *msgraph.Client
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	msClient := msgraph.NewClient(msgraph.Version10, o.TenantID)
+	o.ConfigureMsGraphClient(&msClient)
+
+	return &Client{
+		MsClient: &msClient,
+	}
+}
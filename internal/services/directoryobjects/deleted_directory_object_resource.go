@@ -0,0 +1,89 @@
+package directoryobjects
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const (
+	deletedDirectoryObjectResourceName = "azuread_deleted_directory_object"
+
+	deletedDirectoryObjectActionPurge   = "purge"
+	deletedDirectoryObjectActionRestore = "restore"
+)
+
+func deletedDirectoryObjectResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: deletedDirectoryObjectResourceCreate,
+		ReadContext:   deletedDirectoryObjectResourceRead,
+		DeleteContext: deletedDirectoryObjectResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					deletedDirectoryObjectActionPurge,
+					deletedDirectoryObjectActionRestore,
+				}, false),
+			},
+
+			"deleted_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func deletedDirectoryObjectResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(deletedDirectoryObjectResourceName); diags != nil {
+		return diags
+	}
+	return deletedDirectoryObjectResourceCreateMsGraph(ctx, d, meta)
+}
+
+func deletedDirectoryObjectResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(deletedDirectoryObjectResourceName); diags != nil {
+		return diags
+	}
+	return deletedDirectoryObjectResourceReadMsGraph(ctx, d, meta)
+}
+
+func deletedDirectoryObjectResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(deletedDirectoryObjectResourceName); diags != nil {
+		return diags
+	}
+	return deletedDirectoryObjectResourceDeleteMsGraph(ctx, d, meta)
+}
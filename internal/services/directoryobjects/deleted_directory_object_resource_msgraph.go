@@ -0,0 +1,88 @@
+package directoryobjects
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func deletedDirectoryObjectResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.MsClient
+	objectId := d.Get("object_id").(string)
+	action := d.Get("action").(string)
+
+	item, status, err := helpers.DeletedItemGet(ctx, *client, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "object_id", "Deleted directory object with object ID %q was not found, it may have already been restored or permanently deleted", objectId)
+		}
+		return tf.ErrorDiagPathF(err, "object_id", "Retrieving deleted directory object with object ID %q", objectId)
+	}
+
+	switch action {
+	case deletedDirectoryObjectActionPurge:
+		if _, err := helpers.DeletedItemPermanentlyDelete(ctx, *client, objectId); err != nil {
+			return tf.ErrorDiagF(err, "Permanently deleting directory object with object ID %q", objectId)
+		}
+
+	case deletedDirectoryObjectActionRestore:
+		if _, err := helpers.DeletedItemRestore(ctx, *client, objectId); err != nil {
+			return tf.ErrorDiagF(err, "Restoring deleted directory object with object ID %q", objectId)
+		}
+
+		if _, err := helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+			return helpers.DirectoryObjectGet(ctx, *client, objectId)
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for restored directory object with object ID %q", objectId)
+		}
+	}
+
+	d.SetId(objectId)
+
+	deletedDate := ""
+	if item.DeletedDateTime != nil {
+		deletedDate = item.DeletedDateTime.Format(time.RFC3339)
+	}
+	tf.Set(d, "deleted_date", deletedDate)
+	tf.Set(d, "display_name", item.DisplayName)
+	tf.Set(d, "type", item.Type)
+
+	return deletedDirectoryObjectResourceReadMsGraph(ctx, d, meta)
+}
+
+func deletedDirectoryObjectResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.MsClient
+
+	// A purged object can never return, however a restored object may subsequently be deleted again, in which case it
+	// is removed from state so that it will be restored on the next apply
+	if d.Get("action").(string) != deletedDirectoryObjectActionRestore {
+		return nil
+	}
+
+	_, status, err := helpers.DeletedItemGet(ctx, *client, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving deleted directory object with object ID %q", d.Id())
+	}
+
+	log.Printf("[DEBUG] Restored directory object with object ID %q has been deleted again - removing from state", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func deletedDirectoryObjectResourceDeleteMsGraph(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Restoring and purging cannot be undone, so we simply remove the resource from state
+	log.Printf("[DEBUG] The %s action for directory object with object ID %q cannot be undone - removing from state", d.Get("action").(string), d.Id())
+	return nil
+}
@@ -0,0 +1,87 @@
+package directoryobjects_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type DeletedDirectoryObjectResource struct{}
+
+// Restoring and purging cannot be undone, so these tests are unable to check for destruction
+
+func TestAccDeletedDirectoryObject_purgeApplication(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_deleted_directory_object", "test")
+	r := DeletedDirectoryObjectResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.application(data),
+		},
+		{
+			// Deleting the application moves it to the deleted items container
+			Config: r.deletedApplications(),
+		},
+		{
+			Config: r.purgeApplication(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deleted_date").Exists(),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-DELETED-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("type").HasValue("Application"),
+			),
+		},
+	})
+}
+
+func (r DeletedDirectoryObjectResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.DirectoryObjects.MsClient
+
+	_, status, err := helpers.DeletedItemGet(ctx, *client, state.ID)
+	if err != nil && status != http.StatusNotFound {
+		return nil, fmt.Errorf("failed to retrieve deleted directory object with object ID %q: %+v", state.ID, err)
+	}
+
+	// Both restoring and purging remove the object from the deleted items container
+	return utils.Bool(status == http.StatusNotFound), nil
+}
+
+func (DeletedDirectoryObjectResource) application(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-DELETED-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (DeletedDirectoryObjectResource) deletedApplications() string {
+	return `
+data "azuread_deleted_applications" "test" {}
+`
+}
+
+func (DeletedDirectoryObjectResource) purgeApplication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_deleted_applications" "test" {}
+
+resource "azuread_deleted_directory_object" "test" {
+  object_id = [for a in data.azuread_deleted_applications.test.applications : a.object_id if a.display_name == "acctest-DELETED-%[1]d"][0]
+  action    = "purge"
+}
+`, data.RandomInteger)
+}
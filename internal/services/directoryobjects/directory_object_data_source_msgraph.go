@@ -0,0 +1,37 @@
+package directoryobjects
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func directoryObjectDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.MsClient
+	objectId := d.Get("object_id").(string)
+
+	object, status, err := helpers.DirectoryObjectGet(ctx, *client, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "object_id", "Directory object with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagF(err, "Retrieving directory object with object ID %q", objectId)
+	}
+	if object.ID == nil {
+		return tf.ErrorDiagF(nil, "API returned directory object with nil object ID")
+	}
+
+	d.SetId(*object.ID)
+
+	tf.Set(d, "display_name", object.DisplayName)
+	tf.Set(d, "object_id", object.ID)
+	tf.Set(d, "type", object.Type)
+
+	return nil
+}
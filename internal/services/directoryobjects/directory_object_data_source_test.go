@@ -0,0 +1,80 @@
+package directoryobjects_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryObjectDataSource struct{}
+
+func TestAccDirectoryObjectDataSource_group(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DirectoryObjectDataSource{}.group(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_id").Exists(),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestDirectoryObject-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("type").HasValue("Group"),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryObjectDataSource_servicePrincipal(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_directory_object", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DirectoryObjectDataSource{}.servicePrincipal(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestDirectoryObject-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("type").HasValue("ServicePrincipal"),
+			),
+		},
+	})
+}
+
+func (DirectoryObjectDataSource) group(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestDirectoryObject-%[1]d"
+  security_enabled = true
+}
+
+data "azuread_directory_object" "test" {
+  object_id = azuread_group.test.object_id
+}
+`, data.RandomInteger)
+}
+
+func (DirectoryObjectDataSource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestDirectoryObject-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+data "azuread_directory_object" "test" {
+  object_id = azuread_service_principal.test.object_id
+}
+`, data.RandomInteger)
+}
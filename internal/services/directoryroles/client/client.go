@@ -0,0 +1,40 @@
+package client
+
+import (
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	DirectoryRolesClient                  *msgraph.DirectoryRolesClient
+	DirectoryRoleTemplatesClient          *msgraph.DirectoryRoleTemplatesClient
+	RoleAssignmentScheduleRequestsClient  *RoleScheduleRequestsClient
+	RoleEligibilityScheduleRequestsClient *RoleScheduleRequestsClient
+	RoleManagementPoliciesClient          *RoleManagementPoliciesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	directoryRolesClient := msgraph.NewDirectoryRolesClient(o.TenantID)
+	o.ConfigureMsGraphClient(&directoryRolesClient.BaseClient)
+
+	directoryRoleTemplatesClient := msgraph.NewDirectoryRoleTemplatesClient(o.TenantID)
+	o.ConfigureMsGraphClient(&directoryRoleTemplatesClient.BaseClient)
+
+	roleAssignmentScheduleRequestsClient := NewRoleAssignmentScheduleRequestsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&roleAssignmentScheduleRequestsClient.BaseClient)
+
+	roleEligibilityScheduleRequestsClient := NewRoleEligibilityScheduleRequestsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&roleEligibilityScheduleRequestsClient.BaseClient)
+
+	roleManagementPoliciesClient := NewRoleManagementPoliciesClient(o.TenantID)
+	o.ConfigureMsGraphClient(&roleManagementPoliciesClient.BaseClient)
+
+	return &Client{
+		DirectoryRolesClient:                  directoryRolesClient,
+		DirectoryRoleTemplatesClient:          directoryRoleTemplatesClient,
+		RoleAssignmentScheduleRequestsClient:  roleAssignmentScheduleRequestsClient,
+		RoleEligibilityScheduleRequestsClient: roleEligibilityScheduleRequestsClient,
+		RoleManagementPoliciesClient:          roleManagementPoliciesClient,
+	}
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	RoleManagementPolicyRuleActivationApproval              = "Approval_EndUser_Assignment"
+	RoleManagementPolicyRuleActivationEnablement            = "Enablement_EndUser_Assignment"
+	RoleManagementPolicyRuleActivationExpiration            = "Expiration_EndUser_Assignment"
+	RoleManagementPolicyRuleActivationAdminNotification     = "Notification_Admin_EndUser_Assignment"
+	RoleManagementPolicyRuleActivationApproverNotification  = "Notification_Approver_EndUser_Assignment"
+	RoleManagementPolicyRuleActivationRequestorNotification = "Notification_Requestor_EndUser_Assignment"
+
+	RoleManagementPolicyEnabledRuleJustification             = "Justification"
+	RoleManagementPolicyEnabledRuleMultiFactorAuthentication = "MultiFactorAuthentication"
+	RoleManagementPolicyEnabledRuleTicketing                 = "Ticketing"
+
+	ApproverODataTypeGroupMembers = "#microsoft.graph.groupMembers"
+	ApproverODataTypeSingleUser   = "#microsoft.graph.singleUser"
+)
+
+// UnifiedRoleManagementPolicy describes the PIM settings which apply to a directory role.
+type UnifiedRoleManagementPolicy struct {
+	ID          *string                            `json:"id,omitempty"`
+	Description *string                            `json:"description,omitempty"`
+	DisplayName *string                            `json:"displayName,omitempty"`
+	Rules       *[]UnifiedRoleManagementPolicyRule `json:"rules,omitempty"`
+}
+
+// UnifiedRoleManagementPolicyRule is a single rule of a policy. Rules are polymorphic, so this type holds the
+// properties of each supported rule type, of which only those relevant to the rule's @odata.type are populated.
+type UnifiedRoleManagementPolicyRule struct {
+	ID        *string          `json:"id,omitempty"`
+	ODataType *string          `json:"@odata.type,omitempty"`
+	Target    *json.RawMessage `json:"target,omitempty"`
+
+	// unifiedRoleManagementPolicyApprovalRule
+	Setting *ApprovalSettings `json:"setting,omitempty"`
+
+	// unifiedRoleManagementPolicyEnablementRule
+	EnabledRules *[]string `json:"enabledRules,omitempty"`
+
+	// unifiedRoleManagementPolicyExpirationRule
+	IsExpirationRequired *bool   `json:"isExpirationRequired,omitempty"`
+	MaximumDuration      *string `json:"maximumDuration,omitempty"`
+
+	// unifiedRoleManagementPolicyNotificationRule
+	IsDefaultRecipientsEnabled *bool     `json:"isDefaultRecipientsEnabled,omitempty"`
+	NotificationLevel          *string   `json:"notificationLevel,omitempty"`
+	NotificationRecipients     *[]string `json:"notificationRecipients,omitempty"`
+	NotificationType           *string   `json:"notificationType,omitempty"`
+	RecipientType              *string   `json:"recipientType,omitempty"`
+}
+
+type ApprovalSettings struct {
+	ApprovalMode                     *string          `json:"approvalMode,omitempty"`
+	ApprovalStages                   *[]ApprovalStage `json:"approvalStages,omitempty"`
+	IsApprovalRequired               *bool            `json:"isApprovalRequired,omitempty"`
+	IsApprovalRequiredForExtension   *bool            `json:"isApprovalRequiredForExtension,omitempty"`
+	IsRequestorJustificationRequired *bool            `json:"isRequestorJustificationRequired,omitempty"`
+}
+
+type ApprovalStage struct {
+	ApprovalStageTimeOutInDays      *int       `json:"approvalStageTimeOutInDays,omitempty"`
+	EscalationApprovers             *[]UserSet `json:"escalationApprovers,omitempty"`
+	EscalationTimeInMinutes         *int       `json:"escalationTimeInMinutes,omitempty"`
+	IsApproverJustificationRequired *bool      `json:"isApproverJustificationRequired,omitempty"`
+	IsEscalationEnabled             *bool      `json:"isEscalationEnabled,omitempty"`
+	PrimaryApprovers                *[]UserSet `json:"primaryApprovers,omitempty"`
+}
+
+// UserSet identifies an approver, being either a single user or the members of a group.
+type UserSet struct {
+	ODataType   *string `json:"@odata.type,omitempty"`
+	Description *string `json:"description,omitempty"`
+	GroupId     *string `json:"groupId,omitempty"`
+	IsBackup    *bool   `json:"isBackup,omitempty"`
+	UserId      *string `json:"userId,omitempty"`
+}
+
+// RoleManagementPoliciesClient performs operations on PIM policies for directory roles.
+type RoleManagementPoliciesClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewRoleManagementPoliciesClient returns a new RoleManagementPoliciesClient.
+func NewRoleManagementPoliciesClient(tenantId string) *RoleManagementPoliciesClient {
+	return &RoleManagementPoliciesClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// GetForRole retrieves the policy assigned to the specified directory role at the tenant scope, including its rules.
+// Every directory role has exactly one such policy, which cannot be created or deleted.
+func (c *RoleManagementPoliciesClient) GetForRole(ctx context.Context, roleDefinitionId string) (*UnifiedRoleManagementPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity: "/policies/roleManagementPolicyAssignments",
+			Params: url.Values{
+				"$filter": []string{fmt.Sprintf("scopeId eq '/' and scopeType eq 'Directory' and roleDefinitionId eq '%s'", roleDefinitionId)},
+			},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("RoleManagementPoliciesClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Assignments []struct {
+			PolicyId *string `json:"policyId"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	if len(data.Assignments) == 0 || data.Assignments[0].PolicyId == nil {
+		return nil, http.StatusNotFound, fmt.Errorf("no role management policy found for role definition %q", roleDefinitionId)
+	}
+	return c.Get(ctx, *data.Assignments[0].PolicyId)
+}
+
+// Get retrieves a policy, including its rules.
+func (c *RoleManagementPoliciesClient) Get(ctx context.Context, id string) (*UnifiedRoleManagementPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/roleManagementPolicies/%s", id),
+			Params:      url.Values{"$expand": []string{"rules"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("RoleManagementPoliciesClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var policy UnifiedRoleManagementPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &policy, status, nil
+}
+
+// UpdateRule replaces a single rule of a policy.
+func (c *RoleManagementPoliciesClient) UpdateRule(ctx context.Context, policyId string, rule UnifiedRoleManagementPolicyRule) (int, error) {
+	var status int
+	if rule.ID == nil {
+		return status, fmt.Errorf("cannot update role management policy rule with nil ID")
+	}
+	body, err := json.Marshal(rule)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/roleManagementPolicies/%s/rules/%s", policyId, *rule.ID),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("RoleManagementPoliciesClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
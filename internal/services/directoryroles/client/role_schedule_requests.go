@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	RoleScheduleRequestActionAdminAssign = "adminAssign"
+	RoleScheduleRequestActionAdminRemove = "adminRemove"
+
+	ExpirationPatternTypeAfterDateTime = "afterDateTime"
+	ExpirationPatternTypeAfterDuration = "afterDuration"
+	ExpirationPatternTypeNoExpiration  = "noExpiration"
+
+	RoleScheduleStatusCanceled    = "Canceled"
+	RoleScheduleStatusExpired     = "Expired"
+	RoleScheduleStatusProvisioned = "Provisioned"
+	RoleScheduleStatusRevoked     = "Revoked"
+)
+
+// RoleScheduleStatusInactive returns whether the status of a schedule request or schedule indicates that the
+// eligibility or assignment is no longer in effect.
+func RoleScheduleStatusInactive(status *string) bool {
+	if status == nil {
+		return false
+	}
+	switch *status {
+	case RoleScheduleStatusCanceled, RoleScheduleStatusExpired, RoleScheduleStatusRevoked:
+		return true
+	}
+	return false
+}
+
+// UnifiedRoleScheduleRequest describes a request to make a principal eligible for, or to assign to a principal, a directory role.
+type UnifiedRoleScheduleRequest struct {
+	ID               *string          `json:"id,omitempty"`
+	Action           *string          `json:"action,omitempty"`
+	CreatedDateTime  *string          `json:"createdDateTime,omitempty"`
+	DirectoryScopeId *string          `json:"directoryScopeId,omitempty"`
+	Justification    *string          `json:"justification,omitempty"`
+	PrincipalId      *string          `json:"principalId,omitempty"`
+	RoleDefinitionId *string          `json:"roleDefinitionId,omitempty"`
+	ScheduleInfo     *RequestSchedule `json:"scheduleInfo,omitempty"`
+	Status           *string          `json:"status,omitempty"`
+	TargetScheduleId *string          `json:"targetScheduleId,omitempty"`
+}
+
+// UnifiedRoleSchedule describes the eligibility for, or assignment of, a directory role created by a schedule request.
+type UnifiedRoleSchedule struct {
+	ID               *string          `json:"id,omitempty"`
+	DirectoryScopeId *string          `json:"directoryScopeId,omitempty"`
+	PrincipalId      *string          `json:"principalId,omitempty"`
+	RoleDefinitionId *string          `json:"roleDefinitionId,omitempty"`
+	ScheduleInfo     *RequestSchedule `json:"scheduleInfo,omitempty"`
+	Status           *string          `json:"status,omitempty"`
+}
+
+type RequestSchedule struct {
+	Expiration    *ExpirationPattern `json:"expiration,omitempty"`
+	StartDateTime *string            `json:"startDateTime,omitempty"`
+}
+
+type ExpirationPattern struct {
+	Duration    *string `json:"duration,omitempty"`
+	EndDateTime *string `json:"endDateTime,omitempty"`
+	Type        *string `json:"type,omitempty"`
+}
+
+// RoleScheduleRequestsClient performs operations on role eligibility or role assignment schedule requests, and the
+// schedules created by them.
+type RoleScheduleRequestsClient struct {
+	BaseClient     msgraph.Client
+	entity         string
+	scheduleEntity string
+}
+
+// NewRoleEligibilityScheduleRequestsClient returns a new RoleScheduleRequestsClient for role eligibility schedule requests.
+func NewRoleEligibilityScheduleRequestsClient(tenantId string) *RoleScheduleRequestsClient {
+	return &RoleScheduleRequestsClient{
+		BaseClient:     msgraph.NewClient(msgraph.Version10, tenantId),
+		entity:         "/roleManagement/directory/roleEligibilityScheduleRequests",
+		scheduleEntity: "/roleManagement/directory/roleEligibilitySchedules",
+	}
+}
+
+// NewRoleAssignmentScheduleRequestsClient returns a new RoleScheduleRequestsClient for role assignment schedule requests.
+func NewRoleAssignmentScheduleRequestsClient(tenantId string) *RoleScheduleRequestsClient {
+	return &RoleScheduleRequestsClient{
+		BaseClient:     msgraph.NewClient(msgraph.Version10, tenantId),
+		entity:         "/roleManagement/directory/roleAssignmentScheduleRequests",
+		scheduleEntity: "/roleManagement/directory/roleAssignmentSchedules",
+	}
+}
+
+// Create submits a new schedule request.
+func (c *RoleScheduleRequestsClient) Create(ctx context.Context, request UnifiedRoleScheduleRequest) (*UnifiedRoleScheduleRequest, int, error) {
+	var status int
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      c.entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("RoleScheduleRequestsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newRequest UnifiedRoleScheduleRequest
+	if err := json.Unmarshal(respBody, &newRequest); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newRequest, status, nil
+}
+
+// Get retrieves a schedule request.
+func (c *RoleScheduleRequestsClient) Get(ctx context.Context, id string) (*UnifiedRoleScheduleRequest, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("%s/%s", c.entity, id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("RoleScheduleRequestsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var request UnifiedRoleScheduleRequest
+	if err := json.Unmarshal(respBody, &request); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &request, status, nil
+}
+
+// GetSchedule retrieves the schedule created by a schedule request. Schedules are removed once they have expired or
+// have been revoked.
+func (c *RoleScheduleRequestsClient) GetSchedule(ctx context.Context, id string) (*UnifiedRoleSchedule, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("%s/%s", c.scheduleEntity, id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("RoleScheduleRequestsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var schedule UnifiedRoleSchedule
+	if err := json.Unmarshal(respBody, &schedule); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &schedule, status, nil
+}
@@ -0,0 +1,16 @@
+package directoryroles
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
+)
+
+const directoryRoleAssignmentScheduleRequestResourceName = "azuread_directory_role_assignment_schedule_request"
+
+func directoryRoleAssignmentScheduleRequestResource() *schema.Resource {
+	return roleScheduleRequestResource(directoryRoleAssignmentScheduleRequestResourceName, func(meta interface{}) *client.RoleScheduleRequestsClient {
+		return meta.(*clients.Client).DirectoryRoles.RoleAssignmentScheduleRequestsClient
+	})
+}
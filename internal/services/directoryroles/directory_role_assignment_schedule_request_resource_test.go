@@ -0,0 +1,100 @@
+package directoryroles_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type DirectoryRoleAssignmentScheduleRequestResource struct{}
+
+func TestAccDirectoryRoleAssignmentScheduleRequest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment_schedule_request", "test")
+	r := DirectoryRoleAssignmentScheduleRequestResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleAssignmentScheduleRequest_duration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment_schedule_request", "test")
+	r := DirectoryRoleAssignmentScheduleRequestResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.duration(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("duration").HasValue("P30D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DirectoryRoleAssignmentScheduleRequestResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	request, status, err := clients.DirectoryRoles.RoleAssignmentScheduleRequestsClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Role Assignment Schedule Request with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Role Assignment Schedule Request with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(request.ID != nil && *request.ID == state.ID), nil
+}
+
+// The Helpdesk Administrator role is used since it can be safely granted to test users
+func (DirectoryRoleAssignmentScheduleRequestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[4]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[3]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (r DirectoryRoleAssignmentScheduleRequestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_assignment_schedule_request" "test" {
+  principal_id       = azuread_user.test.object_id
+  role_definition_id = "729827e3-9c14-49f7-bb1b-9608f156bbb8"
+  justification      = "Acceptance testing"
+}
+`, r.template(data))
+}
+
+func (r DirectoryRoleAssignmentScheduleRequestResource) duration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_assignment_schedule_request" "test" {
+  principal_id       = azuread_user.test.object_id
+  role_definition_id = "729827e3-9c14-49f7-bb1b-9608f156bbb8"
+  justification      = "Acceptance testing"
+  duration           = "P30D"
+}
+`, r.template(data))
+}
@@ -0,0 +1,16 @@
+package directoryroles
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
+)
+
+const directoryRoleEligibilityScheduleRequestResourceName = "azuread_directory_role_eligibility_schedule_request"
+
+func directoryRoleEligibilityScheduleRequestResource() *schema.Resource {
+	return roleScheduleRequestResource(directoryRoleEligibilityScheduleRequestResourceName, func(meta interface{}) *client.RoleScheduleRequestsClient {
+		return meta.(*clients.Client).DirectoryRoles.RoleEligibilityScheduleRequestsClient
+	})
+}
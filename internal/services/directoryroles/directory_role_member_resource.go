@@ -0,0 +1,72 @@
+package directoryroles
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const directoryRoleMemberResourceName = "azuread_directory_role_member"
+
+func directoryRoleMemberResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: directoryRoleMemberResourceCreate,
+		ReadContext:   directoryRoleMemberResourceRead,
+		DeleteContext: directoryRoleMemberResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.DirectoryRoleMemberID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"member_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func directoryRoleMemberResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(directoryRoleMemberResourceName); diags != nil {
+		return diags
+	}
+	return directoryRoleMemberResourceCreateMsGraph(ctx, d, meta)
+}
+
+func directoryRoleMemberResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(directoryRoleMemberResourceName); diags != nil {
+		return diags
+	}
+	return directoryRoleMemberResourceReadMsGraph(ctx, d, meta)
+}
+
+func directoryRoleMemberResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(directoryRoleMemberResourceName); diags != nil {
+		return diags
+	}
+	return directoryRoleMemberResourceDeleteMsGraph(ctx, d, meta)
+}
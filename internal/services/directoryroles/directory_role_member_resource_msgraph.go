@@ -0,0 +1,104 @@
+package directoryroles
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func directoryRoleMemberResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRolesClient
+
+	roleId := d.Get("role_object_id").(string)
+	memberId := d.Get("member_object_id").(string)
+
+	id := parse.NewDirectoryRoleMemberID(roleId, memberId)
+
+	tf.LockByName(directoryRoleMemberResourceName, roleId)
+	defer tf.UnlockByName(directoryRoleMemberResourceName, roleId)
+
+	role, status, err := client.Get(ctx, roleId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "role_object_id", "Directory role with object ID %q was not found", roleId)
+		}
+		return tf.ErrorDiagPathF(err, "role_object_id", "Retrieving directory role with object ID: %q", roleId)
+	}
+
+	// Adding a member which already exists is not an error, so that re-adding an existing member is idempotent
+	role.AppendMember(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, memberId)
+	if err := msgraph.WaitForReferenceReplication(ctx, func() (int, error) {
+		return client.AddMembers(ctx, role)
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Adding directory role member %q to directory role %q", memberId, roleId)
+	}
+
+	d.SetId(id.String())
+
+	if _, err := msgraph.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return client.GetMember(ctx, roleId, memberId)
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for directory role membership %q", id.String())
+	}
+
+	return directoryRoleMemberResourceReadMsGraph(ctx, d, meta)
+}
+
+func directoryRoleMemberResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRolesClient
+
+	id, err := parse.DirectoryRoleMemberID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Directory Role Member ID %q", d.Id())
+	}
+
+	if _, status, err := client.GetMember(ctx, id.DirectoryRoleId, id.MemberId); err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Member with ID %q was not found in Directory Role %q - removing from state", id.MemberId, id.DirectoryRoleId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving member %q for directory role with object ID: %q", id.MemberId, id.DirectoryRoleId)
+	}
+
+	tf.Set(d, "member_object_id", id.MemberId)
+	tf.Set(d, "role_object_id", id.DirectoryRoleId)
+
+	return nil
+}
+
+func directoryRoleMemberResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRolesClient
+
+	id, err := parse.DirectoryRoleMemberID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Directory Role Member ID %q", d.Id())
+	}
+
+	tf.LockByName(directoryRoleMemberResourceName, id.DirectoryRoleId)
+	defer tf.UnlockByName(directoryRoleMemberResourceName, id.DirectoryRoleId)
+
+	if _, err := client.RemoveMembers(ctx, id.DirectoryRoleId, &[]string{id.MemberId}); err != nil {
+		return tf.ErrorDiagF(err, "Removing member %q from directory role with object ID: %q", id.MemberId, id.DirectoryRoleId)
+	}
+
+	if _, err := msgraph.WaitForListRemove(ctx, id.MemberId, func() ([]string, error) {
+		members, _, err := client.ListMembers(ctx, id.DirectoryRoleId)
+		if members == nil {
+			return make([]string, 0), err
+		}
+		return *members, err
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for directory role membership removal")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package directoryroles_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type DirectoryRoleMemberResource struct{}
+
+func TestAccDirectoryRoleMember_user(t *testing.T) {
+	roleId := directoryRoleMemberTestRoleId(t)
+	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
+	r := DirectoryRoleMemberResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.user(data, roleId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_object_id").HasValue(roleId),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleMember_servicePrincipal(t *testing.T) {
+	roleId := directoryRoleMemberTestRoleId(t)
+	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
+	r := DirectoryRoleMemberResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.servicePrincipal(data, roleId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDirectoryRoleMember_existing(t *testing.T) {
+	roleId := directoryRoleMemberTestRoleId(t)
+	data := acceptance.BuildTestData(t, "azuread_directory_role_member", "test")
+	r := DirectoryRoleMemberResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.user(data, roleId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.duplicate(data, roleId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_directory_role_member.duplicate").ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+// Directory roles must be activated in the tenant before members can be added, so the object ID of an activated role
+// is supplied via the environment
+func directoryRoleMemberTestRoleId(t *testing.T) string {
+	roleId := os.Getenv("ARM_TEST_DIRECTORY_ROLE_OBJECT_ID")
+	if roleId == "" {
+		t.Skip("Skipping as ARM_TEST_DIRECTORY_ROLE_OBJECT_ID is not specified")
+	}
+	return roleId
+}
+
+func (r DirectoryRoleMemberResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.DirectoryRoleMemberID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Directory Role Member ID: %v", err)
+	}
+
+	if _, status, err := clients.DirectoryRoles.DirectoryRolesClient.GetMember(ctx, id.DirectoryRoleId, id.MemberId); err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Member %q was not found in Directory Role %q", id.MemberId, id.DirectoryRoleId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Directory Role Member %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (DirectoryRoleMemberResource) user(data acceptance.TestData, roleId string) string {
+	return fmt.Sprintf(`
+%[5]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[4]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_directory_role_member" "test" {
+  role_object_id   = "%[3]s"
+  member_object_id = azuread_user.test.object_id
+}
+`, data.RandomInteger, data.RandomPassword, roleId, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (DirectoryRoleMemberResource) servicePrincipal(data acceptance.TestData, roleId string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestDirectoryRoleMember-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_directory_role_member" "test" {
+  role_object_id   = "%[2]s"
+  member_object_id = azuread_service_principal.test.object_id
+}
+`, data.RandomInteger, roleId)
+}
+
+func (r DirectoryRoleMemberResource) duplicate(data acceptance.TestData, roleId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_member" "duplicate" {
+  role_object_id   = azuread_directory_role_member.test.role_object_id
+  member_object_id = azuread_directory_role_member.test.member_object_id
+}
+`, r.user(data, roleId))
+}
@@ -0,0 +1,54 @@
+package directoryroles
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const directoryRoleTemplateDataSourceName = "azuread_directory_role_template"
+
+func directoryRoleTemplateDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: directoryRoleTemplateDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "object_id"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"object_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "object_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func directoryRoleTemplateDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(directoryRoleTemplateDataSourceName); diags != nil {
+		return diags
+	}
+	return directoryRoleTemplateDataSourceReadMsGraph(ctx, d, meta)
+}
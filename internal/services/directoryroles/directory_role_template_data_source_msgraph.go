@@ -0,0 +1,65 @@
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func directoryRoleTemplateDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleTemplatesClient
+
+	var template *msgraph.DirectoryRoleTemplate
+
+	if objectId, ok := d.GetOk("object_id"); ok && objectId.(string) != "" {
+		var status int
+		var err error
+		template, status, err = client.Get(ctx, objectId.(string))
+		if err != nil {
+			if status == http.StatusNotFound {
+				return tf.ErrorDiagPathF(nil, "object_id", "Directory role template with object ID %q was not found", objectId)
+			}
+			return tf.ErrorDiagPathF(err, "object_id", "Retrieving directory role template with object ID %q", objectId)
+		}
+	} else if displayName, ok := d.GetOk("display_name"); ok && displayName.(string) != "" {
+		// The API does not support filtering role templates, so all templates are retrieved and matched here
+		result, _, err := client.List(ctx)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not list directory role templates")
+		}
+		if result != nil {
+			for _, t := range *result {
+				if t.DisplayName != nil && strings.EqualFold(*t.DisplayName, displayName.(string)) {
+					t := t
+					template = &t
+					break
+				}
+			}
+		}
+		if template == nil {
+			return tf.ErrorDiagPathF(nil, "display_name", "Directory role template with display name %q was not found", displayName)
+		}
+	} else {
+		return tf.ErrorDiagF(nil, "One of `object_id` or `display_name` must be specified")
+	}
+
+	if template.ID == nil {
+		return tf.ErrorDiagF(errors.New("API returned directory role template with nil object ID"), "Bad API response")
+	}
+
+	d.SetId(*template.ID)
+
+	tf.Set(d, "description", template.Description)
+	tf.Set(d, "display_name", template.DisplayName)
+	tf.Set(d, "object_id", template.ID)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package directoryroles_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryRoleTemplateDataSource struct{}
+
+// The object ID of the built-in Global Reader role template, which is the same in every tenant
+const directoryRoleTemplateTestGlobalReaderId = "f2ef992c-3afb-46b9-b7cf-a126ee74c451"
+
+func TestAccDirectoryRoleTemplateDataSource_byDisplayName(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_directory_role_template", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DirectoryRoleTemplateDataSource{}.byDisplayName(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_id").HasValue(directoryRoleTemplateTestGlobalReaderId),
+				check.That(data.ResourceName).Key("display_name").HasValue("Global Reader"),
+				check.That(data.ResourceName).Key("description").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryRoleTemplateDataSource_byObjectId(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_directory_role_template", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DirectoryRoleTemplateDataSource{}.byObjectId(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_id").HasValue(directoryRoleTemplateTestGlobalReaderId),
+				check.That(data.ResourceName).Key("display_name").HasValue("Global Reader"),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryRoleTemplatesDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_directory_role_templates", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: `data "azuread_directory_role_templates" "test" {}`,
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_ids.#").Exists(),
+				check.That(data.ResourceName).Key("role_templates.0.display_name").Exists(),
+				check.That(data.ResourceName).Key("role_templates.0.object_id").Exists(),
+			),
+		},
+	})
+}
+
+func (DirectoryRoleTemplateDataSource) byDisplayName() string {
+	return `
+data "azuread_directory_role_template" "test" {
+  display_name = "global reader"
+}
+`
+}
+
+func (DirectoryRoleTemplateDataSource) byObjectId() string {
+	return `
+data "azuread_directory_role_template" "test" {
+  object_id = "` + directoryRoleTemplateTestGlobalReaderId + `"
+}
+`
+}
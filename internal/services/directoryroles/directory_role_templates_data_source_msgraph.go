@@ -0,0 +1,56 @@
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func directoryRoleTemplatesDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRoleTemplatesClient
+
+	result, _, err := client.List(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not list directory role templates")
+	}
+
+	objectIds := make([]string, 0)
+	templates := make([]map[string]interface{}, 0)
+
+	if result != nil {
+		// Sort by display name so that the output is stable between reads
+		roleTemplates := *result
+		sort.SliceStable(roleTemplates, func(i, j int) bool {
+			if roleTemplates[i].DisplayName == nil || roleTemplates[j].DisplayName == nil {
+				return roleTemplates[j].DisplayName != nil
+			}
+			return *roleTemplates[i].DisplayName < *roleTemplates[j].DisplayName
+		})
+
+		for _, t := range roleTemplates {
+			if t.ID == nil {
+				return tf.ErrorDiagF(errors.New("API returned directory role template with nil object ID"), "Bad API response")
+			}
+
+			objectIds = append(objectIds, *t.ID)
+			templates = append(templates, map[string]interface{}{
+				"description":  t.Description,
+				"display_name": t.DisplayName,
+				"object_id":    t.ID,
+			})
+		}
+	}
+
+	d.SetId("directoryRoleTemplates-" + client.BaseClient.TenantId)
+
+	tf.Set(d, "object_ids", objectIds)
+	tf.Set(d, "role_templates", templates)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package directoryroles
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func directoryRolesDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).DirectoryRoles.DirectoryRolesClient
+
+	// Only activated roles are returned, which is all roles that have ever had members assigned
+	result, _, err := client.List(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not list directory roles")
+	}
+
+	objectIds := make([]string, 0)
+	templateIds := make([]string, 0)
+	roles := make([]map[string]interface{}, 0)
+
+	if result != nil {
+		// Sort by display name so that the output is stable between reads
+		directoryRoles := *result
+		sort.SliceStable(directoryRoles, func(i, j int) bool {
+			if directoryRoles[i].DisplayName == nil || directoryRoles[j].DisplayName == nil {
+				return directoryRoles[j].DisplayName != nil
+			}
+			return *directoryRoles[i].DisplayName < *directoryRoles[j].DisplayName
+		})
+
+		for _, r := range directoryRoles {
+			if r.ID == nil {
+				return tf.ErrorDiagF(errors.New("API returned directory role with nil object ID"), "Bad API response")
+			}
+
+			members, _, err := client.ListMembers(ctx, *r.ID)
+			if err != nil {
+				return tf.ErrorDiagF(err, "Could not list members for directory role with object ID %q", *r.ID)
+			}
+			memberCount := 0
+			if members != nil {
+				memberCount = len(*members)
+			}
+
+			objectIds = append(objectIds, *r.ID)
+			if r.RoleTemplateId != nil {
+				templateIds = append(templateIds, *r.RoleTemplateId)
+			}
+
+			roles = append(roles, map[string]interface{}{
+				"description":  r.Description,
+				"display_name": r.DisplayName,
+				"member_count": memberCount,
+				"object_id":    r.ID,
+				"template_id":  r.RoleTemplateId,
+			})
+		}
+	}
+
+	d.SetId("directoryRoles-" + client.BaseClient.TenantId)
+
+	tf.Set(d, "object_ids", objectIds)
+	tf.Set(d, "roles", roles)
+	tf.Set(d, "template_ids", templateIds)
+
+	return nil
+}
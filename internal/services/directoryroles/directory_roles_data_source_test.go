@@ -0,0 +1,40 @@
+package directoryroles_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryRolesDataSource struct{}
+
+func TestAccDirectoryRolesDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_directory_roles", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			// The Global Administrator role is always activated
+			Config: DirectoryRolesDataSource{}.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_ids.#").Exists(),
+				check.That(data.ResourceName).Key("template_ids.#").Exists(),
+				check.That(data.ResourceName).Key("roles.0.display_name").Exists(),
+				check.That(data.ResourceName).Key("roles.0.member_count").Exists(),
+				check.That(data.ResourceName).Key("roles.0.object_id").Exists(),
+				check.That(data.ResourceName).Key("roles.0.template_id").Exists(),
+			),
+		},
+	})
+}
+
+func (DirectoryRolesDataSource) basic() string {
+	return `data "azuread_directory_roles" "test" {}`
+}
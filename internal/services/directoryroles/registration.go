@@ -0,0 +1,38 @@
+package directoryroles
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Directory Roles"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Directory Roles",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azuread_directory_role_template":  directoryRoleTemplateDataSource(),
+		"azuread_directory_role_templates": directoryRoleTemplatesDataSource(),
+		"azuread_directory_roles":          directoryRolesDataSource(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azuread_directory_role_assignment_schedule_request":  directoryRoleAssignmentScheduleRequestResource(),
+		"azuread_directory_role_eligibility_schedule_request": directoryRoleEligibilityScheduleRequestResource(),
+		"azuread_directory_role_member":                       directoryRoleMemberResource(),
+		"azuread_role_management_policy":                      roleManagementPolicyResource(),
+	}
+}
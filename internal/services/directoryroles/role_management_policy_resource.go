@@ -0,0 +1,192 @@
+package directoryroles
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const roleManagementPolicyResourceName = "azuread_role_management_policy"
+
+const (
+	roleManagementPolicyApproverTypeGroup = "group"
+	roleManagementPolicyApproverTypeUser  = "user"
+)
+
+func roleManagementPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: roleManagementPolicyResourceCreate,
+		ReadContext:   roleManagementPolicyResourceRead,
+		UpdateContext: roleManagementPolicyResourceUpdate,
+		DeleteContext: roleManagementPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"role_definition_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"activation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_duration": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "PT8H",
+							ValidateDiagFunc: validate.ISO8601Duration,
+						},
+
+						"require_approval": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"require_justification": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"require_multifactor_authentication": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"require_ticket_info": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"approver": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_id": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validate.UUID,
+									},
+
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											roleManagementPolicyApproverTypeGroup,
+											roleManagementPolicyApproverTypeUser,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"activation_admin_notification": schemaRoleManagementPolicyNotification(),
+
+			"activation_approver_notification": schemaRoleManagementPolicyNotification(),
+
+			"activation_requestor_notification": schemaRoleManagementPolicyNotification(),
+
+			"policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func schemaRoleManagementPolicyNotification() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"additional_recipients": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"default_recipients_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"notification_level": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "All",
+					ValidateFunc: validation.StringInSlice([]string{"All", "Critical"}, false),
+				},
+			},
+		},
+	}
+}
+
+func roleManagementPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(roleManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return roleManagementPolicyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func roleManagementPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(roleManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return roleManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func roleManagementPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(roleManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return roleManagementPolicyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func roleManagementPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(roleManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return roleManagementPolicyResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,305 @@
+package directoryroles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func roleManagementPolicyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).DirectoryRoles.RoleManagementPoliciesClient
+	roleDefinitionId := d.Get("role_definition_id").(string)
+
+	// Every directory role has a policy which cannot be created or deleted, so we simply take ownership of it
+	if diags := roleManagementPolicyResourceApply(ctx, d, c); diags != nil {
+		return diags
+	}
+
+	d.SetId(roleDefinitionId)
+
+	return roleManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func roleManagementPolicyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).DirectoryRoles.RoleManagementPoliciesClient
+
+	policy, status, err := c.GetForRole(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Role management policy for role definition %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving role management policy for role definition %q", d.Id())
+	}
+
+	rules := roleManagementPolicyRulesById(policy)
+
+	tf.Set(d, "activation", flattenRoleManagementPolicyActivation(rules))
+	tf.Set(d, "activation_admin_notification", flattenRoleManagementPolicyNotification(rules[client.RoleManagementPolicyRuleActivationAdminNotification]))
+	tf.Set(d, "activation_approver_notification", flattenRoleManagementPolicyNotification(rules[client.RoleManagementPolicyRuleActivationApproverNotification]))
+	tf.Set(d, "activation_requestor_notification", flattenRoleManagementPolicyNotification(rules[client.RoleManagementPolicyRuleActivationRequestorNotification]))
+	tf.Set(d, "policy_id", policy.ID)
+	tf.Set(d, "role_definition_id", d.Id())
+
+	return nil
+}
+
+func roleManagementPolicyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).DirectoryRoles.RoleManagementPoliciesClient
+
+	if diags := roleManagementPolicyResourceApply(ctx, d, c); diags != nil {
+		return diags
+	}
+
+	return roleManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func roleManagementPolicyResourceDeleteMsGraph(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// The policy cannot be deleted, and there is no reliable way to determine the settings it had before it was
+	// managed, so it is left unchanged and only removed from state
+	log.Printf("[DEBUG] Role management policy for role definition %q cannot be deleted - removing from state only", d.Id())
+	return nil
+}
+
+// roleManagementPolicyResourceApply updates the rules of the policy for which the corresponding blocks have changed.
+func roleManagementPolicyResourceApply(ctx context.Context, d *schema.ResourceData, c *client.RoleManagementPoliciesClient) diag.Diagnostics {
+	roleDefinitionId := d.Get("role_definition_id").(string)
+
+	policy, _, err := c.GetForRole(ctx, roleDefinitionId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "role_definition_id", "Retrieving role management policy for role definition %q", roleDefinitionId)
+	}
+	if policy.ID == nil {
+		return tf.ErrorDiagF(fmt.Errorf("nil ID returned for role management policy"), "Bad API response")
+	}
+
+	rules := roleManagementPolicyRulesById(policy)
+	updated := make([]client.UnifiedRoleManagementPolicyRule, 0)
+
+	if d.HasChange("activation") {
+		if v := d.Get("activation").([]interface{}); len(v) > 0 && v[0] != nil {
+			activationRules, err := expandRoleManagementPolicyActivation(v[0].(map[string]interface{}), rules)
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "activation", "Updating role management policy for role definition %q", roleDefinitionId)
+			}
+			updated = append(updated, activationRules...)
+		}
+	}
+
+	for key, ruleId := range map[string]string{
+		"activation_admin_notification":     client.RoleManagementPolicyRuleActivationAdminNotification,
+		"activation_approver_notification":  client.RoleManagementPolicyRuleActivationApproverNotification,
+		"activation_requestor_notification": client.RoleManagementPolicyRuleActivationRequestorNotification,
+	} {
+		if !d.HasChange(key) {
+			continue
+		}
+		if v := d.Get(key).([]interface{}); len(v) > 0 && v[0] != nil {
+			rule, ok := rules[ruleId]
+			if !ok {
+				return tf.ErrorDiagPathF(fmt.Errorf("rule %q was not found", ruleId), key, "Updating role management policy for role definition %q", roleDefinitionId)
+			}
+			updated = append(updated, expandRoleManagementPolicyNotification(v[0].(map[string]interface{}), rule))
+		}
+	}
+
+	for _, rule := range updated {
+		if _, err := c.UpdateRule(ctx, *policy.ID, rule); err != nil {
+			return tf.ErrorDiagF(err, "Updating rule %q of role management policy for role definition %q", *rule.ID, roleDefinitionId)
+		}
+	}
+
+	return nil
+}
+
+func roleManagementPolicyRulesById(policy *client.UnifiedRoleManagementPolicy) map[string]client.UnifiedRoleManagementPolicyRule {
+	result := make(map[string]client.UnifiedRoleManagementPolicyRule)
+	if policy.Rules != nil {
+		for _, rule := range *policy.Rules {
+			if rule.ID != nil {
+				result[*rule.ID] = rule
+			}
+		}
+	}
+	return result
+}
+
+func expandRoleManagementPolicyActivation(in map[string]interface{}, rules map[string]client.UnifiedRoleManagementPolicyRule) ([]client.UnifiedRoleManagementPolicyRule, error) {
+	for _, ruleId := range []string{
+		client.RoleManagementPolicyRuleActivationApproval,
+		client.RoleManagementPolicyRuleActivationEnablement,
+		client.RoleManagementPolicyRuleActivationExpiration,
+	} {
+		if _, ok := rules[ruleId]; !ok {
+			return nil, fmt.Errorf("rule %q was not found", ruleId)
+		}
+	}
+
+	expiration := rules[client.RoleManagementPolicyRuleActivationExpiration]
+	expiration.MaximumDuration = utils.String(in["maximum_duration"].(string))
+
+	enabledRules := make([]string, 0)
+	if in["require_justification"].(bool) {
+		enabledRules = append(enabledRules, client.RoleManagementPolicyEnabledRuleJustification)
+	}
+	if in["require_multifactor_authentication"].(bool) {
+		enabledRules = append(enabledRules, client.RoleManagementPolicyEnabledRuleMultiFactorAuthentication)
+	}
+	if in["require_ticket_info"].(bool) {
+		enabledRules = append(enabledRules, client.RoleManagementPolicyEnabledRuleTicketing)
+	}
+	enablement := rules[client.RoleManagementPolicyRuleActivationEnablement]
+	enablement.EnabledRules = &enabledRules
+
+	approvers := make([]client.UserSet, 0)
+	for _, raw := range in["approver"].(*schema.Set).List() {
+		a := raw.(map[string]interface{})
+		switch a["type"].(string) {
+		case roleManagementPolicyApproverTypeGroup:
+			approvers = append(approvers, client.UserSet{
+				ODataType: utils.String(client.ApproverODataTypeGroupMembers),
+				GroupId:   utils.String(a["object_id"].(string)),
+			})
+		case roleManagementPolicyApproverTypeUser:
+			approvers = append(approvers, client.UserSet{
+				ODataType: utils.String(client.ApproverODataTypeSingleUser),
+				UserId:    utils.String(a["object_id"].(string)),
+			})
+		}
+	}
+
+	approval := rules[client.RoleManagementPolicyRuleActivationApproval]
+	if approval.Setting == nil {
+		approval.Setting = &client.ApprovalSettings{}
+	}
+	if approval.Setting.ApprovalMode == nil {
+		approval.Setting.ApprovalMode = utils.String("SingleStage")
+	}
+	approval.Setting.IsApprovalRequired = utils.Bool(in["require_approval"].(bool))
+
+	// Only a single approval stage is supported for activation of directory roles
+	stage := client.ApprovalStage{
+		ApprovalStageTimeOutInDays:      utils.Int(1),
+		EscalationApprovers:             &[]client.UserSet{},
+		EscalationTimeInMinutes:         utils.Int(0),
+		IsApproverJustificationRequired: utils.Bool(true),
+		IsEscalationEnabled:             utils.Bool(false),
+	}
+	if approval.Setting.ApprovalStages != nil && len(*approval.Setting.ApprovalStages) > 0 {
+		stage = (*approval.Setting.ApprovalStages)[0]
+	}
+	stage.PrimaryApprovers = &approvers
+	approval.Setting.ApprovalStages = &[]client.ApprovalStage{stage}
+
+	return []client.UnifiedRoleManagementPolicyRule{expiration, enablement, approval}, nil
+}
+
+func expandRoleManagementPolicyNotification(in map[string]interface{}, rule client.UnifiedRoleManagementPolicyRule) client.UnifiedRoleManagementPolicyRule {
+	recipients := make([]string, 0)
+	for _, v := range in["additional_recipients"].([]interface{}) {
+		recipients = append(recipients, v.(string))
+	}
+
+	rule.IsDefaultRecipientsEnabled = utils.Bool(in["default_recipients_enabled"].(bool))
+	rule.NotificationLevel = utils.String(in["notification_level"].(string))
+	rule.NotificationRecipients = &recipients
+
+	return rule
+}
+
+func flattenRoleManagementPolicyActivation(rules map[string]client.UnifiedRoleManagementPolicyRule) []interface{} {
+	maximumDuration := ""
+	if rule, ok := rules[client.RoleManagementPolicyRuleActivationExpiration]; ok && rule.MaximumDuration != nil {
+		maximumDuration = *rule.MaximumDuration
+	}
+
+	requireJustification, requireMfa, requireTicketInfo := false, false, false
+	if rule, ok := rules[client.RoleManagementPolicyRuleActivationEnablement]; ok && rule.EnabledRules != nil {
+		for _, r := range *rule.EnabledRules {
+			switch r {
+			case client.RoleManagementPolicyEnabledRuleJustification:
+				requireJustification = true
+			case client.RoleManagementPolicyEnabledRuleMultiFactorAuthentication:
+				requireMfa = true
+			case client.RoleManagementPolicyEnabledRuleTicketing:
+				requireTicketInfo = true
+			}
+		}
+	}
+
+	requireApproval := false
+	approvers := make([]interface{}, 0)
+	if rule, ok := rules[client.RoleManagementPolicyRuleActivationApproval]; ok && rule.Setting != nil {
+		requireApproval = rule.Setting.IsApprovalRequired != nil && *rule.Setting.IsApprovalRequired
+		if stages := rule.Setting.ApprovalStages; stages != nil && len(*stages) > 0 && (*stages)[0].PrimaryApprovers != nil {
+			for _, approver := range *(*stages)[0].PrimaryApprovers {
+				if approver.ODataType == nil {
+					continue
+				}
+				switch *approver.ODataType {
+				case client.ApproverODataTypeGroupMembers:
+					if approver.GroupId != nil {
+						approvers = append(approvers, map[string]interface{}{
+							"object_id": *approver.GroupId,
+							"type":      roleManagementPolicyApproverTypeGroup,
+						})
+					}
+				case client.ApproverODataTypeSingleUser:
+					if approver.UserId != nil {
+						approvers = append(approvers, map[string]interface{}{
+							"object_id": *approver.UserId,
+							"type":      roleManagementPolicyApproverTypeUser,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"approver":                           approvers,
+			"maximum_duration":                   maximumDuration,
+			"require_approval":                   requireApproval,
+			"require_justification":              requireJustification,
+			"require_multifactor_authentication": requireMfa,
+			"require_ticket_info":                requireTicketInfo,
+		},
+	}
+}
+
+func flattenRoleManagementPolicyNotification(rule client.UnifiedRoleManagementPolicyRule) []interface{} {
+	if rule.ID == nil {
+		return []interface{}{}
+	}
+
+	recipients := make([]interface{}, 0)
+	if rule.NotificationRecipients != nil {
+		for _, r := range *rule.NotificationRecipients {
+			recipients = append(recipients, r)
+		}
+	}
+
+	notificationLevel := ""
+	if rule.NotificationLevel != nil {
+		notificationLevel = *rule.NotificationLevel
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"additional_recipients":      recipients,
+			"default_recipients_enabled": rule.IsDefaultRecipientsEnabled != nil && *rule.IsDefaultRecipientsEnabled,
+			"notification_level":         notificationLevel,
+		},
+	}
+}
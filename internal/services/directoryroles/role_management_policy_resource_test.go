@@ -0,0 +1,145 @@
+package directoryroles_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type RoleManagementPolicyResource struct{}
+
+func TestAccRoleManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("policy_id").Exists(),
+				check.That(data.ResourceName).Key("activation.0.maximum_duration").HasValue("PT4H"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleManagementPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation.0.require_approval").HasValue("true"),
+				check.That(data.ResourceName).Key("activation.0.approver.#").HasValue("1"),
+				check.That(data.ResourceName).Key("activation_admin_notification.0.notification_level").HasValue("Critical"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation.0.require_approval").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r RoleManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	policy, status, err := clients.DirectoryRoles.RoleManagementPoliciesClient.GetForRole(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Role Management Policy for role definition %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Role Management Policy for role definition %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(policy.ID != nil && *policy.ID != ""), nil
+}
+
+// The Helpdesk Administrator role is used since its policy can be safely changed in a test tenant
+func (RoleManagementPolicyResource) basic(data acceptance.TestData) string {
+	return `
+resource "azuread_role_management_policy" "test" {
+  role_definition_id = "729827e3-9c14-49f7-bb1b-9608f156bbb8"
+
+  activation {
+    maximum_duration                   = "PT4H"
+    require_justification              = true
+    require_multifactor_authentication = true
+  }
+}
+`
+}
+
+func (RoleManagementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_role_management_policy" "test" {
+  role_definition_id = "729827e3-9c14-49f7-bb1b-9608f156bbb8"
+
+  activation {
+    maximum_duration                   = "PT2H"
+    require_approval                   = true
+    require_justification              = true
+    require_multifactor_authentication = true
+    require_ticket_info                = true
+
+    approver {
+      type      = "group"
+      object_id = azuread_group.test.object_id
+    }
+  }
+
+  activation_admin_notification {
+    notification_level    = "Critical"
+    additional_recipients = ["acctest-%[1]d@example.com"]
+  }
+
+  activation_requestor_notification {
+    default_recipients_enabled = false
+  }
+}
+`, data.RandomInteger)
+}
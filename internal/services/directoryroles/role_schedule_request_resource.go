@@ -0,0 +1,297 @@
+package directoryroles
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+// Role eligibility and role assignment schedule requests share the same schema and behaviour, differing only in the
+// API endpoint used, so these resources are implemented once here and parameterized with the appropriate client.
+
+type roleScheduleRequestsClientFunc func(meta interface{}) *client.RoleScheduleRequestsClient
+
+func roleScheduleRequestResource(resourceName string, c roleScheduleRequestsClientFunc) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if diags := meta.(*clients.Client).RequireMsGraph(resourceName); diags != nil {
+				return diags
+			}
+			return roleScheduleRequestResourceCreateMsGraph(ctx, d, c(meta))
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if diags := meta.(*clients.Client).RequireMsGraph(resourceName); diags != nil {
+				return diags
+			}
+			return roleScheduleRequestResourceReadMsGraph(ctx, d, c(meta))
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if diags := meta.(*clients.Client).RequireMsGraph(resourceName); diags != nil {
+				return diags
+			}
+			return roleScheduleRequestResourceDeleteMsGraph(ctx, d, c(meta))
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"principal_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"role_definition_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"directory_scope_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "/",
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"justification": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"duration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"end_date"},
+				ValidateDiagFunc: validate.ISO8601Duration,
+			},
+
+			"end_date": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"duration"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_schedule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func roleScheduleRequestResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, c *client.RoleScheduleRequestsClient) diag.Diagnostics {
+	principalId := d.Get("principal_id").(string)
+	roleDefinitionId := d.Get("role_definition_id").(string)
+
+	properties := expandRoleScheduleRequest(d, client.RoleScheduleRequestActionAdminAssign)
+
+	request, _, err := c.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Requesting role %q for principal %q", roleDefinitionId, principalId)
+	}
+	if request.ID == nil || *request.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for role schedule request"), "Bad API response")
+	}
+
+	d.SetId(*request.ID)
+
+	return roleScheduleRequestResourceReadMsGraph(ctx, d, c)
+}
+
+func roleScheduleRequestResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, c *client.RoleScheduleRequestsClient) diag.Diagnostics {
+	request, schedule, err := roleScheduleRequestGetActive(ctx, c, d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving role schedule request with ID %q", d.Id())
+	}
+	if request == nil {
+		log.Printf("[DEBUG] Role schedule request with ID %q was not found or is no longer in effect - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "directory_scope_id", request.DirectoryScopeId)
+	tf.Set(d, "justification", request.Justification)
+	tf.Set(d, "principal_id", request.PrincipalId)
+	tf.Set(d, "role_definition_id", request.RoleDefinitionId)
+	tf.Set(d, "status", request.Status)
+	tf.Set(d, "target_schedule_id", request.TargetScheduleId)
+
+	// The schedule reflects the eligibility or assignment as it currently is, whereas the request does not change
+	if schedule != nil {
+		tf.Set(d, "directory_scope_id", schedule.DirectoryScopeId)
+		tf.Set(d, "principal_id", schedule.PrincipalId)
+		tf.Set(d, "role_definition_id", schedule.RoleDefinitionId)
+		tf.Set(d, "status", schedule.Status)
+	}
+
+	if request.ScheduleInfo != nil {
+		tf.Set(d, "start_date", request.ScheduleInfo.StartDateTime)
+
+		if e := request.ScheduleInfo.Expiration; e != nil && e.Type != nil {
+			switch *e.Type {
+			case client.ExpirationPatternTypeAfterDuration:
+				tf.Set(d, "duration", e.Duration)
+			case client.ExpirationPatternTypeAfterDateTime:
+				tf.Set(d, "end_date", e.EndDateTime)
+			}
+		}
+	}
+
+	return nil
+}
+
+func roleScheduleRequestResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, c *client.RoleScheduleRequestsClient) diag.Diagnostics {
+	principalId := d.Get("principal_id").(string)
+	roleDefinitionId := d.Get("role_definition_id").(string)
+
+	// Removal requests are rejected when the eligibility or assignment has already been canceled, revoked or has expired
+	request, _, err := roleScheduleRequestGetActive(ctx, c, d.Id())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving role schedule request with ID %q", d.Id())
+	}
+	if request == nil {
+		log.Printf("[DEBUG] Role schedule request with ID %q was not found or is no longer in effect - assuming removed", d.Id())
+		return nil
+	}
+
+	// Schedule requests cannot be deleted, instead a new request is submitted to remove the eligibility or assignment
+	properties := client.UnifiedRoleScheduleRequest{
+		Action:           utils.String(client.RoleScheduleRequestActionAdminRemove),
+		DirectoryScopeId: utils.String(d.Get("directory_scope_id").(string)),
+		Justification:    utils.String(d.Get("justification").(string)),
+		PrincipalId:      utils.String(principalId),
+		RoleDefinitionId: utils.String(roleDefinitionId),
+	}
+
+	if _, status, err := c.Create(ctx, properties); err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Role %q for principal %q was not found - assuming removed", roleDefinitionId, principalId)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing role %q for principal %q", roleDefinitionId, principalId)
+	}
+
+	return nil
+}
+
+// roleScheduleRequestGetActive retrieves a schedule request, along with the schedule it created once provisioned. A nil
+// request is returned when the eligibility or assignment is no longer in effect, because the request was not found or
+// was canceled or revoked, or because its schedule has been removed, was revoked or has expired.
+func roleScheduleRequestGetActive(ctx context.Context, c *client.RoleScheduleRequestsClient, id string) (*client.UnifiedRoleScheduleRequest, *client.UnifiedRoleSchedule, error) {
+	request, status, err := c.Get(ctx, id)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if client.RoleScheduleStatusInactive(request.Status) {
+		return nil, nil, nil
+	}
+
+	// Until the request has been provisioned, for example whilst it is pending approval, there is no schedule
+	if request.Status == nil || *request.Status != client.RoleScheduleStatusProvisioned || request.TargetScheduleId == nil || *request.TargetScheduleId == "" {
+		return request, nil, nil
+	}
+
+	schedule, status, err := c.GetSchedule(ctx, *request.TargetScheduleId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("retrieving schedule with ID %q: %v", *request.TargetScheduleId, err)
+	}
+	if client.RoleScheduleStatusInactive(schedule.Status) || roleScheduleExpired(schedule.ScheduleInfo, time.Now()) {
+		return nil, nil, nil
+	}
+
+	return request, schedule, nil
+}
+
+// roleScheduleExpired returns whether a schedule has an end date which has passed
+func roleScheduleExpired(schedule *client.RequestSchedule, now time.Time) bool {
+	if schedule == nil || schedule.Expiration == nil || schedule.Expiration.EndDateTime == nil {
+		return false
+	}
+	endDate, err := time.Parse(time.RFC3339, *schedule.Expiration.EndDateTime)
+	return err == nil && !endDate.After(now)
+}
+
+func expandRoleScheduleRequest(d *schema.ResourceData, action string) client.UnifiedRoleScheduleRequest {
+	schedule := client.RequestSchedule{
+		Expiration: &client.ExpirationPattern{
+			Type: utils.String(client.ExpirationPatternTypeNoExpiration),
+		},
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		schedule.StartDateTime = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("duration"); ok {
+		schedule.Expiration = &client.ExpirationPattern{
+			Duration: utils.String(v.(string)),
+			Type:     utils.String(client.ExpirationPatternTypeAfterDuration),
+		}
+	} else if v, ok := d.GetOk("end_date"); ok {
+		schedule.Expiration = &client.ExpirationPattern{
+			EndDateTime: utils.String(v.(string)),
+			Type:        utils.String(client.ExpirationPatternTypeAfterDateTime),
+		}
+	}
+
+	return client.UnifiedRoleScheduleRequest{
+		Action:           utils.String(action),
+		DirectoryScopeId: utils.String(d.Get("directory_scope_id").(string)),
+		Justification:    utils.String(d.Get("justification").(string)),
+		PrincipalId:      utils.String(d.Get("principal_id").(string)),
+		RoleDefinitionId: utils.String(d.Get("role_definition_id").(string)),
+		ScheduleInfo:     &schedule,
+	}
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// DomainsCache holds the list of domains for the tenant, so that configurations with many data sources do not list
+// domains repeatedly. The cache is scoped to a single provider instance and should be invalidated whenever a domain is
+// created, verified or deleted.
+type DomainsCache struct {
+	mu      sync.RWMutex
+	domains *[]msgraph.Domain
+}
+
+// Get returns the cached domains, or false when the cache is empty
+func (c *DomainsCache) Get() (*[]msgraph.Domain, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.domains, c.domains != nil
+}
+
+// Set replaces the cached domains
+func (c *DomainsCache) Set(domains *[]msgraph.Domain) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.domains = domains
+}
+
+// Invalidate empties the cache, so that domains are listed again on next use
+func (c *DomainsCache) Invalidate() {
+	c.Set(nil)
+}
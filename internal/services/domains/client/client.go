@@ -10,6 +10,10 @@ import (
 type Client struct {
 	AadClient *graphrbac.DomainsClient
 	MsClient  *msgraph.DomainsClient
+
+	OrganizationClient *OrganizationClient
+
+	Cache *DomainsCache
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,8 +21,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	msClient := msgraph.NewDomainsClient(o.TenantID)
 	o.ConfigureClient(&msClient.BaseClient, &aadClient.Client)
 
+	organizationClient := NewOrganizationClient(o.TenantID)
+	o.ConfigureMsGraphClient(&organizationClient.BaseClient)
+
 	return &Client{
 		AadClient: &aadClient,
 		MsClient:  msClient,
+
+		OrganizationClient: organizationClient,
+
+		Cache: &DomainsCache{},
 	}
 }
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// Organization describes the Azure Active Directory tenant.
+type Organization struct {
+	ID                    *string           `json:"id,omitempty"`
+	DisplayName           *string           `json:"displayName,omitempty"`
+	CountryLetterCode     *string           `json:"countryLetterCode,omitempty"`
+	TenantType            *string           `json:"tenantType,omitempty"`
+	OnPremisesSyncEnabled *bool             `json:"onPremisesSyncEnabled,omitempty"`
+	VerifiedDomains       *[]VerifiedDomain `json:"verifiedDomains,omitempty"`
+}
+
+// VerifiedDomain describes a domain which has been verified for the tenant.
+type VerifiedDomain struct {
+	Capabilities *string `json:"capabilities,omitempty"`
+	IsDefault    *bool   `json:"isDefault,omitempty"`
+	IsInitial    *bool   `json:"isInitial,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Type         *string `json:"type,omitempty"`
+}
+
+// OrganizationClient performs operations on the Organization.
+type OrganizationClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewOrganizationClient returns a new OrganizationClient.
+func NewOrganizationClient(tenantId string) *OrganizationClient {
+	return &OrganizationClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Get retrieves the Organization for the current tenant.
+func (c *OrganizationClient) Get(ctx context.Context) (*Organization, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/organization",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("OrganizationClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Organizations []Organization `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	if len(data.Organizations) == 0 {
+		return nil, status, fmt.Errorf("no organization was returned for the tenant")
+	}
+	return &data.Organizations[0], status, nil
+}
@@ -0,0 +1,162 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const domainResourceName = "azuread_domain"
+
+func domainResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: domainResourceCreate,
+		ReadContext:   domainResourceRead,
+		UpdateContext: domainResourceUpdate,
+		DeleteContext: domainResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if strings.TrimSpace(id) == "" {
+				return fmt.Errorf("specified ID (%q) is not a valid domain name", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+
+			"verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"authentication_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_admin_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_initial": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_root": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"is_verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"supported_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"verification_dns_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"mail_exchange": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"preference": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func domainResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(domainResourceName); diags != nil {
+		return diags
+	}
+	return domainResourceCreateMsGraph(ctx, d, meta)
+}
+
+func domainResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(domainResourceName); diags != nil {
+		return diags
+	}
+	return domainResourceReadMsGraph(ctx, d, meta)
+}
+
+func domainResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(domainResourceName); diags != nil {
+		return diags
+	}
+	return domainResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func domainResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(domainResourceName); diags != nil {
+		return diags
+	}
+	return domainResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,182 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func domainResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Domains.MsClient
+	domainName := d.Get("domain_name").(string)
+
+	existing, status, err := client.Get(ctx, domainName)
+	if err != nil && status != http.StatusNotFound {
+		return tf.ErrorDiagPathF(err, "domain_name", "Checking for existing domain %q", domainName)
+	}
+	if err == nil && existing != nil {
+		return tf.ImportAsExistsDiag(domainResourceName, domainName)
+	}
+
+	domain, _, err := helpers.DomainCreate(ctx, client, domainName)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating domain %q", domainName)
+	}
+
+	// The domain should be included the next time domains are listed
+	meta.(*clients.Client).Domains.Cache.Invalidate()
+
+	if domain.ID == nil || *domain.ID == "" {
+		return tf.ErrorDiagF(errors.New("API returned domain with nil ID"), "Bad API Response")
+	}
+
+	d.SetId(*domain.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return client.Get(ctx, *domain.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for domain %q", *domain.ID)
+	}
+
+	// The domain has been created, so failing to verify it is not an error, which would cause the resource to be tainted
+	// and the domain to be replaced. Instead `verify` is left unset in state, so verification is retried on the next apply.
+	var verifyDiags diag.Diagnostics
+	if d.Get("verify").(bool) {
+		if _, _, err := helpers.DomainVerify(ctx, client, *domain.ID); err != nil {
+			tf.Set(d, "verify", false)
+			verifyDiags = diag.Diagnostics{{
+				Severity:      diag.Warning,
+				Summary:       fmt.Sprintf("Could not verify domain %q, verification will be attempted again on the next apply. Please ensure the verification DNS records have been published", *domain.ID),
+				Detail:        err.Error(),
+				AttributePath: cty.Path{cty.GetAttrStep{Name: "verify"}},
+			}}
+		} else {
+			meta.(*clients.Client).Domains.Cache.Invalidate()
+		}
+	}
+
+	return append(domainResourceReadMsGraph(ctx, d, meta), verifyDiags...)
+}
+
+func domainResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Domains.MsClient
+
+	domain, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Domain %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving domain %q", d.Id())
+	}
+
+	tf.Set(d, "authentication_type", domain.AuthenticationType)
+	tf.Set(d, "domain_name", domain.ID)
+	tf.Set(d, "is_admin_managed", domain.IsAdminManaged)
+	tf.Set(d, "is_default", domain.IsDefault)
+	tf.Set(d, "is_initial", domain.IsInitial)
+	tf.Set(d, "is_root", domain.IsRoot)
+	tf.Set(d, "is_verified", domain.IsVerified)
+	tf.Set(d, "supported_services", tf.FlattenStringSlicePtr(domain.SupportedServices))
+
+	records := make([]interface{}, 0)
+
+	// Verification records are only available whilst the domain remains unverified
+	if domain.IsVerified == nil || !*domain.IsVerified {
+		result, _, err := helpers.DomainListVerificationDnsRecords(ctx, client, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "verification_dns_records", "Could not retrieve verification DNS records for domain %q", d.Id())
+		}
+		records = flattenDomainDnsRecords(result)
+	}
+
+	tf.Set(d, "verification_dns_records", records)
+
+	return nil
+}
+
+func domainResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Domains.MsClient
+
+	if d.HasChange("verify") && d.Get("verify").(bool) && !d.Get("is_verified").(bool) {
+		if _, _, err := helpers.DomainVerify(ctx, client, d.Id()); err != nil {
+			// preserve the prior state so that `verify` is not persisted and verification is attempted again on the next apply
+			d.Partial(true)
+			return tf.ErrorDiagPathF(err, "verify", "Verifying domain %q, please ensure the verification DNS records have been published", d.Id())
+		}
+		meta.(*clients.Client).Domains.Cache.Invalidate()
+	}
+
+	return domainResourceReadMsGraph(ctx, d, meta)
+}
+
+func domainResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Domains.MsClient
+
+	_, status, err := client.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Domain was not found"), "id", "Retrieving domain %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving domain %q", d.Id())
+	}
+
+	if _, err := helpers.DomainDelete(ctx, client, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting domain %q", d.Id())
+	}
+
+	meta.(*clients.Client).Domains.Cache.Invalidate()
+
+	return nil
+}
+
+func flattenDomainDnsRecords(in *[]helpers.DomainDnsRecord) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	records := make([]interface{}, 0)
+	for _, r := range *in {
+		record := map[string]interface{}{
+			"record_type":   "",
+			"label":         "",
+			"ttl":           0,
+			"text":          "",
+			"mail_exchange": "",
+			"preference":    0,
+		}
+		if r.RecordType != nil {
+			record["record_type"] = *r.RecordType
+		}
+		if r.Label != nil {
+			record["label"] = *r.Label
+		}
+		if r.Ttl != nil {
+			record["ttl"] = *r.Ttl
+		}
+		if r.Text != nil {
+			record["text"] = *r.Text
+		}
+		if r.MailExchange != nil {
+			record["mail_exchange"] = *r.MailExchange
+		}
+		if r.Preference != nil {
+			record["preference"] = *r.Preference
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
@@ -0,0 +1,82 @@
+package domains_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type DomainResource struct{}
+
+func TestAccDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain", "test")
+	r := DomainResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_verified").HasValue("false"),
+				check.That(data.ResourceName).Key("verification_dns_records.#").Exists(),
+				check.That(data.ResourceName).Key("verification_dns_records.0.record_type").Exists(),
+				check.That(data.ResourceName).Key("verification_dns_records.0.label").Exists(),
+			),
+		},
+		data.ImportStep("verify"),
+	})
+}
+
+func TestAccDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_domain", "test")
+	r := DomainResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r DomainResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	domain, status, err := clients.Domains.MsClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Domain %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Domain %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(domain.ID != nil && *domain.ID == state.ID), nil
+}
+
+func (DomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_domain" "test" {
+  domain_name = "acctest%[1]d.hashicorptest.com"
+}
+`, data.RandomInteger)
+}
+
+func (r DomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_domain" "import" {
+  domain_name = azuread_domain.test.domain_name
+}
+`, r.basic(data))
+}
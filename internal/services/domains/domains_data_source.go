@@ -2,18 +2,30 @@ package domains
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
 func domainsDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: domainsDataSourceRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"admin_managed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"include_unverified": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -29,6 +41,21 @@ func domainsDataSource() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"only_default"},
 			},
+
+			"only_root": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"supports_services": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
 			"domains": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -42,6 +69,10 @@ func domainsDataSource() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"is_admin_managed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"is_default": {
 							Type:     schema.TypeBool,
 							Computed: true,
@@ -50,10 +81,21 @@ func domainsDataSource() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"is_root": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"is_verified": {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"supported_services": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -67,3 +109,20 @@ func domainsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	return domainsDataSourceReadAadGraph(ctx, d, meta)
 }
+
+// domainSupportsServices returns whether all the required services are present in the services supported by a domain
+func domainSupportsServices(supportedServices []string, requiredServices []interface{}) bool {
+	for _, required := range requiredServices {
+		found := false
+		for _, supported := range supportedServices {
+			if strings.EqualFold(supported, required.(string)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -16,9 +16,12 @@ func domainsDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData,
 	tenantId := meta.(*clients.Client).TenantID
 	client := meta.(*clients.Client).Domains.AadClient
 
+	adminManaged := d.Get("admin_managed").(bool)
 	includeUnverified := d.Get("include_unverified").(bool)
 	onlyDefault := d.Get("only_default").(bool)
 	onlyInitial := d.Get("only_initial").(bool)
+	onlyRoot := d.Get("only_root").(bool)
+	supportsServices := d.Get("supports_services").([]interface{})
 
 	results, err := client.List(ctx, "")
 	if err != nil {
@@ -27,7 +30,7 @@ func domainsDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId("domains-" + tenantId) // todo this should be more unique
 
-	domains := flattenDomainsAad(results.Value, includeUnverified, onlyDefault, onlyInitial)
+	domains := flattenDomainsAad(results.Value, adminManaged, includeUnverified, onlyDefault, onlyInitial, onlyRoot, supportsServices)
 	if len(domains) == 0 {
 		return tf.ErrorDiagF(nil, "No domains were returned for the provided filters")
 	}
@@ -37,7 +40,7 @@ func domainsDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func flattenDomainsAad(input *[]graphrbac.Domain, includeUnverified, onlyDefault, onlyInitial bool) []interface{} {
+func flattenDomainsAad(input *[]graphrbac.Domain, adminManaged, includeUnverified, onlyDefault, onlyInitial, onlyRoot bool, supportsServices []interface{}) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -56,6 +59,11 @@ func flattenDomainsAad(input *[]graphrbac.Domain, includeUnverified, onlyDefault
 			authenticationType = *v.AuthenticationType
 		}
 
+		isAdminManaged := false
+		if val, ok := v.AdditionalProperties["isAdminManaged"].(bool); ok {
+			isAdminManaged = val
+		}
+
 		isDefault := false
 		if v.IsDefault != nil {
 			isDefault = *v.IsDefault
@@ -66,11 +74,25 @@ func flattenDomainsAad(input *[]graphrbac.Domain, includeUnverified, onlyDefault
 			isInitial = v.AdditionalProperties["isInitial"].(bool)
 		}
 
+		isRoot := false
+		if val, ok := v.AdditionalProperties["isRoot"].(bool); ok {
+			isRoot = val
+		}
+
 		isVerified := false
 		if v.IsVerified != nil {
 			isVerified = *v.IsVerified
 		}
 
+		supportedServices := make([]string, 0)
+		if services, ok := v.AdditionalProperties["supportedServices"].([]interface{}); ok {
+			for _, service := range services {
+				if s, ok := service.(string); ok {
+					supportedServices = append(supportedServices, s)
+				}
+			}
+		}
+
 		// Filters
 		if !isDefault && onlyDefault {
 			// skip all domains except the initial domain
@@ -84,6 +106,21 @@ func flattenDomainsAad(input *[]graphrbac.Domain, includeUnverified, onlyDefault
 			continue
 		}
 
+		if !isRoot && onlyRoot {
+			log.Printf("[DEBUG] Skipping %q since the filter requires root domains", domainName)
+			continue
+		}
+
+		if !isAdminManaged && adminManaged {
+			log.Printf("[DEBUG] Skipping %q since the filter requires admin managed domains", domainName)
+			continue
+		}
+
+		if !domainSupportsServices(supportedServices, supportsServices) {
+			log.Printf("[DEBUG] Skipping %q since the filter requires support for services %v", domainName, supportsServices)
+			continue
+		}
+
 		if !isVerified && !includeUnverified {
 			//skip unverified domains
 			log.Printf("[DEBUG] Skipping %q since the filter requires verified domains", domainName)
@@ -93,9 +130,12 @@ func flattenDomainsAad(input *[]graphrbac.Domain, includeUnverified, onlyDefault
 		domain := map[string]interface{}{
 			"authentication_type": authenticationType,
 			"domain_name":         domainName,
+			"is_admin_managed":    isAdminManaged,
 			"is_default":          isDefault,
 			"is_initial":          isInitial,
+			"is_root":             isRoot,
 			"is_verified":         isVerified,
+			"supported_services":  supportedServices,
 		}
 
 		domains = append(domains, domain)
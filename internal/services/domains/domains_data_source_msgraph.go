@@ -12,10 +12,16 @@ import (
 
 func domainsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Domains.MsClient
+	cache := meta.(*clients.Client).Domains.Cache
 
-	result, _, err := client.List(ctx)
-	if err != nil {
-		return tf.ErrorDiagF(err, "Could not list domains")
+	result, ok := cache.Get()
+	if !ok {
+		var err error
+		result, _, err = client.List(ctx)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not list domains")
+		}
+		cache.Set(result)
 	}
 
 	// TODO v2.0 improve the ID format
@@ -25,10 +31,12 @@ func domainsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId("domains-" + client.BaseClient.TenantId)
 
-	// TODO: v2.0 support filtering on isAdminManaged, isRoot and supportedServices
+	adminManaged := d.Get("admin_managed").(bool)
 	onlyDefault := d.Get("only_default").(bool)
 	onlyInitial := d.Get("only_initial").(bool)
+	onlyRoot := d.Get("only_root").(bool)
 	includeUnverified := d.Get("include_unverified").(bool)
+	supportsServices := d.Get("supports_services").([]interface{})
 
 	var domains []interface{}
 	if result != nil {
@@ -39,22 +47,39 @@ func domainsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, m
 			if onlyInitial && v.IsInitial != nil && !*v.IsInitial {
 				continue
 			}
+			if onlyRoot && v.IsRoot != nil && !*v.IsRoot {
+				continue
+			}
+			if adminManaged && v.IsAdminManaged != nil && !*v.IsAdminManaged {
+				continue
+			}
 			if !includeUnverified && v.IsVerified != nil && !*v.IsVerified {
 				continue
 			}
 
+			supportedServices := make([]string, 0)
+			if v.SupportedServices != nil {
+				supportedServices = *v.SupportedServices
+			}
+			if !domainSupportsServices(supportedServices, supportsServices) {
+				continue
+			}
+
 			domains = append(domains, map[string]interface{}{
 				"domain_name":         v.ID,
 				"authentication_type": v.AuthenticationType,
+				"is_admin_managed":    v.IsAdminManaged,
 				"is_default":          v.IsDefault,
 				"is_initial":          v.IsInitial,
+				"is_root":             v.IsRoot,
 				"is_verified":         v.IsVerified,
+				"supported_services":  supportedServices,
 			})
 		}
 	}
 
 	if len(domains) == 0 {
-		return tf.ErrorDiagF(err, "No domains found for the provided filters")
+		return tf.ErrorDiagF(nil, "No domains found for the provided filters")
 	}
 
 	tf.Set(d, "domains", domains)
@@ -60,6 +60,36 @@ func TestAccDomainsDataSource_onlyInitial(t *testing.T) {
 	})
 }
 
+func TestAccDomainsDataSource_onlyRoot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_domains", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DomainsDataSource{}.onlyRoot(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("domains.0.domain_name").Exists(),
+				check.That(data.ResourceName).Key("domains.0.is_root").HasValue("true"),
+				check.That(data.ResourceName).Key("domains.0.is_admin_managed").Exists(),
+				check.That(data.ResourceName).Key("domains.0.supported_services.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDomainsDataSource_supportsServices(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_domains", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DomainsDataSource{}.supportsServices(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("domains.0.domain_name").Exists(),
+				check.That(data.ResourceName).Key("domains.0.supported_services.#").Exists(),
+			),
+		},
+	})
+}
+
 func (DomainsDataSource) basic() string {
 	return `data "azuread_domains" "test" {}`
 }
@@ -79,3 +109,19 @@ data "azuread_domains" "test" {
 }
 `
 }
+
+func (DomainsDataSource) onlyRoot() string {
+	return `
+data "azuread_domains" "test" {
+  only_root = true
+}
+`
+}
+
+func (DomainsDataSource) supportsServices() string {
+	return `
+data "azuread_domains" "test" {
+  supports_services = ["Email", "OfficeCommunicationsOnline"]
+}
+`
+}
@@ -0,0 +1,96 @@
+package domains
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+const tenantDataSourceName = "azuread_tenant"
+
+func tenantDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: tenantDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"country_letter_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"initial_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"on_premises_sync_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tenant_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"verified_domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"capabilities": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_initial": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func tenantDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(tenantDataSourceName); diags != nil {
+		return diags
+	}
+	return tenantDataSourceReadMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,60 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func tenantDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Domains.OrganizationClient
+
+	organization, _, err := client.Get(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve organization for tenant")
+	}
+	if organization.ID == nil {
+		return tf.ErrorDiagF(nil, "API returned organization with nil ID")
+	}
+
+	d.SetId(*organization.ID)
+
+	defaultDomain := ""
+	initialDomain := ""
+	verifiedDomains := make([]interface{}, 0)
+	if organization.VerifiedDomains != nil {
+		for _, v := range *organization.VerifiedDomains {
+			if v.Name == nil {
+				continue
+			}
+			if v.IsDefault != nil && *v.IsDefault {
+				defaultDomain = *v.Name
+			}
+			if v.IsInitial != nil && *v.IsInitial {
+				initialDomain = *v.Name
+			}
+			verifiedDomains = append(verifiedDomains, map[string]interface{}{
+				"name":         v.Name,
+				"capabilities": v.Capabilities,
+				"is_default":   v.IsDefault,
+				"is_initial":   v.IsInitial,
+				"type":         v.Type,
+			})
+		}
+	}
+
+	tf.Set(d, "country_letter_code", organization.CountryLetterCode)
+	tf.Set(d, "default_domain", defaultDomain)
+	tf.Set(d, "display_name", organization.DisplayName)
+	tf.Set(d, "initial_domain", initialDomain)
+	tf.Set(d, "on_premises_sync_enabled", organization.OnPremisesSyncEnabled != nil && *organization.OnPremisesSyncEnabled)
+	tf.Set(d, "tenant_id", organization.ID)
+	tf.Set(d, "tenant_type", organization.TenantType)
+	tf.Set(d, "verified_domains", verifiedDomains)
+
+	return nil
+}
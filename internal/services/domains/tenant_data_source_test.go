@@ -0,0 +1,41 @@
+package domains_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type TenantDataSource struct{}
+
+func TestAccTenantDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_tenant", "test")
+	tenantId := os.Getenv("ARM_TENANT_ID")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: TenantDataSource{}.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("tenant_id").HasValue(tenantId),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("default_domain").Exists(),
+				check.That(data.ResourceName).Key("initial_domain").Exists(),
+				check.That(data.ResourceName).Key("on_premises_sync_enabled").Exists(),
+				check.That(data.ResourceName).Key("verified_domains.0.name").Exists(),
+				check.That(data.ResourceName).Key("verified_domains.0.type").Exists(),
+			),
+		},
+	})
+}
+
+func (TenantDataSource) basic() string {
+	return `data "azuread_tenant" "test" {}`
+}
@@ -0,0 +1,18 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	IdentityProvidersClient *IdentityProvidersClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	identityProvidersClient := NewIdentityProvidersClient(o.TenantID)
+	o.ConfigureMsGraphBetaClient(&identityProvidersClient.BaseClient)
+
+	return &Client{
+		IdentityProvidersClient: identityProvidersClient,
+	}
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	IdentityProviderODataTypeApple         = "#microsoft.graph.appleManagedIdentityProvider"
+	IdentityProviderODataTypeOpenIdConnect = "#microsoft.graph.openIdConnectIdentityProvider"
+	IdentityProviderODataTypeSocial        = "#microsoft.graph.socialIdentityProvider"
+)
+
+// IdentityProvider describes a social, Apple or OpenID Connect Identity Provider. Only the fields relevant to the
+// provider type indicated by ODataType are populated.
+type IdentityProvider struct {
+	ODataType   *string `json:"@odata.type,omitempty"`
+	ID          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Social and OpenID Connect providers
+	ClientId     *string `json:"clientId,omitempty"`
+	ClientSecret *string `json:"clientSecret,omitempty"`
+
+	// Social providers
+	IdentityProviderType *string `json:"identityProviderType,omitempty"`
+
+	// Apple
+	CertificateData *string `json:"certificateData,omitempty"`
+	DeveloperId     *string `json:"developerId,omitempty"`
+	KeyId           *string `json:"keyId,omitempty"`
+	ServiceId       *string `json:"serviceId,omitempty"`
+
+	// OpenID Connect providers
+	ClaimsMapping *ClaimsMapping `json:"claimsMapping,omitempty"`
+	DomainHint    *string        `json:"domainHint,omitempty"`
+	MetadataUrl   *string        `json:"metadataUrl,omitempty"`
+	ResponseMode  *string        `json:"responseMode,omitempty"`
+	ResponseType  *string        `json:"responseType,omitempty"`
+	Scope         *string        `json:"scope,omitempty"`
+}
+
+type ClaimsMapping struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	GivenName   *string `json:"givenName,omitempty"`
+	Surname     *string `json:"surname,omitempty"`
+	UserId      *string `json:"userId,omitempty"`
+}
+
+// IdentityProvidersClient performs operations on Identity Providers.
+type IdentityProvidersClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewIdentityProvidersClient returns a new IdentityProvidersClient.
+func NewIdentityProvidersClient(tenantId string) *IdentityProvidersClient {
+	return &IdentityProvidersClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta, tenantId),
+	}
+}
+
+// Create creates a new Identity Provider.
+func (c *IdentityProvidersClient) Create(ctx context.Context, provider IdentityProvider) (*IdentityProvider, int, error) {
+	var status int
+	body, err := json.Marshal(provider)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/identity/identityProviders",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("IdentityProvidersClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newProvider IdentityProvider
+	if err := json.Unmarshal(respBody, &newProvider); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newProvider, status, nil
+}
+
+// Get retrieves an Identity Provider.
+func (c *IdentityProvidersClient) Get(ctx context.Context, id string) (*IdentityProvider, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identity/identityProviders/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("IdentityProvidersClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var provider IdentityProvider
+	if err := json.Unmarshal(respBody, &provider); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &provider, status, nil
+}
+
+// Update amends an existing Identity Provider.
+func (c *IdentityProvidersClient) Update(ctx context.Context, provider IdentityProvider) (int, error) {
+	var status int
+	if provider.ID == nil {
+		return status, fmt.Errorf("cannot update identity provider with nil ID")
+	}
+	body, err := json.Marshal(provider)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identity/identityProviders/%s", *provider.ID),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("IdentityProvidersClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// Delete removes an Identity Provider.
+func (c *IdentityProvidersClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identity/identityProviders/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("IdentityProvidersClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
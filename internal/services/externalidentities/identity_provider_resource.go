@@ -0,0 +1,221 @@
+package externalidentities
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const identityProviderResourceName = "azuread_identity_provider"
+
+const (
+	identityProviderTypeApple         = "Apple"
+	identityProviderTypeFacebook      = "Facebook"
+	identityProviderTypeGoogle        = "Google"
+	identityProviderTypeOpenIdConnect = "OpenIdConnect"
+)
+
+func identityProviderResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: identityProviderResourceCreate,
+		ReadContext:   identityProviderResourceRead,
+		UpdateContext: identityProviderResourceUpdate,
+		DeleteContext: identityProviderResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id == "" {
+				return fmt.Errorf("specified ID cannot be empty")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					identityProviderTypeApple,
+					identityProviderTypeFacebook,
+					identityProviderTypeGoogle,
+					identityProviderTypeOpenIdConnect,
+				}, false),
+			},
+
+			"client_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"client_secret": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"apple": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_data": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"developer_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"key_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"service_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"openid_connect": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata_url": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"scopes": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"response_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "code",
+							ValidateFunc: validation.StringInSlice([]string{"code", "id_token", "token"}, false),
+						},
+
+						"response_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "form_post",
+							ValidateFunc: validation.StringInSlice([]string{"form_post", "query"}, false),
+						},
+
+						"domain_hint": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"claims_mapping": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_id": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validate.NoEmptyStrings,
+									},
+
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"email": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"given_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"surname": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func identityProviderResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(identityProviderResourceName); diags != nil {
+		return diags
+	}
+	return identityProviderResourceCreateMsGraph(ctx, d, meta)
+}
+
+func identityProviderResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(identityProviderResourceName); diags != nil {
+		return diags
+	}
+	return identityProviderResourceReadMsGraph(ctx, d, meta)
+}
+
+func identityProviderResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(identityProviderResourceName); diags != nil {
+		return diags
+	}
+	return identityProviderResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func identityProviderResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(identityProviderResourceName); diags != nil {
+		return diags
+	}
+	return identityProviderResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,242 @@
+package externalidentities
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/externalidentities/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func identityProviderResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).ExternalIdentities.IdentityProvidersClient
+	displayName := d.Get("display_name").(string)
+
+	properties, diags := expandIdentityProvider(d)
+	if diags != nil {
+		return diags
+	}
+
+	provider, _, err := c.Create(ctx, *properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating identity provider %q", displayName)
+	}
+	if provider.ID == nil || *provider.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for identity provider %q", displayName), "Bad API response")
+	}
+
+	d.SetId(*provider.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, *provider.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for identity provider with ID %q", *provider.ID)
+	}
+
+	return identityProviderResourceReadMsGraph(ctx, d, meta)
+}
+
+func identityProviderResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).ExternalIdentities.IdentityProvidersClient
+
+	provider, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Identity provider with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving identity provider with ID %q", d.Id())
+	}
+
+	tf.Set(d, "display_name", provider.DisplayName)
+
+	// Secrets are never returned by the API, so client_secret and certificate_data are retained from configuration
+	var odataType string
+	if provider.ODataType != nil {
+		odataType = *provider.ODataType
+	}
+
+	switch odataType {
+	case client.IdentityProviderODataTypeApple:
+		tf.Set(d, "type", identityProviderTypeApple)
+		tf.Set(d, "apple", []map[string]interface{}{{
+			"certificate_data": d.Get("apple.0.certificate_data").(string),
+			"developer_id":     provider.DeveloperId,
+			"key_id":           provider.KeyId,
+			"service_id":       provider.ServiceId,
+		}})
+
+	case client.IdentityProviderODataTypeOpenIdConnect:
+		tf.Set(d, "type", identityProviderTypeOpenIdConnect)
+		tf.Set(d, "client_id", provider.ClientId)
+		tf.Set(d, "openid_connect", flattenOpenIdConnect(provider))
+
+	default:
+		tf.Set(d, "type", provider.IdentityProviderType)
+		tf.Set(d, "client_id", provider.ClientId)
+	}
+
+	return nil
+}
+
+func identityProviderResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).ExternalIdentities.IdentityProvidersClient
+
+	properties, diags := expandIdentityProvider(d)
+	if diags != nil {
+		return diags
+	}
+
+	// The provider type cannot be changed
+	properties.ID = utils.String(d.Id())
+	properties.IdentityProviderType = nil
+
+	if _, err := c.Update(ctx, *properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating identity provider with ID %q", d.Id())
+	}
+
+	return identityProviderResourceReadMsGraph(ctx, d, meta)
+}
+
+func identityProviderResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).ExternalIdentities.IdentityProvidersClient
+
+	_, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Identity provider was not found"), "id", "Retrieving identity provider with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving identity provider with ID %q", d.Id())
+	}
+
+	if _, err := c.Delete(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting identity provider with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandIdentityProvider(d *schema.ResourceData) (*client.IdentityProvider, diag.Diagnostics) {
+	providerType := d.Get("type").(string)
+
+	properties := client.IdentityProvider{
+		DisplayName: utils.String(d.Get("display_name").(string)),
+	}
+
+	apple := d.Get("apple").([]interface{})
+	openIdConnect := d.Get("openid_connect").([]interface{})
+
+	if providerType != identityProviderTypeApple && len(apple) > 0 {
+		return nil, tf.ErrorDiagPathF(nil, "apple", "The `apple` block can only be specified when `type` is %q", identityProviderTypeApple)
+	}
+	if providerType != identityProviderTypeOpenIdConnect && len(openIdConnect) > 0 {
+		return nil, tf.ErrorDiagPathF(nil, "openid_connect", "The `openid_connect` block can only be specified when `type` is %q", identityProviderTypeOpenIdConnect)
+	}
+
+	if providerType == identityProviderTypeApple {
+		if len(apple) == 0 || apple[0] == nil {
+			return nil, tf.ErrorDiagPathF(nil, "apple", "An `apple` block is required when `type` is %q", identityProviderTypeApple)
+		}
+		if _, ok := d.GetOk("client_id"); ok {
+			return nil, tf.ErrorDiagPathF(nil, "client_id", "`client_id` cannot be specified when `type` is %q", identityProviderTypeApple)
+		}
+		if _, ok := d.GetOk("client_secret"); ok {
+			return nil, tf.ErrorDiagPathF(nil, "client_secret", "`client_secret` cannot be specified when `type` is %q", identityProviderTypeApple)
+		}
+
+		a := apple[0].(map[string]interface{})
+		properties.ODataType = utils.String(client.IdentityProviderODataTypeApple)
+		properties.CertificateData = utils.String(a["certificate_data"].(string))
+		properties.DeveloperId = utils.String(a["developer_id"].(string))
+		properties.KeyId = utils.String(a["key_id"].(string))
+		properties.ServiceId = utils.String(a["service_id"].(string))
+
+		return &properties, nil
+	}
+
+	clientId, ok := d.GetOk("client_id")
+	if !ok {
+		return nil, tf.ErrorDiagPathF(nil, "client_id", "`client_id` is required when `type` is %q", providerType)
+	}
+	clientSecret, ok := d.GetOk("client_secret")
+	if !ok {
+		return nil, tf.ErrorDiagPathF(nil, "client_secret", "`client_secret` is required when `type` is %q", providerType)
+	}
+	properties.ClientId = utils.String(clientId.(string))
+	properties.ClientSecret = utils.String(clientSecret.(string))
+
+	if providerType == identityProviderTypeOpenIdConnect {
+		if len(openIdConnect) == 0 || openIdConnect[0] == nil {
+			return nil, tf.ErrorDiagPathF(nil, "openid_connect", "An `openid_connect` block is required when `type` is %q", identityProviderTypeOpenIdConnect)
+		}
+
+		o := openIdConnect[0].(map[string]interface{})
+		properties.ODataType = utils.String(client.IdentityProviderODataTypeOpenIdConnect)
+		properties.MetadataUrl = utils.String(o["metadata_url"].(string))
+		properties.ResponseMode = utils.String(o["response_mode"].(string))
+		properties.ResponseType = utils.String(o["response_type"].(string))
+		properties.DomainHint = utils.String(o["domain_hint"].(string))
+
+		scopes := make([]string, 0)
+		for _, scope := range o["scopes"].([]interface{}) {
+			scopes = append(scopes, scope.(string))
+		}
+		properties.Scope = utils.String(strings.Join(scopes, " "))
+
+		if claims := o["claims_mapping"].([]interface{}); len(claims) > 0 && claims[0] != nil {
+			cm := claims[0].(map[string]interface{})
+			properties.ClaimsMapping = &client.ClaimsMapping{
+				DisplayName: utils.String(cm["display_name"].(string)),
+				Email:       utils.String(cm["email"].(string)),
+				GivenName:   utils.String(cm["given_name"].(string)),
+				Surname:     utils.String(cm["surname"].(string)),
+				UserId:      utils.String(cm["user_id"].(string)),
+			}
+		}
+
+		return &properties, nil
+	}
+
+	properties.ODataType = utils.String(client.IdentityProviderODataTypeSocial)
+	properties.IdentityProviderType = utils.String(providerType)
+
+	return &properties, nil
+}
+
+func flattenOpenIdConnect(in *client.IdentityProvider) []map[string]interface{} {
+	scopes := make([]string, 0)
+	if in.Scope != nil {
+		scopes = strings.Fields(*in.Scope)
+	}
+
+	claimsMapping := make([]map[string]interface{}, 0)
+	if cm := in.ClaimsMapping; cm != nil {
+		claimsMapping = append(claimsMapping, map[string]interface{}{
+			"display_name": cm.DisplayName,
+			"email":        cm.Email,
+			"given_name":   cm.GivenName,
+			"surname":      cm.Surname,
+			"user_id":      cm.UserId,
+		})
+	}
+
+	return []map[string]interface{}{{
+		"claims_mapping": claimsMapping,
+		"domain_hint":    in.DomainHint,
+		"metadata_url":   in.MetadataUrl,
+		"response_mode":  in.ResponseMode,
+		"response_type":  in.ResponseType,
+		"scopes":         scopes,
+	}}
+}
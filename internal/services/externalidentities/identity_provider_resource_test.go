@@ -0,0 +1,131 @@
+package externalidentities_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type IdentityProviderResource struct{}
+
+func TestAccIdentityProvider_google(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_identity_provider", "test")
+	r := IdentityProviderResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.google(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("type").HasValue("Google"),
+			),
+		},
+		data.ImportStep("client_secret"),
+	})
+}
+
+func TestAccIdentityProvider_openIdConnect(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_identity_provider", "test")
+	r := IdentityProviderResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.openIdConnect(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("openid_connect.0.scopes.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("client_secret"),
+	})
+}
+
+func TestAccIdentityProvider_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_identity_provider", "test")
+	r := IdentityProviderResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.google(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("client_secret"),
+		{
+			Config: r.googleUpdated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-Google-updated-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("client_secret"),
+	})
+}
+
+func (r IdentityProviderResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	provider, status, err := clients.ExternalIdentities.IdentityProvidersClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Identity Provider with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Identity Provider with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(provider.ID != nil && *provider.ID == state.ID), nil
+}
+
+func (IdentityProviderResource) google(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_identity_provider" "test" {
+  display_name  = "acctest-Google-%[1]d"
+  type          = "Google"
+  client_id     = "%[2]s.apps.googleusercontent.com"
+  client_secret = "%[3]s"
+}
+`, data.RandomInteger, data.RandomID, data.RandomPassword)
+}
+
+func (IdentityProviderResource) googleUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_identity_provider" "test" {
+  display_name  = "acctest-Google-updated-%[1]d"
+  type          = "Google"
+  client_id     = "%[2]s-updated.apps.googleusercontent.com"
+  client_secret = "%[3]s"
+}
+`, data.RandomInteger, data.RandomID, data.RandomPassword)
+}
+
+func (IdentityProviderResource) openIdConnect(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_identity_provider" "test" {
+  display_name  = "acctest-OIDC-%[1]d"
+  type          = "OpenIdConnect"
+  client_id     = "%[2]s"
+  client_secret = "%[3]s"
+
+  openid_connect {
+    metadata_url  = "https://login.microsoftonline.com/common/v2.0/.well-known/openid-configuration"
+    scopes        = ["openid", "profile"]
+    response_type = "code"
+    response_mode = "form_post"
+
+    claims_mapping {
+      user_id      = "sub"
+      display_name = "name"
+      email        = "email"
+    }
+  }
+}
+`, data.RandomInteger, data.RandomID, data.RandomPassword)
+}
@@ -8,8 +8,15 @@ import (
 )
 
 type Client struct {
-	AadClient *graphrbac.GroupsClient
-	MsClient  *msgraph.GroupsClient
+	AadClient               *graphrbac.GroupsClient
+	MsClient                *msgraph.GroupsClient
+	DynamicMembershipClient *DynamicMembershipClient
+	MailboxSettingsClient   *MailboxSettingsClient
+	TeamsClient             *TeamsClient
+	WritebackClient         *WritebackClient
+
+	// BetaApi is true when the groups service has opted in to the Microsoft Graph beta API
+	BetaApi bool
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,8 +24,26 @@ func NewClient(o *common.ClientOptions) *Client {
 	msClient := msgraph.NewGroupsClient(o.TenantID)
 	o.ConfigureClient(&msClient.BaseClient, &aadClient.Client)
 
+	dynamicMembershipClient := NewDynamicMembershipClient(o.TenantID)
+	o.ConfigureMsGraphBetaClient(&dynamicMembershipClient.BaseClient)
+
+	mailboxSettingsClient := NewMailboxSettingsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&mailboxSettingsClient.BaseClient)
+
+	teamsClient := NewTeamsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&teamsClient.BaseClient)
+
+	writebackClient := NewWritebackClient(o.TenantID)
+	o.ConfigureMsGraphBetaClient(&writebackClient.BaseClient)
+
 	return &Client{
-		AadClient: &aadClient,
-		MsClient:  msClient,
+		AadClient:               &aadClient,
+		MsClient:                msClient,
+		DynamicMembershipClient: dynamicMembershipClient,
+		MailboxSettingsClient:   mailboxSettingsClient,
+		TeamsClient:             teamsClient,
+		WritebackClient:         writebackClient,
+
+		BetaApi: o.UseBetaApi,
 	}
 }
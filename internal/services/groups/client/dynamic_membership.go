@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// DynamicMembershipEvaluation describes the result of evaluating a dynamic membership rule against a directory object.
+type DynamicMembershipEvaluation struct {
+	MembershipRule                 *string `json:"membershipRule,omitempty"`
+	MembershipRuleEvaluationResult *bool   `json:"membershipRuleEvaluationResult,omitempty"`
+}
+
+// DynamicMembershipClient evaluates dynamic group membership rules. This is only available in the beta API.
+type DynamicMembershipClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewDynamicMembershipClient returns a new DynamicMembershipClient.
+func NewDynamicMembershipClient(tenantId string) *DynamicMembershipClient {
+	return &DynamicMembershipClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta, tenantId),
+	}
+}
+
+// Evaluate determines whether the directory object with the specified ID would be a member of a group having the
+// specified dynamic membership rule. A rule with invalid syntax results in a 400 response.
+func (c *DynamicMembershipClient) Evaluate(ctx context.Context, memberId, membershipRule string) (*DynamicMembershipEvaluation, int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		MemberId       string `json:"memberId"`
+		MembershipRule string `json:"membershipRule"`
+	}{
+		MemberId:       memberId,
+		MembershipRule: membershipRule,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/groups/evaluateDynamicMembership",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DynamicMembershipClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var evaluation DynamicMembershipEvaluation
+	if err := json.Unmarshal(respBody, &evaluation); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &evaluation, status, nil
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// GroupMailboxSettings describes the Exchange settings of a Microsoft 365 group. These are not returned unless
+// explicitly selected, and must be updated separately from other group properties.
+type GroupMailboxSettings struct {
+	AllowExternalSenders    *bool `json:"allowExternalSenders,omitempty"`
+	AutoSubscribeNewMembers *bool `json:"autoSubscribeNewMembers,omitempty"`
+	HideFromAddressLists    *bool `json:"hideFromAddressLists,omitempty"`
+	HideFromOutlookClients  *bool `json:"hideFromOutlookClients,omitempty"`
+}
+
+var groupMailboxSettingsFields = []string{"allowExternalSenders", "autoSubscribeNewMembers", "hideFromAddressLists", "hideFromOutlookClients"}
+
+// MailboxSettingsClient performs operations on the Exchange settings of Microsoft 365 groups. These settings can only
+// be managed using delegated authentication.
+type MailboxSettingsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewMailboxSettingsClient returns a new MailboxSettingsClient.
+func NewMailboxSettingsClient(tenantId string) *MailboxSettingsClient {
+	return &MailboxSettingsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Get retrieves the mailbox settings for the group with the specified ID.
+func (c *MailboxSettingsClient) Get(ctx context.Context, groupId string) (*GroupMailboxSettings, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s", groupId),
+			Params:      url.Values{"$select": []string{strings.Join(groupMailboxSettingsFields, ",")}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("MailboxSettingsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var settings GroupMailboxSettings
+	if err := json.Unmarshal(respBody, &settings); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &settings, status, nil
+}
+
+// Update sets the mailbox settings for the group with the specified ID. Only the settings which are not nil are changed.
+func (c *MailboxSettingsClient) Update(ctx context.Context, groupId string, settings GroupMailboxSettings) (int, error) {
+	var status int
+	body, err := json.Marshal(settings)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s", groupId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("MailboxSettingsClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
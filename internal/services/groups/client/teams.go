@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// Team describes a Microsoft Teams team, which is always backed by a Microsoft 365 group having the same ID.
+type Team struct {
+	ID          *string `json:"id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	InternalId  *string `json:"internalId,omitempty"`
+	IsArchived  *bool   `json:"isArchived,omitempty"`
+	WebUrl      *string `json:"webUrl,omitempty"`
+}
+
+// TeamsAsyncOperation describes a long-running operation, such as the provisioning of a new team.
+type TeamsAsyncOperation struct {
+	ID               *string                   `json:"id,omitempty"`
+	OperationType    *string                   `json:"operationType,omitempty"`
+	Status           *string                   `json:"status,omitempty"`
+	TargetResourceId *string                   `json:"targetResourceId,omitempty"`
+	Error            *TeamsAsyncOperationError `json:"error,omitempty"`
+}
+
+type TeamsAsyncOperationError struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+const (
+	TeamsAsyncOperationStatusFailed     = "failed"
+	TeamsAsyncOperationStatusInProgress = "inProgress"
+	TeamsAsyncOperationStatusInvalid    = "invalid"
+	TeamsAsyncOperationStatusNotStarted = "notStarted"
+	TeamsAsyncOperationStatusSucceeded  = "succeeded"
+)
+
+// TeamTemplateStandard is the template for a team with the default settings and channels.
+const TeamTemplateStandard = "standard"
+
+// teamsOperationLocationRegex matches the Location header returned when creating a team, which refers to the
+// operation that provisions it, e.g. `/teams('{teamId}')/operations('{operationId}')`
+var teamsOperationLocationRegex = regexp.MustCompile(`^/teams\('([^']+)'\)/operations\('([^']+)'\)$`)
+
+// TeamsClient performs operations on Teams.
+type TeamsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewTeamsClient returns a new TeamsClient.
+func NewTeamsClient(tenantId string) *TeamsClient {
+	return &TeamsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// CreateFromGroup adds a team to the Microsoft 365 group with the specified ID, using the specified template. The team
+// is provisioned asynchronously, so the IDs of the team and of the provisioning operation are returned.
+func (c *TeamsClient) CreateFromGroup(ctx context.Context, groupId, templateId string) (teamId, operationId string, status int, err error) {
+	body, err := json.Marshal(map[string]string{
+		"template@odata.bind": fmt.Sprintf("%s/%s/teamsTemplates('%s')", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, templateId),
+		"group@odata.bind":    fmt.Sprintf("%s/%s/groups('%s')", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, groupId),
+	})
+	if err != nil {
+		return "", "", status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusAccepted},
+		Uri: msgraph.Uri{
+			Entity:      "/teams",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return "", "", status, fmt.Errorf("TeamsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	location := resp.Header.Get("Location")
+	m := teamsOperationLocationRegex.FindStringSubmatch(location)
+	if m == nil {
+		return "", "", status, fmt.Errorf("unrecognised Location header for team provisioning operation: %q", location)
+	}
+	return m[1], m[2], status, nil
+}
+
+// Get retrieves a Team.
+func (c *TeamsClient) Get(ctx context.Context, id string) (*Team, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/teams/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TeamsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var team Team
+	if err := json.Unmarshal(respBody, &team); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &team, status, nil
+}
+
+// GetOperation retrieves an asynchronous operation for a Team.
+func (c *TeamsClient) GetOperation(ctx context.Context, teamId, operationId string) (*TeamsAsyncOperation, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/teams/%s/operations/%s", teamId, operationId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TeamsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var operation TeamsAsyncOperation
+	if err := json.Unmarshal(respBody, &operation); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &operation, status, nil
+}
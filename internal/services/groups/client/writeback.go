@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// GroupWritebackConfiguration describes whether a cloud group is written back to on-premises Active Directory.
+type GroupWritebackConfiguration struct {
+	IsEnabled           *bool   `json:"isEnabled,omitempty"`
+	OnPremisesGroupType *string `json:"onPremisesGroupType,omitempty"`
+}
+
+const (
+	OnPremisesGroupTypeUniversalDistributionGroup        = "universalDistributionGroup"
+	OnPremisesGroupTypeUniversalMailEnabledSecurityGroup = "universalMailEnabledSecurityGroup"
+	OnPremisesGroupTypeUniversalSecurityGroup            = "universalSecurityGroup"
+)
+
+// WritebackClient manages the writeback configuration of groups. This is only available in the beta API, so it should
+// only be used when the groups service has opted in to the beta API.
+type WritebackClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewWritebackClient returns a new WritebackClient.
+func NewWritebackClient(tenantId string) *WritebackClient {
+	return &WritebackClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta, tenantId),
+	}
+}
+
+// Get retrieves the writeback configuration for the group with the specified ID.
+func (c *WritebackClient) Get(ctx context.Context, groupId string) (*GroupWritebackConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s", groupId),
+			Params:      url.Values{"$select": []string{"writebackConfiguration"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("WritebackClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var group struct {
+		WritebackConfiguration *GroupWritebackConfiguration `json:"writebackConfiguration"`
+	}
+	if err := json.Unmarshal(respBody, &group); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	if group.WritebackConfiguration == nil {
+		return &GroupWritebackConfiguration{}, status, nil
+	}
+	return group.WritebackConfiguration, status, nil
+}
+
+// Update sets the writeback configuration for the group with the specified ID.
+func (c *WritebackClient) Update(ctx context.Context, groupId string, configuration GroupWritebackConfiguration) (int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		WritebackConfiguration GroupWritebackConfiguration `json:"writebackConfiguration"`
+	}{
+		WritebackConfiguration: configuration,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/groups/%s", groupId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("WritebackClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
@@ -2,6 +2,7 @@ package groups
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,10 @@ func groupDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: groupDataSourceRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -23,7 +28,7 @@ func groupDataSource() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ExactlyOneOf:     []string{"display_name", "name", "object_id"},
+				ExactlyOneOf:     []string{"display_name", "mail", "mail_nickname", "name", "object_id"},
 				ValidateDiagFunc: validate.UUID,
 			},
 
@@ -36,7 +41,7 @@ func groupDataSource() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ExactlyOneOf:     []string{"display_name", "name", "object_id"},
+				ExactlyOneOf:     []string{"display_name", "mail", "mail_nickname", "name", "object_id"},
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
@@ -46,7 +51,23 @@ func groupDataSource() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				Deprecated:       "This property has been renamed to `display_name` and will be removed in version 2.0 of the AzureAD provider.",
-				ExactlyOneOf:     []string{"display_name", "name", "object_id"},
+				ExactlyOneOf:     []string{"display_name", "mail", "mail_nickname", "name", "object_id"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"mail": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "mail", "mail_nickname", "name", "object_id"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"mail_nickname": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "mail", "mail_nickname", "name", "object_id"},
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
@@ -56,6 +77,32 @@ func groupDataSource() *schema.Resource {
 				Computed: true,
 			},
 
+			"onpremises_sam_account_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"onpremises_security_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"onpremises_sync_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"preferred_data_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"proxy_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"security_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -67,19 +67,38 @@ func groupDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		group = resp
-	} else if name != "" {
-		g, err := aadgraph.GroupGetByDisplayName(ctx, client, name, mailEnabled, securityEnabled)
+	} else {
+		var g *graphrbac.ADGroup
+		var err error
+		var params []string
+		var path string
+
+		if name != "" {
+			g, err = aadgraph.GroupGetByDisplayName(ctx, client, name, mailEnabled, securityEnabled)
+			params = append(params, fmt.Sprintf("display_name: %q", name))
+			path = "name"
+		} else if v, ok := d.GetOk("mail"); ok {
+			g, err = aadgraph.GroupGetByMail(ctx, client, v.(string), mailEnabled, securityEnabled)
+			params = append(params, fmt.Sprintf("mail: %q", v.(string)))
+			path = "mail"
+		} else if v, ok := d.GetOk("mail_nickname"); ok {
+			g, err = aadgraph.GroupGetByMailNickname(ctx, client, v.(string), mailEnabled, securityEnabled)
+			params = append(params, fmt.Sprintf("mail_nickname: %q", v.(string)))
+			path = "mail_nickname"
+		}
+
 		if err != nil {
-			params := []string{fmt.Sprintf("display_name: %q", name)}
 			if mailEnabled != nil {
 				params = append(params, fmt.Sprintf("mail_enabled: %t", *mailEnabled))
 			}
 			if securityEnabled != nil {
 				params = append(params, fmt.Sprintf("security_enabled: %t", *securityEnabled))
 			}
-			return tf.ErrorDiagPathF(err, "name", "No group found matching specified parameters (%s)", strings.Join(params, ", "))
+			return tf.ErrorDiagPathF(err, path, "No group found matching specified parameters (%s)", strings.Join(params, ", "))
+		}
+		if g != nil {
+			group = *g
 		}
-		group = *g
 	}
 
 	if group.ObjectID == nil {
@@ -91,7 +110,14 @@ func groupDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData, me
 	tf.Set(d, "object_id", group.ObjectID)
 	tf.Set(d, "display_name", group.DisplayName)
 	tf.Set(d, "name", group.DisplayName)
+	tf.Set(d, "mail", group.Mail)
 	tf.Set(d, "mail_enabled", group.MailEnabled)
+	tf.Set(d, "mail_nickname", group.MailNickname)
+	tf.Set(d, "onpremises_sam_account_name", group.AdditionalProperties["onPremisesSamAccountName"])
+	tf.Set(d, "onpremises_security_identifier", group.AdditionalProperties["onPremisesSecurityIdentifier"])
+	tf.Set(d, "onpremises_sync_enabled", group.AdditionalProperties["dirSyncEnabled"])
+	tf.Set(d, "preferred_data_location", group.AdditionalProperties["preferredDataLocation"])
+	tf.Set(d, "proxy_addresses", group.AdditionalProperties["proxyAddresses"])
 	tf.Set(d, "security_enabled", group.SecurityEnabled)
 
 	description := ""
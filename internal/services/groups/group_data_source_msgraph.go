@@ -35,8 +35,19 @@ func groupDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, met
 		securityEnabled = utils.Bool(v.(bool))
 	}
 
+	var filter, filterField string
 	if displayName != "" {
-		filter := fmt.Sprintf("displayName eq '%s'", displayName)
+		filter = fmt.Sprintf("displayName eq '%s'", displayName)
+		filterField = "name"
+	} else if v, ok := d.GetOk("mail"); ok {
+		filter = fmt.Sprintf("mail eq '%s'", v.(string))
+		filterField = "mail"
+	} else if v, ok := d.GetOk("mail_nickname"); ok {
+		filter = fmt.Sprintf("mailNickname eq '%s'", v.(string))
+		filterField = "mail_nickname"
+	}
+
+	if filter != "" {
 		if mailEnabled != nil {
 			filter = fmt.Sprintf("%s and mailEnabled eq %t", filter, *mailEnabled)
 		}
@@ -46,14 +57,14 @@ func groupDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, met
 
 		groups, _, err := client.List(ctx, filter)
 		if err != nil {
-			return tf.ErrorDiagPathF(err, "name", "No group found matching specified filter (%s)", filter)
+			return tf.ErrorDiagPathF(err, filterField, "No group found matching specified filter (%s)", filter)
 		}
 
 		count := len(*groups)
 		if count > 1 {
-			return tf.ErrorDiagPathF(err, "name", "More than one group found matching specified filter (%s)", filter)
+			return tf.ErrorDiagPathF(err, filterField, "More than one group found matching specified filter (%s)", filter)
 		} else if count == 0 {
-			return tf.ErrorDiagPathF(err, "name", "No group found matching specified filter (%s)", filter)
+			return tf.ErrorDiagPathF(err, filterField, "No group found matching specified filter (%s)", filter)
 		}
 
 		group = (*groups)[0]
@@ -100,7 +111,14 @@ func groupDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, met
 
 	tf.Set(d, "description", group.Description)
 	tf.Set(d, "display_name", group.DisplayName)
+	tf.Set(d, "mail", group.Mail)
 	tf.Set(d, "mail_enabled", group.MailEnabled)
+	tf.Set(d, "mail_nickname", group.MailNickname)
+	tf.Set(d, "onpremises_sam_account_name", group.OnPremisesSamAccountName)
+	tf.Set(d, "onpremises_security_identifier", group.OnPremisesSecurityIdentifier)
+	tf.Set(d, "onpremises_sync_enabled", group.OnPremisesSyncEnabled)
+	tf.Set(d, "preferred_data_location", group.PreferredDataLocation)
+	tf.Set(d, "proxy_addresses", tf.FlattenStringSlicePtr(group.ProxyAddresses))
 	tf.Set(d, "name", group.DisplayName) // TODO: v2.0 remove this
 	tf.Set(d, "object_id", group.ID)
 	tf.Set(d, "security_enabled", group.SecurityEnabled)
@@ -71,6 +71,8 @@ func TestAccGroupDataSource_byObjectId(t *testing.T) {
 			Config: GroupDataSource{}.objectId(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("onpremises_sync_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("proxy_addresses.#").HasValue("0"),
 			),
 		},
 	})
@@ -89,6 +91,20 @@ func TestAccGroupDataSource_byObjectIdWithSecurity(t *testing.T) {
 	})
 }
 
+func TestAccGroupDataSource_byMailNickname(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_group", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: GroupDataSource{}.mailNickname(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("object_id").MatchesOtherKey(check.That("azuread_group.test").Key("object_id")),
+			),
+		},
+	})
+}
+
 func TestAccGroupDataSource_members(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_group", "test")
 
@@ -180,6 +196,22 @@ data "azuread_group" "test" {
 `, GroupResource{}.basic(data))
 }
 
+func (GroupDataSource) mailNickname(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_group" "byObjectId" {
+  object_id = azuread_group.test.object_id
+}
+
+data "azuread_group" "test" {
+  mail_nickname    = data.azuread_group.byObjectId.mail_nickname
+  mail_enabled     = false
+  security_enabled = true
+}
+`, GroupResource{}.basic(data))
+}
+
 func (GroupDataSource) members(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
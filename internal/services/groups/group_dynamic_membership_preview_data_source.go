@@ -0,0 +1,68 @@
+package groups
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const groupDynamicMembershipPreviewDataSourceName = "azuread_group_dynamic_membership_preview"
+
+func groupDynamicMembershipPreviewDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: groupDynamicMembershipPreviewDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"membership_rule": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"user_object_ids": {
+				Description: "The object IDs of the users to evaluate the membership rule against. Only these users are evaluated, other users in the tenant are not considered.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+
+			"matching_user_object_ids": {
+				Description: "The object IDs of the specified users who would be members of a group having the membership rule.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"non_matching_user_object_ids": {
+				Description: "The object IDs of the specified users who would not be members of a group having the membership rule.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func groupDynamicMembershipPreviewDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(groupDynamicMembershipPreviewDataSourceName); diags != nil {
+		return diags
+	}
+	return groupDynamicMembershipPreviewDataSourceReadMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,64 @@
+package groups
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func groupDynamicMembershipPreviewDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.DynamicMembershipClient
+	rule := d.Get("membership_rule").(string)
+
+	userIds := make([]string, 0)
+	matchingIds := make([]string, 0)
+	nonMatchingIds := make([]string, 0)
+
+	// The API evaluates a rule against a single object at a time
+	for _, v := range d.Get("user_object_ids").([]interface{}) {
+		userId := v.(string)
+		userIds = append(userIds, userId)
+
+		evaluation, status, err := client.Evaluate(ctx, userId, rule)
+		if err != nil {
+			switch status {
+			case http.StatusBadRequest:
+				return tf.ErrorDiagPathF(err, "membership_rule", "Evaluating dynamic membership rule")
+			case http.StatusNotFound:
+				return tf.ErrorDiagPathF(fmt.Errorf("User with object ID %q was not found", userId), "user_object_ids", "Evaluating dynamic membership rule")
+			}
+			return tf.ErrorDiagF(err, "Evaluating dynamic membership rule for user with object ID %q", userId)
+		}
+		if evaluation.MembershipRuleEvaluationResult == nil {
+			return tf.ErrorDiagF(errors.New("API returned evaluation with nil membershipRuleEvaluationResult"), "Bad API response")
+		}
+
+		if *evaluation.MembershipRuleEvaluationResult {
+			matchingIds = append(matchingIds, userId)
+		} else {
+			nonMatchingIds = append(nonMatchingIds, userId)
+		}
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(rule + "-" + strings.Join(userIds, "-"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for membership rule and user IDs")
+	}
+
+	d.SetId("dynamicMembershipPreview#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "matching_user_object_ids", matchingIds)
+	tf.Set(d, "non_matching_user_object_ids", nonMatchingIds)
+
+	return nil
+}
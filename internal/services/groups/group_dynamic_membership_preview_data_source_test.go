@@ -0,0 +1,58 @@
+package groups_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type GroupDynamicMembershipPreviewDataSource struct{}
+
+func TestAccGroupDynamicMembershipPreviewDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_group_dynamic_membership_preview", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: GroupDynamicMembershipPreviewDataSource{}.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("matching_user_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("non_matching_user_object_ids.#").HasValue("1"),
+				resource.TestCheckResourceAttrPair(data.ResourceName, "matching_user_object_ids.0", "azuread_user.testA", "object_id"),
+				resource.TestCheckResourceAttrPair(data.ResourceName, "non_matching_user_object_ids.0", "azuread_user.testB", "object_id"),
+			),
+		},
+	})
+}
+
+func (GroupDynamicMembershipPreviewDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[5]s
+
+resource "azuread_user" "testA" {
+  user_principal_name = "%[3]s"
+  display_name        = "acctestUser-%[1]d-A"
+  department          = "acctestDept-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_user" "testB" {
+  user_principal_name = "%[4]s"
+  display_name        = "acctestUser-%[1]d-B"
+  password            = "%[2]s"
+}
+
+data "azuread_group_dynamic_membership_preview" "test" {
+  membership_rule = "user.department -eq \"acctestDept-%[1]d\""
+  user_object_ids = [azuread_user.testA.object_id, azuread_user.testB.object_id]
+}
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser.A"), data.UserPrincipalName("acctestUser.B"), data.DefaultDomainTemplate())
+}
@@ -0,0 +1,101 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const groupMailboxSettingsResourceName = "azuread_group_mailbox_settings"
+
+func groupMailboxSettingsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: groupMailboxSettingsResourceCreate,
+		ReadContext:   groupMailboxSettingsResourceRead,
+		UpdateContext: groupMailboxSettingsResourceUpdate,
+		DeleteContext: groupMailboxSettingsResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"group_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"allow_external_senders": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"auto_subscribe_new_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"hide_from_address_lists": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"hide_from_outlook_clients": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func groupMailboxSettingsResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(groupMailboxSettingsResourceName); diags != nil {
+		return diags
+	}
+	return groupMailboxSettingsResourceCreateMsGraph(ctx, d, meta)
+}
+
+func groupMailboxSettingsResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(groupMailboxSettingsResourceName); diags != nil {
+		return diags
+	}
+	return groupMailboxSettingsResourceReadMsGraph(ctx, d, meta)
+}
+
+func groupMailboxSettingsResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(groupMailboxSettingsResourceName); diags != nil {
+		return diags
+	}
+	return groupMailboxSettingsResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func groupMailboxSettingsResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(groupMailboxSettingsResourceName); diags != nil {
+		return diags
+	}
+	return groupMailboxSettingsResourceDeleteMsGraph(ctx, d, meta)
+}
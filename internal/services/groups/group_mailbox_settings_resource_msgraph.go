@@ -0,0 +1,120 @@
+package groups
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+// groupMailboxSettingsAttributes maps the attributes of this resource to their setters in the mailbox settings
+var groupMailboxSettingsAttributes = map[string]func(*client.GroupMailboxSettings, *bool){
+	"allow_external_senders":     func(s *client.GroupMailboxSettings, v *bool) { s.AllowExternalSenders = v },
+	"auto_subscribe_new_members": func(s *client.GroupMailboxSettings, v *bool) { s.AutoSubscribeNewMembers = v },
+	"hide_from_address_lists":    func(s *client.GroupMailboxSettings, v *bool) { s.HideFromAddressLists = v },
+	"hide_from_outlook_clients":  func(s *client.GroupMailboxSettings, v *bool) { s.HideFromOutlookClients = v },
+}
+
+func groupMailboxSettingsResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	msClient := meta.(*clients.Client).Groups.MsClient
+	c := meta.(*clients.Client).Groups.MailboxSettingsClient
+
+	groupId := d.Get("group_object_id").(string)
+
+	// Mailbox settings only apply to Microsoft 365 groups
+	group, status, err := msClient.Get(ctx, groupId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "Group with object ID %q was not found", groupId)
+		}
+		return tf.ErrorDiagPathF(err, "group_object_id", "Retrieving group with object ID %q", groupId)
+	}
+	unified := false
+	if group.GroupTypes != nil {
+		for _, t := range *group.GroupTypes {
+			if strings.EqualFold(t, "Unified") {
+				unified = true
+				break
+			}
+		}
+	}
+	if !unified {
+		return tf.ErrorDiagPathF(nil, "group_object_id", "Group with object ID %q is not a Microsoft 365 group, mailbox settings can only be managed for Microsoft 365 groups", groupId)
+	}
+
+	settings := client.GroupMailboxSettings{}
+	configured := false
+	for attr, set := range groupMailboxSettingsAttributes {
+		if v, ok := d.GetOkExists(attr); ok { //nolint:SA1019
+			set(&settings, utils.Bool(v.(bool)))
+			configured = true
+		}
+	}
+
+	if configured {
+		if _, err := c.Update(ctx, groupId, settings); err != nil {
+			return tf.ErrorDiagF(err, "Setting mailbox settings for group with object ID %q", groupId)
+		}
+	}
+
+	d.SetId(groupId)
+
+	return groupMailboxSettingsResourceReadMsGraph(ctx, d, meta)
+}
+
+func groupMailboxSettingsResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Groups.MailboxSettingsClient
+
+	settings, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Group with object ID %q was not found - removing mailbox settings from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving mailbox settings for group with object ID %q", d.Id())
+	}
+
+	tf.Set(d, "allow_external_senders", settings.AllowExternalSenders != nil && *settings.AllowExternalSenders)
+	tf.Set(d, "auto_subscribe_new_members", settings.AutoSubscribeNewMembers != nil && *settings.AutoSubscribeNewMembers)
+	tf.Set(d, "group_object_id", d.Id())
+	tf.Set(d, "hide_from_address_lists", settings.HideFromAddressLists != nil && *settings.HideFromAddressLists)
+	tf.Set(d, "hide_from_outlook_clients", settings.HideFromOutlookClients != nil && *settings.HideFromOutlookClients)
+
+	return nil
+}
+
+func groupMailboxSettingsResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Groups.MailboxSettingsClient
+
+	settings := client.GroupMailboxSettings{}
+	changed := false
+	for attr, set := range groupMailboxSettingsAttributes {
+		if d.HasChange(attr) {
+			set(&settings, utils.Bool(d.Get(attr).(bool)))
+			changed = true
+		}
+	}
+
+	if changed {
+		if _, err := c.Update(ctx, d.Id(), settings); err != nil {
+			return tf.ErrorDiagF(err, "Updating mailbox settings for group with object ID %q", d.Id())
+		}
+	}
+
+	return groupMailboxSettingsResourceReadMsGraph(ctx, d, meta)
+}
+
+func groupMailboxSettingsResourceDeleteMsGraph(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Mailbox settings always exist for a Microsoft 365 group, so they are left unchanged
+	log.Printf("[DEBUG] Removing mailbox settings for group with object ID %q from state, the settings are left unchanged", d.Id())
+	return nil
+}
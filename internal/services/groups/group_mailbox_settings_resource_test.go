@@ -0,0 +1,94 @@
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type GroupMailboxSettingsResource struct{}
+
+// Mailbox settings can only be updated using delegated authentication, so this test must be run as a user
+func TestAccGroupMailboxSettings_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	groupId := teamTestGroupObjectId(t)
+	data := acceptance.BuildTestData(t, "azuread_group_mailbox_settings", "test")
+	r := GroupMailboxSettingsResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(groupId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").HasValue(groupId),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(groupId, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_external_senders").HasValue("true"),
+				check.That(data.ResourceName).Key("auto_subscribe_new_members").HasValue("true"),
+				check.That(data.ResourceName).Key("hide_from_address_lists").HasValue("true"),
+				check.That(data.ResourceName).Key("hide_from_outlook_clients").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(groupId, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_external_senders").HasValue("false"),
+				check.That(data.ResourceName).Key("auto_subscribe_new_members").HasValue("false"),
+				check.That(data.ResourceName).Key("hide_from_address_lists").HasValue("false"),
+				check.That(data.ResourceName).Key("hide_from_outlook_clients").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupMailboxSettingsResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	if _, status, err := clients.Groups.MailboxSettingsClient.Get(ctx, state.ID); err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Group with object ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve mailbox settings for Group with object ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (GroupMailboxSettingsResource) basic(groupId string) string {
+	return fmt.Sprintf(`
+resource "azuread_group_mailbox_settings" "test" {
+  group_object_id = "%[1]s"
+}
+`, groupId)
+}
+
+func (GroupMailboxSettingsResource) complete(groupId string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuread_group_mailbox_settings" "test" {
+  group_object_id            = "%[1]s"
+  allow_external_senders     = %[2]t
+  auto_subscribe_new_members = %[2]t
+  hide_from_address_lists    = %[2]t
+  hide_from_outlook_clients  = %[2]t
+}
+`, groupId, enabled)
+}
@@ -0,0 +1,41 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func groupMemberOfDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.MsClient
+	objectId := d.Get("object_id").(string)
+	includeDisplayNames := d.Get("include_display_names").(bool)
+
+	memberships, status, err := helpers.DirectoryObjectMemberOf(ctx, client.BaseClient, objectId, d.Get("security_enabled_only").(bool), includeDisplayNames)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Group with object ID %q was not found", objectId), "object_id", "Retrieving memberships for group")
+		}
+		return tf.ErrorDiagF(err, "Retrieving memberships for group with object ID %q", objectId)
+	}
+
+	displayNames := make(map[string]string)
+	if includeDisplayNames {
+		displayNames = memberships.DisplayNames
+	}
+
+	d.SetId(objectId)
+
+	tf.Set(d, "directory_role_object_ids", memberships.DirectoryRoleIds)
+	tf.Set(d, "display_names", displayNames)
+	tf.Set(d, "group_object_ids", memberships.GroupIds)
+
+	return nil
+}
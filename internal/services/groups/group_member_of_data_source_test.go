@@ -0,0 +1,61 @@
+package groups_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type GroupMemberOfDataSource struct{}
+
+func TestAccGroupMemberOfDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_group_member_of", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: GroupMemberOfDataSource{}.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("directory_role_object_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("display_names.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (GroupMemberOfDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_group" "parent" {
+  display_name     = "acctestGroup-parent-%[1]d"
+  security_enabled = true
+  members          = [azuread_group.test.object_id]
+}
+
+resource "azuread_group" "grandparent" {
+  display_name     = "acctestGroup-grandparent-%[1]d"
+  security_enabled = true
+  members          = [azuread_group.parent.object_id]
+}
+
+data "azuread_group_member_of" "test" {
+  object_id             = azuread_group.test.object_id
+  include_display_names = true
+
+  depends_on = [azuread_group.grandparent]
+}
+`, data.RandomInteger)
+}
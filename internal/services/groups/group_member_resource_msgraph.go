@@ -48,7 +48,9 @@ func groupMemberResourceCreateMsGraph(ctx context.Context, d *schema.ResourceDat
 
 	group.AppendMember(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, memberId)
 
-	if _, err := client.AddMembers(ctx, group); err != nil {
+	if err := msgraph.WaitForReferenceReplication(ctx, func() (int, error) {
+		return client.AddMembers(ctx, group)
+	}); err != nil {
 		return tf.ErrorDiagF(err, "Adding group member %q to group %q", memberId, groupId)
 	}
 
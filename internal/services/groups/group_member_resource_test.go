@@ -189,29 +189,27 @@ resource "azuread_group" "test" {
 
 func (GroupMemberResource) templateThreeUsers(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[7]s
 
 resource "azuread_user" "testA" {
-  user_principal_name = "acctestUser.%[1]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[3]s"
   display_name        = "acctestUser-%[1]d-A"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testB" {
-  user_principal_name = "acctestUser.%[1]d.B@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[4]s"
   display_name        = "acctestUser-%[1]d-B"
-  mail_nickname       = "acctestUser-%[1]d-B"
+  mail_nickname       = "%[6]s"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testC" {
-  user_principal_name = "acctestUser.%[1]d.C@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[5]s"
   display_name        = "acctestUser-%[1]d-C"
   password            = "%[2]s"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser.A"), data.UserPrincipalName("acctestUser.B"), data.UserPrincipalName("acctestUser.C"), data.MailNickname("acctestUser-B"), data.DefaultDomainTemplate())
 }
 
 func (r GroupMemberResource) group(data acceptance.TestData) string {
@@ -3,13 +3,19 @@ package groups
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	groupsClient "github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
@@ -20,6 +26,15 @@ func groupResource() *schema.Resource {
 		UpdateContext: groupResourceUpdate,
 		DeleteContext: groupResourceDelete,
 
+		CustomizeDiff: groupResourceCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
 			if _, err := uuid.ParseUUID(id); err != nil {
 				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
@@ -48,17 +63,56 @@ func groupResource() *schema.Resource {
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
+			"administrative_unit_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+
 			"description": {
 				Type:     schema.TypeString,
 				ForceNew: true, // there is no update method available in the SDK
 				Optional: true,
 			},
 
+			"extension_attributes": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateDiagFunc: validation.MapKeyMatch(helpers.ExtensionAttributeNameRegex, "keys must be directory extension attribute names in the format `extension_{appId}_{name}`"),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"mail": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"mail_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
 
+			"mail_nickname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[!#$%&'*+\\-./0-9=?A-Z^_`a-z{|}~]{1,64}$"), "mail_nickname must be between 1-64 ASCII characters and must not contain spaces or any of the following characters: @()\\[]\";:<>,"),
+			},
+
+			"manage_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"members": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -81,25 +135,156 @@ func groupResource() *schema.Resource {
 				},
 			},
 
+			"ignore_unmanaged_owners": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"retain_caller_as_owner": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"object_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"onpremises_sam_account_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"onpremises_security_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"onpremises_sync_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"preferred_data_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"proxy_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"prevent_duplicate_names": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
 
+			"duplicate_names_case_sensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"duplicate_names_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  helpers.GroupDuplicateNamesScopeAll,
+				ValidateFunc: validation.StringInSlice([]string{
+					helpers.GroupDuplicateNamesScopeAll,
+					helpers.GroupDuplicateNamesScopeSecurity,
+					helpers.GroupDuplicateNamesScopeUnified,
+				}, false),
+			},
+
 			"security_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+
+			"writeback_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"onpremises_group_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					groupsClient.OnPremisesGroupTypeUniversalDistributionGroup,
+					groupsClient.OnPremisesGroupTypeUniversalMailEnabledSecurityGroup,
+					groupsClient.OnPremisesGroupTypeUniversalSecurityGroup,
+				}, false),
+			},
 		},
 	}
 }
 
+// groupOwnersForRemoval returns the existing owners which should be removed from a group. When `ignore_unmanaged_owners`
+// is set, only owners previously recorded in state are removed, so that owners added out-of-band are retained.
+func groupOwnersForRemoval(d *schema.ResourceData, existingOwners, desiredOwners []string) []string {
+	ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
+	if d.Get("ignore_unmanaged_owners").(bool) {
+		old, _ := d.GetChange("owners")
+		ownersForRemoval = utils.Intersection(ownersForRemoval, *tf.ExpandStringSlicePtr(old.(*schema.Set).List()))
+	}
+	return ownersForRemoval
+}
+
+// groupRetainCallerAsOwner returns the configured `retain_caller_as_owner`, which is not known when importing
+// a group, in which case the default is assumed.
+func groupRetainCallerAsOwner(d *schema.ResourceData) bool {
+	if v, ok := d.GetOkExists("retain_caller_as_owner"); ok { //nolint:SA1019
+		return v.(bool)
+	}
+	return true
+}
+
+// groupTrackedOwners returns the owners which should be recorded in state. When `ignore_unmanaged_owners` is set,
+// owners added outside of Terraform are omitted so that they do not show up as a diff.
+func groupTrackedOwners(d *schema.ResourceData, owners []string) []string {
+	if !d.Get("ignore_unmanaged_owners").(bool) {
+		return owners
+	}
+	return utils.Intersection(owners, *tf.ExpandStringSlicePtr(d.Get("owners").(*schema.Set).List()))
+}
+
+// groupManageMembers returns whether the members of the group should be read and managed. This is true when the
+// property is not yet known, such as when importing.
+func groupManageMembers(d *schema.ResourceData) bool {
+	if v, ok := d.GetOkExists("manage_members"); ok { //nolint:SA1019
+		return v.(bool)
+	}
+	return true
+}
+
+func groupResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client)
+
+	// Group writeback can only be configured using the beta API
+	if diff.Get("writeback_enabled").(bool) || diff.Get("onpremises_group_type").(string) != "" {
+		if !client.EnableMsGraphBeta || !client.Groups.BetaApi {
+			return fmt.Errorf("`writeback_enabled` and `onpremises_group_type` are only supported when using Microsoft Graph with `groups` included in `beta_api_services` in the provider `features` block")
+		}
+	}
+
+	// Members of a group with unmanaged members are not read, so members specified here would be added but never
+	// reconciled. Members already in state are only compared when they change, since they are cleared when refreshed.
+	if !diff.Get("manage_members").(bool) && (diff.Id() == "" || diff.HasChange("members")) {
+		if members, ok := diff.GetOk("members"); ok && members.(*schema.Set).Len() > 0 {
+			return fmt.Errorf("`members` cannot be specified when `manage_members` is false, please use the `azuread_group_member` resource to manage the members of this group")
+		}
+	}
+
+	return tf.RetainCallerAsOwnerDiff(diff, client.ObjectID)
+}
+
 func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if meta.(*clients.Client).EnableMsGraphBeta {
 		return groupResourceCreateMsGraph(ctx, d, meta)
@@ -127,3 +312,14 @@ func groupResourceDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	return groupResourceDeleteAadGraph(ctx, d, meta)
 }
+
+// groupWritebackConfiguration returns the writeback configuration for the group from the resource configuration.
+func groupWritebackConfiguration(d *schema.ResourceData) groupsClient.GroupWritebackConfiguration {
+	configuration := groupsClient.GroupWritebackConfiguration{
+		IsEnabled: utils.Bool(d.Get("writeback_enabled").(bool)),
+	}
+	if v, ok := d.GetOk("onpremises_group_type"); ok && v.(string) != "" {
+		configuration.OnPremisesGroupType = utils.String(v.(string))
+	}
+	return configuration
+}
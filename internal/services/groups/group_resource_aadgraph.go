@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/aadgraph"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
@@ -19,6 +20,14 @@ import (
 func groupResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.AadClient
 
+	if _, ok := d.GetOk("extension_attributes"); ok {
+		return tf.ErrorDiagPathF(errors.New("extension attributes are only supported when using Microsoft Graph"), "extension_attributes", "Could not set extension attributes for group")
+	}
+
+	if _, ok := d.GetOk("administrative_unit_ids"); ok {
+		return tf.ErrorDiagPathF(errors.New("administrative units are only supported when using Microsoft Graph"), "administrative_unit_ids", "Could not add group to administrative units")
+	}
+
 	var name string
 	if v, ok := d.GetOk("display_name"); ok && v.(string) != "" {
 		name = v.(string)
@@ -27,7 +36,11 @@ func groupResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.Get("prevent_duplicate_names").(bool) {
-		existingGroup, err := aadgraph.GroupFindByName(ctx, client, name)
+		if scope := d.Get("duplicate_names_scope").(string); scope != helpers.GroupDuplicateNamesScopeAll {
+			return tf.ErrorDiagPathF(errors.New("`duplicate_names_scope` is only supported when using Microsoft Graph"), "duplicate_names_scope", "Scope %q is not supported", scope)
+		}
+
+		existingGroup, err := aadgraph.GroupFindByName(ctx, client, name, d.Get("duplicate_names_case_sensitive").(bool))
 		if err != nil {
 			return tf.ErrorDiagPathF(err, "display_name", "Could not check for existing group(s)")
 		}
@@ -39,9 +52,15 @@ func groupResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	mailNickname, err := uuid.GenerateUUID()
-	if err != nil {
-		return tf.ErrorDiagF(err, "Failed to generate mailNickname")
+	mailNickname := d.Get("mail_nickname").(string)
+	if mailNickname == "" {
+		mailNickname = helpers.GroupDefaultMailNickname(name)
+	}
+	if mailNickname == "" {
+		var err error
+		if mailNickname, err = uuid.GenerateUUID(); err != nil {
+			return tf.ErrorDiagF(err, "Failed to generate mailNickname")
+		}
 	}
 
 	properties := graphrbac.GroupCreateParameters{
@@ -117,7 +136,14 @@ func groupResourceReadAadGraph(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	tf.Set(d, "display_name", resp.DisplayName)
+	tf.Set(d, "mail", resp.Mail)
 	tf.Set(d, "mail_enabled", resp.MailEnabled)
+	tf.Set(d, "mail_nickname", resp.MailNickname)
+	tf.Set(d, "onpremises_sam_account_name", resp.AdditionalProperties["onPremisesSamAccountName"])
+	tf.Set(d, "onpremises_security_identifier", resp.AdditionalProperties["onPremisesSecurityIdentifier"])
+	tf.Set(d, "onpremises_sync_enabled", resp.AdditionalProperties["dirSyncEnabled"])
+	tf.Set(d, "preferred_data_location", resp.AdditionalProperties["preferredDataLocation"])
+	tf.Set(d, "proxy_addresses", resp.AdditionalProperties["proxyAddresses"])
 	tf.Set(d, "name", resp.DisplayName)
 	tf.Set(d, "object_id", resp.ObjectID)
 	tf.Set(d, "security_enabled", resp.SecurityEnabled)
@@ -128,17 +154,25 @@ func groupResourceReadAadGraph(ctx context.Context, d *schema.ResourceData, meta
 	}
 	tf.Set(d, "description", description)
 
-	members, err := aadgraph.GroupAllMembers(ctx, client, d.Id())
-	if err != nil {
-		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve members for group with object ID %q", d.Id())
+	// Listing the members of very large groups is slow, so they are only read when managed by this resource
+	manageMembers := groupManageMembers(d)
+	members := make([]string, 0)
+	if manageMembers {
+		members, err = aadgraph.GroupAllMembers(ctx, client, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "owners", "Could not retrieve members for group with object ID %q", d.Id())
+		}
 	}
+	tf.Set(d, "manage_members", manageMembers)
 	tf.Set(d, "members", members)
 
 	owners, err := aadgraph.GroupAllOwners(ctx, client, d.Id())
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for group with object ID %q", d.Id())
 	}
-	tf.Set(d, "owners", owners)
+	tf.Set(d, "owners", groupTrackedOwners(d, owners))
+	tf.Set(d, "ignore_unmanaged_owners", d.Get("ignore_unmanaged_owners").(bool))
+	tf.Set(d, "retain_caller_as_owner", groupRetainCallerAsOwner(d))
 
 	preventDuplicates := false
 	if v := d.Get("prevent_duplicate_names").(bool); v {
@@ -146,13 +180,28 @@ func groupResourceReadAadGraph(ctx context.Context, d *schema.ResourceData, meta
 	}
 	tf.Set(d, "prevent_duplicate_names", preventDuplicates)
 
+	duplicateNamesScope := helpers.GroupDuplicateNamesScopeAll
+	if v := d.Get("duplicate_names_scope").(string); v != "" {
+		duplicateNamesScope = v
+	}
+	tf.Set(d, "duplicate_names_case_sensitive", d.Get("duplicate_names_case_sensitive").(bool))
+	tf.Set(d, "duplicate_names_scope", duplicateNamesScope)
+
 	return nil
 }
 
 func groupResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.AadClient
 
-	if v, ok := d.GetOkExists("members"); ok && d.HasChange("members") { //nolint:SA1019
+	if _, ok := d.GetOk("extension_attributes"); ok {
+		return tf.ErrorDiagPathF(errors.New("extension attributes are only supported when using Microsoft Graph"), "extension_attributes", "Could not set extension attributes for group")
+	}
+
+	if _, ok := d.GetOk("administrative_unit_ids"); ok {
+		return tf.ErrorDiagPathF(errors.New("administrative units are only supported when using Microsoft Graph"), "administrative_unit_ids", "Could not add group to administrative units")
+	}
+
+	if v, ok := d.GetOkExists("members"); ok && d.HasChange("members") && d.Get("manage_members").(bool) { //nolint:SA1019
 		existingMembers, err := aadgraph.GroupAllMembers(ctx, client, d.Id())
 		if err != nil {
 			return tf.ErrorDiagPathF(err, "owners", "Could not retrieve members for group with object ID %q", d.Id())
@@ -187,7 +236,7 @@ func groupResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		desiredOwners := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
-		ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
+		ownersForRemoval := groupOwnersForRemoval(d, existingOwners, desiredOwners)
 		ownersToAdd := utils.Difference(desiredOwners, existingOwners)
 
 		for _, ownerToDelete := range ownersForRemoval {
@@ -29,7 +29,7 @@ func groupResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if d.Get("prevent_duplicate_names").(bool) {
-		existingId, err := helpers.GroupCheckNameAvailability(ctx, client, displayName, nil)
+		existingId, err := helpers.GroupCheckNameAvailability(ctx, client, displayName, nil, d.Get("duplicate_names_scope").(string), d.Get("duplicate_names_case_sensitive").(bool))
 		if err != nil {
 			return tf.ErrorDiagPathF(err, "display_name", "Could not check for existing group(s)")
 		}
@@ -38,9 +38,28 @@ func groupResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	mailNickname, err := uuid.GenerateUUID()
-	if err != nil {
-		return tf.ErrorDiagF(err, "Failed to generate mailNickname")
+	mailNickname := d.Get("mail_nickname").(string)
+	if mailNickname == "" {
+		mailNickname = helpers.GroupDefaultMailNickname(displayName)
+	}
+
+	// A deleted group is only restored when it matches the group this resource would create. Groups given a random
+	// mail nickname cannot be matched, so are never restored.
+	if meta.(*clients.Client).Features.Groups.RecoverSoftDeleted && mailNickname != "" {
+		deleted, err := helpers.GroupFindDeleted(ctx, client.BaseClient, displayName, mailNickname, []string{}, true)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "display_name", "Could not check for deleted group(s)")
+		}
+		if deleted != nil {
+			return groupResourceRecoverMsGraph(ctx, d, meta, *deleted.ID)
+		}
+	}
+
+	if mailNickname == "" {
+		var err error
+		if mailNickname, err = uuid.GenerateUUID(); err != nil {
+			return tf.ErrorDiagF(err, "Failed to generate mailNickname")
+		}
 	}
 
 	properties := msgraph.Group{
@@ -56,21 +75,48 @@ func groupResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, met
 		properties.Description = utils.String(v.(string))
 	}
 
+	// Only a limited number of members and owners can be specified when creating a group, any others are added afterwards
+	var remainingMembers, remainingOwners []string
+
+	// When creating a group in an administrative unit, all members and owners are added after the group is created
+	var administrativeUnitIds []string
+	createLimit := helpers.GroupMembersCreateLimit
+	if v, ok := d.GetOk("administrative_unit_ids"); ok {
+		administrativeUnitIds = *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
+		createLimit = 0
+	}
+
 	if v, ok := d.GetOk("members"); ok {
-		members := v.(*schema.Set).List()
-		for _, o := range members {
-			properties.AppendMember(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, o.(string))
+		members := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
+		if len(members) > createLimit {
+			remainingMembers = members[createLimit:]
+			members = members[:createLimit]
+		}
+		for _, m := range members {
+			properties.AppendMember(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, m)
 		}
 	}
 
 	if v, ok := d.GetOk("owners"); ok {
-		owners := v.(*schema.Set).List()
+		owners := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
+		if len(owners) > createLimit {
+			remainingOwners = owners[createLimit:]
+			owners = owners[:createLimit]
+		}
 		for _, o := range owners {
-			properties.AppendOwner(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, o.(string))
+			properties.AppendOwner(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, o)
 		}
 	}
 
-	group, _, err := client.Create(ctx, properties)
+	var group *msgraph.Group
+	err := helpers.WaitForReferenceReplication(ctx, func() (status int, err error) {
+		if len(administrativeUnitIds) > 0 {
+			group, status, err = helpers.AdministrativeUnitCreateGroup(ctx, client.BaseClient, administrativeUnitIds[0], properties)
+		} else {
+			group, status, err = client.Create(ctx, properties)
+		}
+		return
+	})
 	if err != nil {
 		return tf.ErrorDiagF(err, "Creating group %q", displayName)
 	}
@@ -89,9 +135,71 @@ func groupResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, met
 		return tf.ErrorDiagF(err, "Waiting for Group with object ID: %q", *group.ID)
 	}
 
+	if len(administrativeUnitIds) > 1 {
+		for _, administrativeUnitId := range administrativeUnitIds[1:] {
+			if _, err := helpers.AdministrativeUnitAddMember(ctx, client.BaseClient, administrativeUnitId, *group.ID); err != nil {
+				return tf.ErrorDiagPathF(err, "administrative_unit_ids", "Could not add group with object ID %q to administrative unit with object ID %q", *group.ID, administrativeUnitId)
+			}
+		}
+	}
+
+	if len(remainingMembers) > 0 {
+		if err := helpers.WaitForReferenceReplication(ctx, func() (int, error) {
+			return 0, helpers.GroupAddMembers(ctx, client, *group.ID, remainingMembers)
+		}); err != nil {
+			return tf.ErrorDiagPathF(err, "members", "Could not add members to group with object ID: %q", *group.ID)
+		}
+	}
+
+	if len(remainingOwners) > 0 {
+		ownersGroup := msgraph.Group{ID: group.ID}
+		for _, o := range remainingOwners {
+			ownersGroup.AppendOwner(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, o)
+		}
+		if err := helpers.WaitForReferenceReplication(ctx, func() (int, error) {
+			return client.AddOwners(ctx, &ownersGroup)
+		}); err != nil {
+			return tf.ErrorDiagPathF(err, "owners", "Could not add owners to group with object ID: %q", *group.ID)
+		}
+	}
+
+	if v, ok := d.GetOk("extension_attributes"); ok {
+		extensionAttributes := helpers.ExtensionAttributesExpand(nil, v.(map[string]interface{}))
+		if _, err := helpers.ExtensionAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/groups/%s", *group.ID), extensionAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "extension_attributes", "Could not set extension attributes for group with object ID: %q", *group.ID)
+		}
+	}
+
+	if v, ok := d.GetOk("onpremises_group_type"); d.Get("writeback_enabled").(bool) || (ok && v.(string) != "") {
+		if _, err := meta.(*clients.Client).Groups.WritebackClient.Update(ctx, *group.ID, groupWritebackConfiguration(d)); err != nil {
+			return tf.ErrorDiagPathF(err, "writeback_enabled", "Could not set writeback configuration for group with object ID: %q", *group.ID)
+		}
+	}
+
 	return groupResourceReadMsGraph(ctx, d, meta)
 }
 
+// groupResourceRecoverMsGraph restores the deleted group with the specified ID, then updates it to match the
+// configuration. Members and owners are reconciled against those the group had when it was deleted.
+func groupResourceRecoverMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.MsClient
+
+	if _, err := helpers.DeletedItemRestore(ctx, client.BaseClient, id); err != nil {
+		return tf.ErrorDiagF(err, "Restoring deleted group with object ID %q", id)
+	}
+
+	if _, err := helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return client.Get(ctx, id)
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for restored group with object ID %q", id)
+	}
+
+	log.Printf("[DEBUG] Restored deleted group with object ID %q", id)
+	d.SetId(id)
+
+	return groupResourceUpdateMsGraph(ctx, d, meta)
+}
+
 func groupResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.MsClient
 
@@ -105,24 +213,65 @@ func groupResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagF(err, "Retrieving group with object ID: %q", d.Id())
 	}
 
+	extensionAttributeNames := make([]string, 0)
+	for k := range d.Get("extension_attributes").(map[string]interface{}) {
+		extensionAttributeNames = append(extensionAttributeNames, k)
+	}
+	extensionAttributes, _, err := helpers.ExtensionAttributesGet(ctx, client.BaseClient, fmt.Sprintf("/groups/%s", d.Id()), extensionAttributeNames)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "extension_attributes", "Could not retrieve extension attributes for group with object ID %q", d.Id())
+	}
+
 	tf.Set(d, "description", group.Description)
 	tf.Set(d, "display_name", group.DisplayName)
+	tf.Set(d, "extension_attributes", extensionAttributes)
+	tf.Set(d, "mail", group.Mail)
 	tf.Set(d, "mail_enabled", group.MailEnabled)
+	tf.Set(d, "mail_nickname", group.MailNickname)
+	tf.Set(d, "onpremises_sam_account_name", group.OnPremisesSamAccountName)
+	tf.Set(d, "onpremises_security_identifier", group.OnPremisesSecurityIdentifier)
+	tf.Set(d, "onpremises_sync_enabled", group.OnPremisesSyncEnabled)
+	tf.Set(d, "preferred_data_location", group.PreferredDataLocation)
+	tf.Set(d, "proxy_addresses", tf.FlattenStringSlicePtr(group.ProxyAddresses))
 	tf.Set(d, "name", group.DisplayName) // TODO: v2.0 remove this
 	tf.Set(d, "object_id", group.ID)
 	tf.Set(d, "security_enabled", group.SecurityEnabled)
 
+	// The writeback configuration is only available in the beta API
+	if meta.(*clients.Client).Groups.BetaApi {
+		writeback, _, err := meta.(*clients.Client).Groups.WritebackClient.Get(ctx, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "writeback_enabled", "Could not retrieve writeback configuration for group with object ID %q", d.Id())
+		}
+		tf.Set(d, "writeback_enabled", writeback.IsEnabled != nil && *writeback.IsEnabled)
+		tf.Set(d, "onpremises_group_type", writeback.OnPremisesGroupType)
+	}
+
 	owners, _, err := client.ListOwners(ctx, *group.ID)
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for group with object ID %q", d.Id())
 	}
-	tf.Set(d, "owners", owners)
+	tf.Set(d, "owners", groupTrackedOwners(d, *owners))
+	tf.Set(d, "ignore_unmanaged_owners", d.Get("ignore_unmanaged_owners").(bool))
+	tf.Set(d, "retain_caller_as_owner", groupRetainCallerAsOwner(d))
+
+	// Listing the members of very large groups is slow, so they are only read when managed by this resource
+	manageMembers := groupManageMembers(d)
+	members := &[]string{}
+	if manageMembers {
+		members, _, err = client.ListMembers(ctx, *group.ID)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "owners", "Could not retrieve members for group with object ID %q", d.Id())
+		}
+	}
+	tf.Set(d, "manage_members", manageMembers)
+	tf.Set(d, "members", members)
 
-	members, _, err := client.ListMembers(ctx, *group.ID)
+	administrativeUnitIds, _, err := helpers.GroupAdministrativeUnitIds(ctx, client.BaseClient, *group.ID)
 	if err != nil {
-		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve members for group with object ID %q", d.Id())
+		return tf.ErrorDiagPathF(err, "administrative_unit_ids", "Could not retrieve administrative units for group with object ID %q", d.Id())
 	}
-	tf.Set(d, "members", members)
+	tf.Set(d, "administrative_unit_ids", administrativeUnitIds)
 
 	preventDuplicates := false
 	if v := d.Get("prevent_duplicate_names").(bool); v {
@@ -130,6 +279,13 @@ func groupResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta
 	}
 	tf.Set(d, "prevent_duplicate_names", preventDuplicates)
 
+	duplicateNamesScope := helpers.GroupDuplicateNamesScopeAll
+	if v := d.Get("duplicate_names_scope").(string); v != "" {
+		duplicateNamesScope = v
+	}
+	tf.Set(d, "duplicate_names_case_sensitive", d.Get("duplicate_names_case_sensitive").(bool))
+	tf.Set(d, "duplicate_names_scope", duplicateNamesScope)
+
 	return nil
 }
 
@@ -146,7 +302,7 @@ func groupResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, met
 
 	if d.HasChange("display_name") {
 		if preventDuplicates := d.Get("prevent_duplicate_names").(bool); preventDuplicates {
-			existingId, err := helpers.GroupCheckNameAvailability(ctx, client, displayName, group.ID)
+			existingId, err := helpers.GroupCheckNameAvailability(ctx, client, displayName, group.ID, d.Get("duplicate_names_scope").(string), d.Get("duplicate_names_case_sensitive").(bool))
 			if err != nil {
 				return tf.ErrorDiagPathF(err, "display_name", "Could not check for existing group(s)")
 			}
@@ -166,7 +322,28 @@ func groupResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, met
 		return tf.ErrorDiagF(err, "Updating group with ID: %q", d.Id())
 	}
 
-	if v, ok := d.GetOkExists("members"); ok && d.HasChange("members") { //nolint:SA1019
+	if v, ok := d.GetOkExists("administrative_unit_ids"); ok && d.HasChange("administrative_unit_ids") { //nolint:SA1019
+		existingAdministrativeUnitIds, _, err := helpers.GroupAdministrativeUnitIds(ctx, client.BaseClient, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "administrative_unit_ids", "Could not retrieve administrative units for group with ID: %q", d.Id())
+		}
+
+		desiredAdministrativeUnitIds := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
+
+		for _, administrativeUnitId := range utils.Difference(existingAdministrativeUnitIds, desiredAdministrativeUnitIds) {
+			if _, err := helpers.AdministrativeUnitRemoveMember(ctx, client.BaseClient, administrativeUnitId, d.Id()); err != nil {
+				return tf.ErrorDiagPathF(err, "administrative_unit_ids", "Could not remove group with ID %q from administrative unit with ID %q", d.Id(), administrativeUnitId)
+			}
+		}
+
+		for _, administrativeUnitId := range utils.Difference(desiredAdministrativeUnitIds, existingAdministrativeUnitIds) {
+			if _, err := helpers.AdministrativeUnitAddMember(ctx, client.BaseClient, administrativeUnitId, d.Id()); err != nil {
+				return tf.ErrorDiagPathF(err, "administrative_unit_ids", "Could not add group with ID %q to administrative unit with ID %q", d.Id(), administrativeUnitId)
+			}
+		}
+	}
+
+	if v, ok := d.GetOkExists("members"); ok && d.HasChange("members") && d.Get("manage_members").(bool) { //nolint:SA1019
 		members, _, err := client.ListMembers(ctx, *group.ID)
 		if err != nil {
 			return tf.ErrorDiagF(err, "Could not retrieve members for group with ID: %q", d.Id())
@@ -178,17 +355,15 @@ func groupResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, met
 		membersToAdd := utils.Difference(desiredMembers, existingMembers)
 
 		if membersForRemoval != nil {
-			if _, err = client.RemoveMembers(ctx, d.Id(), &membersForRemoval); err != nil {
+			if err = helpers.GroupRemoveMembers(ctx, client, d.Id(), membersForRemoval); err != nil {
 				return tf.ErrorDiagF(err, "Could not remove members from group with ID: %q", d.Id())
 			}
 		}
 
 		if membersToAdd != nil {
-			for _, m := range membersToAdd {
-				group.AppendMember(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, m)
-			}
-
-			if _, err := client.AddMembers(ctx, &group); err != nil {
+			if err := helpers.WaitForReferenceReplication(ctx, func() (int, error) {
+				return 0, helpers.GroupAddMembers(ctx, client, d.Id(), membersToAdd)
+			}); err != nil {
 				return tf.ErrorDiagF(err, "Could not add members to group with ID: %q", d.Id())
 			}
 		}
@@ -202,7 +377,7 @@ func groupResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, met
 
 		existingOwners := *owners
 		desiredOwners := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
-		ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
+		ownersForRemoval := groupOwnersForRemoval(d, existingOwners, desiredOwners)
 		ownersToAdd := utils.Difference(desiredOwners, existingOwners)
 
 		if ownersToAdd != nil {
@@ -210,7 +385,9 @@ func groupResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, met
 				group.AppendOwner(client.BaseClient.Endpoint, client.BaseClient.ApiVersion, m)
 			}
 
-			if _, err := client.AddOwners(ctx, &group); err != nil {
+			if err := helpers.WaitForReferenceReplication(ctx, func() (int, error) {
+				return client.AddOwners(ctx, &group)
+			}); err != nil {
 				return tf.ErrorDiagF(err, "Could not add owners to group with ID: %q", d.Id())
 			}
 		}
@@ -222,6 +399,20 @@ func groupResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("extension_attributes") {
+		o, n := d.GetChange("extension_attributes")
+		extensionAttributes := helpers.ExtensionAttributesExpand(o.(map[string]interface{}), n.(map[string]interface{}))
+		if _, err := helpers.ExtensionAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/groups/%s", d.Id()), extensionAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "extension_attributes", "Could not update extension attributes for group with ID: %q", d.Id())
+		}
+	}
+
+	if d.HasChanges("writeback_enabled", "onpremises_group_type") {
+		if _, err := meta.(*clients.Client).Groups.WritebackClient.Update(ctx, d.Id(), groupWritebackConfiguration(d)); err != nil {
+			return tf.ErrorDiagPathF(err, "writeback_enabled", "Could not update writeback configuration for group with ID: %q", d.Id())
+		}
+	}
+
 	return groupResourceReadMsGraph(ctx, d, meta)
 }
 
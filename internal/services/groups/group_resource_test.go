@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/fakegraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/provider"
 	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
@@ -28,12 +34,66 @@ func TestAccGroup_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
 				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("mail_nickname").HasValue(fmt.Sprintf("acctestgroup-%d", data.RandomInteger)),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
+func TestAccGroup_mailNickname(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.mailNickname(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("mail_nickname").HasValue(fmt.Sprintf("acctest.Group-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroup_administrativeUnit(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+	administrativeUnitId := groupTestAdministrativeUnitId(t)
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.administrativeUnit(data, administrativeUnitId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrative_unit_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+// Administrative units cannot yet be managed by the provider, so the object ID of an existing administrative unit is
+// supplied via the environment
+func groupTestAdministrativeUnitId(t *testing.T) string {
+	administrativeUnitId := os.Getenv("ARM_TEST_ADMINISTRATIVE_UNIT_OBJECT_ID")
+	if administrativeUnitId == "" {
+		t.Skip("Skipping as ARM_TEST_ADMINISTRATIVE_UNIT_OBJECT_ID is not specified")
+	}
+	return administrativeUnitId
+}
+
 func TestAccGroup_basicDeprecated(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -184,6 +244,42 @@ func TestAccGroup_membersUpdate(t *testing.T) {
 	})
 }
 
+func TestAccGroup_manyMembers(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.withManyMembers(data, 45),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("45"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withManyMembers(data, 5),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withManyMembers(data, 45),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("45"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccGroup_ownersUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -220,6 +316,60 @@ func TestAccGroup_ownersUpdate(t *testing.T) {
 	})
 }
 
+func TestAccGroup_ignoreUnmanagedOwners(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.ignoreUnmanagedOwners(data, "testA"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("ignore_unmanaged_owners"),
+		{
+			Config: r.ignoreUnmanagedOwners(data, "testB"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+				check.That(data.ResourceName).Key("ignore_unmanaged_owners").HasValue("true"),
+			),
+		},
+		data.ImportStep("ignore_unmanaged_owners"),
+	})
+}
+
+func TestAccGroup_unmanagedMembers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.unmanagedMembers(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("manage_members").HasValue("false"),
+				check.That(data.ResourceName).Key("members.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("manage_members", "members"),
+	})
+}
+
+func TestAccGroup_unmanagedMembersConflict(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.unmanagedMembersConflict(data),
+			ExpectError: regexp.MustCompile("`members` cannot be specified when `manage_members` is false"),
+		},
+	})
+}
+
 func TestAccGroup_preventDuplicateNamesPass(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -244,6 +394,111 @@ func TestAccGroup_preventDuplicateNamesFail(t *testing.T) {
 	})
 }
 
+func TestAccGroup_preventDuplicateNamesCaseSensitivePass(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "duplicate")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.preventDuplicateNamesCaseSensitivePass(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("duplicate_names_case_sensitive", "prevent_duplicate_names"),
+	})
+}
+
+func TestAccGroup_preventDuplicateNamesScopePass(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_group", "duplicate")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.preventDuplicateNamesScopePass(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("duplicate_names_scope", "prevent_duplicate_names"),
+	})
+}
+
+func TestAccGroup_extensionAttributes(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.extensionAttributes(data, "foo"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_attributes.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("extension_attributes"),
+		{
+			Config: r.extensionAttributes(data, "bar"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_attributes.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("extension_attributes"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroup_recoverSoftDeleted(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	var objectId string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.recoverSoftDeleted(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				func(s *terraform.State) error {
+					objectId = s.RootModule().Resources[data.ResourceName].Primary.ID
+					return nil
+				},
+			),
+		},
+		{
+			Config: r.recoverSoftDeleted(data, false),
+		},
+		{
+			// The deleted group should be restored instead of a new group being created
+			Config: r.recoverSoftDeleted(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				resource.TestCheckResourceAttrPtr(data.ResourceName, "object_id", &objectId),
+				check.That(data.ResourceName).Key("description").HasValue("Restored"),
+			),
+		},
+	})
+}
+
 func (r GroupResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	var id *string
 
@@ -272,9 +527,7 @@ func (r GroupResource) Exists(ctx context.Context, clients *clients.Client, stat
 
 func (GroupResource) templateDiverseDirectoryObjects(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[4]s
 
 resource "azuread_application" "test" {
   name = "acctestGroup-%[1]d"
@@ -285,7 +538,7 @@ resource "azuread_service_principal" "test" {
 }
 
 resource "azuread_user" "test" {
-  user_principal_name = "acctestGroup.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[3]s"
   display_name        = "acctestGroup-%[1]d"
   password            = "%[2]s"
 }
@@ -293,34 +546,32 @@ resource "azuread_user" "test" {
 resource "azuread_group" "member" {
   name = "acctestGroup-%[1]d-Member"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestGroup"), data.DefaultDomainTemplate())
 }
 
 func (GroupResource) templateThreeUsers(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[7]s
 
 resource "azuread_user" "testA" {
-  user_principal_name = "acctestGroup.%[1]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[3]s"
   display_name        = "acctestGroup-%[1]d-A"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testB" {
-  user_principal_name = "acctestGroup.%[1]d.B@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[4]s"
   display_name        = "acctestGroup-%[1]d-B"
-  mail_nickname       = "acctestGroup-%[1]d-B"
+  mail_nickname       = "%[6]s"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testC" {
-  user_principal_name = "acctestGroup.%[1]d.C@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[5]s"
   display_name        = "acctestGroup-%[1]d-C"
   password            = "%[2]s"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestGroup.A"), data.UserPrincipalName("acctestGroup.B"), data.UserPrincipalName("acctestGroup.C"), data.MailNickname("acctestGroup-B"), data.DefaultDomainTemplate())
 }
 
 func (GroupResource) basic(data acceptance.TestData) string {
@@ -341,12 +592,10 @@ resource "azuread_group" "test" {
 
 func (GroupResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[4]s
 
 resource "azuread_user" "test" {
-  user_principal_name = "acctestGroup.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[3]s"
   display_name        = "acctestGroup-%[1]d"
   password            = "%[2]s"
 }
@@ -357,7 +606,7 @@ resource "azuread_group" "test" {
   members      = [azuread_user.test.object_id]
   owners       = [azuread_user.test.object_id]
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestGroup"), data.DefaultDomainTemplate())
 }
 
 func (GroupResource) noMembers(data acceptance.TestData) string {
@@ -481,6 +730,65 @@ resource "azuread_group" "test" {
 `, data.RandomInteger)
 }
 
+func (GroupResource) withManyMembers(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%[5]s
+
+resource "azuread_user" "test" {
+  count = 45
+
+  user_principal_name = "acctestGroup.%[1]d.%[4]s.${count.index}@${data.azuread_domains.acctest_default.domains.0.domain_name}"
+  display_name        = "acctestGroup-%[1]d-${count.index}"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  display_name = "acctestGroup-%[1]d"
+  members      = slice(azuread_user.test.*.object_id, 0, %[3]d)
+}
+`, data.RandomInteger, data.RandomPassword, count, data.RunSuffix, data.DefaultDomainTemplate())
+}
+
+func (r GroupResource) ignoreUnmanagedOwners(data acceptance.TestData, owner string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  display_name            = "acctestGroup-%[2]d"
+  owners                  = [azuread_user.%[3]s.object_id]
+  ignore_unmanaged_owners = true
+}
+`, r.templateThreeUsers(data), data.RandomInteger, owner)
+}
+
+func (r GroupResource) unmanagedMembers(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  display_name   = "acctestGroup-%[2]d"
+  manage_members = false
+}
+
+resource "azuread_group_member" "test" {
+  group_object_id  = azuread_group.test.object_id
+  member_object_id = azuread_user.testA.object_id
+}
+`, r.templateThreeUsers(data), data.RandomInteger)
+}
+
+func (r GroupResource) unmanagedMembersConflict(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  display_name   = "acctestGroup-%[2]d"
+  manage_members = false
+  members        = [azuread_user.testA.object_id]
+}
+`, r.templateThreeUsers(data), data.RandomInteger)
+}
+
 func (GroupResource) preventDuplicateNamesPass(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
@@ -500,3 +808,148 @@ resource "azuread_group" "duplicate" {
 }
 `, r.basic(data))
 }
+
+func (GroupResource) administrativeUnit(data acceptance.TestData, administrativeUnitId string) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name            = "acctestGroup-%[1]d"
+  administrative_unit_ids = ["%[2]s"]
+}
+`, data.RandomInteger, administrativeUnitId)
+}
+
+func (GroupResource) mailNickname(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name  = "acctestGroup-%[1]d"
+  mail_nickname = "acctest.Group-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r GroupResource) preventDuplicateNamesCaseSensitivePass(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "duplicate" {
+  display_name                   = upper(azuread_group.test.name)
+  prevent_duplicate_names        = true
+  duplicate_names_case_sensitive = true
+}
+`, r.basic(data))
+}
+
+func (r GroupResource) preventDuplicateNamesScopePass(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "duplicate" {
+  display_name            = azuread_group.test.name
+  prevent_duplicate_names = true
+  duplicate_names_scope   = "unified"
+}
+`, r.basic(data))
+}
+
+func (GroupResource) extensionAttributes(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_application_extension_property" "test" {
+  application_object_id = azuread_application.test.object_id
+  name                  = "acctest%[2]s"
+  target_objects        = ["Group"]
+}
+
+resource "azuread_group" "test" {
+  display_name = "acctestGroup-%[1]d"
+
+  extension_attributes = {
+    (azuread_application_extension_property.test.attribute_name) = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.RandomString, value)
+}
+
+func (GroupResource) recoverSoftDeleted(data acceptance.TestData, withGroup bool) string {
+	group := ""
+	if withGroup {
+		group = fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name  = "acctestGroup-%[1]d"
+  mail_nickname = "acctestGroup-%[1]d"
+  description   = "Restored"
+}
+`, data.RandomInteger)
+	}
+
+	return fmt.Sprintf(`
+provider "azuread" {
+  features {
+    groups {
+      recover_soft_deleted = true
+    }
+  }
+}
+%[1]s
+`, group)
+}
+
+func TestGroupResource_fakeGraph(t *testing.T) {
+	// resource functions expect a deadline, which is usually set by the plugin SDK
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	server := fakegraph.NewServer(t)
+
+	meta, err := clients.NewMsGraphClient(ctx, server.ClientOptions(), clients.Features{})
+	if err != nil {
+		t.Fatalf("building client: %v", err)
+	}
+
+	ownerId := server.Seed("users", map[string]interface{}{"displayName": "Owner", "userPrincipalName": "owner@example.com"})
+
+	resource := provider.AzureADProvider().ResourcesMap["azuread_group"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"display_name":     "acctest-group",
+		"owners":           []interface{}{ownerId},
+		"security_enabled": true,
+	})
+
+	if diags := resource.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("creating group: %+v", diags)
+	}
+	object := server.Object(d.Id())
+	if object == nil || object["displayName"] != "acctest-group" {
+		t.Fatalf("expected group to be created, got %v", object)
+	}
+	if owners := server.Refs(d.Id(), "owners"); len(owners) != 1 || owners[0] != ownerId {
+		t.Fatalf("expected user to be an owner, got %v", owners)
+	}
+
+	if diags := resource.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("reading group: %+v", diags)
+	}
+	if v := d.Get("object_id").(string); v != d.Id() {
+		t.Fatalf("expected object_id %q, got %q", d.Id(), v)
+	}
+	if v := d.Get("mail_nickname").(string); v == "" {
+		t.Fatalf("expected mail_nickname to be populated")
+	}
+
+	if diags := resource.DeleteContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("deleting group: %+v", diags)
+	}
+	if server.Object(d.Id()) != nil || server.DeletedObject(d.Id()) == nil {
+		t.Fatalf("expected group to be deleted")
+	}
+
+	// the group is removed from state when it no longer exists
+	if diags := resource.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("reading deleted group: %+v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected deleted group to be removed from state")
+	}
+}
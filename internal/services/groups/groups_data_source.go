@@ -2,6 +2,7 @@ package groups
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,10 @@ func groupsDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: groupsDataSourceRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -23,7 +28,7 @@ func groupsDataSource() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "names", "object_ids"},
+				ExactlyOneOf: []string{"display_names", "names", "object_ids", "search"},
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: validate.UUID,
@@ -34,19 +39,26 @@ func groupsDataSource() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "names", "object_ids"},
+				ExactlyOneOf: []string{"display_names", "names", "object_ids", "search"},
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: validate.NoEmptyStrings,
 				},
 			},
 
+			"search": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"display_names", "names", "object_ids", "search"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
 			// TODO: remove in v2.0
 			"names": {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"display_names", "names", "object_ids"},
+				ExactlyOneOf: []string{"display_names", "names", "object_ids", "search"},
 				Deprecated:   "This property has been renamed to `display_names` and will be removed in v2.0 of the AzureAD provider",
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
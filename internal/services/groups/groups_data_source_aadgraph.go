@@ -21,6 +21,10 @@ import (
 func groupsDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.AadClient
 
+	if v, ok := d.GetOk("search"); ok && v.(string) != "" {
+		return tf.ErrorDiagPathF(errors.New("searching is only supported when using Microsoft Graph"), "search", "Could not search for groups")
+	}
+
 	var groups []graphrbac.ADGroup
 	expectedCount := 0
 
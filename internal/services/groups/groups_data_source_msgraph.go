@@ -14,6 +14,7 @@ import (
 	"github.com/manicminer/hamilton/msgraph"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
@@ -30,7 +31,14 @@ func groupsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, me
 		displayNames = v.([]interface{})
 	}
 
-	if len(displayNames) > 0 {
+	if search, ok := d.Get("search").(string); ok && search != "" {
+		result, _, err := helpers.GroupsSearch(ctx, client.BaseClient, search)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "search", "Searching for groups matching: %q", search)
+		}
+		expectedCount = len(result)
+		groups = result
+	} else if len(displayNames) > 0 {
 		expectedCount = len(displayNames)
 		for _, v := range displayNames {
 			displayName := v.(string)
@@ -2,6 +2,7 @@ package groups_test
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -68,6 +69,27 @@ func TestAccGroupsDataSource_noNames(t *testing.T) {
 	})
 }
 
+func TestAccGroupsDataSource_bySearch(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: GroupsDataSource{}.template(data),
+		},
+		{
+			Config: GroupsDataSource{}.bySearch(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
 func (GroupsDataSource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "testA" {
@@ -110,6 +132,16 @@ data "azuread_groups" "test" {
 `, GroupsDataSource{}.template(data))
 }
 
+func (GroupsDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_groups" "test" {
+  search = "displayName:%[2]d"
+}
+`, GroupsDataSource{}.template(data), data.RandomInteger)
+}
+
 func (GroupsDataSource) noNames() string {
 	return `
 data "azuread_groups" "test" {
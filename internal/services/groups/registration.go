@@ -21,15 +21,20 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_group":  groupDataSource(),
-		"azuread_groups": groupsDataSource(),
+		"azuread_deleted_groups":                   deletedGroupsDataSource(),
+		"azuread_group":                            groupDataSource(),
+		"azuread_group_dynamic_membership_preview": groupDynamicMembershipPreviewDataSource(),
+		"azuread_group_member_of":                  groupMemberOfDataSource(),
+		"azuread_groups":                           groupsDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_group":        groupResource(),
-		"azuread_group_member": groupMemberResource(),
+		"azuread_group":                  groupResource(),
+		"azuread_group_mailbox_settings": groupMailboxSettingsResource(),
+		"azuread_group_member":           groupMemberResource(),
+		"azuread_team":                   teamResource(),
 	}
 }
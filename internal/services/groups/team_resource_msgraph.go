@@ -0,0 +1,107 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func teamResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Groups.TeamsClient
+
+	groupId := d.Get("group_object_id").(string)
+
+	// Destroying this resource does not remove the team, so an existing team is adopted rather than reported as a
+	// conflict, allowing the resource to be recreated
+	if _, status, err := c.Get(ctx, groupId); err == nil {
+		log.Printf("[DEBUG] Team already exists for group with object ID %q - taking ownership", groupId)
+		d.SetId(groupId)
+		return teamResourceReadMsGraph(ctx, d, meta)
+	} else if status != http.StatusNotFound {
+		return tf.ErrorDiagPathF(err, "group_object_id", "Checking for existing team for group with object ID %q", groupId)
+	}
+
+	// A group created moments earlier may not yet be visible to Teams, in which case the request fails with a 404
+	var teamId, operationId string
+	err := helpers.WaitForReferenceReplication(ctx, func() (status int, err error) {
+		teamId, operationId, status, err = c.CreateFromGroup(ctx, groupId, d.Get("template_id").(string))
+		return
+	})
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "group_object_id", "Creating team for group with object ID %q", groupId)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return tf.ErrorDiagF(errors.New("context has no deadline"), "Waiting for team for group with object ID %q to be provisioned", groupId)
+	}
+	if _, err := (&resource.StateChangeConf{
+		Pending:    []string{client.TeamsAsyncOperationStatusInvalid, client.TeamsAsyncOperationStatusNotStarted, client.TeamsAsyncOperationStatusInProgress},
+		Target:     []string{client.TeamsAsyncOperationStatusSucceeded},
+		Timeout:    time.Until(deadline),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			operation, _, err := c.GetOperation(ctx, teamId, operationId)
+			if err != nil {
+				return nil, "Error", err
+			}
+			if operation.Status == nil {
+				return nil, "Error", errors.New("API returned team provisioning operation with nil status")
+			}
+			if *operation.Status == client.TeamsAsyncOperationStatusFailed {
+				message := "unknown error"
+				if operation.Error != nil && operation.Error.Message != nil {
+					message = *operation.Error.Message
+				}
+				return operation, *operation.Status, fmt.Errorf("team provisioning failed: %s", message)
+			}
+			return operation, *operation.Status, nil
+		},
+	}).WaitForStateContext(ctx); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for team for group with object ID %q to be provisioned", groupId)
+	}
+
+	d.SetId(teamId)
+
+	return teamResourceReadMsGraph(ctx, d, meta)
+}
+
+func teamResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Groups.TeamsClient
+
+	team, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Team with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving team with ID %q", d.Id())
+	}
+
+	tf.Set(d, "archived", team.IsArchived != nil && *team.IsArchived)
+	tf.Set(d, "display_name", team.DisplayName)
+	tf.Set(d, "group_object_id", d.Id())
+	tf.Set(d, "internal_id", team.InternalId)
+	tf.Set(d, "web_url", team.WebUrl)
+
+	return nil
+}
+
+func teamResourceDeleteMsGraph(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A team can only be removed by deleting the group which backs it, so the team is left in place
+	log.Printf("[DEBUG] Removing team with ID %q from state, the team is only deleted when its group is deleted", d.Id())
+	return nil
+}
@@ -0,0 +1,72 @@
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type TeamResource struct{}
+
+func TestAccTeam_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	groupId := teamTestGroupObjectId(t)
+	data := acceptance.BuildTestData(t, "azuread_team", "test")
+	r := TeamResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(groupId),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").HasValue(groupId),
+				check.That(data.ResourceName).Key("internal_id").Exists(),
+				check.That(data.ResourceName).Key("web_url").Exists(),
+			),
+		},
+		data.ImportStep("template_id"),
+	})
+}
+
+// Teams can only be added to Microsoft 365 groups, which cannot be created by this provider, so the object ID of such a
+// group is supplied via the environment
+func teamTestGroupObjectId(t *testing.T) string {
+	objectId := os.Getenv("ARM_TEST_UNIFIED_GROUP_OBJECT_ID")
+	if objectId == "" {
+		t.Skip("Skipping as ARM_TEST_UNIFIED_GROUP_OBJECT_ID is not specified")
+	}
+	return objectId
+}
+
+func (r TeamResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	team, status, err := clients.Groups.TeamsClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Team with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Team with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(team.ID != nil && *team.ID == state.ID), nil
+}
+
+func (TeamResource) basic(groupId string) string {
+	return fmt.Sprintf(`
+resource "azuread_team" "test" {
+  group_object_id = "%[1]s"
+}
+`, groupId)
+}
@@ -0,0 +1,278 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const accessPackageAssignmentPolicyResourceName = "azuread_access_package_assignment_policy"
+
+func accessPackageAssignmentPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: accessPackageAssignmentPolicyResourceCreate,
+		ReadContext:   accessPackageAssignmentPolicyResourceRead,
+		UpdateContext: accessPackageAssignmentPolicyResourceUpdate,
+		DeleteContext: accessPackageAssignmentPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"access_package_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"duration_in_days": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"expiration_date"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+
+			"expiration_date": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"duration_in_days"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"extension_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"requestor_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "notSpecified",
+							ValidateFunc: validation.StringInSlice([]string{
+								"allConfiguredConnectedOrganizationUsers",
+								"allDirectoryServicePrincipals",
+								"allDirectoryUsers",
+								"allExternalUsers",
+								"allMemberUsers",
+								"notSpecified",
+								"specificConnectedOrganizationUsers",
+								"specificDirectoryServicePrincipals",
+								"specificDirectoryUsers",
+							}, false),
+						},
+
+						"requests_accepted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"requestor": schemaAccessPackageSubjectSet(),
+					},
+				},
+			},
+
+			"approval_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"approval_required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"approval_required_for_extension": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"approval_stage": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"approval_timeout_in_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(2, 14),
+									},
+
+									"approver_justification_required": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"alternative_approval_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"enable_alternative_approval_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"primary_approver": schemaAccessPackageSubjectSet(),
+
+									"alternative_approver": schemaAccessPackageSubjectSet(),
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"assignment_review_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"review_frequency": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								accessReviewFrequencyWeekly,
+								accessReviewFrequencyMonthly,
+								accessReviewFrequencyQuarterly,
+								accessReviewFrequencyHalfYearly,
+								accessReviewFrequencyAnnual,
+							}, false),
+						},
+
+						"review_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Self", "Reviewers"}, false),
+						},
+
+						"starting_on": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+
+						"duration_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"access_recommendation_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"access_review_timeout_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"acceptAccessRecommendation",
+								"keepAccess",
+								"removeAccess",
+							}, false),
+						},
+
+						"reviewer_justification_required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"reviewer": schemaAccessPackageSubjectSet(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessPackageAssignmentPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageAssignmentPolicyResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageAssignmentPolicyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func accessPackageAssignmentPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageAssignmentPolicyResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageAssignmentPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageAssignmentPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageAssignmentPolicyResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageAssignmentPolicyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func accessPackageAssignmentPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageAssignmentPolicyResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageAssignmentPolicyResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,341 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+const (
+	accessReviewFrequencyWeekly     = "weekly"
+	accessReviewFrequencyMonthly    = "monthly"
+	accessReviewFrequencyQuarterly  = "quarterly"
+	accessReviewFrequencyHalfYearly = "halfyearly"
+	accessReviewFrequencyAnnual     = "annual"
+)
+
+// accessReviewRecurrences maps the supported review frequencies to their equivalent recurrence patterns
+var accessReviewRecurrences = map[string]client.RecurrencePattern{
+	accessReviewFrequencyWeekly:     {Type: utils.String("weekly"), Interval: utils.Int32(1)},
+	accessReviewFrequencyMonthly:    {Type: utils.String("absoluteMonthly"), Interval: utils.Int32(1)},
+	accessReviewFrequencyQuarterly:  {Type: utils.String("absoluteMonthly"), Interval: utils.Int32(3)},
+	accessReviewFrequencyHalfYearly: {Type: utils.String("absoluteMonthly"), Interval: utils.Int32(6)},
+	accessReviewFrequencyAnnual:     {Type: utils.String("absoluteYearly"), Interval: utils.Int32(1)},
+}
+
+func accessPackageAssignmentPolicyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+	displayName := d.Get("display_name").(string)
+
+	policy, _, err := c.CreateAssignmentPolicy(ctx, expandAccessPackageAssignmentPolicy(d))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating access package assignment policy %q", displayName)
+	}
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for access package assignment policy %q", displayName), "Bad API response")
+	}
+
+	d.SetId(*policy.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.GetAssignmentPolicy(ctx, *policy.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for access package assignment policy with ID %q", *policy.ID)
+	}
+
+	return accessPackageAssignmentPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageAssignmentPolicyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	policy, status, err := c.GetAssignmentPolicy(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package assignment policy with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving access package assignment policy with ID %q", d.Id())
+	}
+
+	if policy.AccessPackage != nil {
+		tf.Set(d, "access_package_id", policy.AccessPackage.ID)
+	}
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+
+	durationInDays, expirationDate := 0, ""
+	if policy.Expiration != nil && policy.Expiration.Type != nil {
+		switch *policy.Expiration.Type {
+		case client.ExpirationPatternTypeAfterDuration:
+			durationInDays = daysFromDuration(policy.Expiration.Duration)
+		case client.ExpirationPatternTypeAfterDateTime:
+			if policy.Expiration.EndDateTime != nil {
+				expirationDate = *policy.Expiration.EndDateTime
+			}
+		}
+	}
+	tf.Set(d, "duration_in_days", durationInDays)
+	tf.Set(d, "expiration_date", expirationDate)
+
+	extensionEnabled := false
+	if policy.RequestorSettings != nil && policy.RequestorSettings.EnableTargetsToSelfUpdateAccess != nil {
+		extensionEnabled = *policy.RequestorSettings.EnableTargetsToSelfUpdateAccess
+	}
+	tf.Set(d, "extension_enabled", extensionEnabled)
+
+	tf.Set(d, "approval_settings", flattenAccessPackageApprovalSettings(policy.RequestApprovalSettings))
+	tf.Set(d, "assignment_review_settings", flattenAccessPackageReviewSettings(policy.ReviewSettings))
+	tf.Set(d, "requestor_settings", flattenAccessPackageRequestorSettings(policy))
+
+	return nil
+}
+
+func accessPackageAssignmentPolicyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	properties := expandAccessPackageAssignmentPolicy(d)
+	properties.ID = utils.String(d.Id())
+
+	if _, err := c.UpdateAssignmentPolicy(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating access package assignment policy with ID %q", d.Id())
+	}
+
+	return accessPackageAssignmentPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageAssignmentPolicyResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	_, status, err := c.GetAssignmentPolicy(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Access package assignment policy was not found"), "id", "Retrieving access package assignment policy with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving access package assignment policy with ID %q", d.Id())
+	}
+
+	if _, err := c.DeleteAssignmentPolicy(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting access package assignment policy with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandAccessPackageAssignmentPolicy(d *schema.ResourceData) client.AccessPackageAssignmentPolicy {
+	policy := client.AccessPackageAssignmentPolicy{
+		AccessPackage: &client.AccessPackage{
+			ID: utils.String(d.Get("access_package_id").(string)),
+		},
+		AllowedTargetScope: utils.String("notSpecified"),
+		Description:        utils.String(d.Get("description").(string)),
+		DisplayName:        utils.String(d.Get("display_name").(string)),
+		Expiration: &client.ExpirationPattern{
+			Type: utils.String(client.ExpirationPatternTypeNoExpiration),
+		},
+		RequestorSettings: &client.AccessPackageAssignmentRequestorSettings{
+			EnableTargetsToSelfAddAccess:    utils.Bool(false),
+			EnableTargetsToSelfRemoveAccess: utils.Bool(true),
+			EnableTargetsToSelfUpdateAccess: utils.Bool(d.Get("extension_enabled").(bool)),
+		},
+		RequestApprovalSettings: &client.AccessPackageAssignmentApprovalSettings{
+			IsApprovalRequiredForAdd:    utils.Bool(false),
+			IsApprovalRequiredForUpdate: utils.Bool(false),
+			Stages:                      &[]client.AccessPackageApprovalStage{},
+		},
+	}
+
+	if v, ok := d.GetOk("duration_in_days"); ok {
+		policy.Expiration = &client.ExpirationPattern{
+			Duration: durationFromDays(v.(int)),
+			Type:     utils.String(client.ExpirationPatternTypeAfterDuration),
+		}
+	} else if v, ok := d.GetOk("expiration_date"); ok {
+		policy.Expiration = &client.ExpirationPattern{
+			EndDateTime: utils.String(v.(string)),
+			Type:        utils.String(client.ExpirationPatternTypeAfterDateTime),
+		}
+	}
+
+	if v := d.Get("requestor_settings").([]interface{}); len(v) > 0 && v[0] != nil {
+		settings := v[0].(map[string]interface{})
+		scopeType := settings["scope_type"].(string)
+		requestors := expandAccessPackageSubjectSets(settings["requestor"].([]interface{}))
+
+		policy.AllowedTargetScope = utils.String(scopeType)
+		policy.RequestorSettings.EnableTargetsToSelfAddAccess = utils.Bool(settings["requests_accepted"].(bool))
+		if scopeType == "specificDirectoryUsers" || scopeType == "specificConnectedOrganizationUsers" || scopeType == "specificDirectoryServicePrincipals" {
+			policy.SpecificAllowedTargets = requestors
+		}
+	}
+
+	if v := d.Get("approval_settings").([]interface{}); len(v) > 0 && v[0] != nil {
+		settings := v[0].(map[string]interface{})
+		policy.RequestApprovalSettings.IsApprovalRequiredForAdd = utils.Bool(settings["approval_required"].(bool))
+		policy.RequestApprovalSettings.IsApprovalRequiredForUpdate = utils.Bool(settings["approval_required_for_extension"].(bool))
+
+		stages := make([]client.AccessPackageApprovalStage, 0)
+		for _, raw := range settings["approval_stage"].([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			s := raw.(map[string]interface{})
+			stage := client.AccessPackageApprovalStage{
+				DurationBeforeAutomaticDenial:   durationFromDays(s["approval_timeout_in_days"].(int)),
+				IsApproverJustificationRequired: utils.Bool(s["approver_justification_required"].(bool)),
+				IsEscalationEnabled:             utils.Bool(s["alternative_approval_enabled"].(bool)),
+				PrimaryApprovers:                expandAccessPackageSubjectSets(s["primary_approver"].([]interface{})),
+				EscalationApprovers:             expandAccessPackageSubjectSets(s["alternative_approver"].([]interface{})),
+			}
+			if days := s["enable_alternative_approval_in_days"].(int); days > 0 {
+				stage.DurationBeforeEscalation = durationFromDays(days)
+			}
+			stages = append(stages, stage)
+		}
+		policy.RequestApprovalSettings.Stages = &stages
+	}
+
+	if v := d.Get("assignment_review_settings").([]interface{}); len(v) > 0 && v[0] != nil {
+		settings := v[0].(map[string]interface{})
+		reviewSettings := client.AccessPackageAssignmentReviewSettings{
+			IsEnabled:                       utils.Bool(settings["enabled"].(bool)),
+			IsRecommendationEnabled:         utils.Bool(settings["access_recommendation_enabled"].(bool)),
+			IsReviewerJustificationRequired: utils.Bool(settings["reviewer_justification_required"].(bool)),
+			IsSelfReview:                    utils.Bool(settings["review_type"].(string) == "Self"),
+			PrimaryReviewers:                expandAccessPackageSubjectSets(settings["reviewer"].([]interface{})),
+		}
+
+		if behavior := settings["access_review_timeout_behavior"].(string); behavior != "" {
+			reviewSettings.ExpirationBehavior = utils.String(behavior)
+		}
+
+		schedule := client.EntitlementManagementSchedule{}
+		if startingOn := settings["starting_on"].(string); startingOn != "" {
+			schedule.StartDateTime = utils.String(startingOn)
+		}
+		if days := settings["duration_in_days"].(int); days > 0 {
+			schedule.Expiration = &client.ExpirationPattern{
+				Duration: durationFromDays(days),
+				Type:     utils.String(client.ExpirationPatternTypeAfterDuration),
+			}
+		}
+		if pattern, ok := accessReviewRecurrences[settings["review_frequency"].(string)]; ok {
+			schedule.Recurrence = &client.PatternedRecurrence{
+				Pattern: &pattern,
+				Range: &client.RecurrenceRange{
+					Type: utils.String("noEnd"),
+				},
+			}
+			if schedule.StartDateTime != nil && len(*schedule.StartDateTime) >= 10 {
+				schedule.Recurrence.Range.StartDate = utils.String((*schedule.StartDateTime)[0:10])
+			}
+		}
+		reviewSettings.Schedule = &schedule
+
+		policy.ReviewSettings = &reviewSettings
+	}
+
+	return policy
+}
+
+func flattenAccessPackageRequestorSettings(policy *client.AccessPackageAssignmentPolicy) []map[string]interface{} {
+	scopeType := "notSpecified"
+	if policy.AllowedTargetScope != nil {
+		scopeType = *policy.AllowedTargetScope
+	}
+
+	requestsAccepted := false
+	if policy.RequestorSettings != nil && policy.RequestorSettings.EnableTargetsToSelfAddAccess != nil {
+		requestsAccepted = *policy.RequestorSettings.EnableTargetsToSelfAddAccess
+	}
+
+	return []map[string]interface{}{{
+		"requestor":         flattenAccessPackageSubjectSets(policy.SpecificAllowedTargets),
+		"requests_accepted": requestsAccepted,
+		"scope_type":        scopeType,
+	}}
+}
+
+func flattenAccessPackageApprovalSettings(in *client.AccessPackageAssignmentApprovalSettings) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	stages := make([]map[string]interface{}, 0)
+	if in.Stages != nil {
+		for _, stage := range *in.Stages {
+			stages = append(stages, map[string]interface{}{
+				"alternative_approval_enabled":        stage.IsEscalationEnabled != nil && *stage.IsEscalationEnabled,
+				"alternative_approver":                flattenAccessPackageSubjectSets(stage.EscalationApprovers),
+				"approval_timeout_in_days":            daysFromDuration(stage.DurationBeforeAutomaticDenial),
+				"approver_justification_required":     stage.IsApproverJustificationRequired != nil && *stage.IsApproverJustificationRequired,
+				"enable_alternative_approval_in_days": daysFromDuration(stage.DurationBeforeEscalation),
+				"primary_approver":                    flattenAccessPackageSubjectSets(stage.PrimaryApprovers),
+			})
+		}
+	}
+
+	return []map[string]interface{}{{
+		"approval_required":               in.IsApprovalRequiredForAdd != nil && *in.IsApprovalRequiredForAdd,
+		"approval_required_for_extension": in.IsApprovalRequiredForUpdate != nil && *in.IsApprovalRequiredForUpdate,
+		"approval_stage":                  stages,
+	}}
+}
+
+func flattenAccessPackageReviewSettings(in *client.AccessPackageAssignmentReviewSettings) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	reviewType := "Reviewers"
+	if in.IsSelfReview != nil && *in.IsSelfReview {
+		reviewType = "Self"
+	}
+
+	var durationInDays int
+	var reviewFrequency, startingOn string
+	if in.Schedule != nil {
+		if in.Schedule.StartDateTime != nil {
+			startingOn = *in.Schedule.StartDateTime
+		}
+		if in.Schedule.Expiration != nil {
+			durationInDays = daysFromDuration(in.Schedule.Expiration.Duration)
+		}
+		if r := in.Schedule.Recurrence; r != nil && r.Pattern != nil && r.Pattern.Type != nil && r.Pattern.Interval != nil {
+			for frequency, pattern := range accessReviewRecurrences {
+				if *pattern.Type == *r.Pattern.Type && *pattern.Interval == *r.Pattern.Interval {
+					reviewFrequency = frequency
+				}
+			}
+		}
+	}
+
+	var timeoutBehavior string
+	if in.ExpirationBehavior != nil {
+		timeoutBehavior = *in.ExpirationBehavior
+	}
+
+	return []map[string]interface{}{{
+		"access_recommendation_enabled":   in.IsRecommendationEnabled != nil && *in.IsRecommendationEnabled,
+		"access_review_timeout_behavior":  timeoutBehavior,
+		"duration_in_days":                durationInDays,
+		"enabled":                         in.IsEnabled != nil && *in.IsEnabled,
+		"review_frequency":                reviewFrequency,
+		"review_type":                     reviewType,
+		"reviewer":                        flattenAccessPackageSubjectSets(in.PrimaryReviewers),
+		"reviewer_justification_required": in.IsReviewerJustificationRequired != nil && *in.IsReviewerJustificationRequired,
+		"starting_on":                     startingOn,
+	}}
+}
@@ -0,0 +1,188 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AccessPackageAssignmentPolicyResource struct{}
+
+func TestAccAccessPackageAssignmentPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	r := AccessPackageAssignmentPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("duration_in_days").HasValue("90"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageAssignmentPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	r := AccessPackageAssignmentPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("approval_settings.0.approval_stage.#").HasValue("1"),
+				check.That(data.ResourceName).Key("assignment_review_settings.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageAssignmentPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment_policy", "test")
+	r := AccessPackageAssignmentPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AccessPackageAssignmentPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	policy, status, err := clients.IdentityGovernance.EntitlementManagementClient.GetAssignmentPolicy(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Access Package Assignment Policy with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Access Package Assignment Policy with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(policy.ID != nil && *policy.ID == state.ID), nil
+}
+
+func (AccessPackageAssignmentPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-Catalog-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctest-AccessPackage-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r AccessPackageAssignmentPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment_policy" "test" {
+  access_package_id = azuread_access_package.test.id
+  display_name      = "acctest-AssignmentPolicy-%[2]d"
+  description       = "Test assignment policy"
+  duration_in_days  = 90
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AccessPackageAssignmentPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[5]s
+
+resource "azuread_user" "approver" {
+  user_principal_name = "%[4]s"
+  display_name        = "acctestUser-%[2]d-Approver"
+  password            = "%[3]s"
+}
+
+resource "azuread_group" "requestors" {
+  display_name = "acctestGroup-%[2]d-Requestors"
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  access_package_id = azuread_access_package.test.id
+  display_name      = "acctest-AssignmentPolicy-updated-%[2]d"
+  description       = "Updated test assignment policy"
+  expiration_date   = "2030-01-01T00:00:00Z"
+  extension_enabled = true
+
+  requestor_settings {
+    scope_type        = "specificDirectoryUsers"
+    requests_accepted = true
+
+    requestor {
+      subject_type = "groupMembers"
+      object_id    = azuread_group.requestors.object_id
+    }
+  }
+
+  approval_settings {
+    approval_required               = true
+    approval_required_for_extension = true
+
+    approval_stage {
+      approval_timeout_in_days            = 14
+      approver_justification_required     = true
+      alternative_approval_enabled        = true
+      enable_alternative_approval_in_days = 7
+
+      primary_approver {
+        subject_type = "requestorManager"
+      }
+
+      alternative_approver {
+        subject_type = "singleUser"
+        object_id    = azuread_user.approver.object_id
+      }
+    }
+  }
+
+  assignment_review_settings {
+    enabled                        = true
+    review_frequency               = "quarterly"
+    review_type                    = "Reviewers"
+    duration_in_days               = 14
+    access_review_timeout_behavior = "removeAccess"
+
+    reviewer {
+      subject_type = "singleUser"
+      object_id    = azuread_user.approver.object_id
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser.Approver"), data.DefaultDomainTemplate())
+}
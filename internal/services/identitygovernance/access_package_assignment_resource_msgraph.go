@@ -0,0 +1,153 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func accessPackageAssignmentResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+	accessPackageId := d.Get("access_package_id").(string)
+	targetObjectId := d.Get("target_object_id").(string)
+
+	schedule := client.EntitlementManagementSchedule{
+		Expiration: &client.ExpirationPattern{
+			Type: utils.String(client.ExpirationPatternTypeNoExpiration),
+		},
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		schedule.StartDateTime = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("duration"); ok {
+		schedule.Expiration = &client.ExpirationPattern{
+			Duration: utils.String(v.(string)),
+			Type:     utils.String(client.ExpirationPatternTypeAfterDuration),
+		}
+	} else if v, ok := d.GetOk("end_date"); ok {
+		schedule.Expiration = &client.ExpirationPattern{
+			EndDateTime: utils.String(v.(string)),
+			Type:        utils.String(client.ExpirationPatternTypeAfterDateTime),
+		}
+	}
+
+	properties := client.AccessPackageAssignmentRequest{
+		Assignment: &client.AccessPackageAssignment{
+			AccessPackageId:    utils.String(accessPackageId),
+			AssignmentPolicyId: utils.String(d.Get("assignment_policy_id").(string)),
+			TargetId:           utils.String(targetObjectId),
+		},
+		RequestType: utils.String(client.AccessPackageAssignmentRequestTypeAdminAdd),
+		Schedule:    &schedule,
+	}
+
+	if v, ok := d.GetOk("justification"); ok {
+		properties.Justification = utils.String(v.(string))
+	}
+
+	request, _, err := c.CreateAssignmentRequest(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Requesting assignment of access package %q to %q", accessPackageId, targetObjectId)
+	}
+	if request.ID == nil || *request.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for access package assignment request"), "Bad API response")
+	}
+
+	d.SetId(*request.ID)
+
+	return accessPackageAssignmentResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageAssignmentResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	request, status, err := c.GetAssignmentRequest(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package assignment request with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving access package assignment request with ID %q", d.Id())
+	}
+
+	tf.Set(d, "justification", request.Justification)
+	tf.Set(d, "state", request.State)
+	tf.Set(d, "status", request.Status)
+
+	if request.AccessPackage != nil && request.AccessPackage.ID != nil {
+		tf.Set(d, "access_package_id", request.AccessPackage.ID)
+	}
+
+	// The assignment is only available once the request has been delivered
+	if a := request.Assignment; a != nil {
+		tf.Set(d, "assignment_id", a.ID)
+		if a.AssignmentPolicy != nil && a.AssignmentPolicy.ID != nil {
+			tf.Set(d, "assignment_policy_id", a.AssignmentPolicy.ID)
+		}
+		if a.Target != nil && a.Target.ObjectId != nil {
+			tf.Set(d, "target_object_id", a.Target.ObjectId)
+		}
+	}
+
+	if request.Schedule != nil {
+		tf.Set(d, "start_date", request.Schedule.StartDateTime)
+
+		if e := request.Schedule.Expiration; e != nil && e.Type != nil {
+			switch *e.Type {
+			case client.ExpirationPatternTypeAfterDuration:
+				tf.Set(d, "duration", e.Duration)
+			case client.ExpirationPatternTypeAfterDateTime:
+				tf.Set(d, "end_date", e.EndDateTime)
+			}
+		}
+	}
+
+	return nil
+}
+
+func accessPackageAssignmentResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	request, status, err := c.GetAssignmentRequest(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package assignment request with ID %q was not found - assuming removed", d.Id())
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving access package assignment request with ID %q", d.Id())
+	}
+	if request.Assignment == nil || request.Assignment.ID == nil {
+		return tf.ErrorDiagPathF(fmt.Errorf("assignment was not found for request, it may not have been delivered yet"), "id", "Removing access package assignment for request with ID %q", d.Id())
+	}
+	assignmentId := *request.Assignment.ID
+
+	// Assignment requests cannot be deleted, instead a new request is submitted to remove the assignment
+	properties := client.AccessPackageAssignmentRequest{
+		Assignment: &client.AccessPackageAssignment{
+			ID: utils.String(assignmentId),
+		},
+		RequestType: utils.String(client.AccessPackageAssignmentRequestTypeAdminRemove),
+	}
+
+	if _, status, err := c.CreateAssignmentRequest(ctx, properties); err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package assignment with ID %q was not found - assuming removed", assignmentId)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing access package assignment with ID %q", assignmentId)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AccessPackageAssignmentResource struct{}
+
+func TestAccAccessPackageAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	r := AccessPackageAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageAssignment_duration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_assignment", "test")
+	r := AccessPackageAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.duration(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("duration").HasValue("P30D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AccessPackageAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	request, status, err := clients.IdentityGovernance.EntitlementManagementClient.GetAssignmentRequest(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Access Package Assignment Request with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Access Package Assignment Request with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(request.ID != nil && *request.ID == state.ID), nil
+}
+
+func (AccessPackageAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[4]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[3]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-Catalog-%[1]d"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctest-AccessPackage-%[1]d"
+}
+
+resource "azuread_access_package_assignment_policy" "test" {
+  access_package_id = azuread_access_package.test.id
+  display_name      = "acctest-AssignmentPolicy-%[1]d"
+  duration_in_days  = 90
+}
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (r AccessPackageAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment" "test" {
+  access_package_id    = azuread_access_package.test.id
+  assignment_policy_id = azuread_access_package_assignment_policy.test.id
+  target_object_id     = azuread_user.test.object_id
+}
+`, r.template(data))
+}
+
+func (r AccessPackageAssignmentResource) duration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_assignment" "test" {
+  access_package_id    = azuread_access_package.test.id
+  assignment_policy_id = azuread_access_package_assignment_policy.test.id
+  target_object_id     = azuread_user.test.object_id
+  justification        = "Acceptance testing"
+  duration             = "P30D"
+}
+`, r.template(data))
+}
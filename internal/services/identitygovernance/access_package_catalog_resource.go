@@ -0,0 +1,93 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const accessPackageCatalogResourceName = "azuread_access_package_catalog"
+
+func accessPackageCatalogResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: accessPackageCatalogResourceCreate,
+		ReadContext:   accessPackageCatalogResourceRead,
+		UpdateContext: accessPackageCatalogResourceUpdate,
+		DeleteContext: accessPackageCatalogResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"externally_visible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"published": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func accessPackageCatalogResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageCatalogResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageCatalogResourceCreateMsGraph(ctx, d, meta)
+}
+
+func accessPackageCatalogResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageCatalogResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageCatalogResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageCatalogResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageCatalogResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageCatalogResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func accessPackageCatalogResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageCatalogResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageCatalogResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,107 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func accessPackageCatalogResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+	displayName := d.Get("display_name").(string)
+
+	catalog, _, err := c.CreateCatalog(ctx, expandAccessPackageCatalog(d))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating access package catalog %q", displayName)
+	}
+	if catalog.ID == nil || *catalog.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for access package catalog %q", displayName), "Bad API response")
+	}
+
+	d.SetId(*catalog.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.GetCatalog(ctx, *catalog.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for access package catalog with ID %q", *catalog.ID)
+	}
+
+	return accessPackageCatalogResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageCatalogResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	catalog, status, err := c.GetCatalog(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package catalog with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving access package catalog with ID %q", d.Id())
+	}
+
+	tf.Set(d, "description", catalog.Description)
+	tf.Set(d, "display_name", catalog.DisplayName)
+	tf.Set(d, "externally_visible", catalog.IsExternallyVisible)
+	tf.Set(d, "published", catalog.State != nil && *catalog.State == client.AccessPackageCatalogStatePublished)
+
+	return nil
+}
+
+func accessPackageCatalogResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	properties := expandAccessPackageCatalog(d)
+	properties.ID = utils.String(d.Id())
+
+	if _, err := c.UpdateCatalog(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating access package catalog with ID %q", d.Id())
+	}
+
+	return accessPackageCatalogResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageCatalogResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	_, status, err := c.GetCatalog(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Access package catalog was not found"), "id", "Retrieving access package catalog with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving access package catalog with ID %q", d.Id())
+	}
+
+	if _, err := c.DeleteCatalog(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting access package catalog with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandAccessPackageCatalog(d *schema.ResourceData) client.AccessPackageCatalog {
+	state := client.AccessPackageCatalogStateUnpublished
+	if d.Get("published").(bool) {
+		state = client.AccessPackageCatalogStatePublished
+	}
+
+	return client.AccessPackageCatalog{
+		Description:         utils.String(d.Get("description").(string)),
+		DisplayName:         utils.String(d.Get("display_name").(string)),
+		IsExternallyVisible: utils.Bool(d.Get("externally_visible").(bool)),
+		State:               utils.String(state),
+	}
+}
@@ -0,0 +1,95 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AccessPackageCatalogResource struct{}
+
+func TestAccAccessPackageCatalog_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_catalog", "test")
+	r := AccessPackageCatalogResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageCatalog_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_catalog", "test")
+	r := AccessPackageCatalogResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("externally_visible").HasValue("false"),
+				check.That(data.ResourceName).Key("published").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AccessPackageCatalogResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	catalog, status, err := clients.IdentityGovernance.EntitlementManagementClient.GetCatalog(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Access Package Catalog with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Access Package Catalog with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(catalog.ID != nil && *catalog.ID == state.ID), nil
+}
+
+func (AccessPackageCatalogResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-Catalog-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (AccessPackageCatalogResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name       = "acctest-Catalog-updated-%[1]d"
+  description        = "Test catalog"
+  externally_visible = false
+  published          = false
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,89 @@
+package identitygovernance
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const accessPackageResourceCatalogAssociationResourceName = "azuread_access_package_resource_catalog_association"
+
+func accessPackageResourceCatalogAssociationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: accessPackageResourceCatalogAssociationResourceCreate,
+		ReadContext:   accessPackageResourceCatalogAssociationResourceRead,
+		DeleteContext: accessPackageResourceCatalogAssociationResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AccessPackageResourceCatalogAssociationID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"resource_origin_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"resource_origin_system": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.AccessPackageResourceOriginSystemApplication,
+					client.AccessPackageResourceOriginSystemGroup,
+				}, false),
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func accessPackageResourceCatalogAssociationResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageResourceCatalogAssociationResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageResourceCatalogAssociationResourceCreateMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceCatalogAssociationResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageResourceCatalogAssociationResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageResourceCatalogAssociationResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceCatalogAssociationResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageResourceCatalogAssociationResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageResourceCatalogAssociationResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,145 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func accessPackageResourceCatalogAssociationResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+	catalogId := d.Get("catalog_id").(string)
+	originId := d.Get("resource_origin_id").(string)
+	originSystem := d.Get("resource_origin_system").(string)
+
+	id := parse.NewAccessPackageResourceCatalogAssociationID(catalogId, originId)
+
+	tf.LockByName(accessPackageCatalogResourceName, catalogId)
+	defer tf.UnlockByName(accessPackageCatalogResourceName, catalogId)
+
+	existing, status, err := getAccessPackageCatalogResource(ctx, c, catalogId, originId)
+	if err != nil && status != http.StatusNotFound {
+		return tf.ErrorDiagF(err, "Checking for existing resource %q in access package catalog %q", originId, catalogId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag(accessPackageResourceCatalogAssociationResourceName, id.String())
+	}
+
+	request := client.AccessPackageResourceRequest{
+		Catalog: &client.AccessPackageCatalog{
+			ID: utils.String(catalogId),
+		},
+		RequestType: utils.String(client.AccessPackageResourceRequestTypeAdminAdd),
+		Resource: &client.AccessPackageResource{
+			OriginId:     utils.String(originId),
+			OriginSystem: utils.String(originSystem),
+		},
+	}
+
+	if _, _, err := c.CreateResourceRequest(ctx, request); err != nil {
+		return tf.ErrorDiagF(err, "Adding resource %q to access package catalog %q", originId, catalogId)
+	}
+
+	d.SetId(id.String())
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return getAccessPackageCatalogResource(ctx, c, catalogId, originId)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for resource %q to be added to access package catalog %q", originId, catalogId)
+	}
+
+	return accessPackageResourceCatalogAssociationResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceCatalogAssociationResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	id, err := parse.AccessPackageResourceCatalogAssociationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing catalog resource association with ID %q", d.Id())
+	}
+
+	resource, status, err := getAccessPackageCatalogResource(ctx, c, id.CatalogId, id.OriginId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Resource %q was not found in access package catalog %q - removing from state", id.OriginId, id.CatalogId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving resource %q from access package catalog %q", id.OriginId, id.CatalogId)
+	}
+
+	tf.Set(d, "catalog_id", id.CatalogId)
+	tf.Set(d, "display_name", resource.DisplayName)
+	tf.Set(d, "resource_origin_id", resource.OriginId)
+	tf.Set(d, "resource_origin_system", resource.OriginSystem)
+
+	return nil
+}
+
+func accessPackageResourceCatalogAssociationResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	id, err := parse.AccessPackageResourceCatalogAssociationID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing catalog resource association with ID %q", d.Id())
+	}
+
+	tf.LockByName(accessPackageCatalogResourceName, id.CatalogId)
+	defer tf.UnlockByName(accessPackageCatalogResourceName, id.CatalogId)
+
+	resource, status, err := getAccessPackageCatalogResource(ctx, c, id.CatalogId, id.OriginId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Resource was not found in catalog"), "id", "Retrieving resource %q from access package catalog %q", id.OriginId, id.CatalogId)
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving resource %q from access package catalog %q", id.OriginId, id.CatalogId)
+	}
+
+	request := client.AccessPackageResourceRequest{
+		Catalog: &client.AccessPackageCatalog{
+			ID: utils.String(id.CatalogId),
+		},
+		RequestType: utils.String(client.AccessPackageResourceRequestTypeAdminRemove),
+		Resource: &client.AccessPackageResource{
+			ID:           resource.ID,
+			OriginId:     resource.OriginId,
+			OriginSystem: resource.OriginSystem,
+		},
+	}
+
+	if _, _, err := c.CreateResourceRequest(ctx, request); err != nil {
+		return tf.ErrorDiagF(err, "Removing resource %q from access package catalog %q", id.OriginId, id.CatalogId)
+	}
+
+	return nil
+}
+
+// getAccessPackageCatalogResource returns the resource in the specified catalog having the specified origin ID. When
+// no such resource exists, a 404 status is returned to simplify waiting for replication.
+func getAccessPackageCatalogResource(ctx context.Context, c *client.EntitlementManagementClient, catalogId, originId string) (*client.AccessPackageResource, int, error) {
+	resources, status, err := c.ListCatalogResources(ctx, catalogId, fmt.Sprintf("originId eq '%s'", originId))
+	if err != nil {
+		return nil, status, err
+	}
+	if resources != nil {
+		for _, resource := range *resources {
+			if resource.OriginId != nil && *resource.OriginId == originId {
+				return &resource, status, nil
+			}
+		}
+	}
+	return nil, http.StatusNotFound, fmt.Errorf("resource %q was not found in catalog %q", originId, catalogId)
+}
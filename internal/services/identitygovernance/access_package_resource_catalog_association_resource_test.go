@@ -0,0 +1,105 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AccessPackageResourceCatalogAssociationResource struct{}
+
+func TestAccAccessPackageResourceCatalogAssociation_group(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_resource_catalog_association", "test")
+	r := AccessPackageResourceCatalogAssociationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.group(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageResourceCatalogAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_resource_catalog_association", "test")
+	r := AccessPackageResourceCatalogAssociationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.group(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r AccessPackageResourceCatalogAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.AccessPackageResourceCatalogAssociationID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Catalog Resource Association ID: %v", err)
+	}
+
+	resources, status, err := clients.IdentityGovernance.EntitlementManagementClient.ListCatalogResources(ctx, id.CatalogId, fmt.Sprintf("originId eq '%s'", id.OriginId))
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Access Package Catalog with ID %q does not exist", id.CatalogId)
+		}
+		return nil, fmt.Errorf("failed to retrieve resources for Access Package Catalog with ID %q: %+v", id.CatalogId, err)
+	}
+
+	if resources != nil {
+		for _, resource := range *resources {
+			if resource.OriginId != nil && *resource.OriginId == id.OriginId {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Resource %q was not found in Access Package Catalog with ID %q", id.OriginId, id.CatalogId)
+}
+
+func (AccessPackageResourceCatalogAssociationResource) group(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-Catalog-%[1]d"
+}
+
+resource "azuread_group" "test" {
+  display_name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_access_package_resource_catalog_association" "test" {
+  catalog_id             = azuread_access_package_catalog.test.id
+  resource_origin_id     = azuread_group.test.object_id
+  resource_origin_system = "AadGroup"
+}
+`, data.RandomInteger)
+}
+
+func (r AccessPackageResourceCatalogAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_access_package_resource_catalog_association" "import" {
+  catalog_id             = azuread_access_package_resource_catalog_association.test.catalog_id
+  resource_origin_id     = azuread_access_package_resource_catalog_association.test.resource_origin_id
+  resource_origin_system = azuread_access_package_resource_catalog_association.test.resource_origin_system
+}
+`, r.group(data))
+}
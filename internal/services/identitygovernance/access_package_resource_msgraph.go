@@ -0,0 +1,108 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func accessPackageResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+	displayName := d.Get("display_name").(string)
+
+	properties := expandAccessPackage(d)
+	properties.Catalog = &client.AccessPackageCatalog{
+		ID: utils.String(d.Get("catalog_id").(string)),
+	}
+
+	accessPackage, _, err := c.CreateAccessPackage(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating access package %q", displayName)
+	}
+	if accessPackage.ID == nil || *accessPackage.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for access package %q", displayName), "Bad API response")
+	}
+
+	d.SetId(*accessPackage.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.GetAccessPackage(ctx, *accessPackage.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for access package with ID %q", *accessPackage.ID)
+	}
+
+	return accessPackageResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	accessPackage, status, err := c.GetAccessPackage(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving access package with ID %q", d.Id())
+	}
+
+	if accessPackage.Catalog != nil {
+		tf.Set(d, "catalog_id", accessPackage.Catalog.ID)
+	}
+	tf.Set(d, "description", accessPackage.Description)
+	tf.Set(d, "display_name", accessPackage.DisplayName)
+	tf.Set(d, "hidden", accessPackage.IsHidden)
+
+	return nil
+}
+
+func accessPackageResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	properties := expandAccessPackage(d)
+	properties.ID = utils.String(d.Id())
+
+	if _, err := c.UpdateAccessPackage(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating access package with ID %q", d.Id())
+	}
+
+	return accessPackageResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	_, status, err := c.GetAccessPackage(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Access package was not found"), "id", "Retrieving access package with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving access package with ID %q", d.Id())
+	}
+
+	if _, err := c.DeleteAccessPackage(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting access package with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandAccessPackage(d *schema.ResourceData) client.AccessPackage {
+	return client.AccessPackage{
+		Description: utils.String(d.Get("description").(string)),
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		IsHidden:    utils.Bool(d.Get("hidden").(bool)),
+	}
+}
@@ -0,0 +1,85 @@
+package identitygovernance
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const accessPackageResourceRoleScopeResourceName = "azuread_access_package_resource_role_scope"
+
+func accessPackageResourceRoleScopeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: accessPackageResourceRoleScopeResourceCreate,
+		ReadContext:   accessPackageResourceRoleScopeResourceRead,
+		DeleteContext: accessPackageResourceRoleScopeResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AccessPackageResourceRoleScopeID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"access_package_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"catalog_resource_association_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "Member",
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"role_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func accessPackageResourceRoleScopeResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageResourceRoleScopeResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageResourceRoleScopeResourceCreateMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceRoleScopeResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageResourceRoleScopeResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageResourceRoleScopeResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceRoleScopeResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(accessPackageResourceRoleScopeResourceName); diags != nil {
+		return diags
+	}
+	return accessPackageResourceRoleScopeResourceDeleteMsGraph(ctx, d, meta)
+}
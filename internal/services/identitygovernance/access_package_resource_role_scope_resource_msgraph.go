@@ -0,0 +1,145 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func accessPackageResourceRoleScopeResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+	accessPackageId := d.Get("access_package_id").(string)
+	role := d.Get("role").(string)
+
+	associationId, err := parse.AccessPackageResourceCatalogAssociationID(d.Get("catalog_resource_association_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "catalog_resource_association_id", "Parsing `catalog_resource_association_id`")
+	}
+
+	tf.LockByName(accessPackageResourceName, accessPackageId)
+	defer tf.UnlockByName(accessPackageResourceName, accessPackageId)
+
+	resource, _, err := getAccessPackageCatalogResource(ctx, c, associationId.CatalogId, associationId.OriginId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "catalog_resource_association_id", "Retrieving resource %q from access package catalog %q", associationId.OriginId, associationId.CatalogId)
+	}
+
+	// Group roles are identified by the role name and the object ID of the group, whereas application roles are
+	// identified solely by the ID of the app role
+	roleOriginId := role
+	if resource.OriginSystem != nil && *resource.OriginSystem == client.AccessPackageResourceOriginSystemGroup {
+		roleOriginId = fmt.Sprintf("%s_%s", role, associationId.OriginId)
+	}
+
+	properties := client.AccessPackageResourceRoleScope{
+		Role: &client.AccessPackageResourceRole{
+			OriginId:     utils.String(roleOriginId),
+			OriginSystem: resource.OriginSystem,
+			Resource: &client.AccessPackageResource{
+				ID:           resource.ID,
+				OriginId:     resource.OriginId,
+				OriginSystem: resource.OriginSystem,
+			},
+		},
+		Scope: &client.AccessPackageResourceScope{
+			OriginId:     resource.OriginId,
+			OriginSystem: resource.OriginSystem,
+		},
+	}
+
+	roleScope, _, err := c.CreateResourceRoleScope(ctx, accessPackageId, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Adding role %q for resource %q to access package %q", role, associationId.OriginId, accessPackageId)
+	}
+	if roleScope.ID == nil || *roleScope.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for resource role scope"), "Bad API response")
+	}
+
+	d.SetId(parse.NewAccessPackageResourceRoleScopeID(accessPackageId, *roleScope.ID).String())
+
+	return accessPackageResourceRoleScopeResourceReadMsGraph(ctx, d, meta)
+}
+
+func accessPackageResourceRoleScopeResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	id, err := parse.AccessPackageResourceRoleScopeID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing resource role scope with ID %q", d.Id())
+	}
+
+	accessPackage, status, err := c.GetAccessPackage(ctx, id.AccessPackageId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Access package with ID %q was not found - removing resource role scope from state", id.AccessPackageId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving access package with ID %q", id.AccessPackageId)
+	}
+
+	roleScope := findAccessPackageResourceRoleScope(accessPackage, id.RoleScopeId)
+	if roleScope == nil {
+		log.Printf("[DEBUG] Resource role scope %q was not found in access package %q - removing from state", id.RoleScopeId, id.AccessPackageId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "access_package_id", id.AccessPackageId)
+
+	if roleScope.Scope != nil && roleScope.Scope.OriginId != nil && accessPackage.Catalog != nil && accessPackage.Catalog.ID != nil {
+		associationId := parse.NewAccessPackageResourceCatalogAssociationID(*accessPackage.Catalog.ID, *roleScope.Scope.OriginId)
+		tf.Set(d, "catalog_resource_association_id", associationId.String())
+
+		if roleScope.Role != nil && roleScope.Role.OriginId != nil {
+			tf.Set(d, "role", strings.TrimSuffix(*roleScope.Role.OriginId, fmt.Sprintf("_%s", *roleScope.Scope.OriginId)))
+		}
+	}
+
+	if roleScope.Role != nil {
+		tf.Set(d, "role_display_name", roleScope.Role.DisplayName)
+	}
+
+	return nil
+}
+
+func accessPackageResourceRoleScopeResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	id, err := parse.AccessPackageResourceRoleScopeID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing resource role scope with ID %q", d.Id())
+	}
+
+	tf.LockByName(accessPackageResourceName, id.AccessPackageId)
+	defer tf.UnlockByName(accessPackageResourceName, id.AccessPackageId)
+
+	if _, err := c.DeleteResourceRoleScope(ctx, id.AccessPackageId, id.RoleScopeId); err != nil {
+		return tf.ErrorDiagF(err, "Removing resource role scope %q from access package %q", id.RoleScopeId, id.AccessPackageId)
+	}
+
+	return nil
+}
+
+func findAccessPackageResourceRoleScope(accessPackage *client.AccessPackage, id string) *client.AccessPackageResourceRoleScope {
+	if accessPackage == nil || accessPackage.ResourceRoleScopes == nil {
+		return nil
+	}
+	for _, roleScope := range *accessPackage.ResourceRoleScopes {
+		if roleScope.ID != nil && *roleScope.ID == id {
+			return &roleScope
+		}
+	}
+	return nil
+}
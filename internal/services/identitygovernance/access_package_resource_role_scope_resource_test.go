@@ -0,0 +1,105 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AccessPackageResourceRoleScopeResource struct{}
+
+func TestAccAccessPackageResourceRoleScope_groupMember(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_resource_role_scope", "test")
+	r := AccessPackageResourceRoleScopeResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.group(data, "Member"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Member"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAccessPackageResourceRoleScope_groupOwner(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_access_package_resource_role_scope", "test")
+	r := AccessPackageResourceRoleScopeResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.group(data, "Owner"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Owner"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AccessPackageResourceRoleScopeResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.AccessPackageResourceRoleScopeID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource Role Scope ID: %v", err)
+	}
+
+	accessPackage, status, err := clients.IdentityGovernance.EntitlementManagementClient.GetAccessPackage(ctx, id.AccessPackageId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Access Package with ID %q does not exist", id.AccessPackageId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Access Package with ID %q: %+v", id.AccessPackageId, err)
+	}
+
+	if accessPackage.ResourceRoleScopes != nil {
+		for _, roleScope := range *accessPackage.ResourceRoleScopes {
+			if roleScope.ID != nil && *roleScope.ID == id.RoleScopeId {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Resource Role Scope %q was not found in Access Package with ID %q", id.RoleScopeId, id.AccessPackageId)
+}
+
+func (AccessPackageResourceRoleScopeResource) group(data acceptance.TestData, role string) string {
+	return fmt.Sprintf(`
+resource "azuread_access_package_catalog" "test" {
+  display_name = "acctest-Catalog-%[1]d"
+}
+
+resource "azuread_group" "test" {
+  display_name = "acctestGroup-%[1]d"
+}
+
+resource "azuread_access_package_resource_catalog_association" "test" {
+  catalog_id             = azuread_access_package_catalog.test.id
+  resource_origin_id     = azuread_group.test.object_id
+  resource_origin_system = "AadGroup"
+}
+
+resource "azuread_access_package" "test" {
+  catalog_id   = azuread_access_package_catalog.test.id
+  display_name = "acctest-AccessPackage-%[1]d"
+}
+
+resource "azuread_access_package_resource_role_scope" "test" {
+  access_package_id               = azuread_access_package.test.id
+  catalog_resource_association_id = azuread_access_package_resource_catalog_association.test.id
+  role                            = "%[2]s"
+}
+`, data.RandomInteger, role)
+}
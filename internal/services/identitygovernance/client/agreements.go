@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// Agreement describes a Terms of Use agreement.
+type Agreement struct {
+	ID                                *string          `json:"id,omitempty"`
+	DisplayName                       *string          `json:"displayName,omitempty"`
+	Files                             *[]AgreementFile `json:"files,omitempty"`
+	IsPerDeviceAcceptanceRequired     *bool            `json:"isPerDeviceAcceptanceRequired,omitempty"`
+	IsViewingBeforeAcceptanceRequired *bool            `json:"isViewingBeforeAcceptanceRequired,omitempty"`
+	TermsExpiration                   *TermsExpiration `json:"termsExpiration,omitempty"`
+	UserReacceptRequiredFrequency     *string          `json:"userReacceptRequiredFrequency,omitempty"`
+}
+
+// AgreementFile describes a localized version of the document for a Terms of Use agreement.
+type AgreementFile struct {
+	ID          *string            `json:"id,omitempty"`
+	DisplayName *string            `json:"displayName,omitempty"`
+	FileData    *AgreementFileData `json:"fileData,omitempty"`
+	FileName    *string            `json:"fileName,omitempty"`
+	IsDefault   *bool              `json:"isDefault,omitempty"`
+	Language    *string            `json:"language,omitempty"`
+}
+
+type AgreementFileData struct {
+	Data *string `json:"data,omitempty"`
+}
+
+type TermsExpiration struct {
+	Frequency     *string `json:"frequency,omitempty"`
+	StartDateTime *string `json:"startDateTime,omitempty"`
+}
+
+// AgreementsClient performs operations on Terms of Use agreements.
+type AgreementsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAgreementsClient returns a new AgreementsClient.
+func NewAgreementsClient(tenantId string) *AgreementsClient {
+	return &AgreementsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create creates a new Terms of Use agreement.
+func (c *AgreementsClient) Create(ctx context.Context, agreement Agreement) (*Agreement, int, error) {
+	var status int
+	body, err := json.Marshal(agreement)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/identityGovernance/termsOfUse/agreements",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AgreementsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newAgreement Agreement
+	if err := json.Unmarshal(respBody, &newAgreement); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newAgreement, status, nil
+}
+
+// Get retrieves a Terms of Use agreement, including its files.
+func (c *AgreementsClient) Get(ctx context.Context, id string) (*Agreement, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s", id),
+			Params:      url.Values{"$expand": []string{"files"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AgreementsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var agreement Agreement
+	if err := json.Unmarshal(respBody, &agreement); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &agreement, status, nil
+}
+
+// Update amends an existing Terms of Use agreement. Files cannot be changed once an agreement has been created. Any
+// properties named in nullProperties are explicitly set to null, so that values which are no longer wanted are removed.
+func (c *AgreementsClient) Update(ctx context.Context, agreement Agreement, nullProperties ...string) (int, error) {
+	var status int
+	if agreement.ID == nil {
+		return status, fmt.Errorf("cannot update agreement with nil ID")
+	}
+	id := *agreement.ID
+	agreement.ID = nil
+	agreement.Files = nil
+	body, err := json.Marshal(agreement)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	if len(nullProperties) > 0 {
+		properties := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(body, &properties); err != nil {
+			return status, fmt.Errorf("json.Unmarshal(): %v", err)
+		}
+		for _, p := range nullProperties {
+			properties[p] = json.RawMessage("null")
+		}
+		if body, err = json.Marshal(properties); err != nil {
+			return status, fmt.Errorf("json.Marshal(): %v", err)
+		}
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AgreementsClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// Delete removes a Terms of Use agreement.
+func (c *AgreementsClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AgreementsClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
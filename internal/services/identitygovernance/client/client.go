@@ -0,0 +1,28 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	AgreementsClient            *AgreementsClient
+	EntitlementManagementClient *EntitlementManagementClient
+	LifecycleWorkflowsClient    *LifecycleWorkflowsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	agreementsClient := NewAgreementsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&agreementsClient.BaseClient)
+
+	entitlementManagementClient := NewEntitlementManagementClient(o.TenantID)
+	o.ConfigureMsGraphClient(&entitlementManagementClient.BaseClient)
+
+	lifecycleWorkflowsClient := NewLifecycleWorkflowsClient(o.TenantID)
+	o.ConfigureMsGraphClient(&lifecycleWorkflowsClient.BaseClient)
+
+	return &Client{
+		AgreementsClient:            agreementsClient,
+		EntitlementManagementClient: entitlementManagementClient,
+		LifecycleWorkflowsClient:    lifecycleWorkflowsClient,
+	}
+}
@@ -0,0 +1,567 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	AccessPackageResourceOriginSystemGroup       = "AadGroup"
+	AccessPackageResourceOriginSystemApplication = "AadApplication"
+
+	AccessPackageResourceRequestTypeAdminAdd    = "adminAdd"
+	AccessPackageResourceRequestTypeAdminRemove = "adminRemove"
+
+	AccessPackageAssignmentRequestTypeAdminAdd    = "adminAdd"
+	AccessPackageAssignmentRequestTypeAdminRemove = "adminRemove"
+
+	AccessPackageCatalogStatePublished   = "published"
+	AccessPackageCatalogStateUnpublished = "unpublished"
+
+	ConnectedOrganizationStateConfigured = "configured"
+	ConnectedOrganizationStateProposed   = "proposed"
+
+	ExpirationPatternTypeAfterDateTime = "afterDateTime"
+	ExpirationPatternTypeAfterDuration = "afterDuration"
+	ExpirationPatternTypeNoExpiration  = "noExpiration"
+
+	IdentitySourceODataTypeAzureActiveDirectoryTenant = "#microsoft.graph.azureActiveDirectoryTenant"
+	IdentitySourceODataTypeDomain                     = "#microsoft.graph.domainIdentitySource"
+
+	SubjectSetODataTypeExternalSponsors = "#microsoft.graph.externalSponsors"
+	SubjectSetODataTypeGroupMembers     = "#microsoft.graph.groupMembers"
+	SubjectSetODataTypeInternalSponsors = "#microsoft.graph.internalSponsors"
+	SubjectSetODataTypeRequestorManager = "#microsoft.graph.requestorManager"
+	SubjectSetODataTypeSingleUser       = "#microsoft.graph.singleUser"
+)
+
+// AccessPackageCatalog describes a container for access packages and the resources they grant access to.
+type AccessPackageCatalog struct {
+	ID                  *string `json:"id,omitempty"`
+	CatalogType         *string `json:"catalogType,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	DisplayName         *string `json:"displayName,omitempty"`
+	IsExternallyVisible *bool   `json:"isExternallyVisible,omitempty"`
+	State               *string `json:"state,omitempty"`
+}
+
+// AccessPackage describes a bundle of resource roles which can be assigned to users.
+type AccessPackage struct {
+	ID                 *string                           `json:"id,omitempty"`
+	Catalog            *AccessPackageCatalog             `json:"catalog,omitempty"`
+	Description        *string                           `json:"description,omitempty"`
+	DisplayName        *string                           `json:"displayName,omitempty"`
+	IsHidden           *bool                             `json:"isHidden,omitempty"`
+	ResourceRoleScopes *[]AccessPackageResourceRoleScope `json:"resourceRoleScopes,omitempty"`
+}
+
+// AccessPackageAssignment describes the assignment of an access package to a subject, granting the subject the
+// resource roles of the access package.
+type AccessPackageAssignment struct {
+	ID                 *string                        `json:"id,omitempty"`
+	AccessPackage      *AccessPackage                 `json:"accessPackage,omitempty"`
+	AccessPackageId    *string                        `json:"accessPackageId,omitempty"`
+	AssignmentPolicy   *AccessPackageAssignmentPolicy `json:"assignmentPolicy,omitempty"`
+	AssignmentPolicyId *string                        `json:"assignmentPolicyId,omitempty"`
+	State              *string                        `json:"state,omitempty"`
+	Status             *string                        `json:"status,omitempty"`
+	Target             *AccessPackageSubject          `json:"target,omitempty"`
+	TargetId           *string                        `json:"targetId,omitempty"`
+}
+
+// AccessPackageAssignmentRequest describes a request to create or remove an access package assignment.
+type AccessPackageAssignmentRequest struct {
+	ID              *string                        `json:"id,omitempty"`
+	AccessPackage   *AccessPackage                 `json:"accessPackage,omitempty"`
+	Assignment      *AccessPackageAssignment       `json:"assignment,omitempty"`
+	CreatedDateTime *string                        `json:"createdDateTime,omitempty"`
+	Justification   *string                        `json:"justification,omitempty"`
+	RequestType     *string                        `json:"requestType,omitempty"`
+	Schedule        *EntitlementManagementSchedule `json:"schedule,omitempty"`
+	State           *string                        `json:"state,omitempty"`
+	Status          *string                        `json:"status,omitempty"`
+}
+
+// AccessPackageSubject describes the user or service principal to which an access package is assigned.
+type AccessPackageSubject struct {
+	ID            *string `json:"id,omitempty"`
+	DisplayName   *string `json:"displayName,omitempty"`
+	Email         *string `json:"email,omitempty"`
+	ObjectId      *string `json:"objectId,omitempty"`
+	PrincipalName *string `json:"principalName,omitempty"`
+	SubjectType   *string `json:"subjectType,omitempty"`
+}
+
+// AccessPackageResource describes a resource, such as a group or an application, which has been added to a catalog.
+type AccessPackageResource struct {
+	ID           *string `json:"id,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	DisplayName  *string `json:"displayName,omitempty"`
+	OriginId     *string `json:"originId,omitempty"`
+	OriginSystem *string `json:"originSystem,omitempty"`
+}
+
+// AccessPackageResourceRequest describes a request to add or remove a resource to or from a catalog.
+type AccessPackageResourceRequest struct {
+	ID          *string                `json:"id,omitempty"`
+	Catalog     *AccessPackageCatalog  `json:"catalog,omitempty"`
+	RequestType *string                `json:"requestType,omitempty"`
+	Resource    *AccessPackageResource `json:"resource,omitempty"`
+	State       *string                `json:"state,omitempty"`
+}
+
+type AccessPackageResourceRole struct {
+	ID           *string                `json:"id,omitempty"`
+	DisplayName  *string                `json:"displayName,omitempty"`
+	OriginId     *string                `json:"originId,omitempty"`
+	OriginSystem *string                `json:"originSystem,omitempty"`
+	Resource     *AccessPackageResource `json:"resource,omitempty"`
+}
+
+type AccessPackageResourceScope struct {
+	ID           *string `json:"id,omitempty"`
+	OriginId     *string `json:"originId,omitempty"`
+	OriginSystem *string `json:"originSystem,omitempty"`
+}
+
+// AccessPackageResourceRoleScope describes a role of a catalog resource which is granted by an access package.
+type AccessPackageResourceRoleScope struct {
+	ID    *string                     `json:"id,omitempty"`
+	Role  *AccessPackageResourceRole  `json:"role,omitempty"`
+	Scope *AccessPackageResourceScope `json:"scope,omitempty"`
+}
+
+// AccessPackageAssignmentPolicy describes who can request an access package, and how those requests are approved and reviewed.
+type AccessPackageAssignmentPolicy struct {
+	ID                      *string                                   `json:"id,omitempty"`
+	AccessPackage           *AccessPackage                            `json:"accessPackage,omitempty"`
+	AllowedTargetScope      *string                                   `json:"allowedTargetScope,omitempty"`
+	Description             *string                                   `json:"description,omitempty"`
+	DisplayName             *string                                   `json:"displayName,omitempty"`
+	Expiration              *ExpirationPattern                        `json:"expiration,omitempty"`
+	RequestApprovalSettings *AccessPackageAssignmentApprovalSettings  `json:"requestApprovalSettings,omitempty"`
+	RequestorSettings       *AccessPackageAssignmentRequestorSettings `json:"requestorSettings,omitempty"`
+	ReviewSettings          *AccessPackageAssignmentReviewSettings    `json:"reviewSettings,omitempty"`
+	SpecificAllowedTargets  *[]SubjectSet                             `json:"specificAllowedTargets,omitempty"`
+}
+
+type AccessPackageAssignmentApprovalSettings struct {
+	IsApprovalRequiredForAdd    *bool                         `json:"isApprovalRequiredForAdd,omitempty"`
+	IsApprovalRequiredForUpdate *bool                         `json:"isApprovalRequiredForUpdate,omitempty"`
+	Stages                      *[]AccessPackageApprovalStage `json:"stages,omitempty"`
+}
+
+type AccessPackageApprovalStage struct {
+	DurationBeforeAutomaticDenial   *string       `json:"durationBeforeAutomaticDenial,omitempty"`
+	DurationBeforeEscalation        *string       `json:"durationBeforeEscalation,omitempty"`
+	EscalationApprovers             *[]SubjectSet `json:"escalationApprovers,omitempty"`
+	FallbackEscalationApprovers     *[]SubjectSet `json:"fallbackEscalationApprovers,omitempty"`
+	FallbackPrimaryApprovers        *[]SubjectSet `json:"fallbackPrimaryApprovers,omitempty"`
+	IsApproverJustificationRequired *bool         `json:"isApproverJustificationRequired,omitempty"`
+	IsEscalationEnabled             *bool         `json:"isEscalationEnabled,omitempty"`
+	PrimaryApprovers                *[]SubjectSet `json:"primaryApprovers,omitempty"`
+}
+
+type AccessPackageAssignmentRequestorSettings struct {
+	AllowCustomAssignmentSchedule          *bool         `json:"allowCustomAssignmentSchedule,omitempty"`
+	EnableOnBehalfRequestorsToAddAccess    *bool         `json:"enableOnBehalfRequestorsToAddAccess,omitempty"`
+	EnableOnBehalfRequestorsToRemoveAccess *bool         `json:"enableOnBehalfRequestorsToRemoveAccess,omitempty"`
+	EnableOnBehalfRequestorsToUpdateAccess *bool         `json:"enableOnBehalfRequestorsToUpdateAccess,omitempty"`
+	EnableTargetsToSelfAddAccess           *bool         `json:"enableTargetsToSelfAddAccess,omitempty"`
+	EnableTargetsToSelfRemoveAccess        *bool         `json:"enableTargetsToSelfRemoveAccess,omitempty"`
+	EnableTargetsToSelfUpdateAccess        *bool         `json:"enableTargetsToSelfUpdateAccess,omitempty"`
+	OnBehalfRequestors                     *[]SubjectSet `json:"onBehalfRequestors,omitempty"`
+}
+
+type AccessPackageAssignmentReviewSettings struct {
+	ExpirationBehavior              *string                        `json:"expirationBehavior,omitempty"`
+	FallbackReviewers               *[]SubjectSet                  `json:"fallbackReviewers,omitempty"`
+	IsEnabled                       *bool                          `json:"isEnabled,omitempty"`
+	IsRecommendationEnabled         *bool                          `json:"isRecommendationEnabled,omitempty"`
+	IsReviewerJustificationRequired *bool                          `json:"isReviewerJustificationRequired,omitempty"`
+	IsSelfReview                    *bool                          `json:"isSelfReview,omitempty"`
+	PrimaryReviewers                *[]SubjectSet                  `json:"primaryReviewers,omitempty"`
+	Schedule                        *EntitlementManagementSchedule `json:"schedule,omitempty"`
+}
+
+// ConnectedOrganization describes an external organization whose users can request access packages.
+type ConnectedOrganization struct {
+	ID              *string           `json:"id,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	DisplayName     *string           `json:"displayName,omitempty"`
+	IdentitySources *[]IdentitySource `json:"identitySources,omitempty"`
+	State           *string           `json:"state,omitempty"`
+}
+
+// IdentitySource identifies a connected organization, either by its Azure Active Directory tenant or by its domain.
+type IdentitySource struct {
+	ODataType   *string `json:"@odata.type,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	DomainName  *string `json:"domainName,omitempty"`
+	TenantId    *string `json:"tenantId,omitempty"`
+}
+
+type EntitlementManagementSchedule struct {
+	Expiration    *ExpirationPattern   `json:"expiration,omitempty"`
+	Recurrence    *PatternedRecurrence `json:"recurrence,omitempty"`
+	StartDateTime *string              `json:"startDateTime,omitempty"`
+}
+
+type ExpirationPattern struct {
+	Duration    *string `json:"duration,omitempty"`
+	EndDateTime *string `json:"endDateTime,omitempty"`
+	Type        *string `json:"type,omitempty"`
+}
+
+type PatternedRecurrence struct {
+	Pattern *RecurrencePattern `json:"pattern,omitempty"`
+	Range   *RecurrenceRange   `json:"range,omitempty"`
+}
+
+type RecurrencePattern struct {
+	Interval *int32  `json:"interval,omitempty"`
+	Type     *string `json:"type,omitempty"`
+}
+
+type RecurrenceRange struct {
+	StartDate *string `json:"startDate,omitempty"`
+	Type      *string `json:"type,omitempty"`
+}
+
+// SubjectSet describes a set of users, such as a single user, the members of a group or the manager of a requestor.
+type SubjectSet struct {
+	ODataType    *string `json:"@odata.type,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	GroupId      *string `json:"groupId,omitempty"`
+	ManagerLevel *int32  `json:"managerLevel,omitempty"`
+	UserId       *string `json:"userId,omitempty"`
+}
+
+// EntitlementManagementClient performs operations on catalogs, access packages and their assignment policies.
+type EntitlementManagementClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewEntitlementManagementClient returns a new EntitlementManagementClient.
+func NewEntitlementManagementClient(tenantId string) *EntitlementManagementClient {
+	return &EntitlementManagementClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// CreateCatalog creates a new catalog.
+func (c *EntitlementManagementClient) CreateCatalog(ctx context.Context, catalog AccessPackageCatalog) (*AccessPackageCatalog, int, error) {
+	var newCatalog AccessPackageCatalog
+	status, err := c.post(ctx, "/identityGovernance/entitlementManagement/catalogs", catalog, &newCatalog)
+	if err != nil {
+		return nil, status, err
+	}
+	return &newCatalog, status, nil
+}
+
+// GetCatalog retrieves a catalog.
+func (c *EntitlementManagementClient) GetCatalog(ctx context.Context, id string) (*AccessPackageCatalog, int, error) {
+	var catalog AccessPackageCatalog
+	status, err := c.get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/catalogs/%s", id), nil, &catalog)
+	if err != nil {
+		return nil, status, err
+	}
+	return &catalog, status, nil
+}
+
+// UpdateCatalog amends an existing catalog.
+func (c *EntitlementManagementClient) UpdateCatalog(ctx context.Context, catalog AccessPackageCatalog) (int, error) {
+	var status int
+	if catalog.ID == nil {
+		return status, fmt.Errorf("cannot update catalog with nil ID")
+	}
+	id := *catalog.ID
+	catalog.ID = nil
+	return c.patch(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/catalogs/%s", id), catalog)
+}
+
+// DeleteCatalog removes a catalog.
+func (c *EntitlementManagementClient) DeleteCatalog(ctx context.Context, id string) (int, error) {
+	return c.delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/catalogs/%s", id))
+}
+
+// ListCatalogResources returns a list of resources which have been added to a catalog, optionally filtered using OData.
+func (c *EntitlementManagementClient) ListCatalogResources(ctx context.Context, catalogId, filter string) (*[]AccessPackageResource, int, error) {
+	params := url.Values{}
+	if filter != "" {
+		params.Add("$filter", filter)
+	}
+	var data struct {
+		Resources []AccessPackageResource `json:"value"`
+	}
+	status, err := c.get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/catalogs/%s/resources", catalogId), params, &data)
+	if err != nil {
+		return nil, status, err
+	}
+	return &data.Resources, status, nil
+}
+
+// CreateResourceRequest requests that a resource be added to, or removed from, a catalog.
+func (c *EntitlementManagementClient) CreateResourceRequest(ctx context.Context, request AccessPackageResourceRequest) (*AccessPackageResourceRequest, int, error) {
+	var newRequest AccessPackageResourceRequest
+	status, err := c.post(ctx, "/identityGovernance/entitlementManagement/resourceRequests", request, &newRequest)
+	if err != nil {
+		return nil, status, err
+	}
+	return &newRequest, status, nil
+}
+
+// CreateAccessPackage creates a new access package.
+func (c *EntitlementManagementClient) CreateAccessPackage(ctx context.Context, accessPackage AccessPackage) (*AccessPackage, int, error) {
+	var newAccessPackage AccessPackage
+	status, err := c.post(ctx, "/identityGovernance/entitlementManagement/accessPackages", accessPackage, &newAccessPackage)
+	if err != nil {
+		return nil, status, err
+	}
+	return &newAccessPackage, status, nil
+}
+
+// GetAccessPackage retrieves an access package, including its catalog and resource role scopes.
+func (c *EntitlementManagementClient) GetAccessPackage(ctx context.Context, id string) (*AccessPackage, int, error) {
+	params := url.Values{"$expand": []string{"catalog,resourceRoleScopes($expand=role,scope)"}}
+	var accessPackage AccessPackage
+	status, err := c.get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s", id), params, &accessPackage)
+	if err != nil {
+		return nil, status, err
+	}
+	return &accessPackage, status, nil
+}
+
+// UpdateAccessPackage amends an existing access package.
+func (c *EntitlementManagementClient) UpdateAccessPackage(ctx context.Context, accessPackage AccessPackage) (int, error) {
+	var status int
+	if accessPackage.ID == nil {
+		return status, fmt.Errorf("cannot update access package with nil ID")
+	}
+	id := *accessPackage.ID
+	accessPackage.ID = nil
+	accessPackage.Catalog = nil
+	accessPackage.ResourceRoleScopes = nil
+	return c.patch(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s", id), accessPackage)
+}
+
+// DeleteAccessPackage removes an access package.
+func (c *EntitlementManagementClient) DeleteAccessPackage(ctx context.Context, id string) (int, error) {
+	return c.delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s", id))
+}
+
+// CreateResourceRoleScope adds a resource role to an access package.
+func (c *EntitlementManagementClient) CreateResourceRoleScope(ctx context.Context, accessPackageId string, roleScope AccessPackageResourceRoleScope) (*AccessPackageResourceRoleScope, int, error) {
+	var newRoleScope AccessPackageResourceRoleScope
+	status, err := c.post(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s/resourceRoleScopes", accessPackageId), roleScope, &newRoleScope)
+	if err != nil {
+		return nil, status, err
+	}
+	return &newRoleScope, status, nil
+}
+
+// DeleteResourceRoleScope removes a resource role from an access package.
+func (c *EntitlementManagementClient) DeleteResourceRoleScope(ctx context.Context, accessPackageId, id string) (int, error) {
+	return c.delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/accessPackages/%s/resourceRoleScopes/%s", accessPackageId, id))
+}
+
+// CreateAssignmentPolicy creates a new assignment policy for an access package.
+func (c *EntitlementManagementClient) CreateAssignmentPolicy(ctx context.Context, policy AccessPackageAssignmentPolicy) (*AccessPackageAssignmentPolicy, int, error) {
+	var newPolicy AccessPackageAssignmentPolicy
+	status, err := c.post(ctx, "/identityGovernance/entitlementManagement/assignmentPolicies", policy, &newPolicy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &newPolicy, status, nil
+}
+
+// GetAssignmentPolicy retrieves an assignment policy, including the access package it belongs to.
+func (c *EntitlementManagementClient) GetAssignmentPolicy(ctx context.Context, id string) (*AccessPackageAssignmentPolicy, int, error) {
+	params := url.Values{"$expand": []string{"accessPackage"}}
+	var policy AccessPackageAssignmentPolicy
+	status, err := c.get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/assignmentPolicies/%s", id), params, &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+// UpdateAssignmentPolicy replaces an existing assignment policy. All properties must be specified, since omitted
+// properties are reset to their defaults.
+func (c *EntitlementManagementClient) UpdateAssignmentPolicy(ctx context.Context, policy AccessPackageAssignmentPolicy) (int, error) {
+	var status int
+	if policy.ID == nil {
+		return status, fmt.Errorf("cannot update assignment policy with nil ID")
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Put(ctx, msgraph.PutHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/entitlementManagement/assignmentPolicies/%s", *policy.ID),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("EntitlementManagementClient.BaseClient.Put(): %v", err)
+	}
+	return status, nil
+}
+
+// DeleteAssignmentPolicy removes an assignment policy.
+func (c *EntitlementManagementClient) DeleteAssignmentPolicy(ctx context.Context, id string) (int, error) {
+	return c.delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/assignmentPolicies/%s", id))
+}
+
+// CreateConnectedOrganization creates a new connected organization.
+func (c *EntitlementManagementClient) CreateConnectedOrganization(ctx context.Context, org ConnectedOrganization) (*ConnectedOrganization, int, error) {
+	var newOrg ConnectedOrganization
+	status, err := c.post(ctx, "/identityGovernance/entitlementManagement/connectedOrganizations", org, &newOrg)
+	if err != nil {
+		return nil, status, err
+	}
+	return &newOrg, status, nil
+}
+
+// GetConnectedOrganization retrieves a connected organization.
+func (c *EntitlementManagementClient) GetConnectedOrganization(ctx context.Context, id string) (*ConnectedOrganization, int, error) {
+	var org ConnectedOrganization
+	status, err := c.get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/connectedOrganizations/%s", id), nil, &org)
+	if err != nil {
+		return nil, status, err
+	}
+	return &org, status, nil
+}
+
+// UpdateConnectedOrganization amends an existing connected organization. The identity sources of a connected
+// organization cannot be changed.
+func (c *EntitlementManagementClient) UpdateConnectedOrganization(ctx context.Context, org ConnectedOrganization) (int, error) {
+	var status int
+	if org.ID == nil {
+		return status, fmt.Errorf("cannot update connected organization with nil ID")
+	}
+	id := *org.ID
+	org.ID = nil
+	org.IdentitySources = nil
+	return c.patch(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/connectedOrganizations/%s", id), org)
+}
+
+// DeleteConnectedOrganization removes a connected organization.
+func (c *EntitlementManagementClient) DeleteConnectedOrganization(ctx context.Context, id string) (int, error) {
+	return c.delete(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/connectedOrganizations/%s", id))
+}
+
+// CreateAssignmentRequest requests that an access package be assigned to, or removed from, a subject.
+func (c *EntitlementManagementClient) CreateAssignmentRequest(ctx context.Context, request AccessPackageAssignmentRequest) (*AccessPackageAssignmentRequest, int, error) {
+	var newRequest AccessPackageAssignmentRequest
+	status, err := c.post(ctx, "/identityGovernance/entitlementManagement/assignmentRequests", request, &newRequest)
+	if err != nil {
+		return nil, status, err
+	}
+	return &newRequest, status, nil
+}
+
+// GetAssignmentRequest retrieves an assignment request, including the access package and the resulting assignment.
+func (c *EntitlementManagementClient) GetAssignmentRequest(ctx context.Context, id string) (*AccessPackageAssignmentRequest, int, error) {
+	params := url.Values{"$expand": []string{"accessPackage,assignment($expand=assignmentPolicy,target)"}}
+	var request AccessPackageAssignmentRequest
+	status, err := c.get(ctx, fmt.Sprintf("/identityGovernance/entitlementManagement/assignmentRequests/%s", id), params, &request)
+	if err != nil {
+		return nil, status, err
+	}
+	return &request, status, nil
+}
+
+func (c *EntitlementManagementClient) get(ctx context.Context, entity string, params url.Values, out interface{}) (int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			Params:      params,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("EntitlementManagementClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return status, nil
+}
+
+func (c *EntitlementManagementClient) post(ctx context.Context, entity string, in interface{}, out interface{}) (int, error) {
+	var status int
+	body, err := json.Marshal(in)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("EntitlementManagementClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return status, nil
+}
+
+func (c *EntitlementManagementClient) patch(ctx context.Context, entity string, in interface{}) (int, error) {
+	var status int
+	body, err := json.Marshal(in)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("EntitlementManagementClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+func (c *EntitlementManagementClient) delete(ctx context.Context, entity string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("EntitlementManagementClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
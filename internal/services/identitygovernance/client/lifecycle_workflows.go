@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	LifecycleWorkflowExecutionConditionsODataType = "#microsoft.graph.identityGovernance.triggerAndScopeBasedConditions"
+	LifecycleWorkflowScopeODataType               = "#microsoft.graph.identityGovernance.ruleBasedSubjectSet"
+	LifecycleWorkflowTriggerODataType             = "#microsoft.graph.identityGovernance.timeBasedAttributeTrigger"
+)
+
+// LifecycleWorkflow describes a joiner, mover or leaver workflow which runs a sequence of tasks for users in scope
+// when the workflow is triggered.
+type LifecycleWorkflow struct {
+	ID                  *string                               `json:"id,omitempty"`
+	Category            *string                               `json:"category,omitempty"`
+	Description         *string                               `json:"description,omitempty"`
+	DisplayName         *string                               `json:"displayName,omitempty"`
+	ExecutionConditions *LifecycleWorkflowExecutionConditions `json:"executionConditions,omitempty"`
+	IsEnabled           *bool                                 `json:"isEnabled,omitempty"`
+	IsSchedulingEnabled *bool                                 `json:"isSchedulingEnabled,omitempty"`
+	Tasks               *[]LifecycleWorkflowTask              `json:"tasks,omitempty"`
+	Version             *int                                  `json:"version,omitempty"`
+}
+
+type LifecycleWorkflowExecutionConditions struct {
+	ODataType *string                   `json:"@odata.type,omitempty"`
+	Scope     *LifecycleWorkflowScope   `json:"scope,omitempty"`
+	Trigger   *LifecycleWorkflowTrigger `json:"trigger,omitempty"`
+}
+
+type LifecycleWorkflowScope struct {
+	ODataType *string `json:"@odata.type,omitempty"`
+	Rule      *string `json:"rule,omitempty"`
+}
+
+type LifecycleWorkflowTrigger struct {
+	ODataType          *string `json:"@odata.type,omitempty"`
+	OffsetInDays       *int    `json:"offsetInDays,omitempty"`
+	TimeBasedAttribute *string `json:"timeBasedAttribute,omitempty"`
+}
+
+type LifecycleWorkflowTask struct {
+	ID                *string                          `json:"id,omitempty"`
+	Arguments         *[]LifecycleWorkflowTaskArgument `json:"arguments,omitempty"`
+	ContinueOnError   *bool                            `json:"continueOnError,omitempty"`
+	Description       *string                          `json:"description,omitempty"`
+	DisplayName       *string                          `json:"displayName,omitempty"`
+	ExecutionSequence *int                             `json:"executionSequence,omitempty"`
+	IsEnabled         *bool                            `json:"isEnabled,omitempty"`
+	TaskDefinitionId  *string                          `json:"taskDefinitionId,omitempty"`
+}
+
+type LifecycleWorkflowTaskArgument struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// LifecycleWorkflowsClient performs operations on Lifecycle Workflows.
+type LifecycleWorkflowsClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewLifecycleWorkflowsClient returns a new LifecycleWorkflowsClient.
+func NewLifecycleWorkflowsClient(tenantId string) *LifecycleWorkflowsClient {
+	return &LifecycleWorkflowsClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create creates a new Lifecycle Workflow, including its tasks.
+func (c *LifecycleWorkflowsClient) Create(ctx context.Context, workflow LifecycleWorkflow) (*LifecycleWorkflow, int, error) {
+	var status int
+	body, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/identityGovernance/lifecycleWorkflows/workflows",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("LifecycleWorkflowsClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newWorkflow LifecycleWorkflow
+	if err := json.Unmarshal(respBody, &newWorkflow); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newWorkflow, status, nil
+}
+
+// Get retrieves a Lifecycle Workflow, including its tasks.
+func (c *LifecycleWorkflowsClient) Get(ctx context.Context, id string) (*LifecycleWorkflow, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/lifecycleWorkflows/workflows/%s", id),
+			Params:      url.Values{"$expand": []string{"tasks"}},
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("LifecycleWorkflowsClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var workflow LifecycleWorkflow
+	if err := json.Unmarshal(respBody, &workflow); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &workflow, status, nil
+}
+
+// Update amends the display name, description and enabled state of an existing Lifecycle Workflow. The execution
+// conditions and tasks cannot be changed with this method, use CreateNewVersion instead.
+func (c *LifecycleWorkflowsClient) Update(ctx context.Context, workflow LifecycleWorkflow) (int, error) {
+	var status int
+	if workflow.ID == nil {
+		return status, fmt.Errorf("cannot update lifecycle workflow with nil ID")
+	}
+	body, err := json.Marshal(LifecycleWorkflow{
+		Description:         workflow.Description,
+		DisplayName:         workflow.DisplayName,
+		IsEnabled:           workflow.IsEnabled,
+		IsSchedulingEnabled: workflow.IsSchedulingEnabled,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/lifecycleWorkflows/workflows/%s", *workflow.ID),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("LifecycleWorkflowsClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// CreateNewVersion replaces a Lifecycle Workflow with a new version, which is required to change its execution
+// conditions or tasks.
+func (c *LifecycleWorkflowsClient) CreateNewVersion(ctx context.Context, workflow LifecycleWorkflow) (int, error) {
+	var status int
+	if workflow.ID == nil {
+		return status, fmt.Errorf("cannot create new version of lifecycle workflow with nil ID")
+	}
+	id := *workflow.ID
+	workflow.ID = nil
+	workflow.Version = nil
+	body, err := json.Marshal(struct {
+		Workflow LifecycleWorkflow `json:"workflow"`
+	}{
+		Workflow: workflow,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/lifecycleWorkflows/workflows/%s/createNewVersion", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("LifecycleWorkflowsClient.BaseClient.Post(): %v", err)
+	}
+	return status, nil
+}
+
+// Delete removes a Lifecycle Workflow. Deleted workflows are retained as deleted items for a period before they are
+// permanently deleted.
+func (c *LifecycleWorkflowsClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/identityGovernance/lifecycleWorkflows/workflows/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("LifecycleWorkflowsClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
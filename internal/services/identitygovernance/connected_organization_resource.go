@@ -0,0 +1,109 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const connectedOrganizationResourceName = "azuread_connected_organization"
+
+func connectedOrganizationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: connectedOrganizationResourceCreate,
+		ReadContext:   connectedOrganizationResourceRead,
+		UpdateContext: connectedOrganizationResourceUpdate,
+		DeleteContext: connectedOrganizationResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"domain_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"domain_name", "tenant_id"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"tenant_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"domain_name", "tenant_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  client.ConnectedOrganizationStateConfigured,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.ConnectedOrganizationStateConfigured,
+					client.ConnectedOrganizationStateProposed,
+				}, false),
+			},
+		},
+	}
+}
+
+func connectedOrganizationResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(connectedOrganizationResourceName); diags != nil {
+		return diags
+	}
+	return connectedOrganizationResourceCreateMsGraph(ctx, d, meta)
+}
+
+func connectedOrganizationResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(connectedOrganizationResourceName); diags != nil {
+		return diags
+	}
+	return connectedOrganizationResourceReadMsGraph(ctx, d, meta)
+}
+
+func connectedOrganizationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(connectedOrganizationResourceName); diags != nil {
+		return diags
+	}
+	return connectedOrganizationResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func connectedOrganizationResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(connectedOrganizationResourceName); diags != nil {
+		return diags
+	}
+	return connectedOrganizationResourceDeleteMsGraph(ctx, d, meta)
+}
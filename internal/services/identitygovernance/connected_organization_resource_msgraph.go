@@ -0,0 +1,135 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func connectedOrganizationResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+	displayName := d.Get("display_name").(string)
+
+	identitySource := client.IdentitySource{
+		DisplayName: utils.String(displayName),
+	}
+	if v, ok := d.GetOk("tenant_id"); ok {
+		identitySource.ODataType = utils.String(client.IdentitySourceODataTypeAzureActiveDirectoryTenant)
+		identitySource.TenantId = utils.String(v.(string))
+	} else {
+		identitySource.ODataType = utils.String(client.IdentitySourceODataTypeDomain)
+		identitySource.DomainName = utils.String(d.Get("domain_name").(string))
+	}
+
+	properties := expandConnectedOrganization(d)
+	properties.IdentitySources = &[]client.IdentitySource{identitySource}
+
+	org, _, err := c.CreateConnectedOrganization(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating connected organization %q", displayName)
+	}
+	if org.ID == nil || *org.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for connected organization %q", displayName), "Bad API response")
+	}
+
+	d.SetId(*org.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.GetConnectedOrganization(ctx, *org.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for connected organization with ID %q", *org.ID)
+	}
+
+	return connectedOrganizationResourceReadMsGraph(ctx, d, meta)
+}
+
+func connectedOrganizationResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	org, status, err := c.GetConnectedOrganization(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Connected organization with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving connected organization with ID %q", d.Id())
+	}
+
+	tf.Set(d, "description", org.Description)
+	tf.Set(d, "display_name", org.DisplayName)
+	tf.Set(d, "state", org.State)
+
+	domainName, tenantId := "", ""
+	if org.IdentitySources != nil {
+		for _, source := range *org.IdentitySources {
+			if source.ODataType == nil {
+				continue
+			}
+			switch *source.ODataType {
+			case client.IdentitySourceODataTypeAzureActiveDirectoryTenant:
+				if source.TenantId != nil {
+					tenantId = *source.TenantId
+				}
+			case client.IdentitySourceODataTypeDomain:
+				if source.DomainName != nil {
+					domainName = *source.DomainName
+				}
+			}
+		}
+	}
+	tf.Set(d, "domain_name", domainName)
+	tf.Set(d, "tenant_id", tenantId)
+
+	return nil
+}
+
+func connectedOrganizationResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	properties := expandConnectedOrganization(d)
+	properties.ID = utils.String(d.Id())
+
+	if _, err := c.UpdateConnectedOrganization(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating connected organization with ID %q", d.Id())
+	}
+
+	return connectedOrganizationResourceReadMsGraph(ctx, d, meta)
+}
+
+func connectedOrganizationResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.EntitlementManagementClient
+
+	_, status, err := c.GetConnectedOrganization(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Connected organization was not found"), "id", "Retrieving connected organization with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving connected organization with ID %q", d.Id())
+	}
+
+	if _, err := c.DeleteConnectedOrganization(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting connected organization with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandConnectedOrganization(d *schema.ResourceData) client.ConnectedOrganization {
+	return client.ConnectedOrganization{
+		Description: utils.String(d.Get("description").(string)),
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		State:       utils.String(d.Get("state").(string)),
+	}
+}
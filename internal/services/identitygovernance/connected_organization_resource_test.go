@@ -0,0 +1,122 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ConnectedOrganizationResource struct{}
+
+func TestAccConnectedOrganization_domain(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.domain(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("configured"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConnectedOrganization_tenant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.tenant(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tenant_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccConnectedOrganization_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_connected_organization", "test")
+	r := ConnectedOrganizationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.domain(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.domainUpdated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("proposed"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.domain(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ConnectedOrganizationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	org, status, err := clients.IdentityGovernance.EntitlementManagementClient.GetConnectedOrganization(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Connected Organization with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Connected Organization with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(org.ID != nil && *org.ID == state.ID), nil
+}
+
+func (ConnectedOrganizationResource) domain(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_connected_organization" "test" {
+  display_name = "acctest-ConnectedOrg-%[1]d"
+  domain_name  = "acctest%[1]d.example.com"
+}
+`, data.RandomInteger)
+}
+
+func (ConnectedOrganizationResource) domainUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_connected_organization" "test" {
+  display_name = "acctest-ConnectedOrg-updated-%[1]d"
+  description  = "Acceptance test connected organization"
+  domain_name  = "acctest%[1]d.example.com"
+  state        = "proposed"
+}
+`, data.RandomInteger)
+}
+
+// The Microsoft Services tenant is used, since it is guaranteed to exist and is distinct from the test tenant
+func (ConnectedOrganizationResource) tenant(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_connected_organization" "test" {
+  display_name = "acctest-ConnectedOrg-%[1]d"
+  tenant_id    = "f8cdef31-a31e-4b4a-93e4-5f571e91255a"
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,194 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const lifecycleWorkflowResourceName = "azuread_lifecycle_workflow"
+
+const (
+	lifecycleWorkflowCategoryJoiner = "joiner"
+	lifecycleWorkflowCategoryLeaver = "leaver"
+	lifecycleWorkflowCategoryMover  = "mover"
+)
+
+func lifecycleWorkflowResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: lifecycleWorkflowResourceCreate,
+		ReadContext:   lifecycleWorkflowResourceRead,
+		UpdateContext: lifecycleWorkflowResourceUpdate,
+		DeleteContext: lifecycleWorkflowResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					lifecycleWorkflowCategoryJoiner,
+					lifecycleWorkflowCategoryLeaver,
+					lifecycleWorkflowCategoryMover,
+				}, false),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"scheduling_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"scope_rule": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"trigger": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_based_attribute": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"createdDateTime",
+								"employeeHireDate",
+								"employeeLeaveDateTime",
+							}, false),
+						},
+
+						"offset_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(-180, 180),
+						},
+					},
+				},
+			},
+
+			"task": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_definition_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.UUID,
+						},
+
+						"display_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"arguments": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"continue_on_error": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func lifecycleWorkflowResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(lifecycleWorkflowResourceName); diags != nil {
+		return diags
+	}
+	return lifecycleWorkflowResourceCreateMsGraph(ctx, d, meta)
+}
+
+func lifecycleWorkflowResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(lifecycleWorkflowResourceName); diags != nil {
+		return diags
+	}
+	return lifecycleWorkflowResourceReadMsGraph(ctx, d, meta)
+}
+
+func lifecycleWorkflowResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(lifecycleWorkflowResourceName); diags != nil {
+		return diags
+	}
+	return lifecycleWorkflowResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func lifecycleWorkflowResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(lifecycleWorkflowResourceName); diags != nil {
+		return diags
+	}
+	return lifecycleWorkflowResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,268 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func lifecycleWorkflowResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowsClient
+	displayName := d.Get("display_name").(string)
+
+	properties := expandLifecycleWorkflow(d)
+	properties.Category = utils.String(d.Get("category").(string))
+
+	workflow, _, err := c.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating lifecycle workflow %q", displayName)
+	}
+	if workflow.ID == nil || *workflow.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for lifecycle workflow %q", displayName), "Bad API response")
+	}
+
+	d.SetId(*workflow.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, *workflow.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for lifecycle workflow with ID %q", *workflow.ID)
+	}
+
+	return lifecycleWorkflowResourceReadMsGraph(ctx, d, meta)
+}
+
+func lifecycleWorkflowResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowsClient
+
+	workflow, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Lifecycle workflow with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving lifecycle workflow with ID %q", d.Id())
+	}
+
+	tf.Set(d, "category", workflow.Category)
+	tf.Set(d, "description", workflow.Description)
+	tf.Set(d, "display_name", workflow.DisplayName)
+	tf.Set(d, "enabled", workflow.IsEnabled)
+	tf.Set(d, "scheduling_enabled", workflow.IsSchedulingEnabled)
+	tf.Set(d, "task", flattenLifecycleWorkflowTasks(workflow.Tasks))
+
+	scopeRule := ""
+	var trigger []interface{}
+	if conditions := workflow.ExecutionConditions; conditions != nil {
+		if conditions.Scope != nil && conditions.Scope.Rule != nil {
+			scopeRule = *conditions.Scope.Rule
+		}
+		trigger = flattenLifecycleWorkflowTrigger(conditions.Trigger)
+	}
+	tf.Set(d, "scope_rule", scopeRule)
+	tf.Set(d, "trigger", trigger)
+
+	version := 0
+	if workflow.Version != nil {
+		version = *workflow.Version
+	}
+	tf.Set(d, "version", version)
+
+	return nil
+}
+
+func lifecycleWorkflowResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowsClient
+
+	properties := expandLifecycleWorkflow(d)
+	properties.ID = utils.String(d.Id())
+
+	// Execution conditions and tasks can only be changed by creating a new version of the workflow, which also
+	// carries over the remaining properties
+	if d.HasChanges("scope_rule", "task", "trigger") {
+		properties.Category = utils.String(d.Get("category").(string))
+		if _, err := c.CreateNewVersion(ctx, properties); err != nil {
+			return tf.ErrorDiagF(err, "Creating new version of lifecycle workflow with ID %q", d.Id())
+		}
+	} else if _, err := c.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating lifecycle workflow with ID %q", d.Id())
+	}
+
+	return lifecycleWorkflowResourceReadMsGraph(ctx, d, meta)
+}
+
+func lifecycleWorkflowResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.LifecycleWorkflowsClient
+
+	_, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Lifecycle workflow was not found"), "id", "Retrieving lifecycle workflow with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving lifecycle workflow with ID %q", d.Id())
+	}
+
+	if _, err := c.Delete(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting lifecycle workflow with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandLifecycleWorkflow(d *schema.ResourceData) client.LifecycleWorkflow {
+	workflow := client.LifecycleWorkflow{
+		Description:         utils.String(d.Get("description").(string)),
+		DisplayName:         utils.String(d.Get("display_name").(string)),
+		IsEnabled:           utils.Bool(d.Get("enabled").(bool)),
+		IsSchedulingEnabled: utils.Bool(d.Get("scheduling_enabled").(bool)),
+		ExecutionConditions: &client.LifecycleWorkflowExecutionConditions{
+			ODataType: utils.String(client.LifecycleWorkflowExecutionConditionsODataType),
+			Scope: &client.LifecycleWorkflowScope{
+				ODataType: utils.String(client.LifecycleWorkflowScopeODataType),
+				Rule:      utils.String(d.Get("scope_rule").(string)),
+			},
+		},
+		Tasks: expandLifecycleWorkflowTasks(d.Get("task").([]interface{})),
+	}
+
+	if v := d.Get("trigger").([]interface{}); len(v) > 0 && v[0] != nil {
+		t := v[0].(map[string]interface{})
+		offsetInDays := t["offset_in_days"].(int)
+		workflow.ExecutionConditions.Trigger = &client.LifecycleWorkflowTrigger{
+			ODataType:          utils.String(client.LifecycleWorkflowTriggerODataType),
+			OffsetInDays:       &offsetInDays,
+			TimeBasedAttribute: utils.String(t["time_based_attribute"].(string)),
+		}
+	}
+
+	return workflow
+}
+
+func expandLifecycleWorkflowTasks(in []interface{}) *[]client.LifecycleWorkflowTask {
+	result := make([]client.LifecycleWorkflowTask, 0)
+
+	for i, raw := range in {
+		if raw == nil {
+			continue
+		}
+		t := raw.(map[string]interface{})
+
+		arguments := make([]client.LifecycleWorkflowTaskArgument, 0)
+		for name, value := range t["arguments"].(map[string]interface{}) {
+			arguments = append(arguments, client.LifecycleWorkflowTaskArgument{
+				Name:  utils.String(name),
+				Value: utils.String(value.(string)),
+			})
+		}
+		sort.Slice(arguments, func(i, j int) bool {
+			return *arguments[i].Name < *arguments[j].Name
+		})
+
+		sequence := i + 1
+		result = append(result, client.LifecycleWorkflowTask{
+			Arguments:         &arguments,
+			ContinueOnError:   utils.Bool(t["continue_on_error"].(bool)),
+			Description:       utils.String(t["description"].(string)),
+			DisplayName:       utils.String(t["display_name"].(string)),
+			ExecutionSequence: &sequence,
+			IsEnabled:         utils.Bool(t["enabled"].(bool)),
+			TaskDefinitionId:  utils.String(t["task_definition_id"].(string)),
+		})
+	}
+
+	return &result
+}
+
+func flattenLifecycleWorkflowTasks(in *[]client.LifecycleWorkflowTask) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	tasks := make([]client.LifecycleWorkflowTask, len(*in))
+	copy(tasks, *in)
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].ExecutionSequence == nil || tasks[j].ExecutionSequence == nil {
+			return false
+		}
+		return *tasks[i].ExecutionSequence < *tasks[j].ExecutionSequence
+	})
+
+	result := make([]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		arguments := make(map[string]interface{})
+		if task.Arguments != nil {
+			for _, arg := range *task.Arguments {
+				if arg.Name != nil && arg.Value != nil {
+					arguments[*arg.Name] = *arg.Value
+				}
+			}
+		}
+
+		continueOnError := false
+		if task.ContinueOnError != nil {
+			continueOnError = *task.ContinueOnError
+		}
+		description := ""
+		if task.Description != nil {
+			description = *task.Description
+		}
+		displayName := ""
+		if task.DisplayName != nil {
+			displayName = *task.DisplayName
+		}
+		enabled := false
+		if task.IsEnabled != nil {
+			enabled = *task.IsEnabled
+		}
+		taskDefinitionId := ""
+		if task.TaskDefinitionId != nil {
+			taskDefinitionId = *task.TaskDefinitionId
+		}
+
+		result = append(result, map[string]interface{}{
+			"arguments":          arguments,
+			"continue_on_error":  continueOnError,
+			"description":        description,
+			"display_name":       displayName,
+			"enabled":            enabled,
+			"task_definition_id": taskDefinitionId,
+		})
+	}
+
+	return result
+}
+
+func flattenLifecycleWorkflowTrigger(in *client.LifecycleWorkflowTrigger) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	offsetInDays := 0
+	if in.OffsetInDays != nil {
+		offsetInDays = *in.OffsetInDays
+	}
+	timeBasedAttribute := ""
+	if in.TimeBasedAttribute != nil {
+		timeBasedAttribute = *in.TimeBasedAttribute
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"offset_in_days":       offsetInDays,
+			"time_based_attribute": timeBasedAttribute,
+		},
+	}
+}
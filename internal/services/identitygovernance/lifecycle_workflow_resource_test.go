@@ -0,0 +1,153 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type LifecycleWorkflowResource struct{}
+
+func TestAccLifecycleWorkflow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_lifecycle_workflow", "test")
+	r := LifecycleWorkflowResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("task.#").HasValue("1"),
+				check.That(data.ResourceName).Key("version").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLifecycleWorkflow_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_lifecycle_workflow", "test")
+	r := LifecycleWorkflowResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("task.#").HasValue("2"),
+				check.That(data.ResourceName).Key("task.0.arguments.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLifecycleWorkflow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_lifecycle_workflow", "test")
+	r := LifecycleWorkflowResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LifecycleWorkflowResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	workflow, status, err := clients.IdentityGovernance.LifecycleWorkflowsClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Lifecycle Workflow with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Lifecycle Workflow with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(workflow.ID != nil && *workflow.ID == state.ID), nil
+}
+
+func (LifecycleWorkflowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_lifecycle_workflow" "test" {
+  display_name = "acctest-LCW-%[1]d"
+  category     = "leaver"
+  scope_rule   = "(department eq 'acctest-%[1]d')"
+
+  trigger {
+    time_based_attribute = "employeeLeaveDateTime"
+    offset_in_days       = 0
+  }
+
+  task {
+    display_name       = "Disable user account"
+    task_definition_id = "1dfdfcc7-52fa-4c2e-bf3a-e3919cc12950"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (LifecycleWorkflowResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctest-LCW-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_lifecycle_workflow" "test" {
+  display_name       = "acctest-LCW-updated-%[1]d"
+  description        = "Acceptance test workflow"
+  category           = "leaver"
+  enabled            = true
+  scheduling_enabled = false
+  scope_rule         = "(department eq 'acctest-%[1]d')"
+
+  trigger {
+    time_based_attribute = "employeeLeaveDateTime"
+    offset_in_days       = 7
+  }
+
+  task {
+    display_name       = "Add user to groups"
+    task_definition_id = "22085229-5809-45e8-97fd-270d28d66910"
+    continue_on_error  = true
+
+    arguments = {
+      groupID = azuread_group.test.object_id
+    }
+  }
+
+  task {
+    display_name       = "Disable user account"
+    description        = "Disable the user account"
+    task_definition_id = "1dfdfcc7-52fa-4c2e-bf3a-e3919cc12950"
+  }
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,57 @@
+package parse
+
+import "fmt"
+
+type AccessPackageResourceCatalogAssociationId struct {
+	ObjectSubResourceId
+	CatalogId string
+	OriginId  string
+}
+
+func NewAccessPackageResourceCatalogAssociationID(catalogId, originId string) AccessPackageResourceCatalogAssociationId {
+	return AccessPackageResourceCatalogAssociationId{
+		ObjectSubResourceId: NewObjectSubResourceID(catalogId, "resource", originId),
+		CatalogId:           catalogId,
+		OriginId:            originId,
+	}
+}
+
+func AccessPackageResourceCatalogAssociationID(idString string) (*AccessPackageResourceCatalogAssociationId, error) {
+	id, err := ObjectSubResourceID(idString, "resource")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Catalog Resource Association ID: %v", err)
+	}
+
+	return &AccessPackageResourceCatalogAssociationId{
+		ObjectSubResourceId: *id,
+		CatalogId:           id.objectId,
+		OriginId:            id.subId,
+	}, nil
+}
+
+type AccessPackageResourceRoleScopeId struct {
+	ObjectSubResourceId
+	AccessPackageId string
+	RoleScopeId     string
+}
+
+func NewAccessPackageResourceRoleScopeID(accessPackageId, roleScopeId string) AccessPackageResourceRoleScopeId {
+	return AccessPackageResourceRoleScopeId{
+		ObjectSubResourceId: NewObjectSubResourceID(accessPackageId, "resourceRoleScope", roleScopeId),
+		AccessPackageId:     accessPackageId,
+		RoleScopeId:         roleScopeId,
+	}
+}
+
+func AccessPackageResourceRoleScopeID(idString string) (*AccessPackageResourceRoleScopeId, error) {
+	id, err := ObjectSubResourceID(idString, "resourceRoleScope")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Resource Role Scope ID: %v", err)
+	}
+
+	return &AccessPackageResourceRoleScopeId{
+		ObjectSubResourceId: *id,
+		AccessPackageId:     id.objectId,
+		RoleScopeId:         id.subId,
+	}, nil
+}
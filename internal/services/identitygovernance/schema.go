@@ -0,0 +1,130 @@
+package identitygovernance
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const (
+	subjectTypeExternalSponsors = "externalSponsors"
+	subjectTypeGroupMembers     = "groupMembers"
+	subjectTypeInternalSponsors = "internalSponsors"
+	subjectTypeRequestorManager = "requestorManager"
+	subjectTypeSingleUser       = "singleUser"
+)
+
+var subjectTypeODataTypes = map[string]string{
+	subjectTypeExternalSponsors: client.SubjectSetODataTypeExternalSponsors,
+	subjectTypeGroupMembers:     client.SubjectSetODataTypeGroupMembers,
+	subjectTypeInternalSponsors: client.SubjectSetODataTypeInternalSponsors,
+	subjectTypeRequestorManager: client.SubjectSetODataTypeRequestorManager,
+	subjectTypeSingleUser:       client.SubjectSetODataTypeSingleUser,
+}
+
+func schemaAccessPackageSubjectSet() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"subject_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						subjectTypeExternalSponsors,
+						subjectTypeGroupMembers,
+						subjectTypeInternalSponsors,
+						subjectTypeRequestorManager,
+						subjectTypeSingleUser,
+					}, false),
+				},
+
+				"object_id": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+		},
+	}
+}
+
+func expandAccessPackageSubjectSets(in []interface{}) *[]client.SubjectSet {
+	result := make([]client.SubjectSet, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		s := raw.(map[string]interface{})
+		subjectType := s["subject_type"].(string)
+		objectId := s["object_id"].(string)
+
+		subject := client.SubjectSet{
+			ODataType: utils.String(subjectTypeODataTypes[subjectType]),
+		}
+		switch subjectType {
+		case subjectTypeGroupMembers:
+			subject.GroupId = utils.String(objectId)
+		case subjectTypeRequestorManager:
+			subject.ManagerLevel = utils.Int32(1)
+		case subjectTypeSingleUser:
+			subject.UserId = utils.String(objectId)
+		}
+
+		result = append(result, subject)
+	}
+	return &result
+}
+
+func flattenAccessPackageSubjectSets(in *[]client.SubjectSet) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+	for _, subject := range *in {
+		if subject.ODataType == nil {
+			continue
+		}
+		var subjectType, objectId string
+		for k, v := range subjectTypeODataTypes {
+			if v == *subject.ODataType {
+				subjectType = k
+			}
+		}
+		if subject.GroupId != nil {
+			objectId = *subject.GroupId
+		} else if subject.UserId != nil {
+			objectId = *subject.UserId
+		}
+		result = append(result, map[string]interface{}{
+			"object_id":    objectId,
+			"subject_type": subjectType,
+		})
+	}
+	return result
+}
+
+// durationFromDays returns an ISO 8601 duration representing the specified number of days
+func durationFromDays(days int) *string {
+	return utils.String(fmt.Sprintf("P%dD", days))
+}
+
+// daysFromDuration returns the number of days in an ISO 8601 duration, only when expressed solely in days
+func daysFromDuration(duration *string) int {
+	if duration == nil {
+		return 0
+	}
+	days, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(*duration, "P"), "D"))
+	if err != nil {
+		return 0
+	}
+	return days
+}
@@ -0,0 +1,164 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const termsOfUseAgreementResourceName = "azuread_terms_of_use_agreement"
+
+func termsOfUseAgreementResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: termsOfUseAgreementResourceCreate,
+		ReadContext:   termsOfUseAgreementResourceRead,
+		UpdateContext: termsOfUseAgreementResourceUpdate,
+		DeleteContext: termsOfUseAgreementResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"file": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsBase64,
+							DiffSuppressFunc: func(_, old, _ string, d *schema.ResourceData) bool {
+								// File content is not returned by the API, so it's unknown after importing an agreement
+								return d.Id() != "" && old == ""
+							},
+						},
+
+						"file_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"language": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"is_default": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"per_device_acceptance_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"terms_expiration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.ISO8601Duration,
+						},
+
+						"start_date": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+
+			"user_reaccept_required_frequency": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.ISO8601Duration,
+			},
+
+			"viewing_before_acceptance_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func termsOfUseAgreementResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(termsOfUseAgreementResourceName); diags != nil {
+		return diags
+	}
+	return termsOfUseAgreementResourceCreateMsGraph(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(termsOfUseAgreementResourceName); diags != nil {
+		return diags
+	}
+	return termsOfUseAgreementResourceReadMsGraph(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(termsOfUseAgreementResourceName); diags != nil {
+		return diags
+	}
+	return termsOfUseAgreementResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(termsOfUseAgreementResourceName); diags != nil {
+		return diags
+	}
+	return termsOfUseAgreementResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,246 @@
+package identitygovernance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func termsOfUseAgreementResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.AgreementsClient
+	displayName := d.Get("display_name").(string)
+
+	files, diags := expandAgreementFiles(d.Get("file").([]interface{}))
+	if diags != nil {
+		return diags
+	}
+
+	properties := expandAgreement(d)
+	properties.Files = files
+
+	agreement, _, err := c.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating terms of use agreement %q", displayName)
+	}
+	if agreement.ID == nil || *agreement.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned for terms of use agreement %q", displayName), "Bad API response")
+	}
+
+	d.SetId(*agreement.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, *agreement.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for terms of use agreement with ID %q", *agreement.ID)
+	}
+
+	return termsOfUseAgreementResourceReadMsGraph(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.AgreementsClient
+
+	agreement, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Terms of use agreement with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving terms of use agreement with ID %q", d.Id())
+	}
+
+	tf.Set(d, "display_name", agreement.DisplayName)
+	tf.Set(d, "file", flattenAgreementFiles(agreement.Files, d.Get("file").([]interface{})))
+	tf.Set(d, "per_device_acceptance_required", agreement.IsPerDeviceAcceptanceRequired)
+	tf.Set(d, "terms_expiration", flattenTermsExpiration(agreement.TermsExpiration))
+	tf.Set(d, "user_reaccept_required_frequency", agreement.UserReacceptRequiredFrequency)
+	tf.Set(d, "viewing_before_acceptance_required", agreement.IsViewingBeforeAcceptanceRequired)
+
+	return nil
+}
+
+func termsOfUseAgreementResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.AgreementsClient
+
+	properties := expandAgreement(d)
+	properties.ID = utils.String(d.Id())
+
+	// Properties removed from the configuration are omitted by expandAgreement, so they must be cleared explicitly
+	nullProperties := make([]string, 0)
+	if properties.TermsExpiration == nil && d.HasChange("terms_expiration") {
+		nullProperties = append(nullProperties, "termsExpiration")
+	}
+	if properties.UserReacceptRequiredFrequency == nil && d.HasChange("user_reaccept_required_frequency") {
+		nullProperties = append(nullProperties, "userReacceptRequiredFrequency")
+	}
+
+	if _, err := c.Update(ctx, properties, nullProperties...); err != nil {
+		return tf.ErrorDiagF(err, "Updating terms of use agreement with ID %q", d.Id())
+	}
+
+	return termsOfUseAgreementResourceReadMsGraph(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).IdentityGovernance.AgreementsClient
+
+	_, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Terms of use agreement was not found"), "id", "Retrieving terms of use agreement with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving terms of use agreement with ID %q", d.Id())
+	}
+
+	if _, err := c.Delete(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting terms of use agreement with ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandAgreement(d *schema.ResourceData) client.Agreement {
+	agreement := client.Agreement{
+		DisplayName:                       utils.String(d.Get("display_name").(string)),
+		IsPerDeviceAcceptanceRequired:     utils.Bool(d.Get("per_device_acceptance_required").(bool)),
+		IsViewingBeforeAcceptanceRequired: utils.Bool(d.Get("viewing_before_acceptance_required").(bool)),
+	}
+
+	if v, ok := d.GetOk("user_reaccept_required_frequency"); ok {
+		agreement.UserReacceptRequiredFrequency = utils.String(v.(string))
+	}
+
+	if v := d.Get("terms_expiration").([]interface{}); len(v) > 0 && v[0] != nil {
+		e := v[0].(map[string]interface{})
+		agreement.TermsExpiration = &client.TermsExpiration{
+			Frequency:     utils.String(e["frequency"].(string)),
+			StartDateTime: utils.String(e["start_date"].(string)),
+		}
+	}
+
+	return agreement
+}
+
+func expandAgreementFiles(in []interface{}) (*[]client.AgreementFile, diag.Diagnostics) {
+	result := make([]client.AgreementFile, 0)
+	languages := make(map[string]bool)
+	defaults := 0
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		f := raw.(map[string]interface{})
+
+		language := f["language"].(string)
+		if languages[strings.ToLower(language)] {
+			return nil, tf.ErrorDiagPathF(nil, "file", "Duplicate file specified for language %q", language)
+		}
+		languages[strings.ToLower(language)] = true
+
+		isDefault := f["is_default"].(bool)
+		if isDefault {
+			defaults++
+		}
+
+		file := client.AgreementFile{
+			FileData: &client.AgreementFileData{
+				Data: utils.String(f["content"].(string)),
+			},
+			FileName:  utils.String(f["file_name"].(string)),
+			IsDefault: utils.Bool(isDefault),
+			Language:  utils.String(language),
+		}
+		if v := f["display_name"].(string); v != "" {
+			file.DisplayName = utils.String(v)
+		}
+
+		result = append(result, file)
+	}
+
+	// A single file is always the default
+	if len(result) == 1 {
+		result[0].IsDefault = utils.Bool(true)
+	} else if defaults != 1 {
+		return nil, tf.ErrorDiagPathF(nil, "file", "Exactly one file must have `is_default = true` when more than one file is specified")
+	}
+
+	return &result, nil
+}
+
+func flattenAgreementFiles(in *[]client.AgreementFile, existing []interface{}) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	// File content is not returned by the API, so it's retained from configuration by matching the language. Files
+	// are also returned in the order in which they were previously configured, to avoid spurious diffs.
+	contents := make(map[string]string)
+	order := make(map[string]int)
+	for i, raw := range existing {
+		if raw == nil {
+			continue
+		}
+		f := raw.(map[string]interface{})
+		language := strings.ToLower(f["language"].(string))
+		contents[language] = f["content"].(string)
+		order[language] = i
+	}
+
+	files := *in
+	sort.SliceStable(files, func(i, j int) bool {
+		return fileOrder(order, files[i]) < fileOrder(order, files[j])
+	})
+
+	result := make([]map[string]interface{}, 0)
+	for _, file := range files {
+		var language string
+		if file.Language != nil {
+			language = *file.Language
+		}
+
+		result = append(result, map[string]interface{}{
+			"content":      contents[strings.ToLower(language)],
+			"display_name": file.DisplayName,
+			"file_name":    file.FileName,
+			"is_default":   file.IsDefault != nil && *file.IsDefault,
+			"language":     language,
+		})
+	}
+
+	return result
+}
+
+func fileOrder(order map[string]int, file client.AgreementFile) int {
+	if file.Language != nil {
+		if i, ok := order[strings.ToLower(*file.Language)]; ok {
+			return i
+		}
+	}
+	return len(order)
+}
+
+func flattenTermsExpiration(in *client.TermsExpiration) []map[string]interface{} {
+	if in == nil || in.Frequency == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"frequency":  in.Frequency,
+		"start_date": in.StartDateTime,
+	}}
+}
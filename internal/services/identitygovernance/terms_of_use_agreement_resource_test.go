@@ -0,0 +1,203 @@
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+// A minimal, single page PDF document
+const testAgreementFileContent = "JVBERi0xLjEKMSAwIG9iajw8L1R5cGUvQ2F0YWxvZy9QYWdlcyAyIDAgUj4+ZW5kb2JqCjIgMCBvYmo8PC9UeXBlL1BhZ2VzL0tpZHNbMyAwIFJdL0NvdW50IDE+PmVuZG9iagozIDAgb2JqPDwvVHlwZS9QYWdlL1BhcmVudCAyIDAgUi9NZWRpYUJveFswIDAgNjEyIDc5Ml0+PmVuZG9iagp0cmFpbGVyPDwvUm9vdCAxIDAgUj4+CiUlRU9GCg=="
+
+type TermsOfUseAgreementResource struct{}
+
+func TestAccTermsOfUseAgreement_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.0.is_default").HasValue("true"),
+			),
+		},
+		data.ImportStep("file.0.content"),
+	})
+}
+
+func TestAccTermsOfUseAgreement_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("file.0.content", "file.1.content"),
+	})
+}
+
+func TestAccTermsOfUseAgreement_removeExpiration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.withExpiration(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("terms_expiration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("user_reaccept_required_frequency").HasValue("P90D"),
+			),
+		},
+		data.ImportStep("file.0.content"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("terms_expiration.#").HasValue("0"),
+				check.That(data.ResourceName).Key("user_reaccept_required_frequency").HasValue(""),
+			),
+		},
+		data.ImportStep("file.0.content"),
+	})
+}
+
+func TestAccTermsOfUseAgreement_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("file.0.content"),
+		{
+			Config: r.basicUpdated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("viewing_before_acceptance_required").HasValue("true"),
+				check.That(data.ResourceName).Key("user_reaccept_required_frequency").HasValue("P90D"),
+			),
+		},
+		data.ImportStep("file.0.content"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("file.0.content"),
+	})
+}
+
+func (r TermsOfUseAgreementResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	agreement, status, err := clients.IdentityGovernance.AgreementsClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Terms of Use Agreement with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Terms of Use Agreement with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(agreement.ID != nil && *agreement.ID == state.ID), nil
+}
+
+func (TermsOfUseAgreementResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name = "acctest-ToU-%[1]d"
+
+  file {
+    file_name = "terms.pdf"
+    language  = "en"
+    content   = "%[2]s"
+  }
+}
+`, data.RandomInteger, testAgreementFileContent)
+}
+
+func (TermsOfUseAgreementResource) basicUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name                       = "acctest-ToU-updated-%[1]d"
+  per_device_acceptance_required     = false
+  user_reaccept_required_frequency   = "P90D"
+  viewing_before_acceptance_required = true
+
+  file {
+    file_name = "terms.pdf"
+    language  = "en"
+    content   = "%[2]s"
+  }
+}
+`, data.RandomInteger, testAgreementFileContent)
+}
+
+func (TermsOfUseAgreementResource) withExpiration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name                     = "acctest-ToU-%[1]d"
+  user_reaccept_required_frequency = "P90D"
+
+  terms_expiration {
+    frequency  = "P365D"
+    start_date = "2030-01-01T00:00:00Z"
+  }
+
+  file {
+    file_name = "terms.pdf"
+    language  = "en"
+    content   = "%[2]s"
+  }
+}
+`, data.RandomInteger, testAgreementFileContent)
+}
+
+func (TermsOfUseAgreementResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name                       = "acctest-ToU-%[1]d"
+  per_device_acceptance_required     = true
+  viewing_before_acceptance_required = true
+
+  terms_expiration {
+    frequency  = "P365D"
+    start_date = "2030-01-01T00:00:00Z"
+  }
+
+  file {
+    display_name = "Terms of Use"
+    file_name    = "terms-en.pdf"
+    language     = "en"
+    is_default   = true
+    content      = "%[2]s"
+  }
+
+  file {
+    display_name = "Nutzungsbedingungen"
+    file_name    = "terms-de.pdf"
+    language     = "de"
+    content      = "%[2]s"
+  }
+}
+`, data.RandomInteger, testAgreementFileContent)
+}
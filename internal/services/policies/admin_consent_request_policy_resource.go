@@ -0,0 +1,131 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const (
+	adminConsentRequestPolicyResourceName = "azuread_admin_consent_request_policy"
+	adminConsentRequestPolicyId           = "adminConsentRequestPolicy"
+)
+
+func adminConsentRequestPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: adminConsentRequestPolicyResourceCreate,
+		ReadContext:   adminConsentRequestPolicyResourceRead,
+		UpdateContext: adminConsentRequestPolicyResourceUpdate,
+		DeleteContext: adminConsentRequestPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != adminConsentRequestPolicyId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, adminConsentRequestPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"notify_reviewers": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"reminders_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"request_duration_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 30),
+			},
+
+			"reviewer": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"query_root": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"query_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      client.ReviewerScopeQueryTypeMicrosoftGraph,
+							ValidateFunc: validation.StringInSlice([]string{client.ReviewerScopeQueryTypeMicrosoftGraph}, false),
+						},
+					},
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func adminConsentRequestPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(adminConsentRequestPolicyResourceName); diags != nil {
+		return diags
+	}
+	return adminConsentRequestPolicyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func adminConsentRequestPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(adminConsentRequestPolicyResourceName); diags != nil {
+		return diags
+	}
+	return adminConsentRequestPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func adminConsentRequestPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(adminConsentRequestPolicyResourceName); diags != nil {
+		return diags
+	}
+	return adminConsentRequestPolicyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func adminConsentRequestPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(adminConsentRequestPolicyResourceName); diags != nil {
+		return diags
+	}
+	return adminConsentRequestPolicyResourceDeleteMsGraph(ctx, d, meta)
+}
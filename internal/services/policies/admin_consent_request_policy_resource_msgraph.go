@@ -0,0 +1,142 @@
+package policies
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func adminConsentRequestPolicyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AdminConsentRequestPolicyClient
+
+	policy, err := expandAdminConsentRequestPolicy(d)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "reviewer", "Invalid admin consent request policy")
+	}
+
+	// The admin consent request policy always exists for a tenant, so we simply take ownership of it
+	if _, err := client.Update(ctx, *policy); err != nil {
+		return tf.ErrorDiagF(err, "Updating admin consent request policy")
+	}
+
+	d.SetId(adminConsentRequestPolicyId)
+
+	return adminConsentRequestPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func adminConsentRequestPolicyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AdminConsentRequestPolicyClient
+
+	policy, _, err := client.Get(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving admin consent request policy")
+	}
+
+	tf.Set(d, "enabled", policy.IsEnabled != nil && *policy.IsEnabled)
+	tf.Set(d, "notify_reviewers", policy.NotifyReviewers != nil && *policy.NotifyReviewers)
+	tf.Set(d, "reminders_enabled", policy.RemindersEnabled != nil && *policy.RemindersEnabled)
+	tf.Set(d, "request_duration_in_days", policy.RequestDurationInDays)
+	tf.Set(d, "reviewer", flattenAdminConsentRequestPolicyReviewers(policy.Reviewers))
+	tf.Set(d, "version", policy.Version)
+
+	return nil
+}
+
+func adminConsentRequestPolicyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AdminConsentRequestPolicyClient
+
+	policy, err := expandAdminConsentRequestPolicy(d)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "reviewer", "Invalid admin consent request policy")
+	}
+
+	if _, err := client.Update(ctx, *policy); err != nil {
+		return tf.ErrorDiagF(err, "Updating admin consent request policy")
+	}
+
+	return adminConsentRequestPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func adminConsentRequestPolicyResourceDeleteMsGraph(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AdminConsentRequestPolicyClient
+
+	// The policy cannot be deleted, so restore the default settings for a new tenant, where users cannot request consent
+	properties := client.AdminConsentRequestPolicy{
+		IsEnabled:             utils.Bool(false),
+		NotifyReviewers:       utils.Bool(false),
+		RemindersEnabled:      utils.Bool(false),
+		RequestDurationInDays: utils.Int(30),
+		Reviewers:             &[]client.ReviewerScope{},
+	}
+
+	if _, err := c.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Restoring default admin consent request policy")
+	}
+
+	return nil
+}
+
+func expandAdminConsentRequestPolicy(d *schema.ResourceData) (*client.AdminConsentRequestPolicy, error) {
+	reviewers := make([]client.ReviewerScope, 0)
+	for _, raw := range d.Get("reviewer").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+		reviewer := client.ReviewerScope{
+			Query:     utils.String(v["query"].(string)),
+			QueryType: utils.String(v["query_type"].(string)),
+		}
+		if queryRoot := v["query_root"].(string); queryRoot != "" {
+			reviewer.QueryRoot = utils.String(queryRoot)
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	if enabled && len(reviewers) == 0 {
+		return nil, errors.New("at least one `reviewer` must be specified when `enabled` is true")
+	}
+
+	return &client.AdminConsentRequestPolicy{
+		IsEnabled:             utils.Bool(enabled),
+		NotifyReviewers:       utils.Bool(d.Get("notify_reviewers").(bool)),
+		RemindersEnabled:      utils.Bool(d.Get("reminders_enabled").(bool)),
+		RequestDurationInDays: utils.Int(d.Get("request_duration_in_days").(int)),
+		Reviewers:             &reviewers,
+	}, nil
+}
+
+func flattenAdminConsentRequestPolicyReviewers(in *[]client.ReviewerScope) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make([]map[string]interface{}, 0, len(*in))
+	for _, reviewer := range *in {
+		query, queryRoot, queryType := "", "", ""
+		if reviewer.Query != nil {
+			query = *reviewer.Query
+		}
+		if reviewer.QueryRoot != nil {
+			queryRoot = *reviewer.QueryRoot
+		}
+		if reviewer.QueryType != nil {
+			queryType = *reviewer.QueryType
+		}
+		result = append(result, map[string]interface{}{
+			"query":      query,
+			"query_root": queryRoot,
+			"query_type": queryType,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,135 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AdminConsentRequestPolicyResource struct{}
+
+func TestAccAdminConsentRequestPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_admin_consent_request_policy", "test")
+	r := AdminConsentRequestPolicyResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("reviewer.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAdminConsentRequestPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_admin_consent_request_policy", "test")
+	r := AdminConsentRequestPolicyResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("request_duration_in_days").HasValue("14"),
+				check.That(data.ResourceName).Key("reviewer.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabled(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AdminConsentRequestPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	if _, _, err := clients.Policies.AdminConsentRequestPolicyClient.Get(ctx); err != nil {
+		return nil, fmt.Errorf("failed to retrieve Admin Consent Request Policy: %+v", err)
+	}
+
+	return utils.Bool(state.ID == "adminConsentRequestPolicy"), nil
+}
+
+func (AdminConsentRequestPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[4]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[3]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (r AdminConsentRequestPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_admin_consent_request_policy" "test" {
+  reviewer {
+    query = "/users/${azuread_user.test.object_id}"
+  }
+}
+`, r.template(data))
+}
+
+func (r AdminConsentRequestPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_admin_consent_request_policy" "test" {
+  enabled                  = true
+  notify_reviewers         = false
+  reminders_enabled        = false
+  request_duration_in_days = 14
+
+  reviewer {
+    query      = "/users/${azuread_user.test.object_id}"
+    query_type = "MicrosoftGraph"
+  }
+
+  reviewer {
+    query = "/groups/${azuread_group.test.object_id}/transitiveMembers/microsoft.graph.user"
+  }
+}
+`, r.template(data))
+}
+
+func (AdminConsentRequestPolicyResource) disabled() string {
+	return `
+resource "azuread_admin_consent_request_policy" "test" {
+  enabled           = false
+  notify_reviewers  = false
+  reminders_enabled = false
+}
+`
+}
@@ -0,0 +1,72 @@
+package policies
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const appManagementPolicyAssignmentResourceName = "azuread_app_management_policy_assignment"
+
+func appManagementPolicyAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: appManagementPolicyAssignmentResourceCreate,
+		ReadContext:   appManagementPolicyAssignmentResourceRead,
+		DeleteContext: appManagementPolicyAssignmentResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AppManagementPolicyAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func appManagementPolicyAssignmentResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(appManagementPolicyAssignmentResourceName); diags != nil {
+		return diags
+	}
+	return appManagementPolicyAssignmentResourceCreateMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyAssignmentResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(appManagementPolicyAssignmentResourceName); diags != nil {
+		return diags
+	}
+	return appManagementPolicyAssignmentResourceReadMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyAssignmentResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(appManagementPolicyAssignmentResourceName); diags != nil {
+		return diags
+	}
+	return appManagementPolicyAssignmentResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,146 @@
+package policies
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func appManagementPolicyAssignmentResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	policyId := d.Get("policy_id").(string)
+	objectId := d.Get("object_id").(string)
+
+	id := parse.NewAppManagementPolicyAssignmentID(policyId, objectId)
+
+	tf.LockByName(appManagementPolicyAssignmentResourceName, policyId)
+	defer tf.UnlockByName(appManagementPolicyAssignmentResourceName, policyId)
+
+	if _, status, err := client.Get(ctx, policyId); err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "policy_id", "App management policy with object ID %q was not found", policyId)
+		}
+		return tf.ErrorDiagPathF(err, "policy_id", "Retrieving app management policy with object ID %q", policyId)
+	}
+
+	objectType, status, err := client.GetObjectType(ctx, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "object_id", "Directory object with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagPathF(err, "object_id", "Retrieving directory object with object ID %q", objectId)
+	}
+
+	existing, _, err := client.ListAppliesTo(ctx, policyId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing existing assignments for app management policy with object ID %q", policyId)
+	}
+	for v := range existing {
+		if strings.EqualFold(v, objectId) {
+			return tf.ImportAsExistsDiag(appManagementPolicyAssignmentResourceName, id.String())
+		}
+	}
+
+	if err := helpers.WaitForReferenceReplication(ctx, func() (int, error) {
+		return client.Assign(ctx, policyId, objectType, objectId)
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Assigning app management policy %q to object %q", policyId, objectId)
+	}
+
+	d.SetId(id.String())
+
+	return appManagementPolicyAssignmentResourceReadMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyAssignmentResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	id, err := parse.AppManagementPolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Management Policy Assignment ID %q", d.Id())
+	}
+
+	objects, status, err := client.ListAppliesTo(ctx, id.PolicyId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] App management policy with object ID %q was not found - removing from state", id.PolicyId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving assignments for app management policy with object ID %q", id.PolicyId)
+	}
+
+	var objectId string
+	for v := range objects {
+		if strings.EqualFold(v, id.ObjectId) {
+			objectId = v
+			break
+		}
+	}
+
+	if objectId == "" {
+		log.Printf("[DEBUG] Object with ID %q was not assigned app management policy %q - removing from state", id.ObjectId, id.PolicyId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "object_id", objectId)
+	tf.Set(d, "policy_id", id.PolicyId)
+
+	return nil
+}
+
+func appManagementPolicyAssignmentResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	id, err := parse.AppManagementPolicyAssignmentID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Management Policy Assignment ID %q", d.Id())
+	}
+
+	tf.LockByName(appManagementPolicyAssignmentResourceName, id.PolicyId)
+	defer tf.UnlockByName(appManagementPolicyAssignmentResourceName, id.PolicyId)
+
+	objects, _, err := client.ListAppliesTo(ctx, id.PolicyId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving assignments for app management policy with object ID %q", id.PolicyId)
+	}
+
+	var objectType string
+	for v, t := range objects {
+		if strings.EqualFold(v, id.ObjectId) {
+			objectType = t
+			break
+		}
+	}
+	if objectType == "" {
+		return nil
+	}
+
+	if _, err := client.Unassign(ctx, id.PolicyId, objectType, id.ObjectId); err != nil {
+		return tf.ErrorDiagF(err, "Removing assignment of app management policy %q from object %q", id.PolicyId, id.ObjectId)
+	}
+
+	if _, err := helpers.WaitForListRemove(ctx, id.ObjectId, func() ([]string, error) {
+		objects, _, err := client.ListAppliesTo(ctx, id.PolicyId)
+		result := make([]string, 0, len(objects))
+		for v := range objects {
+			result = append(result, v)
+		}
+		return result, err
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for app management policy assignment removal")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AppManagementPolicyAssignmentResource struct{}
+
+func TestAccAppManagementPolicyAssignment_application(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy_assignment", "test")
+	r := AppManagementPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.application(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppManagementPolicyAssignment_servicePrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy_assignment", "test")
+	r := AppManagementPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.servicePrincipal(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppManagementPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy_assignment", "test")
+	r := AppManagementPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.application(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r AppManagementPolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.AppManagementPolicyAssignmentID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing App Management Policy Assignment ID: %v", err)
+	}
+
+	objects, status, err := clients.Policies.AppManagementPolicyClient.ListAppliesTo(ctx, id.PolicyId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("App Management Policy with object ID %q does not exist", id.PolicyId)
+		}
+		return nil, fmt.Errorf("failed to retrieve assignments for App Management Policy with object ID %q: %+v", id.PolicyId, err)
+	}
+
+	for objectId := range objects {
+		if strings.EqualFold(objectId, id.ObjectId) {
+			return utils.Bool(true), nil
+		}
+	}
+
+	return nil, fmt.Errorf("App Management Policy %q was not assigned to object %q", id.PolicyId, id.ObjectId)
+}
+
+func (AppManagementPolicyAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctestAppManagementPolicy-%[1]d"
+  description  = "Acceptance test app management policy"
+
+  restrictions {
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P90D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+  }
+}
+
+resource "azuread_application" "test" {
+  name = "acctestAppManagementPolicy-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r AppManagementPolicyAssignmentResource) application(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_management_policy_assignment" "test" {
+  policy_id = azuread_app_management_policy.test.id
+  object_id = azuread_application.test.object_id
+}
+`, r.template(data))
+}
+
+func (r AppManagementPolicyAssignmentResource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_app_management_policy_assignment" "test" {
+  policy_id = azuread_app_management_policy.test.id
+  object_id = azuread_service_principal.test.object_id
+}
+`, r.template(data))
+}
+
+func (r AppManagementPolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_app_management_policy_assignment" "import" {
+  policy_id = azuread_app_management_policy_assignment.test.policy_id
+  object_id = azuread_app_management_policy_assignment.test.object_id
+}
+`, r.application(data))
+}
@@ -0,0 +1,90 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const appManagementPolicyResourceName = "azuread_app_management_policy"
+
+func appManagementPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: appManagementPolicyResourceCreate,
+		ReadContext:   appManagementPolicyResourceRead,
+		UpdateContext: appManagementPolicyResourceUpdate,
+		DeleteContext: appManagementPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"restrictions": schemaAppManagementRestrictions(),
+		},
+	}
+}
+
+func appManagementPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(appManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return appManagementPolicyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(appManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return appManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(appManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return appManagementPolicyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(appManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return appManagementPolicyResourceDeleteMsGraph(ctx, d, meta)
+}
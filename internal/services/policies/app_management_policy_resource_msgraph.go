@@ -0,0 +1,103 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func appManagementPolicyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+	displayName := d.Get("display_name").(string)
+
+	policy, _, err := c.Create(ctx, expandAppManagementPolicy(d))
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating app management policy %q", displayName)
+	}
+
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned"), "Bad API response for app management policy %q", displayName)
+	}
+
+	d.SetId(*policy.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, *policy.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for app management policy with object ID %q", *policy.ID)
+	}
+
+	return appManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	policy, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] App management policy with object ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving app management policy with object ID %q", d.Id())
+	}
+
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "enabled", policy.IsEnabled != nil && *policy.IsEnabled)
+	tf.Set(d, "restrictions", flattenAppManagementConfiguration(policy.Restrictions))
+
+	return nil
+}
+
+func appManagementPolicyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	properties := expandAppManagementPolicy(d)
+	properties.ID = utils.String(d.Id())
+
+	if _, err := c.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating app management policy with object ID %q", d.Id())
+	}
+
+	return appManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func appManagementPolicyResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	_, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("App management policy was not found"), "id", "Retrieving app management policy with object ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving app management policy with object ID %q", d.Id())
+	}
+
+	if _, err := c.Delete(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting app management policy with object ID %q", d.Id())
+	}
+
+	return nil
+}
+
+func expandAppManagementPolicy(d *schema.ResourceData) client.AppManagementPolicy {
+	return client.AppManagementPolicy{
+		Description:  utils.String(d.Get("description").(string)),
+		DisplayName:  utils.String(d.Get("display_name").(string)),
+		IsEnabled:    utils.Bool(d.Get("enabled").(bool)),
+		Restrictions: expandAppManagementConfiguration(d.Get("restrictions").([]interface{})),
+	}
+}
@@ -0,0 +1,115 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AppManagementPolicyResource struct{}
+
+func TestAccAppManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+	r := AppManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+	r := AppManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("restrictions.0.password_credential.#").HasValue("2"),
+				check.That(data.ResourceName).Key("restrictions.0.key_credential.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AppManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	policy, status, err := clients.Policies.AppManagementPolicyClient.Get(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("App Management Policy with object ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve App Management Policy with object ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(policy.ID != nil && *policy.ID == state.ID), nil
+}
+
+func (AppManagementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctestAppManagementPolicy-%[1]d"
+  description  = "Acceptance test app management policy"
+}
+`, data.RandomInteger)
+}
+
+func (AppManagementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctestAppManagementPolicy-%[1]d"
+  description  = "Acceptance test app management policy"
+  enabled      = false
+
+  restrictions {
+    password_credential {
+      restriction_type                = "passwordAddition"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P90D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+
+    key_credential {
+      restriction_type                = "asymmetricKeyLifetime"
+      max_lifetime                    = "P365D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+  }
+}
+`, data.RandomInteger)
+}
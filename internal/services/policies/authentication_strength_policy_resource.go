@@ -0,0 +1,138 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const authenticationStrengthPolicyResourceName = "azuread_authentication_strength_policy"
+
+// authenticationMethodModes are the authentication methods which can be combined in an authentication strength.
+// See https://learn.microsoft.com/en-us/graph/api/resources/authenticationstrengthpolicy
+var authenticationMethodModes = []string{
+	"deviceBasedPush",
+	"email",
+	"federatedMultiFactor",
+	"federatedSingleFactor",
+	"fido2",
+	"hardwareOath",
+	"microsoftAuthenticatorPush",
+	"password",
+	"sms",
+	"softwareOath",
+	"temporaryAccessPassMultiUse",
+	"temporaryAccessPassOneTime",
+	"voice",
+	"windowsHelloForBusiness",
+	"x509CertificateMultiFactor",
+	"x509CertificateSingleFactor",
+}
+
+func authenticationStrengthPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: authenticationStrengthPolicyResourceCreate,
+		ReadContext:   authenticationStrengthPolicyResourceRead,
+		UpdateContext: authenticationStrengthPolicyResourceUpdate,
+		DeleteContext: authenticationStrengthPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"allowed_combinations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAuthenticationMethodCombination,
+				},
+			},
+		},
+	}
+}
+
+// validateAuthenticationMethodCombination checks that a combination is made up of known authentication methods, which
+// are separated by commas when a combination requires more than one method, e.g. `password,sms`.
+func validateAuthenticationMethodCombination(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	for _, mode := range strings.Split(v, ",") {
+		found := false
+		for _, m := range authenticationMethodModes {
+			if mode == m {
+				found = true
+				break
+			}
+		}
+		if !found {
+			errors = append(errors, fmt.Errorf("%q contains an unknown authentication method %q, supported methods are: %s", k, mode, strings.Join(authenticationMethodModes, ", ")))
+		}
+	}
+
+	return
+}
+
+func authenticationStrengthPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authenticationStrengthPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authenticationStrengthPolicyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func authenticationStrengthPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authenticationStrengthPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authenticationStrengthPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func authenticationStrengthPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authenticationStrengthPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authenticationStrengthPolicyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func authenticationStrengthPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authenticationStrengthPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authenticationStrengthPolicyResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,113 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func authenticationStrengthPolicyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+	displayName := d.Get("display_name").(string)
+
+	properties := client.AuthenticationStrengthPolicy{
+		AllowedCombinations: tf.ExpandStringSlicePtr(d.Get("allowed_combinations").(*schema.Set).List()),
+		DisplayName:         utils.String(displayName),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		properties.Description = utils.String(v.(string))
+	}
+
+	policy, _, err := c.Create(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating authentication strength policy %q", displayName)
+	}
+
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("nil or empty ID returned"), "Bad API response for authentication strength policy %q", displayName)
+	}
+
+	d.SetId(*policy.ID)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return c.Get(ctx, *policy.ID)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for authentication strength policy with ID %q", *policy.ID)
+	}
+
+	return authenticationStrengthPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func authenticationStrengthPolicyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+
+	policy, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Authentication strength policy with ID %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving authentication strength policy with ID %q", d.Id())
+	}
+
+	tf.Set(d, "allowed_combinations", tf.FlattenStringSlicePtr(policy.AllowedCombinations))
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+
+	return nil
+}
+
+func authenticationStrengthPolicyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+
+	if d.HasChanges("description", "display_name") {
+		properties := client.AuthenticationStrengthPolicy{
+			ID:          utils.String(d.Id()),
+			Description: utils.String(d.Get("description").(string)),
+			DisplayName: utils.String(d.Get("display_name").(string)),
+		}
+		if _, err := c.Update(ctx, properties); err != nil {
+			return tf.ErrorDiagF(err, "Updating authentication strength policy with ID %q", d.Id())
+		}
+	}
+
+	// Allowed combinations can only be changed using a separate action
+	if d.HasChange("allowed_combinations") {
+		allowedCombinations := *tf.ExpandStringSlicePtr(d.Get("allowed_combinations").(*schema.Set).List())
+		if _, err := c.UpdateAllowedCombinations(ctx, d.Id(), allowedCombinations); err != nil {
+			return tf.ErrorDiagPathF(err, "allowed_combinations", "Updating allowed combinations for authentication strength policy with ID %q", d.Id())
+		}
+	}
+
+	return authenticationStrengthPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func authenticationStrengthPolicyResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AuthenticationStrengthPolicyClient
+
+	_, status, err := c.Get(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Authentication strength policy was not found"), "id", "Retrieving authentication strength policy with ID %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving authentication strength policy with ID %q", d.Id())
+	}
+
+	if _, err := c.Delete(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting authentication strength policy with ID %q", d.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const (
+	authorizationPolicyResourceName = "azuread_authorization_policy"
+	authorizationPolicyId           = "authorizationPolicy"
+)
+
+func authorizationPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: authorizationPolicyResourceCreate,
+		ReadContext:   authorizationPolicyResourceRead,
+		UpdateContext: authorizationPolicyResourceUpdate,
+		DeleteContext: authorizationPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != authorizationPolicyId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, authorizationPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"allow_email_verified_users_to_join_organization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allow_invites_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.AllowInvitesFromNone,
+					client.AllowInvitesFromAdminsAndGuestInviters,
+					client.AllowInvitesFromAdminsGuestInvitersAndAllMembers,
+					client.AllowInvitesFromEveryone,
+				}, false),
+			},
+
+			"allowed_to_sign_up_email_based_subscriptions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allowed_to_use_sspr": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"block_msol_powershell": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"default_user_role_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_to_create_apps": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"allowed_to_create_security_groups": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"allowed_to_read_other_users": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"guest_user_role_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func authorizationPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authorizationPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authorizationPolicyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func authorizationPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authorizationPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authorizationPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func authorizationPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authorizationPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authorizationPolicyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func authorizationPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(authorizationPolicyResourceName); diags != nil {
+		return diags
+	}
+	return authorizationPolicyResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,127 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+// The default role for guest users, which has limited access to properties and memberships of directory objects
+const defaultGuestUserRoleId = "10dae51f-b6af-4d8d-a681-3d4e2de3a6b0"
+
+func authorizationPolicyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	// The authorization policy always exists for a tenant, so we simply take ownership of it
+	if _, err := client.Update(ctx, expandAuthorizationPolicy(d)); err != nil {
+		return tf.ErrorDiagF(err, "Updating authorization policy")
+	}
+
+	d.SetId(authorizationPolicyId)
+
+	return authorizationPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func authorizationPolicyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	policy, _, err := client.Get(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving authorization policy")
+	}
+
+	tf.Set(d, "allow_email_verified_users_to_join_organization", policy.AllowEmailVerifiedUsersToJoinOrganization)
+	tf.Set(d, "allow_invites_from", policy.AllowInvitesFrom)
+	tf.Set(d, "allowed_to_sign_up_email_based_subscriptions", policy.AllowedToSignUpEmailBasedSubscriptions)
+	tf.Set(d, "allowed_to_use_sspr", policy.AllowedToUseSSPR)
+	tf.Set(d, "block_msol_powershell", policy.BlockMsolPowerShell)
+	tf.Set(d, "default_user_role_permissions", flattenDefaultUserRolePermissions(policy.DefaultUserRolePermissions))
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "guest_user_role_id", policy.GuestUserRoleId)
+
+	return nil
+}
+
+func authorizationPolicyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	if _, err := client.Update(ctx, expandAuthorizationPolicy(d)); err != nil {
+		return tf.ErrorDiagF(err, "Updating authorization policy")
+	}
+
+	return authorizationPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func authorizationPolicyResourceDeleteMsGraph(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+
+	// The policy cannot be deleted, so restore the default settings for a new tenant. The guest invitation and access
+	// settings are left unchanged, since these are only managed when specified and may instead be managed by the
+	// azuread_guest_user_settings resource.
+	properties := client.AuthorizationPolicy{
+		AllowedToSignUpEmailBasedSubscriptions:    utils.Bool(true),
+		AllowedToUseSSPR:                          utils.Bool(true),
+		AllowEmailVerifiedUsersToJoinOrganization: utils.Bool(true),
+		BlockMsolPowerShell:                       utils.Bool(false),
+		DefaultUserRolePermissions: &client.DefaultUserRolePermissions{
+			AllowedToCreateApps:           utils.Bool(true),
+			AllowedToCreateSecurityGroups: utils.Bool(true),
+			AllowedToReadOtherUsers:       utils.Bool(true),
+		},
+	}
+
+	if _, err := c.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Restoring default authorization policy")
+	}
+
+	return nil
+}
+
+func expandAuthorizationPolicy(d *schema.ResourceData) client.AuthorizationPolicy {
+	policy := client.AuthorizationPolicy{
+		AllowedToSignUpEmailBasedSubscriptions:    utils.Bool(d.Get("allowed_to_sign_up_email_based_subscriptions").(bool)),
+		AllowedToUseSSPR:                          utils.Bool(d.Get("allowed_to_use_sspr").(bool)),
+		AllowEmailVerifiedUsersToJoinOrganization: utils.Bool(d.Get("allow_email_verified_users_to_join_organization").(bool)),
+		BlockMsolPowerShell:                       utils.Bool(d.Get("block_msol_powershell").(bool)),
+	}
+
+	if v, ok := d.GetOk("default_user_role_permissions"); ok {
+		if permissions := v.([]interface{}); len(permissions) > 0 && permissions[0] != nil {
+			p := permissions[0].(map[string]interface{})
+			policy.DefaultUserRolePermissions = &client.DefaultUserRolePermissions{
+				AllowedToCreateApps:           utils.Bool(p["allowed_to_create_apps"].(bool)),
+				AllowedToCreateSecurityGroups: utils.Bool(p["allowed_to_create_security_groups"].(bool)),
+				AllowedToReadOtherUsers:       utils.Bool(p["allowed_to_read_other_users"].(bool)),
+			}
+		}
+	}
+
+	// These are only sent when specified, so that they can be managed by the azuread_guest_user_settings resource
+	if v, ok := d.GetOk("allow_invites_from"); ok && (d.IsNewResource() || d.HasChange("allow_invites_from")) {
+		policy.AllowInvitesFrom = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("guest_user_role_id"); ok && (d.IsNewResource() || d.HasChange("guest_user_role_id")) {
+		policy.GuestUserRoleId = utils.String(v.(string))
+	}
+
+	return policy
+}
+
+func flattenDefaultUserRolePermissions(in *client.DefaultUserRolePermissions) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"allowed_to_create_apps":            in.AllowedToCreateApps != nil && *in.AllowedToCreateApps,
+		"allowed_to_create_security_groups": in.AllowedToCreateSecurityGroups != nil && *in.AllowedToCreateSecurityGroups,
+		"allowed_to_read_other_users":       in.AllowedToReadOtherUsers != nil && *in.AllowedToReadOtherUsers,
+	}}
+}
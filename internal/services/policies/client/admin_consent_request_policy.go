@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// AdminConsentRequestPolicy describes the tenant-wide policy which allows users to request admin consent for
+// applications they are not permitted to consent to themselves.
+type AdminConsentRequestPolicy struct {
+	IsEnabled             *bool            `json:"isEnabled,omitempty"`
+	NotifyReviewers       *bool            `json:"notifyReviewers,omitempty"`
+	RemindersEnabled      *bool            `json:"remindersEnabled,omitempty"`
+	RequestDurationInDays *int             `json:"requestDurationInDays,omitempty"`
+	Reviewers             *[]ReviewerScope `json:"reviewers,omitempty"`
+	Version               *int             `json:"version,omitempty"`
+}
+
+// ReviewerScope identifies the users who can review admin consent requests, using a Microsoft Graph query.
+type ReviewerScope struct {
+	Query     *string `json:"query,omitempty"`
+	QueryRoot *string `json:"queryRoot,omitempty"`
+	QueryType *string `json:"queryType,omitempty"`
+}
+
+const ReviewerScopeQueryTypeMicrosoftGraph = "MicrosoftGraph"
+
+// AdminConsentRequestPolicyClient performs operations on the Admin Consent Request Policy.
+type AdminConsentRequestPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAdminConsentRequestPolicyClient returns a new AdminConsentRequestPolicyClient.
+func NewAdminConsentRequestPolicyClient(tenantId string) *AdminConsentRequestPolicyClient {
+	return &AdminConsentRequestPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Get retrieves the Admin Consent Request Policy.
+func (c *AdminConsentRequestPolicyClient) Get(ctx context.Context) (*AdminConsentRequestPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/adminConsentRequestPolicy",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AdminConsentRequestPolicyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var policy AdminConsentRequestPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &policy, status, nil
+}
+
+// Update replaces the Admin Consent Request Policy. All properties must be specified, since the policy does not
+// support partial updates.
+func (c *AdminConsentRequestPolicyClient) Update(ctx context.Context, policy AdminConsentRequestPolicy) (int, error) {
+	var status int
+	policy.Version = nil
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Put(ctx, msgraph.PutHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/adminConsentRequestPolicy",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AdminConsentRequestPolicyClient.BaseClient.Put(): %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,295 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const (
+	AppManagementPolicyObjectTypeApplication      = "#microsoft.graph.application"
+	AppManagementPolicyObjectTypeServicePrincipal = "#microsoft.graph.servicePrincipal"
+)
+
+// AppManagementPolicy describes a custom policy which restricts the credentials of the applications and service
+// principals it is assigned to.
+type AppManagementPolicy struct {
+	ID           *string                     `json:"id,omitempty"`
+	Description  *string                     `json:"description,omitempty"`
+	DisplayName  *string                     `json:"displayName,omitempty"`
+	IsEnabled    *bool                       `json:"isEnabled,omitempty"`
+	Restrictions *AppManagementConfiguration `json:"restrictions,omitempty"`
+}
+
+// TenantAppManagementPolicy describes the tenant-wide default policy which restricts the credentials of applications
+// and service principals.
+type TenantAppManagementPolicy struct {
+	ID                           *string                     `json:"id,omitempty"`
+	ApplicationRestrictions      *AppManagementConfiguration `json:"applicationRestrictions,omitempty"`
+	Description                  *string                     `json:"description,omitempty"`
+	DisplayName                  *string                     `json:"displayName,omitempty"`
+	IsEnabled                    *bool                       `json:"isEnabled,omitempty"`
+	ServicePrincipalRestrictions *AppManagementConfiguration `json:"servicePrincipalRestrictions,omitempty"`
+}
+
+type AppManagementConfiguration struct {
+	KeyCredentials      *[]CredentialConfiguration `json:"keyCredentials,omitempty"`
+	PasswordCredentials *[]CredentialConfiguration `json:"passwordCredentials,omitempty"`
+}
+
+type CredentialConfiguration struct {
+	MaxLifetime                         *string `json:"maxLifetime,omitempty"`
+	RestrictForAppsCreatedAfterDateTime *string `json:"restrictForAppsCreatedAfterDateTime,omitempty"`
+	RestrictionType                     *string `json:"restrictionType,omitempty"`
+}
+
+// AppManagementPolicyClient performs operations on App Management Policies.
+type AppManagementPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAppManagementPolicyClient returns a new AppManagementPolicyClient.
+func NewAppManagementPolicyClient(tenantId string) *AppManagementPolicyClient {
+	return &AppManagementPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// GetDefault retrieves the tenant-wide default App Management Policy.
+func (c *AppManagementPolicyClient) GetDefault(ctx context.Context) (*TenantAppManagementPolicy, int, error) {
+	var policy TenantAppManagementPolicy
+	status, err := c.get(ctx, "/policies/defaultAppManagementPolicy", &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+// UpdateDefault amends the tenant-wide default App Management Policy.
+func (c *AppManagementPolicyClient) UpdateDefault(ctx context.Context, policy TenantAppManagementPolicy) (int, error) {
+	return c.patch(ctx, "/policies/defaultAppManagementPolicy", policy)
+}
+
+// Create creates a new App Management Policy.
+func (c *AppManagementPolicyClient) Create(ctx context.Context, policy AppManagementPolicy) (*AppManagementPolicy, int, error) {
+	var status int
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/appManagementPolicies",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newPolicy AppManagementPolicy
+	if err := json.Unmarshal(respBody, &newPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newPolicy, status, nil
+}
+
+// Get retrieves an App Management Policy.
+func (c *AppManagementPolicyClient) Get(ctx context.Context, id string) (*AppManagementPolicy, int, error) {
+	var policy AppManagementPolicy
+	status, err := c.get(ctx, fmt.Sprintf("/policies/appManagementPolicies/%s", id), &policy)
+	if err != nil {
+		return nil, status, err
+	}
+	return &policy, status, nil
+}
+
+// Update amends an existing App Management Policy.
+func (c *AppManagementPolicyClient) Update(ctx context.Context, policy AppManagementPolicy) (int, error) {
+	var status int
+	if policy.ID == nil {
+		return status, fmt.Errorf("cannot update app management policy with nil ID")
+	}
+	id := *policy.ID
+	policy.ID = nil
+	return c.patch(ctx, fmt.Sprintf("/policies/appManagementPolicies/%s", id), policy)
+}
+
+// Delete removes an App Management Policy.
+func (c *AppManagementPolicyClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/appManagementPolicies/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
+
+// ListAppliesTo returns the object IDs of the applications and service principals to which an App Management Policy
+// is assigned, keyed by object ID with the OData type of each object as the value.
+func (c *AppManagementPolicyClient) ListAppliesTo(ctx context.Context, id string) (map[string]string, int, error) {
+	var data struct {
+		Objects []struct {
+			ODataType *string `json:"@odata.type"`
+			ID        *string `json:"id"`
+		} `json:"value"`
+	}
+	status, err := c.get(ctx, fmt.Sprintf("/policies/appManagementPolicies/%s/appliesTo", id), &data)
+	if err != nil {
+		return nil, status, err
+	}
+	result := make(map[string]string)
+	for _, object := range data.Objects {
+		if object.ID != nil && object.ODataType != nil {
+			result[*object.ID] = *object.ODataType
+		}
+	}
+	return result, status, nil
+}
+
+// ListForObject returns the App Management Policies assigned to an application or service principal.
+func (c *AppManagementPolicyClient) ListForObject(ctx context.Context, objectType, objectId string) (*[]AppManagementPolicy, int, error) {
+	collection, err := appManagementPolicyObjectCollection(objectType)
+	if err != nil {
+		return nil, 0, err
+	}
+	var data struct {
+		Policies []AppManagementPolicy `json:"value"`
+	}
+	status, err := c.get(ctx, fmt.Sprintf("/%s/%s/appManagementPolicies", collection, objectId), &data)
+	if err != nil {
+		return nil, status, err
+	}
+	return &data.Policies, status, nil
+}
+
+// GetObjectType returns the OData type of the directory object with the specified object ID.
+func (c *AppManagementPolicyClient) GetObjectType(ctx context.Context, objectId string) (string, int, error) {
+	var object struct {
+		ODataType *string `json:"@odata.type"`
+	}
+	status, err := c.get(ctx, fmt.Sprintf("/directoryObjects/%s", objectId), &object)
+	if err != nil {
+		return "", status, err
+	}
+	if object.ODataType == nil {
+		return "", status, fmt.Errorf("nil OData type returned for directory object %q", objectId)
+	}
+	return *object.ODataType, status, nil
+}
+
+// Assign assigns an App Management Policy to an application or service principal.
+func (c *AppManagementPolicyClient) Assign(ctx context.Context, id, objectType, objectId string) (int, error) {
+	var status int
+	collection, err := appManagementPolicyObjectCollection(objectType)
+	if err != nil {
+		return status, err
+	}
+	body, err := json.Marshal(struct {
+		ODataId string `json:"@odata.id"`
+	}{
+		ODataId: fmt.Sprintf("%s/%s/policies/appManagementPolicies/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, id),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/%s/%s/appManagementPolicies/$ref", collection, objectId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Post(): %v", err)
+	}
+	return status, nil
+}
+
+// Unassign removes the assignment of an App Management Policy from an application or service principal.
+func (c *AppManagementPolicyClient) Unassign(ctx context.Context, id, objectType, objectId string) (int, error) {
+	var status int
+	collection, err := appManagementPolicyObjectCollection(objectType)
+	if err != nil {
+		return status, err
+	}
+	_, status, _, err = c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/%s/%s/appManagementPolicies/%s/$ref", collection, objectId, id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
+
+func appManagementPolicyObjectCollection(objectType string) (string, error) {
+	switch objectType {
+	case AppManagementPolicyObjectTypeApplication:
+		return "applications", nil
+	case AppManagementPolicyObjectTypeServicePrincipal:
+		return "servicePrincipals", nil
+	}
+	return "", fmt.Errorf("app management policies cannot be assigned to objects of type %q", objectType)
+}
+
+func (c *AppManagementPolicyClient) get(ctx context.Context, entity string, out interface{}) (int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return status, nil
+}
+
+func (c *AppManagementPolicyClient) patch(ctx context.Context, entity string, in interface{}) (int, error) {
+	var status int
+	body, err := json.Marshal(in)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AppManagementPolicyClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
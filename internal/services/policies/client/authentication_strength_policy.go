@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// AuthenticationStrengthPolicy describes the combinations of authentication methods which satisfy an authentication
+// strength, for use in conditional access policies.
+type AuthenticationStrengthPolicy struct {
+	ID                  *string   `json:"id,omitempty"`
+	AllowedCombinations *[]string `json:"allowedCombinations,omitempty"`
+	Description         *string   `json:"description,omitempty"`
+	DisplayName         *string   `json:"displayName,omitempty"`
+	PolicyType          *string   `json:"policyType,omitempty"`
+}
+
+// AuthenticationStrengthPolicyClient performs operations on Authentication Strength Policies.
+type AuthenticationStrengthPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAuthenticationStrengthPolicyClient returns a new AuthenticationStrengthPolicyClient.
+func NewAuthenticationStrengthPolicyClient(tenantId string) *AuthenticationStrengthPolicyClient {
+	return &AuthenticationStrengthPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Create creates a new Authentication Strength Policy.
+func (c *AuthenticationStrengthPolicyClient) Create(ctx context.Context, policy AuthenticationStrengthPolicy) (*AuthenticationStrengthPolicy, int, error) {
+	var status int
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/authenticationStrengthPolicies",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationStrengthPolicyClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newPolicy AuthenticationStrengthPolicy
+	if err := json.Unmarshal(respBody, &newPolicy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newPolicy, status, nil
+}
+
+// Get retrieves an Authentication Strength Policy.
+func (c *AuthenticationStrengthPolicyClient) Get(ctx context.Context, id string) (*AuthenticationStrengthPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/authenticationStrengthPolicies/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationStrengthPolicyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var policy AuthenticationStrengthPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &policy, status, nil
+}
+
+// Update amends the display name and description of an existing Authentication Strength Policy. The allowed
+// combinations cannot be changed with this method, use UpdateAllowedCombinations instead.
+func (c *AuthenticationStrengthPolicyClient) Update(ctx context.Context, policy AuthenticationStrengthPolicy) (int, error) {
+	var status int
+	if policy.ID == nil {
+		return status, fmt.Errorf("cannot update authentication strength policy with nil ID")
+	}
+	body, err := json.Marshal(AuthenticationStrengthPolicy{
+		Description: policy.Description,
+		DisplayName: policy.DisplayName,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/authenticationStrengthPolicies/%s", *policy.ID),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationStrengthPolicyClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// UpdateAllowedCombinations replaces the allowed combinations of authentication methods for an Authentication
+// Strength Policy.
+func (c *AuthenticationStrengthPolicyClient) UpdateAllowedCombinations(ctx context.Context, id string, allowedCombinations []string) (int, error) {
+	var status int
+	body, err := json.Marshal(struct {
+		AllowedCombinations []string `json:"allowedCombinations"`
+	}{
+		AllowedCombinations: allowedCombinations,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/authenticationStrengthPolicies/%s/updateAllowedCombinations", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationStrengthPolicyClient.BaseClient.Post(): %v", err)
+	}
+	return status, nil
+}
+
+// Delete removes an Authentication Strength Policy.
+func (c *AuthenticationStrengthPolicyClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/authenticationStrengthPolicies/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationStrengthPolicyClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// AuthorizationPolicy describes the tenant-wide Authorization Policy.
+type AuthorizationPolicy struct {
+	ID                                        *string                     `json:"id,omitempty"`
+	AllowedToSignUpEmailBasedSubscriptions    *bool                       `json:"allowedToSignUpEmailBasedSubscriptions,omitempty"`
+	AllowedToUseSSPR                          *bool                       `json:"allowedToUseSSPR,omitempty"`
+	AllowEmailVerifiedUsersToJoinOrganization *bool                       `json:"allowEmailVerifiedUsersToJoinOrganization,omitempty"`
+	AllowInvitesFrom                          *string                     `json:"allowInvitesFrom,omitempty"`
+	BlockMsolPowerShell                       *bool                       `json:"blockMsolPowerShell,omitempty"`
+	DefaultUserRolePermissions                *DefaultUserRolePermissions `json:"defaultUserRolePermissions,omitempty"`
+	Description                               *string                     `json:"description,omitempty"`
+	DisplayName                               *string                     `json:"displayName,omitempty"`
+	GuestUserRoleId                           *string                     `json:"guestUserRoleId,omitempty"`
+}
+
+type DefaultUserRolePermissions struct {
+	AllowedToCreateApps           *bool `json:"allowedToCreateApps,omitempty"`
+	AllowedToCreateSecurityGroups *bool `json:"allowedToCreateSecurityGroups,omitempty"`
+	AllowedToReadOtherUsers       *bool `json:"allowedToReadOtherUsers,omitempty"`
+}
+
+const (
+	AllowInvitesFromNone                             = "none"
+	AllowInvitesFromAdminsAndGuestInviters           = "adminsAndGuestInviters"
+	AllowInvitesFromAdminsGuestInvitersAndAllMembers = "adminsGuestInvitersAndAllMembers"
+	AllowInvitesFromEveryone                         = "everyone"
+)
+
+// AuthorizationPolicyClient performs operations on the Authorization Policy.
+type AuthorizationPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewAuthorizationPolicyClient returns a new AuthorizationPolicyClient.
+func NewAuthorizationPolicyClient(tenantId string) *AuthorizationPolicyClient {
+	return &AuthorizationPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// Get retrieves the Authorization Policy.
+func (c *AuthorizationPolicyClient) Get(ctx context.Context) (*AuthorizationPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/authorizationPolicy",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthorizationPolicyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var policy AuthorizationPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &policy, status, nil
+}
+
+// Update amends the Authorization Policy.
+func (c *AuthorizationPolicyClient) Update(ctx context.Context, policy AuthorizationPolicy) (int, error) {
+	var status int
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/authorizationPolicy",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthorizationPolicyClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+const B2BManagementPolicyType = "B2BManagementPolicy"
+
+// B2BManagementPolicy describes the legacy policy which restricts the domains to which B2B invitations can be sent.
+// The policy settings are held in a JSON-encoded definition.
+type B2BManagementPolicy struct {
+	ID                    *string   `json:"id,omitempty"`
+	Definition            *[]string `json:"definition,omitempty"`
+	DisplayName           *string   `json:"displayName,omitempty"`
+	IsOrganizationDefault *bool     `json:"isOrganizationDefault,omitempty"`
+	Type                  *string   `json:"type,omitempty"`
+}
+
+// B2BManagementPolicyDefinition is the decoded definition of a B2BManagementPolicy.
+type B2BManagementPolicyDefinition struct {
+	B2BManagementPolicy B2BManagementPolicySettings `json:"B2BManagementPolicy"`
+}
+
+type B2BManagementPolicySettings struct {
+	InvitationsAllowedAndBlockedDomainsPolicy *InvitationsAllowedAndBlockedDomainsPolicy `json:"InvitationsAllowedAndBlockedDomainsPolicy,omitempty"`
+}
+
+// InvitationsAllowedAndBlockedDomainsPolicy holds either a list of allowed domains, or a list of blocked domains.
+type InvitationsAllowedAndBlockedDomainsPolicy struct {
+	AllowedDomains *[]string `json:"AllowedDomains,omitempty"`
+	BlockedDomains *[]string `json:"BlockedDomains,omitempty"`
+}
+
+// DomainsPolicy decodes the definition of the policy and returns the allowed or blocked domains, if any.
+func (p B2BManagementPolicy) DomainsPolicy() (*InvitationsAllowedAndBlockedDomainsPolicy, error) {
+	if p.Definition == nil || len(*p.Definition) == 0 {
+		return nil, nil
+	}
+	var definition B2BManagementPolicyDefinition
+	if err := json.Unmarshal([]byte((*p.Definition)[0]), &definition); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return definition.B2BManagementPolicy.InvitationsAllowedAndBlockedDomainsPolicy, nil
+}
+
+// NewB2BManagementPolicy returns a B2BManagementPolicy with a definition encoding the specified domains policy.
+func NewB2BManagementPolicy(domains InvitationsAllowedAndBlockedDomainsPolicy) (*B2BManagementPolicy, error) {
+	definition, err := json.Marshal(B2BManagementPolicyDefinition{
+		B2BManagementPolicy: B2BManagementPolicySettings{
+			InvitationsAllowedAndBlockedDomainsPolicy: &domains,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	policyType := B2BManagementPolicyType
+	isOrganizationDefault := true
+	return &B2BManagementPolicy{
+		Definition:            &[]string{string(definition)},
+		DisplayName:           &policyType,
+		IsOrganizationDefault: &isOrganizationDefault,
+		Type:                  &policyType,
+	}, nil
+}
+
+// B2BManagementPolicyClient performs operations on the B2B Management Policy, which is only available in the beta API.
+type B2BManagementPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewB2BManagementPolicyClient returns a new B2BManagementPolicyClient.
+func NewB2BManagementPolicyClient(tenantId string) *B2BManagementPolicyClient {
+	return &B2BManagementPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.VersionBeta, tenantId),
+	}
+}
+
+// Get retrieves the B2B Management Policy. A 404 status is returned when the tenant does not have one.
+func (c *B2BManagementPolicyClient) Get(ctx context.Context) (*B2BManagementPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      "/legacy/policies",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2BManagementPolicyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var data struct {
+		Policies []B2BManagementPolicy `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	for _, policy := range data.Policies {
+		if policy.Type != nil && *policy.Type == B2BManagementPolicyType {
+			policy := policy
+			return &policy, status, nil
+		}
+	}
+	return nil, http.StatusNotFound, fmt.Errorf("B2B Management Policy not found")
+}
+
+// Create creates the B2B Management Policy.
+func (c *B2BManagementPolicyClient) Create(ctx context.Context, policy B2BManagementPolicy) (int, error) {
+	var status int
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/legacy/policies",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2BManagementPolicyClient.BaseClient.Post(): %v", err)
+	}
+	return status, nil
+}
+
+// Update amends the B2B Management Policy with the specified ID.
+func (c *B2BManagementPolicyClient) Update(ctx context.Context, id string, policy B2BManagementPolicy) (int, error) {
+	var status int
+	policy.ID = nil
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/legacy/policies/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2BManagementPolicyClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
+
+// Delete removes the B2B Management Policy with the specified ID.
+func (c *B2BManagementPolicyClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/legacy/policies/%s", id),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2BManagementPolicyClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	AdminConsentRequestPolicyClient    *AdminConsentRequestPolicyClient
+	AppManagementPolicyClient          *AppManagementPolicyClient
+	AuthenticationStrengthPolicyClient *AuthenticationStrengthPolicyClient
+	AuthorizationPolicyClient          *AuthorizationPolicyClient
+	B2BManagementPolicyClient          *B2BManagementPolicyClient
+	CrossTenantAccessPolicyClient      *CrossTenantAccessPolicyClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	adminConsentRequestPolicyClient := NewAdminConsentRequestPolicyClient(o.TenantID)
+	o.ConfigureMsGraphClient(&adminConsentRequestPolicyClient.BaseClient)
+
+	appManagementPolicyClient := NewAppManagementPolicyClient(o.TenantID)
+	o.ConfigureMsGraphClient(&appManagementPolicyClient.BaseClient)
+
+	authenticationStrengthPolicyClient := NewAuthenticationStrengthPolicyClient(o.TenantID)
+	o.ConfigureMsGraphClient(&authenticationStrengthPolicyClient.BaseClient)
+
+	authorizationPolicyClient := NewAuthorizationPolicyClient(o.TenantID)
+	o.ConfigureMsGraphClient(&authorizationPolicyClient.BaseClient)
+
+	b2bManagementPolicyClient := NewB2BManagementPolicyClient(o.TenantID)
+	o.ConfigureMsGraphBetaClient(&b2bManagementPolicyClient.BaseClient)
+
+	crossTenantAccessPolicyClient := NewCrossTenantAccessPolicyClient(o.TenantID)
+	o.ConfigureMsGraphClient(&crossTenantAccessPolicyClient.BaseClient)
+
+	return &Client{
+		AdminConsentRequestPolicyClient:    adminConsentRequestPolicyClient,
+		AppManagementPolicyClient:          appManagementPolicyClient,
+		AuthenticationStrengthPolicyClient: authenticationStrengthPolicyClient,
+		AuthorizationPolicyClient:          authorizationPolicyClient,
+		B2BManagementPolicyClient:          b2bManagementPolicyClient,
+		CrossTenantAccessPolicyClient:      crossTenantAccessPolicyClient,
+	}
+}
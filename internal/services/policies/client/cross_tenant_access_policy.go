@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// CrossTenantAccessPolicyConfiguration describes the default or partner-specific configuration of the Cross Tenant Access Policy.
+type CrossTenantAccessPolicyConfiguration struct {
+	TenantId                     *string                              `json:"tenantId,omitempty"`
+	AutomaticUserConsentSettings *InboundOutboundPolicyConfiguration  `json:"automaticUserConsentSettings,omitempty"`
+	InboundTrust                 *CrossTenantAccessPolicyInboundTrust `json:"inboundTrust,omitempty"`
+	IsServiceDefault             *bool                                `json:"isServiceDefault,omitempty"`
+	IsServiceProvider            *bool                                `json:"isServiceProvider,omitempty"`
+}
+
+type CrossTenantAccessPolicyInboundTrust struct {
+	IsCompliantDeviceAccepted           *bool `json:"isCompliantDeviceAccepted,omitempty"`
+	IsHybridAzureADJoinedDeviceAccepted *bool `json:"isHybridAzureADJoinedDeviceAccepted,omitempty"`
+	IsMfaAccepted                       *bool `json:"isMfaAccepted,omitempty"`
+}
+
+type InboundOutboundPolicyConfiguration struct {
+	InboundAllowed  *bool `json:"inboundAllowed,omitempty"`
+	OutboundAllowed *bool `json:"outboundAllowed,omitempty"`
+}
+
+// CrossTenantAccessPolicyClient performs operations on the Cross Tenant Access Policy.
+type CrossTenantAccessPolicyClient struct {
+	BaseClient msgraph.Client
+}
+
+// NewCrossTenantAccessPolicyClient returns a new CrossTenantAccessPolicyClient.
+func NewCrossTenantAccessPolicyClient(tenantId string) *CrossTenantAccessPolicyClient {
+	return &CrossTenantAccessPolicyClient{
+		BaseClient: msgraph.NewClient(msgraph.Version10, tenantId),
+	}
+}
+
+// GetDefault retrieves the default configuration, which applies to all tenants without a partner configuration.
+func (c *CrossTenantAccessPolicyClient) GetDefault(ctx context.Context) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	return c.get(ctx, "/policies/crossTenantAccessPolicy/default")
+}
+
+// UpdateDefault amends the default configuration.
+func (c *CrossTenantAccessPolicyClient) UpdateDefault(ctx context.Context, configuration CrossTenantAccessPolicyConfiguration) (int, error) {
+	return c.update(ctx, "/policies/crossTenantAccessPolicy/default", configuration)
+}
+
+// ResetDefault restores the default configuration to the system defaults.
+func (c *CrossTenantAccessPolicyClient) ResetDefault(ctx context.Context) (int, error) {
+	_, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/crossTenantAccessPolicy/default/resetToSystemDefault",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Post(): %v", err)
+	}
+	return status, nil
+}
+
+// CreatePartner creates a configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) CreatePartner(ctx context.Context, configuration CrossTenantAccessPolicyConfiguration) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	var status int
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, msgraph.PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: msgraph.Uri{
+			Entity:      "/policies/crossTenantAccessPolicy/partners",
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var newConfiguration CrossTenantAccessPolicyConfiguration
+	if err := json.Unmarshal(respBody, &newConfiguration); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newConfiguration, status, nil
+}
+
+// GetPartner retrieves the configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) GetPartner(ctx context.Context, tenantId string) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	return c.get(ctx, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId))
+}
+
+// UpdatePartner amends the configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) UpdatePartner(ctx context.Context, configuration CrossTenantAccessPolicyConfiguration) (int, error) {
+	var status int
+	if configuration.TenantId == nil {
+		return status, fmt.Errorf("cannot update partner configuration with nil tenant ID")
+	}
+	tenantId := *configuration.TenantId
+	configuration.TenantId = nil
+	return c.update(ctx, fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId), configuration)
+}
+
+// DeletePartner removes the configuration for a partner tenant.
+func (c *CrossTenantAccessPolicyClient) DeletePartner(ctx context.Context, tenantId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, msgraph.DeleteHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      fmt.Sprintf("/policies/crossTenantAccessPolicy/partners/%s", tenantId),
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Delete(): %v", err)
+	}
+	return status, nil
+}
+
+func (c *CrossTenantAccessPolicyClient) get(ctx context.Context, entity string) (*CrossTenantAccessPolicyConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, msgraph.GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("ioutil.ReadAll(): %v", err)
+	}
+	var configuration CrossTenantAccessPolicyConfiguration
+	if err := json.Unmarshal(respBody, &configuration); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &configuration, status, nil
+}
+
+func (c *CrossTenantAccessPolicyClient) update(ctx context.Context, entity string, configuration CrossTenantAccessPolicyConfiguration) (int, error) {
+	var status int
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	_, status, _, err = c.BaseClient.Patch(ctx, msgraph.PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: msgraph.Uri{
+			Entity:      entity,
+			HasTenantId: true,
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("CrossTenantAccessPolicyClient.BaseClient.Patch(): %v", err)
+	}
+	return status, nil
+}
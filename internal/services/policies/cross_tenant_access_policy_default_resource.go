@@ -0,0 +1,80 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+const (
+	crossTenantAccessPolicyDefaultResourceName = "azuread_cross_tenant_access_policy_default"
+	crossTenantAccessPolicyDefaultId           = "crossTenantAccessPolicy/default"
+)
+
+func crossTenantAccessPolicyDefaultResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crossTenantAccessPolicyDefaultResourceCreate,
+		ReadContext:   crossTenantAccessPolicyDefaultResourceRead,
+		UpdateContext: crossTenantAccessPolicyDefaultResourceUpdate,
+		DeleteContext: crossTenantAccessPolicyDefaultResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != crossTenantAccessPolicyDefaultId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, crossTenantAccessPolicyDefaultId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"automatic_user_consent": schemaCrossTenantAutomaticUserConsent(),
+
+			"inbound_trust": schemaCrossTenantInboundTrust(),
+
+			"is_service_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyDefaultResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyDefaultResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyDefaultResourceCreateMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyDefaultResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyDefaultResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyDefaultResourceReadMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyDefaultResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyDefaultResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyDefaultResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyDefaultResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyDefaultResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyDefaultResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,59 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func crossTenantAccessPolicyDefaultResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+
+	// The default configuration always exists for a tenant, so we simply take ownership of it
+	if _, err := client.UpdateDefault(ctx, expandCrossTenantAccessPolicyConfiguration(d)); err != nil {
+		return tf.ErrorDiagF(err, "Updating default cross-tenant access configuration")
+	}
+
+	d.SetId(crossTenantAccessPolicyDefaultId)
+
+	return crossTenantAccessPolicyDefaultResourceReadMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyDefaultResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+
+	configuration, _, err := client.GetDefault(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving default cross-tenant access configuration")
+	}
+
+	tf.Set(d, "automatic_user_consent", flattenCrossTenantAutomaticUserConsent(configuration.AutomaticUserConsentSettings))
+	tf.Set(d, "inbound_trust", flattenCrossTenantInboundTrust(configuration.InboundTrust))
+	tf.Set(d, "is_service_default", configuration.IsServiceDefault)
+
+	return nil
+}
+
+func crossTenantAccessPolicyDefaultResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+
+	if _, err := client.UpdateDefault(ctx, expandCrossTenantAccessPolicyConfiguration(d)); err != nil {
+		return tf.ErrorDiagF(err, "Updating default cross-tenant access configuration")
+	}
+
+	return crossTenantAccessPolicyDefaultResourceReadMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyDefaultResourceDeleteMsGraph(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+
+	if _, err := client.ResetDefault(ctx); err != nil {
+		return tf.ErrorDiagF(err, "Resetting default cross-tenant access configuration to system defaults")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type CrossTenantAccessPolicyDefaultResource struct{}
+
+func TestAccCrossTenantAccessPolicyDefault_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_default", "test")
+	r := CrossTenantAccessPolicyDefaultResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inbound_trust.0.mfa_accepted").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CrossTenantAccessPolicyDefaultResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	if _, _, err := clients.Policies.CrossTenantAccessPolicyClient.GetDefault(ctx); err != nil {
+		return nil, fmt.Errorf("failed to retrieve default Cross-Tenant Access Configuration: %+v", err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (CrossTenantAccessPolicyDefaultResource) basic() string {
+	return `resource "azuread_cross_tenant_access_policy_default" "test" {}`
+}
+
+func (CrossTenantAccessPolicyDefaultResource) complete() string {
+	return `
+resource "azuread_cross_tenant_access_policy_default" "test" {
+  inbound_trust {
+    compliant_device_accepted              = true
+    hybrid_azure_ad_joined_device_accepted = true
+    mfa_accepted                           = true
+  }
+}
+`
+}
@@ -0,0 +1,86 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const crossTenantAccessPolicyPartnerResourceName = "azuread_cross_tenant_access_policy_partner"
+
+func crossTenantAccessPolicyPartnerResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: crossTenantAccessPolicyPartnerResourceCreate,
+		ReadContext:   crossTenantAccessPolicyPartnerResourceRead,
+		UpdateContext: crossTenantAccessPolicyPartnerResourceUpdate,
+		DeleteContext: crossTenantAccessPolicyPartnerResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"automatic_user_consent": schemaCrossTenantAutomaticUserConsent(),
+
+			"inbound_trust": schemaCrossTenantInboundTrust(),
+
+			"is_service_provider": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func crossTenantAccessPolicyPartnerResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyPartnerResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyPartnerResourceCreateMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyPartnerResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyPartnerResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyPartnerResourceReadMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyPartnerResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyPartnerResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyPartnerResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyPartnerResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(crossTenantAccessPolicyPartnerResourceName); diags != nil {
+		return diags
+	}
+	return crossTenantAccessPolicyPartnerResourceDeleteMsGraph(ctx, d, meta)
+}
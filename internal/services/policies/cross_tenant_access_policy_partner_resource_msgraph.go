@@ -0,0 +1,99 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func crossTenantAccessPolicyPartnerResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+	tenantId := d.Get("tenant_id").(string)
+
+	existing, status, err := client.GetPartner(ctx, tenantId)
+	if err != nil && status != http.StatusNotFound {
+		return tf.ErrorDiagPathF(err, "tenant_id", "Checking for existing cross-tenant access configuration for tenant %q", tenantId)
+	}
+	if err == nil && existing != nil {
+		return tf.ImportAsExistsDiag(crossTenantAccessPolicyPartnerResourceName, tenantId)
+	}
+
+	properties := expandCrossTenantAccessPolicyConfiguration(d)
+	properties.TenantId = utils.String(tenantId)
+
+	if _, _, err := client.CreatePartner(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Creating cross-tenant access configuration for tenant %q", tenantId)
+	}
+
+	d.SetId(tenantId)
+
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return client.GetPartner(ctx, tenantId)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for cross-tenant access configuration for tenant %q", tenantId)
+	}
+
+	return crossTenantAccessPolicyPartnerResourceReadMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyPartnerResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+
+	configuration, status, err := client.GetPartner(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Cross-tenant access configuration for tenant %q was not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving cross-tenant access configuration for tenant %q", d.Id())
+	}
+
+	tf.Set(d, "automatic_user_consent", flattenCrossTenantAutomaticUserConsent(configuration.AutomaticUserConsentSettings))
+	tf.Set(d, "inbound_trust", flattenCrossTenantInboundTrust(configuration.InboundTrust))
+	tf.Set(d, "is_service_provider", configuration.IsServiceProvider)
+	tf.Set(d, "tenant_id", configuration.TenantId)
+
+	return nil
+}
+
+func crossTenantAccessPolicyPartnerResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+
+	properties := expandCrossTenantAccessPolicyConfiguration(d)
+	properties.TenantId = utils.String(d.Id())
+
+	if _, err := client.UpdatePartner(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating cross-tenant access configuration for tenant %q", d.Id())
+	}
+
+	return crossTenantAccessPolicyPartnerResourceReadMsGraph(ctx, d, meta)
+}
+
+func crossTenantAccessPolicyPartnerResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.CrossTenantAccessPolicyClient
+
+	_, status, err := client.GetPartner(ctx, d.Id())
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Cross-tenant access configuration was not found"), "id", "Retrieving cross-tenant access configuration for tenant %q", d.Id())
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving cross-tenant access configuration for tenant %q", d.Id())
+	}
+
+	if _, err := client.DeletePartner(ctx, d.Id()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting cross-tenant access configuration for tenant %q", d.Id())
+	}
+
+	return nil
+}
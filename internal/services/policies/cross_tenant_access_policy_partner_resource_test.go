@@ -0,0 +1,104 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type CrossTenantAccessPolicyPartnerResource struct{}
+
+func TestAccCrossTenantAccessPolicyPartner_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_partner", "test")
+	r := CrossTenantAccessPolicyPartnerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCrossTenantAccessPolicyPartner_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_cross_tenant_access_policy_partner", "test")
+	r := CrossTenantAccessPolicyPartnerResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inbound_trust.0.mfa_accepted").HasValue("true"),
+				check.That(data.ResourceName).Key("automatic_user_consent.0.inbound_allowed").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CrossTenantAccessPolicyPartnerResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	configuration, status, err := clients.Policies.CrossTenantAccessPolicyClient.GetPartner(ctx, state.ID)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Cross-Tenant Access Configuration for tenant %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Cross-Tenant Access Configuration for tenant %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(configuration.TenantId != nil && *configuration.TenantId == state.ID), nil
+}
+
+// The Microsoft Services tenant is used as a partner, since it always exists
+func (CrossTenantAccessPolicyPartnerResource) basic() string {
+	return `
+resource "azuread_cross_tenant_access_policy_partner" "test" {
+  tenant_id = "f8cdef31-a31e-4b4a-93e4-5f571e91255a"
+}
+`
+}
+
+func (CrossTenantAccessPolicyPartnerResource) complete() string {
+	return `
+resource "azuread_cross_tenant_access_policy_partner" "test" {
+  tenant_id = "f8cdef31-a31e-4b4a-93e4-5f571e91255a"
+
+  automatic_user_consent {
+    inbound_allowed  = true
+    outbound_allowed = true
+  }
+
+  inbound_trust {
+    compliant_device_accepted              = true
+    hybrid_azure_ad_joined_device_accepted = false
+    mfa_accepted                           = true
+  }
+}
+`
+}
@@ -0,0 +1,132 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const (
+	guestUserSettingsResourceName = "azuread_guest_user_settings"
+	guestUserSettingsId           = "guestUserSettings"
+)
+
+const (
+	guestUserAccessSameAsMember = "same_as_member"
+	guestUserAccessLimited      = "limited"
+	guestUserAccessRestricted   = "restricted"
+)
+
+// guestUserAccessRoleIds maps each guest user access level to the role template which grants it
+var guestUserAccessRoleIds = map[string]string{
+	guestUserAccessSameAsMember: "a0b1b346-4d3e-4e8b-98f8-753987be4970",
+	guestUserAccessLimited:      defaultGuestUserRoleId,
+	guestUserAccessRestricted:   "2af84b1e-32c8-42b7-82bc-daa82404023b",
+}
+
+func guestUserSettingsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: guestUserSettingsResourceCreate,
+		ReadContext:   guestUserSettingsResourceRead,
+		UpdateContext: guestUserSettingsResourceUpdate,
+		DeleteContext: guestUserSettingsResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != guestUserSettingsId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, guestUserSettingsId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"guest_user_access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  guestUserAccessLimited,
+				ValidateFunc: validation.StringInSlice([]string{
+					guestUserAccessSameAsMember,
+					guestUserAccessLimited,
+					guestUserAccessRestricted,
+				}, false),
+			},
+
+			"allow_invites_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  client.AllowInvitesFromEveryone,
+				ValidateFunc: validation.StringInSlice([]string{
+					client.AllowInvitesFromNone,
+					client.AllowInvitesFromAdminsAndGuestInviters,
+					client.AllowInvitesFromAdminsGuestInvitersAndAllMembers,
+					client.AllowInvitesFromEveryone,
+				}, false),
+			},
+
+			"allowed_domains": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Set:           schema.HashString,
+				ConflictsWith: []string{"blocked_domains"},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"blocked_domains": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Set:           schema.HashString,
+				ConflictsWith: []string{"allowed_domains"},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func guestUserSettingsResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(guestUserSettingsResourceName); diags != nil {
+		return diags
+	}
+	return guestUserSettingsResourceCreateMsGraph(ctx, d, meta)
+}
+
+func guestUserSettingsResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(guestUserSettingsResourceName); diags != nil {
+		return diags
+	}
+	return guestUserSettingsResourceReadMsGraph(ctx, d, meta)
+}
+
+func guestUserSettingsResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(guestUserSettingsResourceName); diags != nil {
+		return diags
+	}
+	return guestUserSettingsResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func guestUserSettingsResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(guestUserSettingsResourceName); diags != nil {
+		return diags
+	}
+	return guestUserSettingsResourceDeleteMsGraph(ctx, d, meta)
+}
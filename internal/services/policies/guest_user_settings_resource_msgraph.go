@@ -0,0 +1,172 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func guestUserSettingsResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The settings always exist for a tenant, so we simply take ownership of them
+	if diags := guestUserSettingsApplyMsGraph(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	d.SetId(guestUserSettingsId)
+
+	return guestUserSettingsResourceReadMsGraph(ctx, d, meta)
+}
+
+func guestUserSettingsResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authorizationPolicyClient := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+	b2bManagementPolicyClient := meta.(*clients.Client).Policies.B2BManagementPolicyClient
+
+	policy, _, err := authorizationPolicyClient.Get(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving authorization policy")
+	}
+
+	guestUserAccess := ""
+	if policy.GuestUserRoleId != nil {
+		for level, roleId := range guestUserAccessRoleIds {
+			if roleId == *policy.GuestUserRoleId {
+				guestUserAccess = level
+			}
+		}
+		if guestUserAccess == "" {
+			return tf.ErrorDiagPathF(fmt.Errorf("unrecognised guest user role ID %q", *policy.GuestUserRoleId), "guest_user_access", "Retrieving authorization policy")
+		}
+	}
+
+	allowedDomains := make([]string, 0)
+	blockedDomains := make([]string, 0)
+	domainsPolicy, err := guestUserSettingsGetDomainsPolicy(ctx, b2bManagementPolicyClient)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving B2B management policy")
+	}
+	if domainsPolicy != nil {
+		if domainsPolicy.AllowedDomains != nil {
+			allowedDomains = *domainsPolicy.AllowedDomains
+		}
+		if domainsPolicy.BlockedDomains != nil {
+			blockedDomains = *domainsPolicy.BlockedDomains
+		}
+	}
+
+	tf.Set(d, "allow_invites_from", policy.AllowInvitesFrom)
+	tf.Set(d, "allowed_domains", allowedDomains)
+	tf.Set(d, "blocked_domains", blockedDomains)
+	tf.Set(d, "guest_user_access", guestUserAccess)
+
+	return nil
+}
+
+func guestUserSettingsResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := guestUserSettingsApplyMsGraph(ctx, d, meta); diags != nil {
+		return diags
+	}
+
+	return guestUserSettingsResourceReadMsGraph(ctx, d, meta)
+}
+
+func guestUserSettingsResourceDeleteMsGraph(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authorizationPolicyClient := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+	b2bManagementPolicyClient := meta.(*clients.Client).Policies.B2BManagementPolicyClient
+
+	// The settings cannot be deleted, so restore the default settings for a new tenant
+	properties := client.AuthorizationPolicy{
+		AllowInvitesFrom: utils.String(client.AllowInvitesFromEveryone),
+		GuestUserRoleId:  utils.String(defaultGuestUserRoleId),
+	}
+	if _, err := authorizationPolicyClient.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Restoring default guest user settings")
+	}
+
+	if err := guestUserSettingsSetDomainsPolicy(ctx, b2bManagementPolicyClient, nil, nil); err != nil {
+		return tf.ErrorDiagF(err, "Removing collaboration domain restrictions")
+	}
+
+	return nil
+}
+
+func guestUserSettingsApplyMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	authorizationPolicyClient := meta.(*clients.Client).Policies.AuthorizationPolicyClient
+	b2bManagementPolicyClient := meta.(*clients.Client).Policies.B2BManagementPolicyClient
+
+	// Only the properties managed by this resource are sent, so that other authorization policy settings are retained
+	properties := client.AuthorizationPolicy{
+		AllowInvitesFrom: utils.String(d.Get("allow_invites_from").(string)),
+		GuestUserRoleId:  utils.String(guestUserAccessRoleIds[d.Get("guest_user_access").(string)]),
+	}
+	if _, err := authorizationPolicyClient.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating guest user settings")
+	}
+
+	allowedDomains := *tf.ExpandStringSlicePtr(d.Get("allowed_domains").(*schema.Set).List())
+	blockedDomains := *tf.ExpandStringSlicePtr(d.Get("blocked_domains").(*schema.Set).List())
+	if err := guestUserSettingsSetDomainsPolicy(ctx, b2bManagementPolicyClient, allowedDomains, blockedDomains); err != nil {
+		return tf.ErrorDiagF(err, "Updating collaboration domain restrictions")
+	}
+
+	return nil
+}
+
+// guestUserSettingsGetDomainsPolicy returns the collaboration domain restrictions for the tenant, or nil when there are none
+func guestUserSettingsGetDomainsPolicy(ctx context.Context, c *client.B2BManagementPolicyClient) (*client.InvitationsAllowedAndBlockedDomainsPolicy, error) {
+	policy, status, err := c.Get(ctx)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return policy.DomainsPolicy()
+}
+
+// guestUserSettingsSetDomainsPolicy creates, updates or removes the B2B management policy so that invitations are
+// restricted to the allowed domains, or excluded from the blocked domains. When neither are specified, the policy is removed.
+func guestUserSettingsSetDomainsPolicy(ctx context.Context, c *client.B2BManagementPolicyClient, allowedDomains, blockedDomains []string) error {
+	existing, status, err := c.Get(ctx)
+	if err != nil {
+		if status != http.StatusNotFound {
+			return err
+		}
+		existing = nil
+	}
+
+	if len(allowedDomains) == 0 && len(blockedDomains) == 0 {
+		if existing != nil && existing.ID != nil {
+			if _, err := c.Delete(ctx, *existing.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	domains := client.InvitationsAllowedAndBlockedDomainsPolicy{}
+	if len(allowedDomains) > 0 {
+		domains.AllowedDomains = &allowedDomains
+	} else {
+		domains.BlockedDomains = &blockedDomains
+	}
+
+	policy, err := client.NewB2BManagementPolicy(domains)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && existing.ID != nil {
+		_, err = c.Update(ctx, *existing.ID, *policy)
+	} else {
+		_, err = c.Create(ctx, *policy)
+	}
+	return err
+}
@@ -0,0 +1,132 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type GuestUserSettingsResource struct{}
+
+func TestAccGuestUserSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_guest_user_settings", "test")
+	r := GuestUserSettingsResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("guest_user_access").HasValue("limited"),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("everyone"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGuestUserSettings_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_guest_user_settings", "test")
+	r := GuestUserSettingsResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.allowedDomains(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("guest_user_access").HasValue("restricted"),
+				check.That(data.ResourceName).Key("allowed_domains.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.blockedDomains(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allowed_domains.#").HasValue("0"),
+				check.That(data.ResourceName).Key("blocked_domains.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blocked_domains.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGuestUserSettings_withAuthorizationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_guest_user_settings", "test")
+	r := GuestUserSettingsResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.withAuthorizationPolicy(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("guest_user_access").HasValue("restricted"),
+				check.That(data.ResourceName).Key("allow_invites_from").HasValue("adminsAndGuestInviters"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GuestUserSettingsResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	if _, _, err := clients.Policies.AuthorizationPolicyClient.Get(ctx); err != nil {
+		return nil, fmt.Errorf("failed to retrieve Authorization Policy: %+v", err)
+	}
+
+	return utils.Bool(state.ID == "guestUserSettings"), nil
+}
+
+func (GuestUserSettingsResource) basic() string {
+	return `resource "azuread_guest_user_settings" "test" {}`
+}
+
+func (GuestUserSettingsResource) withAuthorizationPolicy() string {
+	return `
+resource "azuread_authorization_policy" "test" {
+  allowed_to_use_sspr = false
+}
+
+resource "azuread_guest_user_settings" "test" {
+  guest_user_access  = "restricted"
+  allow_invites_from = "adminsAndGuestInviters"
+
+  depends_on = [azuread_authorization_policy.test]
+}
+`
+}
+
+func (GuestUserSettingsResource) allowedDomains(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_guest_user_settings" "test" {
+  guest_user_access  = "restricted"
+  allow_invites_from = "adminsAndGuestInviters"
+  allowed_domains    = ["acctest%[1]d-a.example.com", "acctest%[1]d-b.example.com"]
+}
+`, data.RandomInteger)
+}
+
+func (GuestUserSettingsResource) blockedDomains(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_guest_user_settings" "test" {
+  guest_user_access  = "limited"
+  allow_invites_from = "adminsGuestInvitersAndAllMembers"
+  blocked_domains    = ["acctest%[1]d.example.com"]
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,30 @@
+package parse
+
+import "fmt"
+
+type AppManagementPolicyAssignmentId struct {
+	ObjectSubResourceId
+	PolicyId string
+	ObjectId string
+}
+
+func NewAppManagementPolicyAssignmentID(policyId, objectId string) AppManagementPolicyAssignmentId {
+	return AppManagementPolicyAssignmentId{
+		ObjectSubResourceId: NewObjectSubResourceID(policyId, "appliesTo", objectId),
+		PolicyId:            policyId,
+		ObjectId:            objectId,
+	}
+}
+
+func AppManagementPolicyAssignmentID(idString string) (*AppManagementPolicyAssignmentId, error) {
+	id, err := ObjectSubResourceID(idString, "appliesTo")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse App Management Policy Assignment ID: %v", err)
+	}
+
+	return &AppManagementPolicyAssignmentId{
+		ObjectSubResourceId: *id,
+		PolicyId:            id.objectId,
+		ObjectId:            id.subId,
+	}, nil
+}
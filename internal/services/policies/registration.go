@@ -0,0 +1,39 @@
+package policies
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Policies"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Policies",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azuread_admin_consent_request_policy":       adminConsentRequestPolicyResource(),
+		"azuread_app_management_policy":              appManagementPolicyResource(),
+		"azuread_app_management_policy_assignment":   appManagementPolicyAssignmentResource(),
+		"azuread_authentication_strength_policy":     authenticationStrengthPolicyResource(),
+		"azuread_authorization_policy":               authorizationPolicyResource(),
+		"azuread_cross_tenant_access_policy_default": crossTenantAccessPolicyDefaultResource(),
+		"azuread_cross_tenant_access_policy_partner": crossTenantAccessPolicyPartnerResource(),
+		"azuread_guest_user_settings":                guestUserSettingsResource(),
+		"azuread_tenant_app_management_policy":       tenantAppManagementPolicyResource(),
+	}
+}
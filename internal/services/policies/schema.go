@@ -0,0 +1,237 @@
+package policies
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func schemaCrossTenantAutomaticUserConsent() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"inbound_allowed": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"outbound_allowed": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func schemaCrossTenantInboundTrust() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"compliant_device_accepted": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"hybrid_azure_ad_joined_device_accepted": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"mfa_accepted": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandCrossTenantAccessPolicyConfiguration(d *schema.ResourceData) client.CrossTenantAccessPolicyConfiguration {
+	configuration := client.CrossTenantAccessPolicyConfiguration{
+		AutomaticUserConsentSettings: &client.InboundOutboundPolicyConfiguration{
+			InboundAllowed:  utils.Bool(false),
+			OutboundAllowed: utils.Bool(false),
+		},
+		InboundTrust: &client.CrossTenantAccessPolicyInboundTrust{
+			IsCompliantDeviceAccepted:           utils.Bool(false),
+			IsHybridAzureADJoinedDeviceAccepted: utils.Bool(false),
+			IsMfaAccepted:                       utils.Bool(false),
+		},
+	}
+
+	if v := d.Get("automatic_user_consent").([]interface{}); len(v) > 0 && v[0] != nil {
+		consent := v[0].(map[string]interface{})
+		configuration.AutomaticUserConsentSettings.InboundAllowed = utils.Bool(consent["inbound_allowed"].(bool))
+		configuration.AutomaticUserConsentSettings.OutboundAllowed = utils.Bool(consent["outbound_allowed"].(bool))
+	}
+
+	if v := d.Get("inbound_trust").([]interface{}); len(v) > 0 && v[0] != nil {
+		trust := v[0].(map[string]interface{})
+		configuration.InboundTrust.IsCompliantDeviceAccepted = utils.Bool(trust["compliant_device_accepted"].(bool))
+		configuration.InboundTrust.IsHybridAzureADJoinedDeviceAccepted = utils.Bool(trust["hybrid_azure_ad_joined_device_accepted"].(bool))
+		configuration.InboundTrust.IsMfaAccepted = utils.Bool(trust["mfa_accepted"].(bool))
+	}
+
+	return configuration
+}
+
+func flattenCrossTenantAutomaticUserConsent(in *client.InboundOutboundPolicyConfiguration) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"inbound_allowed":  in.InboundAllowed != nil && *in.InboundAllowed,
+		"outbound_allowed": in.OutboundAllowed != nil && *in.OutboundAllowed,
+	}}
+}
+
+func flattenCrossTenantInboundTrust(in *client.CrossTenantAccessPolicyInboundTrust) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"compliant_device_accepted":              in.IsCompliantDeviceAccepted != nil && *in.IsCompliantDeviceAccepted,
+		"hybrid_azure_ad_joined_device_accepted": in.IsHybridAzureADJoinedDeviceAccepted != nil && *in.IsHybridAzureADJoinedDeviceAccepted,
+		"mfa_accepted":                           in.IsMfaAccepted != nil && *in.IsMfaAccepted,
+	}}
+}
+
+func schemaAppManagementRestrictions() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_credential": schemaAppManagementCredentialRestriction([]string{
+					"asymmetricKeyLifetime",
+				}),
+
+				"password_credential": schemaAppManagementCredentialRestriction([]string{
+					"customPasswordAddition",
+					"passwordAddition",
+					"passwordLifetime",
+					"symmetricKeyAddition",
+					"symmetricKeyLifetime",
+				}),
+			},
+		},
+	}
+}
+
+func schemaAppManagementCredentialRestriction(restrictionTypes []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"restriction_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(restrictionTypes, false),
+				},
+
+				"max_lifetime": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validate.ISO8601Duration,
+				},
+
+				"restrict_for_apps_created_after": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+			},
+		},
+	}
+}
+
+func expandAppManagementConfiguration(in []interface{}) *client.AppManagementConfiguration {
+	configuration := client.AppManagementConfiguration{
+		KeyCredentials:      &[]client.CredentialConfiguration{},
+		PasswordCredentials: &[]client.CredentialConfiguration{},
+	}
+
+	if len(in) == 0 || in[0] == nil {
+		return &configuration
+	}
+
+	restrictions := in[0].(map[string]interface{})
+	configuration.KeyCredentials = expandAppManagementCredentialConfigurations(restrictions["key_credential"].([]interface{}))
+	configuration.PasswordCredentials = expandAppManagementCredentialConfigurations(restrictions["password_credential"].([]interface{}))
+
+	return &configuration
+}
+
+func expandAppManagementCredentialConfigurations(in []interface{}) *[]client.CredentialConfiguration {
+	result := make([]client.CredentialConfiguration, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		c := raw.(map[string]interface{})
+		configuration := client.CredentialConfiguration{
+			RestrictionType: utils.String(c["restriction_type"].(string)),
+		}
+		if v := c["max_lifetime"].(string); v != "" {
+			configuration.MaxLifetime = utils.String(v)
+		}
+		if v := c["restrict_for_apps_created_after"].(string); v != "" {
+			configuration.RestrictForAppsCreatedAfterDateTime = utils.String(v)
+		}
+		result = append(result, configuration)
+	}
+	return &result
+}
+
+func flattenAppManagementConfiguration(in *client.AppManagementConfiguration) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	keyCredentials := flattenAppManagementCredentialConfigurations(in.KeyCredentials)
+	passwordCredentials := flattenAppManagementCredentialConfigurations(in.PasswordCredentials)
+	if len(keyCredentials) == 0 && len(passwordCredentials) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"key_credential":      keyCredentials,
+		"password_credential": passwordCredentials,
+	}}
+}
+
+func flattenAppManagementCredentialConfigurations(in *[]client.CredentialConfiguration) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+	for _, c := range *in {
+		result = append(result, map[string]interface{}{
+			"max_lifetime":                    c.MaxLifetime,
+			"restrict_for_apps_created_after": c.RestrictForAppsCreatedAfterDateTime,
+			"restriction_type":                c.RestrictionType,
+		})
+	}
+	return result
+}
@@ -0,0 +1,91 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+const (
+	tenantAppManagementPolicyResourceName = "azuread_tenant_app_management_policy"
+	tenantAppManagementPolicyId           = "defaultAppManagementPolicy"
+)
+
+func tenantAppManagementPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: tenantAppManagementPolicyResourceCreate,
+		ReadContext:   tenantAppManagementPolicyResourceRead,
+		UpdateContext: tenantAppManagementPolicyResourceUpdate,
+		DeleteContext: tenantAppManagementPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			if id != tenantAppManagementPolicyId {
+				return fmt.Errorf("specified ID (%q) is not valid, expected %q", id, tenantAppManagementPolicyId)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"application_restrictions": schemaAppManagementRestrictions(),
+
+			"service_principal_restrictions": schemaAppManagementRestrictions(),
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func tenantAppManagementPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(tenantAppManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return tenantAppManagementPolicyResourceCreateMsGraph(ctx, d, meta)
+}
+
+func tenantAppManagementPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(tenantAppManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return tenantAppManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func tenantAppManagementPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(tenantAppManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return tenantAppManagementPolicyResourceUpdateMsGraph(ctx, d, meta)
+}
+
+func tenantAppManagementPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(tenantAppManagementPolicyResourceName); diags != nil {
+		return diags
+	}
+	return tenantAppManagementPolicyResourceDeleteMsGraph(ctx, d, meta)
+}
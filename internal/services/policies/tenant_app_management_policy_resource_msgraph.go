@@ -0,0 +1,78 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func tenantAppManagementPolicyResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	// The default app management policy always exists for a tenant, so we simply take ownership of it
+	if _, err := c.UpdateDefault(ctx, expandTenantAppManagementPolicy(d)); err != nil {
+		return tf.ErrorDiagF(err, "Updating default app management policy")
+	}
+
+	d.SetId(tenantAppManagementPolicyId)
+
+	return tenantAppManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func tenantAppManagementPolicyResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	policy, _, err := c.GetDefault(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving default app management policy")
+	}
+
+	tf.Set(d, "application_restrictions", flattenAppManagementConfiguration(policy.ApplicationRestrictions))
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+	tf.Set(d, "enabled", policy.IsEnabled != nil && *policy.IsEnabled)
+	tf.Set(d, "service_principal_restrictions", flattenAppManagementConfiguration(policy.ServicePrincipalRestrictions))
+
+	return nil
+}
+
+func tenantAppManagementPolicyResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	if _, err := c.UpdateDefault(ctx, expandTenantAppManagementPolicy(d)); err != nil {
+		return tf.ErrorDiagF(err, "Updating default app management policy")
+	}
+
+	return tenantAppManagementPolicyResourceReadMsGraph(ctx, d, meta)
+}
+
+func tenantAppManagementPolicyResourceDeleteMsGraph(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	// The policy cannot be deleted, so disable it and remove all restrictions, as for a new tenant
+	properties := client.TenantAppManagementPolicy{
+		ApplicationRestrictions:      expandAppManagementConfiguration(nil),
+		IsEnabled:                    utils.Bool(false),
+		ServicePrincipalRestrictions: expandAppManagementConfiguration(nil),
+	}
+
+	if _, err := c.UpdateDefault(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Restoring default app management policy")
+	}
+
+	return nil
+}
+
+func expandTenantAppManagementPolicy(d *schema.ResourceData) client.TenantAppManagementPolicy {
+	return client.TenantAppManagementPolicy{
+		ApplicationRestrictions:      expandAppManagementConfiguration(d.Get("application_restrictions").([]interface{})),
+		IsEnabled:                    utils.Bool(d.Get("enabled").(bool)),
+		ServicePrincipalRestrictions: expandAppManagementConfiguration(d.Get("service_principal_restrictions").([]interface{})),
+	}
+}
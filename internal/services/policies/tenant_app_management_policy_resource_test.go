@@ -0,0 +1,94 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type TenantAppManagementPolicyResource struct{}
+
+func TestAccTenantAppManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_tenant_app_management_policy", "test")
+	r := TenantAppManagementPolicyResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTenantAppManagementPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_tenant_app_management_policy", "test")
+	r := TenantAppManagementPolicyResource{}
+
+	data.ResourceTestIgnoreCheckDestroy(t, r, []resource.TestStep{
+		{
+			Config: r.complete(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_restrictions.0.password_credential.#").HasValue("1"),
+				check.That(data.ResourceName).Key("service_principal_restrictions.0.key_credential.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r TenantAppManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	policy, _, err := clients.Policies.AppManagementPolicyClient.GetDefault(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve default App Management Policy: %+v", err)
+	}
+
+	return utils.Bool(policy.ID != nil && *policy.ID == state.ID), nil
+}
+
+func (TenantAppManagementPolicyResource) basic() string {
+	return `resource "azuread_tenant_app_management_policy" "test" {}`
+}
+
+func (TenantAppManagementPolicyResource) complete() string {
+	return `
+resource "azuread_tenant_app_management_policy" "test" {
+  enabled = true
+
+  application_restrictions {
+    password_credential {
+      restriction_type                = "passwordLifetime"
+      max_lifetime                    = "P180D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+  }
+
+  service_principal_restrictions {
+    key_credential {
+      restriction_type                = "asymmetricKeyLifetime"
+      max_lifetime                    = "P365D"
+      restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+    }
+  }
+}
+`
+}
@@ -0,0 +1,356 @@
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+// adminConsentPermissions holds the app roles and delegated permission scopes to be consented for a resource service
+// principal
+type adminConsentPermissions struct {
+	appRoleIds []string
+	scopes     []string
+}
+
+// adminConsentRequiredPermissions returns the permissions required by the application associated with the specified
+// service principal, keyed by the object ID of each resource service principal
+func adminConsentRequiredPermissions(ctx context.Context, client *msgraph.ServicePrincipalsClient, servicePrincipal *msgraph.ServicePrincipal) (map[string]*adminConsentPermissions, error) {
+	if servicePrincipal.AppId == nil {
+		return nil, errors.New("service principal has a nil application ID")
+	}
+
+	appsClient := &msgraph.ApplicationsClient{BaseClient: client.BaseClient}
+	apps, _, err := appsClient.List(ctx, fmt.Sprintf("appId eq '%s'", *servicePrincipal.AppId))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving application with application ID %q: %+v", *servicePrincipal.AppId, err)
+	}
+	if apps == nil || len(*apps) == 0 {
+		return nil, fmt.Errorf("application with application ID %q was not found in this tenant", *servicePrincipal.AppId)
+	}
+	app := (*apps)[0]
+
+	result := make(map[string]*adminConsentPermissions)
+	if app.RequiredResourceAccess == nil {
+		return result, nil
+	}
+
+	for _, requiredResourceAccess := range *app.RequiredResourceAccess {
+		if requiredResourceAccess.ResourceAppId == nil || requiredResourceAccess.ResourceAccess == nil {
+			continue
+		}
+		resourceAppId := *requiredResourceAccess.ResourceAppId
+
+		resources, _, err := client.List(ctx, fmt.Sprintf("appId eq '%s'", resourceAppId))
+		if err != nil {
+			return nil, fmt.Errorf("retrieving service principal for resource application ID %q: %+v", resourceAppId, err)
+		}
+		if resources == nil || len(*resources) == 0 || (*resources)[0].ID == nil {
+			return nil, fmt.Errorf("service principal for resource application ID %q was not found", resourceAppId)
+		}
+		resource := (*resources)[0]
+
+		scopeValues := make(map[string]string)
+		if resource.PublishedPermissionScopes != nil {
+			for _, scope := range *resource.PublishedPermissionScopes {
+				if scope.ID != nil && scope.Value != nil {
+					scopeValues[*scope.ID] = *scope.Value
+				}
+			}
+		}
+
+		permissions, ok := result[*resource.ID]
+		if !ok {
+			permissions = &adminConsentPermissions{}
+			result[*resource.ID] = permissions
+		}
+
+		for _, access := range *requiredResourceAccess.ResourceAccess {
+			if access.ID == nil {
+				continue
+			}
+			switch access.Type {
+			case msgraph.ResourceAccessTypeRole:
+				permissions.appRoleIds = append(permissions.appRoleIds, *access.ID)
+			case msgraph.ResourceAccessTypeScope:
+				value, ok := scopeValues[*access.ID]
+				if !ok {
+					return nil, fmt.Errorf("delegated permission scope %q is not published by resource application ID %q", *access.ID, resourceAppId)
+				}
+				permissions.scopes = append(permissions.scopes, value)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// adminConsentAllPrincipalsGrants returns the tenant-wide delegated permission grants for the specified client service
+// principal, keyed by the object ID of the resource service principal
+func adminConsentAllPrincipalsGrants(ctx context.Context, client msgraph.Client, id string) (map[string]helpers.DelegatedPermissionGrant, error) {
+	grants, _, err := helpers.ServicePrincipalDelegatedPermissionGrants(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]helpers.DelegatedPermissionGrant)
+	for _, grant := range grants {
+		if grant.ID == nil || grant.ResourceId == nil || grant.ConsentType == nil || *grant.ConsentType != helpers.DelegatedPermissionGrantConsentTypeAllPrincipals {
+			continue
+		}
+		result[*grant.ResourceId] = grant
+	}
+	return result, nil
+}
+
+func adminConsentResourceIds(in map[string]*adminConsentPermissions) []string {
+	result := make([]string, 0, len(in))
+	for resourceId := range in {
+		result = append(result, resourceId)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func adminConsentFindAppRoleAssignment(assignments []msgraph.AppRoleAssignment, resourceId, appRoleId string) *msgraph.AppRoleAssignment {
+	for _, assignment := range assignments {
+		if assignment.Id != nil && assignment.ResourceId != nil && *assignment.ResourceId == resourceId && assignment.AppRoleId != nil && *assignment.AppRoleId == appRoleId {
+			return &assignment
+		}
+	}
+	return nil
+}
+
+func adminConsentResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	objectId := d.Get("service_principal_object_id").(string)
+
+	servicePrincipal, status, err := client.Get(ctx, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "service_principal_object_id", "Service principal with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_object_id", "Retrieving service principal with object ID %q", objectId)
+	}
+
+	required, err := adminConsentRequiredPermissions(ctx, client, servicePrincipal)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_object_id", "Determining required permissions for service principal with object ID %q", objectId)
+	}
+
+	assignments, _, err := helpers.ServicePrincipalAppRoleAssignments(ctx, client.BaseClient, objectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving app role assignments for service principal with object ID %q", objectId)
+	}
+
+	grants, err := adminConsentAllPrincipalsGrants(ctx, client.BaseClient, objectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving delegated permission grants for service principal with object ID %q", objectId)
+	}
+
+	appRoleAssignmentIds := make([]string, 0)
+	delegatedPermissionGrants := make([]interface{}, 0)
+
+	for _, resourceId := range adminConsentResourceIds(required) {
+		permissions := required[resourceId]
+
+		for _, appRoleId := range permissions.appRoleIds {
+			if existing := adminConsentFindAppRoleAssignment(assignments, resourceId, appRoleId); existing != nil {
+				appRoleAssignmentIds = append(appRoleAssignmentIds, *existing.Id)
+				continue
+			}
+
+			// The service principal may have been created moments earlier and not yet be visible
+			var assignment *msgraph.AppRoleAssignment
+			err := helpers.WaitForReferenceReplication(ctx, func() (status int, err error) {
+				assignment, status, err = helpers.ServicePrincipalAssignAppRole(ctx, client.BaseClient, objectId, resourceId, appRoleId)
+				return
+			})
+			if err != nil {
+				return tf.ErrorDiagF(err, "Assigning app role %q for resource service principal %q to service principal with object ID %q", appRoleId, resourceId, objectId)
+			}
+			if assignment.Id == nil {
+				return tf.ErrorDiagF(errors.New("API returned app role assignment with nil ID"), "Bad API response")
+			}
+			appRoleAssignmentIds = append(appRoleAssignmentIds, *assignment.Id)
+		}
+
+		if len(permissions.scopes) == 0 {
+			continue
+		}
+
+		var grantId string
+		if existing, ok := grants[resourceId]; ok {
+			grantId = *existing.ID
+			if missing := utils.Difference(permissions.scopes, existing.Scopes()); len(missing) > 0 {
+				if _, err := helpers.DelegatedPermissionGrantSetScopes(ctx, client.BaseClient, grantId, append(existing.Scopes(), missing...)); err != nil {
+					return tf.ErrorDiagF(err, "Updating delegated permission grant %q for service principal with object ID %q", grantId, objectId)
+				}
+			}
+		} else {
+			var grant *helpers.DelegatedPermissionGrant
+			err := helpers.WaitForReferenceReplication(ctx, func() (status int, err error) {
+				grant, status, err = helpers.DelegatedPermissionGrantCreate(ctx, client.BaseClient, objectId, resourceId, "", permissions.scopes)
+				return
+			})
+			if err != nil {
+				return tf.ErrorDiagF(err, "Granting delegated permissions for resource service principal %q to service principal with object ID %q", resourceId, objectId)
+			}
+			if grant.ID == nil {
+				return tf.ErrorDiagF(errors.New("API returned delegated permission grant with nil ID"), "Bad API response")
+			}
+			grantId = *grant.ID
+		}
+
+		delegatedPermissionGrants = append(delegatedPermissionGrants, map[string]interface{}{
+			"id":                 grantId,
+			"resource_object_id": resourceId,
+			"scopes":             permissions.scopes,
+		})
+	}
+
+	d.SetId(objectId)
+
+	// Newly created assignments and grants may not be visible immediately, so the state is set here rather than by
+	// reading them back
+	tf.Set(d, "app_role_assignment_ids", appRoleAssignmentIds)
+	tf.Set(d, "delegated_permission_grant", delegatedPermissionGrants)
+
+	return nil
+}
+
+func adminConsentResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	objectId := d.Id()
+
+	servicePrincipal, status, err := client.Get(ctx, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing admin consent from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving service principal with object ID %q", objectId)
+	}
+
+	required, err := adminConsentRequiredPermissions(ctx, client, servicePrincipal)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Determining required permissions for service principal with object ID %q", objectId)
+	}
+
+	assignments, _, err := helpers.ServicePrincipalAppRoleAssignments(ctx, client.BaseClient, objectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving app role assignments for service principal with object ID %q", objectId)
+	}
+
+	grants, err := adminConsentAllPrincipalsGrants(ctx, client.BaseClient, objectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving delegated permission grants for service principal with object ID %q", objectId)
+	}
+
+	appRoleAssignmentIds := make([]string, 0)
+	delegatedPermissionGrants := make([]interface{}, 0)
+	consented := true
+
+	for _, resourceId := range adminConsentResourceIds(required) {
+		permissions := required[resourceId]
+
+		for _, appRoleId := range permissions.appRoleIds {
+			if existing := adminConsentFindAppRoleAssignment(assignments, resourceId, appRoleId); existing != nil {
+				appRoleAssignmentIds = append(appRoleAssignmentIds, *existing.Id)
+			} else {
+				consented = false
+			}
+		}
+
+		if len(permissions.scopes) == 0 {
+			continue
+		}
+
+		existing, ok := grants[resourceId]
+		if !ok || len(utils.Difference(permissions.scopes, existing.Scopes())) > 0 {
+			consented = false
+			continue
+		}
+		delegatedPermissionGrants = append(delegatedPermissionGrants, map[string]interface{}{
+			"id":                 *existing.ID,
+			"resource_object_id": resourceId,
+			"scopes":             permissions.scopes,
+		})
+	}
+
+	// Consent must be granted again when permissions have been revoked, or when the application requires new permissions
+	if !consented {
+		log.Printf("[DEBUG] Admin consent for Service Principal with Object ID %q is incomplete - removing from state!", objectId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "app_role_assignment_ids", appRoleAssignmentIds)
+	tf.Set(d, "delegated_permission_grant", delegatedPermissionGrants)
+	tf.Set(d, "service_principal_object_id", objectId)
+
+	return nil
+}
+
+func adminConsentResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	objectId := d.Id()
+
+	for _, id := range d.Get("app_role_assignment_ids").([]interface{}) {
+		assignmentId := id.(string)
+		if status, err := helpers.ServicePrincipalRemoveAppRoleAssignment(ctx, client.BaseClient, objectId, assignmentId); err != nil && status != http.StatusNotFound {
+			return tf.ErrorDiagPathF(err, "app_role_assignment_ids", "Removing app role assignment %q from service principal with object ID %q", assignmentId, objectId)
+		}
+	}
+
+	grants, err := adminConsentAllPrincipalsGrants(ctx, client.BaseClient, objectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving delegated permission grants for service principal with object ID %q", objectId)
+	}
+
+	// Only the consented scopes are revoked, so that scopes granted by other means are retained
+	for _, raw := range d.Get("delegated_permission_grant").([]interface{}) {
+		v := raw.(map[string]interface{})
+		grantId := v["id"].(string)
+
+		var existing *helpers.DelegatedPermissionGrant
+		for _, grant := range grants {
+			if *grant.ID == grantId {
+				grant := grant
+				existing = &grant
+				break
+			}
+		}
+		if existing == nil {
+			continue
+		}
+
+		remaining := utils.Difference(existing.Scopes(), *tf.ExpandStringSlicePtr(v["scopes"].([]interface{})))
+		if len(remaining) > 0 {
+			if _, err := helpers.DelegatedPermissionGrantSetScopes(ctx, client.BaseClient, grantId, remaining); err != nil {
+				return tf.ErrorDiagPathF(err, "delegated_permission_grant", "Updating delegated permission grant %q for service principal with object ID %q", grantId, objectId)
+			}
+			continue
+		}
+		if status, err := helpers.DelegatedPermissionGrantDelete(ctx, client.BaseClient, grantId); err != nil && status != http.StatusNotFound {
+			return tf.ErrorDiagPathF(err, "delegated_permission_grant", "Deleting delegated permission grant %q for service principal with object ID %q", grantId, objectId)
+		}
+	}
+
+	return nil
+}
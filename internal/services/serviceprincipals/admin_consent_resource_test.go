@@ -0,0 +1,100 @@
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type AdminConsentResource struct{}
+
+func TestAccAdminConsent_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_admin_consent", "test")
+	r := AdminConsentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.#").HasValue("1"),
+				check.That(data.ResourceName).Key("delegated_permission_grant.0.scopes.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AdminConsentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.MsClient
+
+	if _, status, err := client.Get(ctx, state.ID); err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Service Principal with object ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Service Principal with object ID %q: %+v", state.ID, err)
+	}
+
+	assignments, _, err := helpers.ServicePrincipalAppRoleAssignments(ctx, client.BaseClient, state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve app role assignments for Service Principal with object ID %q: %+v", state.ID, err)
+	}
+
+	grants, _, err := helpers.ServicePrincipalDelegatedPermissionGrants(ctx, client.BaseClient, state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve delegated permission grants for Service Principal with object ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(len(assignments) > 0 && len(grants) > 0), nil
+}
+
+func (AdminConsentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestAdminConsent-%[1]d"
+
+  required_resource_access {
+    resource_app_id = "00000003-0000-0000-c000-000000000000"
+
+    resource_access {
+      id   = "df021288-bdef-4463-88db-98f22de89214"
+      type = "Role"
+    }
+
+    resource_access {
+      id   = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
+      type = "Scope"
+    }
+
+    resource_access {
+      id   = "37f7f235-527c-4136-accd-4a02d197296e"
+      type = "Scope"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_admin_consent" "test" {
+  service_principal_object_id = azuread_service_principal.test.object_id
+}
+`, data.RandomInteger)
+}
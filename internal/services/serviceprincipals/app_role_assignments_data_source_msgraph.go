@@ -0,0 +1,41 @@
+package serviceprincipals
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func appRoleAssignmentsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+	resourceId := d.Get("resource_object_id").(string)
+
+	assignments, status, err := helpers.ServicePrincipalAppRoleAssignedTo(ctx, client.BaseClient, resourceId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Service Principal with object ID %q was not found", resourceId), "resource_object_id", "Retrieving app role assignments")
+		}
+		return tf.ErrorDiagPathF(err, "resource_object_id", "Retrieving app role assignments for service principal with object ID %q", resourceId)
+	}
+
+	principalIds := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		if assignment.PrincipalId != nil {
+			principalIds = append(principalIds, *assignment.PrincipalId)
+		}
+	}
+
+	d.SetId(resourceId)
+
+	tf.Set(d, "assignments", helpers.AppRoleAssignmentsFlatten(assignments))
+	tf.Set(d, "principal_object_ids", principalIds)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type AppRoleAssignmentsDataSource struct{}
+
+func TestAccAppRoleAssignmentsDataSource_noAssignments(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_app_role_assignments", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: AppRoleAssignmentsDataSource{}.noAssignments(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_object_id").MatchesOtherKey(check.That("azuread_service_principal.test").Key("object_id")),
+				check.That(data.ResourceName).Key("assignments.#").HasValue("0"),
+				check.That(data.ResourceName).Key("principal_object_ids.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (AppRoleAssignmentsDataSource) noAssignments(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestAppRoleAssignments-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+data "azuread_app_role_assignments" "test" {
+  resource_object_id = azuread_service_principal.test.object_id
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+// microsoftTenantIds are the tenants which own first-party applications published by Microsoft, such as Microsoft Graph
+var microsoftTenantIds = []string{
+	"f8cdef31-a31e-4b4a-93e4-5f571e91255a", // Microsoft Services
+	"72f988bf-86f1-41af-91ab-2d7cd011db47", // Microsoft
+}
+
+// WellKnownServicePrincipalsCache holds service principals for first-party applications published by Microsoft, keyed
+// by application ID. These are frequently looked up in order to resolve app role and scope IDs, and do not change over
+// the course of an apply, so are only retrieved once per provider instance. Service principals for applications owned
+// by any other tenant are never cached, since they may be modified by the configuration.
+type WellKnownServicePrincipalsCache struct {
+	mu    sync.RWMutex
+	items map[string]msgraph.ServicePrincipal
+}
+
+// Get returns the cached service principal for the specified application ID, if any
+func (c *WellKnownServicePrincipalsCache) Get(applicationId string) (*msgraph.ServicePrincipal, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	sp, ok := c.items[strings.ToLower(applicationId)]
+	if !ok {
+		return nil, false
+	}
+	return &sp, true
+}
+
+// Add caches the specified service principal if it belongs to a first-party application published by Microsoft
+func (c *WellKnownServicePrincipalsCache) Add(sp msgraph.ServicePrincipal) {
+	if sp.AppId == nil || !isMicrosoftTenant(sp.AppOwnerOrganizationId) {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.items == nil {
+		c.items = make(map[string]msgraph.ServicePrincipal)
+	}
+	c.items[strings.ToLower(*sp.AppId)] = sp
+}
+
+func isMicrosoftTenant(tenantId *string) bool {
+	if tenantId == nil {
+		return false
+	}
+	for _, id := range microsoftTenantIds {
+		if strings.EqualFold(*tenantId, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+func TestWellKnownServicePrincipalsCache(t *testing.T) {
+	cache := &WellKnownServicePrincipalsCache{}
+
+	msGraph := msgraph.ServicePrincipal{
+		ID:                     utils.String("11111111-1111-1111-1111-111111111111"),
+		AppId:                  utils.String("00000003-0000-0000-C000-000000000000"),
+		AppOwnerOrganizationId: utils.String("f8cdef31-a31e-4b4a-93e4-5f571e91255a"),
+	}
+	cache.Add(msGraph)
+
+	sp, ok := cache.Get("00000003-0000-0000-c000-000000000000")
+	if !ok {
+		t.Fatal("expected service principal for Microsoft Graph to be cached")
+	}
+	if *sp.ID != *msGraph.ID {
+		t.Fatalf("expected cached service principal to have ID %q, got %q", *msGraph.ID, *sp.ID)
+	}
+
+	cache.Add(msgraph.ServicePrincipal{
+		ID:                     utils.String("22222222-2222-2222-2222-222222222222"),
+		AppId:                  utils.String("33333333-3333-3333-3333-333333333333"),
+		AppOwnerOrganizationId: utils.String("44444444-4444-4444-4444-444444444444"),
+	})
+	if _, ok := cache.Get("33333333-3333-3333-3333-333333333333"); ok {
+		t.Fatal("expected service principal owned by another tenant not to be cached")
+	}
+
+	cache.Add(msgraph.ServicePrincipal{
+		ID:    utils.String("55555555-5555-5555-5555-555555555555"),
+		AppId: utils.String("66666666-6666-6666-6666-666666666666"),
+	})
+	if _, ok := cache.Get("66666666-6666-6666-6666-666666666666"); ok {
+		t.Fatal("expected service principal without an owner tenant not to be cached")
+	}
+}
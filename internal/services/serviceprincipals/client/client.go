@@ -10,6 +10,8 @@ import (
 type Client struct {
 	AadClient *graphrbac.ServicePrincipalsClient
 	MsClient  *msgraph.ServicePrincipalsClient
+
+	WellKnownCache *WellKnownServicePrincipalsCache
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -20,5 +22,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		AadClient: &aadClient,
 		MsClient:  msClient,
+
+		WellKnownCache: &WellKnownServicePrincipalsCache{},
 	}
 }
@@ -33,10 +33,41 @@ func clientConfigDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"authenticated_as": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"scopes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
+const (
+	clientConfigAuthenticatedAsServicePrincipal = "ServicePrincipal"
+	clientConfigAuthenticatedAsUser             = "User"
+)
+
 func clientConfigDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if meta.(*clients.Client).EnableMsGraphBeta {
 		return clientConfigDataSourceReadMsGraph(ctx, d, meta)
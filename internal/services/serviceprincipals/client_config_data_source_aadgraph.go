@@ -32,8 +32,18 @@ func clientConfigDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(fmt.Sprintf("%s-%s-%s", client.TenantID, client.ObjectID, client.ClientID))
 
+	// Token claims are only available when using Microsoft Graph
+	authenticatedAs := clientConfigAuthenticatedAsUser
+	if client.AuthenticatedAsAServicePrincipal {
+		authenticatedAs = clientConfigAuthenticatedAsServicePrincipal
+	}
+
+	tf.Set(d, "authenticated_as", authenticatedAs)
 	tf.Set(d, "client_id", client.ClientID)
 	tf.Set(d, "object_id", client.ObjectID)
+	tf.Set(d, "object_type", authenticatedAs)
+	tf.Set(d, "roles", []string{})
+	tf.Set(d, "scopes", []string{})
 	tf.Set(d, "tenant_id", client.TenantID)
 
 	return nil
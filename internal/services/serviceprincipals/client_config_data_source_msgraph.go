@@ -3,20 +3,49 @@ package serviceprincipals
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
-func clientConfigDataSourceReadMsGraph(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func clientConfigDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client)
 	objectId := client.Claims.ObjectId
+
+	// Delegated tokens contain scopes, whereas tokens issued to service principals contain application roles
+	authenticatedAs := clientConfigAuthenticatedAsServicePrincipal
+	if client.Claims.Scopes != "" || strings.EqualFold(client.Claims.IdType, "user") {
+		authenticatedAs = clientConfigAuthenticatedAsUser
+	}
+
+	// Reading the directory object requires directory read permissions, so fall back to the type of authentication
+	objectType, _, err := helpers.DirectoryObjectType(ctx, client.ServicePrincipals.MsClient.BaseClient, objectId)
+	if err != nil {
+		log.Printf("[DEBUG] Could not determine object type for authenticated principal with object ID %q: %v", objectId, err)
+		objectType = authenticatedAs
+	}
+
+	roles := make([]string, 0)
+	roles = append(roles, client.Claims.Roles...)
+	sort.Strings(roles)
+
+	scopes := strings.Fields(client.Claims.Scopes)
+	sort.Strings(scopes)
+
 	d.SetId(fmt.Sprintf("%s-%s-%s", client.TenantID, client.ClientID, objectId))
-	tf.Set(d, "tenant_id", client.TenantID)
+	tf.Set(d, "authenticated_as", authenticatedAs)
 	tf.Set(d, "client_id", client.ClientID)
 	tf.Set(d, "object_id", objectId)
+	tf.Set(d, "object_type", objectType)
+	tf.Set(d, "roles", roles)
+	tf.Set(d, "scopes", scopes)
+	tf.Set(d, "tenant_id", client.TenantID)
 	return nil
 }
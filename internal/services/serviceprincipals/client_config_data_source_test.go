@@ -24,6 +24,27 @@ func TestAccClientConfigDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("client_id").HasValue(clientId),
 				check.That(data.ResourceName).Key("tenant_id").HasValue(tenantId),
 				check.That(data.ResourceName).Key("object_id").IsUuid(),
+				check.That(data.ResourceName).Key("authenticated_as").HasValue("ServicePrincipal"),
+				check.That(data.ResourceName).Key("object_type").HasValue("ServicePrincipal"),
+			),
+		},
+	})
+}
+
+func TestAccClientConfigDataSource_tokenClaims(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_client_config", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ClientConfigDataSource{}.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("authenticated_as").HasValue("ServicePrincipal"),
+				check.That(data.ResourceName).Key("roles.#").Exists(),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("0"),
 			),
 		},
 	})
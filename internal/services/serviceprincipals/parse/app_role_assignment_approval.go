@@ -0,0 +1,31 @@
+package parse
+
+import "fmt"
+
+type AppRoleAssignmentApprovalId struct {
+	ObjectId         string
+	ResourceObjectId string
+}
+
+func NewAppRoleAssignmentApprovalID(objectId, resourceObjectId string) AppRoleAssignmentApprovalId {
+	return AppRoleAssignmentApprovalId{
+		ObjectId:         objectId,
+		ResourceObjectId: resourceObjectId,
+	}
+}
+
+func (id AppRoleAssignmentApprovalId) String() string {
+	return NewObjectSubResourceID(id.ObjectId, "approval", id.ResourceObjectId).String()
+}
+
+func AppRoleAssignmentApprovalID(idString string) (*AppRoleAssignmentApprovalId, error) {
+	id, err := ObjectSubResourceID(idString, "approval")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse App Role Assignment Approval ID: %v", err)
+	}
+
+	return &AppRoleAssignmentApprovalId{
+		ObjectId:         id.objectId,
+		ResourceObjectId: id.subId,
+	}, nil
+}
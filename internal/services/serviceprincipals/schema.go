@@ -1,6 +1,11 @@
 package serviceprincipals
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
 
 func schemaAppRolesComputed() *schema.Schema {
 	return &schema.Schema{
@@ -155,3 +160,117 @@ func schemaOauth2PermissionsComputed() *schema.Schema {
 		},
 	}
 }
+
+func schemaCustomSecurityAttributes() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute_set": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+
+				"name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "String",
+					ValidateFunc: validation.StringInSlice([]string{"Boolean", "Integer", "String"}, false),
+				},
+
+				"collection": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaKeyCredentialsComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"end_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"key_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"start_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"thumbprint": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"usage": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func schemaPasswordCredentialsComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"end_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"hint": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"key_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"start_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
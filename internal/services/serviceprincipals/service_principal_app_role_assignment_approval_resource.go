@@ -0,0 +1,91 @@
+package serviceprincipals
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const servicePrincipalAppRoleAssignmentApprovalResourceName = "azuread_service_principal_app_role_assignment_approval"
+
+func servicePrincipalAppRoleAssignmentApprovalResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: servicePrincipalAppRoleAssignmentApprovalResourceCreate,
+		ReadContext:   servicePrincipalAppRoleAssignmentApprovalResourceRead,
+		DeleteContext: servicePrincipalAppRoleAssignmentApprovalResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.AppRoleAssignmentApprovalID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"resource_service_principal_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"app_role_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+
+			"app_role_assignment_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func servicePrincipalAppRoleAssignmentApprovalResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(servicePrincipalAppRoleAssignmentApprovalResourceName); diags != nil {
+		return diags
+	}
+	return servicePrincipalAppRoleAssignmentApprovalResourceCreateMsGraph(ctx, d, meta)
+}
+
+func servicePrincipalAppRoleAssignmentApprovalResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(servicePrincipalAppRoleAssignmentApprovalResourceName); diags != nil {
+		return diags
+	}
+	return servicePrincipalAppRoleAssignmentApprovalResourceReadMsGraph(ctx, d, meta)
+}
+
+func servicePrincipalAppRoleAssignmentApprovalResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(servicePrincipalAppRoleAssignmentApprovalResourceName); diags != nil {
+		return diags
+	}
+	return servicePrincipalAppRoleAssignmentApprovalResourceDeleteMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,183 @@
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/manicminer/hamilton/msgraph"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+// appRoleAssignmentApprovalStatus returns the IDs of the assignments of the specified app roles, exposed by the
+// resource service principal, along with the IDs of any app roles which have not yet been assigned
+func appRoleAssignmentApprovalStatus(assignments []msgraph.AppRoleAssignment, resourceId string, appRoleIds []string) (assignmentIds []string, pending []string) {
+	assignmentIds = make([]string, 0, len(appRoleIds))
+	pending = make([]string, 0)
+	for _, appRoleId := range appRoleIds {
+		if existing := adminConsentFindAppRoleAssignment(assignments, resourceId, appRoleId); existing != nil {
+			assignmentIds = append(assignmentIds, *existing.Id)
+		} else {
+			pending = append(pending, appRoleId)
+		}
+	}
+	return
+}
+
+// appRoleAssignmentApprovalWait polls the app role assignments of the specified service principal until each of the
+// specified app roles exposed by the resource service principal has been assigned, which happens when an administrator
+// approves the admin consent request for the application. The IDs of the assignments are returned.
+func appRoleAssignmentApprovalWait(ctx context.Context, client msgraph.Client, id, resourceId string, appRoleIds []string) ([]string, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, errors.New("context has no deadline")
+	}
+
+	var pending []string
+	result, err := (&resource.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{"Approved"},
+		Timeout:    time.Until(deadline),
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			assignments, _, err := helpers.ServicePrincipalAppRoleAssignments(ctx, client, id)
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			var assignmentIds []string
+			assignmentIds, pending = appRoleAssignmentApprovalStatus(assignments, resourceId, appRoleIds)
+			if len(pending) > 0 {
+				log.Printf("[DEBUG] Waiting for approval of app roles %s for Service Principal with Object ID %q", strings.Join(pending, ", "), id)
+				return assignmentIds, "Pending", nil
+			}
+			return assignmentIds, "Approved", nil
+		},
+	}).WaitForStateContext(ctx)
+	if err != nil {
+		if len(pending) > 0 {
+			return nil, fmt.Errorf("app roles were not approved (pending: %s). An administrator must approve the admin consent request for this application, e.g. under Enterprise applications > Admin consent requests in the Azure Portal: %v", strings.Join(pending, ", "), err)
+		}
+		return nil, err
+	}
+
+	return result.([]string), nil
+}
+
+func servicePrincipalAppRoleAssignmentApprovalResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	objectId := d.Get("service_principal_object_id").(string)
+	resourceId := d.Get("resource_service_principal_object_id").(string)
+	appRoleIds := *tf.ExpandStringSlicePtr(d.Get("app_role_ids").(*schema.Set).List())
+	sort.Strings(appRoleIds)
+
+	if _, status, err := client.Get(ctx, objectId); err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "service_principal_object_id", "Service principal with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_object_id", "Retrieving service principal with object ID %q", objectId)
+	}
+
+	resourceServicePrincipal, status, err := client.Get(ctx, resourceId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "resource_service_principal_object_id", "Resource service principal with object ID %q was not found", resourceId)
+		}
+		return tf.ErrorDiagPathF(err, "resource_service_principal_object_id", "Retrieving resource service principal with object ID %q", resourceId)
+	}
+
+	// An app role which is not exposed by the resource can never be approved, so fail now rather than at the timeout
+	published := make(map[string]bool)
+	if resourceServicePrincipal.AppRoles != nil {
+		for _, role := range *resourceServicePrincipal.AppRoles {
+			if role.ID != nil {
+				published[strings.ToLower(*role.ID)] = true
+			}
+		}
+	}
+	for _, appRoleId := range appRoleIds {
+		if !published[strings.ToLower(appRoleId)] {
+			return tf.ErrorDiagPathF(nil, "app_role_ids", "App role %q is not exposed by resource service principal with object ID %q", appRoleId, resourceId)
+		}
+	}
+
+	assignmentIds, err := appRoleAssignmentApprovalWait(ctx, client.BaseClient, objectId, resourceId, appRoleIds)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for app role assignments to service principal with object ID %q to be approved", objectId)
+	}
+
+	id := parse.NewAppRoleAssignmentApprovalID(objectId, resourceId)
+	d.SetId(id.String())
+
+	// Assignments may not be visible to subsequent requests immediately, so the state is set here rather than by
+	// reading them back
+	tf.Set(d, "app_role_assignment_ids", assignmentIds)
+
+	return nil
+}
+
+func servicePrincipalAppRoleAssignmentApprovalResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	id, err := parse.AppRoleAssignmentApprovalID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Role Assignment Approval ID %q", d.Id())
+	}
+
+	assignments, status, err := helpers.ServicePrincipalAppRoleAssignments(ctx, client.BaseClient, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing app role assignment approval from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving app role assignments for service principal with object ID %q", id.ObjectId)
+	}
+
+	appRoleIds := *tf.ExpandStringSlicePtr(d.Get("app_role_ids").(*schema.Set).List())
+
+	// When importing, the approval covers all app roles currently assigned for the resource
+	if len(appRoleIds) == 0 {
+		for _, assignment := range assignments {
+			if assignment.AppRoleId != nil && assignment.ResourceId != nil && *assignment.ResourceId == id.ResourceObjectId {
+				appRoleIds = append(appRoleIds, *assignment.AppRoleId)
+			}
+		}
+	}
+	sort.Strings(appRoleIds)
+
+	assignmentIds, pending := appRoleAssignmentApprovalStatus(assignments, id.ResourceObjectId, appRoleIds)
+
+	// Approval must be awaited again when any of the app role assignments have been removed
+	if len(appRoleIds) == 0 || len(pending) > 0 {
+		log.Printf("[DEBUG] App role assignments for Service Principal with Object ID %q are no longer approved - removing from state!", id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "app_role_assignment_ids", assignmentIds)
+	tf.Set(d, "app_role_ids", appRoleIds)
+	tf.Set(d, "resource_service_principal_object_id", id.ResourceObjectId)
+	tf.Set(d, "service_principal_object_id", id.ObjectId)
+
+	return nil
+}
+
+func servicePrincipalAppRoleAssignmentApprovalResourceDeleteMsGraph(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Approved app role assignments are retained, since this resource only waits for their approval
+	log.Printf("[DEBUG] Removing app role assignment approval %q from state, any approved app role assignments are retained", d.Id())
+	return nil
+}
@@ -0,0 +1,105 @@
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
+)
+
+type ServicePrincipalAppRoleAssignmentApprovalResource struct{}
+
+func TestAccServicePrincipalAppRoleAssignmentApproval_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_service_principal_app_role_assignment_approval", "test")
+	r := ServicePrincipalAppRoleAssignmentApprovalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role_assignment_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServicePrincipalAppRoleAssignmentApprovalResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.MsClient
+
+	id, err := parse.AppRoleAssignmentApprovalID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing App Role Assignment Approval ID: %v", err)
+	}
+
+	assignments, status, err := helpers.ServicePrincipalAppRoleAssignments(ctx, client.BaseClient, id.ObjectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, fmt.Errorf("Service Principal with object ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve app role assignments for Service Principal with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	for _, assignment := range assignments {
+		if assignment.ResourceId != nil && *assignment.ResourceId == id.ResourceObjectId {
+			return utils.Bool(true), nil
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ServicePrincipalAppRoleAssignmentApprovalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_service_principal" "msgraph" {
+  application_id = "00000003-0000-0000-c000-000000000000"
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestAppRoleApproval-%[1]d"
+
+  required_resource_access {
+    resource_app_id = "00000003-0000-0000-c000-000000000000"
+
+    resource_access {
+      id   = "df021288-bdef-4463-88db-98f22de89214"
+      type = "Role"
+    }
+  }
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_admin_consent" "test" {
+  service_principal_object_id = azuread_service_principal.test.object_id
+}
+
+resource "azuread_service_principal_app_role_assignment_approval" "test" {
+  service_principal_object_id          = azuread_admin_consent.test.service_principal_object_id
+  resource_service_principal_object_id = data.azuread_service_principal.msgraph.object_id
+  app_role_ids                         = ["df021288-bdef-4463-88db-98f22de89214"]
+
+  timeouts {
+    create = "5m"
+  }
+}
+`, data.RandomInteger)
+}
@@ -2,6 +2,7 @@ package serviceprincipals
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,8 +18,16 @@ func servicePrincipalCertificateResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: servicePrincipalCertificateResourceCreate,
 		ReadContext:   servicePrincipalCertificateResourceRead,
+		UpdateContext: servicePrincipalCertificateResourceUpdate,
 		DeleteContext: servicePrincipalCertificateResourceDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
 			_, err := parse.CertificateID(id)
 			return err
@@ -70,11 +79,20 @@ func servicePrincipalCertificateResource() *schema.Resource {
 			},
 
 			"start_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_date_relative"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"start_date_relative": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"start_date"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
 			"end_date": {
@@ -93,6 +111,26 @@ func servicePrincipalCertificateResource() *schema.Resource {
 				ConflictsWith:    []string{"end_date"},
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
+
+			"lifecycle_hints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiry_warning_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -105,10 +143,21 @@ func servicePrincipalCertificateResourceCreate(ctx context.Context, d *schema.Re
 }
 
 func servicePrincipalCertificateResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if meta.(*clients.Client).EnableMsGraphBeta {
-		return servicePrincipalCertificateResourceReadMsGraph(ctx, d, meta)
+		diags = servicePrincipalCertificateResourceReadMsGraph(ctx, d, meta)
+	} else {
+		diags = servicePrincipalCertificateResourceReadAadGraph(ctx, d, meta)
+	}
+	if diags.HasError() {
+		return diags
 	}
-	return servicePrincipalCertificateResourceReadAadGraph(ctx, d, meta)
+	return append(diags, meta.(*clients.Client).CredentialExpiryDiags(d, "Service principal certificate")...)
+}
+
+func servicePrincipalCertificateResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only `lifecycle_hints` can be updated in-place, which is not sent to the API
+	return servicePrincipalCertificateResourceRead(ctx, d, meta)
 }
 
 func servicePrincipalCertificateResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
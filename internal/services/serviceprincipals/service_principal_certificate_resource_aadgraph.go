@@ -61,6 +61,11 @@ func servicePrincipalCertificateResourceCreateAadGraph(ctx context.Context, d *s
 
 	d.SetId(id.String())
 
+	// the thumbprint is calculated from the uploaded certificate, in case it's not returned by the API
+	if v := cred.Value; v != nil {
+		tf.Set(d, "thumbprint", utils.CertificateThumbprintFromEncodedPEM(*v))
+	}
+
 	return servicePrincipalCertificateResourceReadAadGraph(ctx, d, meta)
 }
 
@@ -117,6 +122,10 @@ func servicePrincipalCertificateResourceReadAadGraph(ctx context.Context, d *sch
 	}
 	tf.Set(d, "end_date", endDate)
 
+	if v := utils.CertificateThumbprintFromCustomKeyIdentifier(credential.CustomKeyIdentifier); v != "" {
+		tf.Set(d, "thumbprint", v)
+	}
+
 	return nil
 }
 
@@ -16,6 +16,7 @@ import (
 	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/utils"
 )
 
 func servicePrincipalCertificateResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -39,7 +40,12 @@ func servicePrincipalCertificateResourceCreateMsGraph(ctx context.Context, d *sc
 	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
 
-	app, status, err := client.Get(ctx, id.ObjectId)
+	// The service principal may have been created moments earlier in the same apply, so wait for it to become visible
+	var app *msgraph.ServicePrincipal
+	status, err := helpers.WaitForParentReplication(ctx, id.ObjectId, func() (status int, err error) {
+		app, status, err = client.Get(ctx, id.ObjectId)
+		return
+	})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagPathF(nil, "service_principal_id", "Service principal with object ID %q was not found", id.ObjectId)
@@ -63,12 +69,19 @@ func servicePrincipalCertificateResourceCreateMsGraph(ctx context.Context, d *sc
 		ID:             &id.ObjectId,
 		KeyCredentials: &newCredentials,
 	}
-	if _, err := client.Update(ctx, properties); err != nil {
+	if _, err := helpers.WaitForParentReplication(ctx, id.ObjectId, func() (int, error) {
+		return client.Update(ctx, properties)
+	}); err != nil {
 		return tf.ErrorDiagF(err, "Adding certificate for service principal with object ID %q", id.ObjectId)
 	}
 
 	d.SetId(id.String())
 
+	// the thumbprint is calculated from the uploaded certificate, in case it's not returned by the API
+	if v := credential.Key; v != nil {
+		tf.Set(d, "thumbprint", utils.CertificateThumbprintFromEncodedPEM(*v))
+	}
+
 	return servicePrincipalCertificateResourceReadMsGraph(ctx, d, meta)
 }
 
@@ -122,6 +135,10 @@ func servicePrincipalCertificateResourceReadMsGraph(ctx context.Context, d *sche
 	}
 	tf.Set(d, "end_date", endDate)
 
+	if v := utils.CertificateThumbprintFromCustomKeyIdentifier(credential.CustomKeyIdentifier); v != "" {
+		tf.Set(d, "thumbprint", v)
+	}
+
 	return nil
 }
 
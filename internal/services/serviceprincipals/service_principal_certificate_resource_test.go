@@ -77,6 +77,7 @@ func TestAccServicePrincipalCertificate_basic(t *testing.T) {
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("thumbprint").HasValue("B29066877F3CA826A4A597D1D3572AE7AD11765D"),
 			),
 		},
 		data.ImportStep("encoding", "value"),
@@ -0,0 +1,43 @@
+package serviceprincipals
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const servicePrincipalCredentialsDataSourceName = "azuread_service_principal_credentials"
+
+func servicePrincipalCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: servicePrincipalCredentialsDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"certificates": schemaKeyCredentialsComputed(),
+
+			"passwords": schemaPasswordCredentialsComputed(),
+		},
+	}
+}
+
+func servicePrincipalCredentialsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(servicePrincipalCredentialsDataSourceName); diags != nil {
+		return diags
+	}
+	return servicePrincipalCredentialsDataSourceReadMsGraph(ctx, d, meta)
+}
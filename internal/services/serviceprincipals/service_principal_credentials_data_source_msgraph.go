@@ -0,0 +1,34 @@
+package serviceprincipals
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func servicePrincipalCredentialsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+	objectId := d.Get("service_principal_id").(string)
+
+	servicePrincipal, status, err := client.Get(ctx, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Service Principal with object ID %q was not found", objectId), "service_principal_id", "Retrieving service principal")
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving service principal with object ID %q", objectId)
+	}
+
+	d.SetId(objectId)
+
+	tf.Set(d, "certificates", helpers.KeyCredentialsFlatten(servicePrincipal.KeyCredentials))
+	tf.Set(d, "passwords", helpers.PasswordCredentialsFlatten(servicePrincipal.PasswordCredentials))
+
+	return nil
+}
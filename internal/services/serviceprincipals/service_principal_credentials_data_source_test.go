@@ -0,0 +1,56 @@
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalCredentialsDataSource struct{}
+
+func TestAccServicePrincipalCredentialsDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_credentials", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ServicePrincipalCredentialsDataSource{}.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("certificates.#").HasValue("0"),
+				check.That(data.ResourceName).Key("passwords.#").HasValue("1"),
+				check.That(data.ResourceName).Key("passwords.0.key_id").MatchesOtherKey(check.That("azuread_service_principal_password.test").Key("key_id")),
+				check.That(data.ResourceName).Key("passwords.0.display_name").HasValue(fmt.Sprintf("acctestSPPassword-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("passwords.0.end_date").Exists(),
+			),
+		},
+	})
+}
+
+func (ServicePrincipalCredentialsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipalCredentials-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_service_principal_password" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  display_name         = "acctestSPPassword-%[1]d"
+}
+
+data "azuread_service_principal_credentials" "test" {
+  service_principal_id = azuread_service_principal_password.test.service_principal_id
+}
+`, data.RandomInteger)
+}
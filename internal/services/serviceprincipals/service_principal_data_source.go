@@ -2,18 +2,53 @@ package serviceprincipals
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
 
+const (
+	servicePrincipalMatchExact      = "exact"
+	servicePrincipalMatchStartsWith = "startsWith"
+)
+
+// servicePrincipalCandidatesLimit is the maximum number of candidates listed when more than one service principal
+// matches the specified display name
+const servicePrincipalCandidatesLimit = 10
+
+type servicePrincipalCandidate struct {
+	applicationId string
+	displayName   string
+	objectId      string
+}
+
+func newServicePrincipalCandidate(displayName string, objectId, applicationId *string) servicePrincipalCandidate {
+	c := servicePrincipalCandidate{displayName: displayName}
+	if objectId != nil {
+		c.objectId = *objectId
+	}
+	if applicationId != nil {
+		c.applicationId = *applicationId
+	}
+	return c
+}
+
 func servicePrincipalData() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: servicePrincipalDataSourceRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"object_id": {
 				Type:             schema.TypeString,
@@ -39,11 +74,25 @@ func servicePrincipalData() *schema.Resource {
 				ConflictsWith:    []string{"object_id", "display_name"},
 			},
 
+			"match": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  servicePrincipalMatchExact,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicePrincipalMatchExact,
+					servicePrincipalMatchStartsWith,
+				}, false),
+			},
+
 			"app_roles": schemaAppRolesComputed(),
 
 			"oauth2_permissions": schemaOauth2PermissionsComputed(), // TODO: v2.0 remove this
 
 			"oauth2_permission_scopes": schemaOauth2PermissionScopesComputed(),
+
+			"key_credentials": schemaKeyCredentialsComputed(),
+
+			"password_credentials": schemaPasswordCredentialsComputed(),
 		},
 	}
 }
@@ -54,3 +103,38 @@ func servicePrincipalDataSourceRead(ctx context.Context, d *schema.ResourceData,
 	}
 	return servicePrincipalDataSourceReadAadGraph(ctx, d, meta)
 }
+
+// servicePrincipalDisplayNameFilter returns an OData filter for service principals matching the provided display name
+func servicePrincipalDisplayNameFilter(displayName, match string) string {
+	if match == servicePrincipalMatchStartsWith {
+		return fmt.Sprintf("startswith(displayName, '%s')", displayName)
+	}
+	return fmt.Sprintf("displayName eq '%s'", displayName)
+}
+
+// servicePrincipalDisplayNameMatches returns whether a service principal display name matches the specified display name
+func servicePrincipalDisplayNameMatches(actual, displayName, match string) bool {
+	if match == servicePrincipalMatchStartsWith {
+		return strings.HasPrefix(strings.ToLower(actual), strings.ToLower(displayName))
+	}
+	return actual == displayName
+}
+
+// servicePrincipalMultipleMatchesDiag returns an error diagnostic listing the service principals matching a display name
+func servicePrincipalMultipleMatchesDiag(displayName, match string, candidates []servicePrincipalCandidate) diag.Diagnostics {
+	lines := make([]string, 0, len(candidates))
+	for i, c := range candidates {
+		if i == servicePrincipalCandidatesLimit {
+			lines = append(lines, fmt.Sprintf("  (and %d more)", len(candidates)-servicePrincipalCandidatesLimit))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("  - %q (object ID: %q, application ID: %q)", c.displayName, c.objectId, c.applicationId))
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("Found %d service principals matching display name %q (match: %s)", len(candidates), displayName, match),
+		Detail:        fmt.Sprintf("Specify the `object_id` or `application_id` of the intended service principal, or a more specific `display_name`. Matching service principals:\n%s", strings.Join(lines, "\n")),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "display_name"}},
+	}}
+}
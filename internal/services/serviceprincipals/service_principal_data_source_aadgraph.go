@@ -36,24 +36,30 @@ func servicePrincipalDataSourceReadAadGraph(ctx context.Context, d *schema.Resou
 	} else if _, ok := d.GetOk("display_name"); ok {
 		// use the display_name to find the Azure AD service principal
 		displayName := d.Get("display_name").(string)
-		filter := fmt.Sprintf("displayName eq '%s'", displayName)
+		match := d.Get("match").(string)
+		filter := servicePrincipalDisplayNameFilter(displayName, match)
 
 		apps, err := client.ListComplete(ctx, filter)
 		if err != nil {
 			return tf.ErrorDiagF(err, "Listing service principals for filter %q", filter)
 		}
 
-		for _, app := range *apps.Response().Value {
-			if app.DisplayName == nil {
-				continue
+		var candidates []servicePrincipalCandidate
+		for apps.NotDone() {
+			app := apps.Value()
+			if app.DisplayName != nil && servicePrincipalDisplayNameMatches(*app.DisplayName, displayName, match) {
+				sp = &app
+				candidates = append(candidates, newServicePrincipalCandidate(*app.DisplayName, app.ObjectID, app.AppID))
 			}
 
-			if *app.DisplayName == displayName {
-				sp = &app
-				break
+			if err := apps.NextWithContext(ctx); err != nil {
+				return tf.ErrorDiagF(err, "Listing service principals for filter %q", filter)
 			}
 		}
 
+		if len(candidates) > 1 {
+			return servicePrincipalMultipleMatchesDiag(displayName, match, candidates)
+		}
 		if sp == nil {
 			return tf.ErrorDiagF(nil, "No service principal found matching display name: %q", displayName)
 		}
@@ -17,6 +17,7 @@ import (
 
 func servicePrincipalDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.MsClient
+	wellKnownCache := meta.(*clients.Client).ServicePrincipals.WellKnownCache
 
 	var servicePrincipal *msgraph.ServicePrincipal
 
@@ -34,7 +35,8 @@ func servicePrincipalDataSourceReadMsGraph(ctx context.Context, d *schema.Resour
 		servicePrincipal = sp
 	} else if _, ok := d.GetOk("display_name"); ok {
 		displayName := d.Get("display_name").(string)
-		filter := fmt.Sprintf("displayName eq '%s'", displayName)
+		match := d.Get("match").(string)
+		filter := servicePrincipalDisplayNameFilter(displayName, match)
 
 		result, _, err := client.List(ctx, filter)
 		if err != nil {
@@ -44,20 +46,24 @@ func servicePrincipalDataSourceReadMsGraph(ctx context.Context, d *schema.Resour
 			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
 		}
 
-		for _, sp := range *result {
-			if sp.DisplayName == nil {
+		var candidates []servicePrincipalCandidate
+		for i, sp := range *result {
+			if sp.DisplayName == nil || !servicePrincipalDisplayNameMatches(*sp.DisplayName, displayName, match) {
 				continue
 			}
 
-			if *sp.DisplayName == displayName {
-				servicePrincipal = &sp
-				break
-			}
+			servicePrincipal = &(*result)[i]
+			candidates = append(candidates, newServicePrincipalCandidate(*sp.DisplayName, sp.ID, sp.AppId))
 		}
 
+		if len(candidates) > 1 {
+			return servicePrincipalMultipleMatchesDiag(displayName, match, candidates)
+		}
 		if servicePrincipal == nil {
 			return tf.ErrorDiagF(nil, "No service principal found matching display name: %q", displayName)
 		}
+	} else if sp, ok := wellKnownCache.Get(d.Get("application_id").(string)); ok {
+		servicePrincipal = sp
 	} else {
 		applicationId := d.Get("application_id").(string)
 		filter := fmt.Sprintf("appId eq '%s'", applicationId)
@@ -84,6 +90,8 @@ func servicePrincipalDataSourceReadMsGraph(ctx context.Context, d *schema.Resour
 		if servicePrincipal == nil {
 			return tf.ErrorDiagF(nil, "No service principal found for application ID: %q", applicationId)
 		}
+
+		wellKnownCache.Add(*servicePrincipal)
 	}
 
 	if servicePrincipal.ID == nil {
@@ -95,9 +103,11 @@ func servicePrincipalDataSourceReadMsGraph(ctx context.Context, d *schema.Resour
 	tf.Set(d, "app_roles", helpers.ApplicationFlattenAppRoles(servicePrincipal.AppRoles))
 	tf.Set(d, "application_id", servicePrincipal.AppId)
 	tf.Set(d, "display_name", servicePrincipal.DisplayName)
+	tf.Set(d, "key_credentials", helpers.KeyCredentialsFlatten(servicePrincipal.KeyCredentials))
 	tf.Set(d, "oauth2_permission_scopes", helpers.ApplicationFlattenOAuth2PermissionScopes(servicePrincipal.PublishedPermissionScopes))
 	tf.Set(d, "oauth2_permissions", helpers.ApplicationFlattenOAuth2Permissions(servicePrincipal.PublishedPermissionScopes)) // TODO: v2.0 remove this
 	tf.Set(d, "object_id", servicePrincipal.ID)
+	tf.Set(d, "password_credentials", helpers.PasswordCredentialsFlatten(servicePrincipal.PasswordCredentials))
 
 	return nil
 }
@@ -2,6 +2,8 @@ package serviceprincipals_test
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -54,6 +56,33 @@ func TestAccServicePrincipalDataSource_byDisplayName(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalDataSource_byDisplayNameStartsWith(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal", "test")
+	r := ServicePrincipalDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.byDisplayNameStartsWith(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("object_id").MatchesOtherKey(check.That("azuread_service_principal.test").Key("object_id")),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func TestAccServicePrincipalDataSource_byDisplayNameMultipleMatches(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal", "test")
+	r := ServicePrincipalDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config:      r.byDisplayNameMultipleMatches(data),
+			ExpectError: regexp.MustCompile(`Found 2 service principals matching display name`),
+		},
+	})
+}
+
 func TestAccServicePrincipalDataSource_byObjectId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azuread_service_principal", "test")
 	r := ServicePrincipalDataSource{}
@@ -75,6 +104,28 @@ func TestAccServicePrincipalDataSource_byObjectId(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalDataSource_credentials(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal", "test")
+	r := ServicePrincipalDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.credentials(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("key_credentials.#").HasValue("0"),
+				check.That(data.ResourceName).Key("password_credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("password_credentials.0.end_date").Exists(),
+				check.That(data.ResourceName).Key("password_credentials.0.hint").Exists(),
+				resource.TestCheckResourceAttrPair(data.ResourceName, "password_credentials.0.key_id", "azuread_service_principal_password.test", "key_id"),
+			),
+		},
+	})
+}
+
 func (ServicePrincipalDataSource) byApplicationId(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -95,6 +146,40 @@ data "azuread_service_principal" "test" {
 `, ServicePrincipalResource{}.basic(data))
 }
 
+func (ServicePrincipalDataSource) byDisplayNameStartsWith(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal" "test" {
+  display_name = "acctestServicePrincipal-%[2]d"
+  match        = "startsWith"
+
+  depends_on = [azuread_service_principal.test]
+}
+`, ServicePrincipalResource{}.basic(data), data.RandomInteger)
+}
+
+func (ServicePrincipalDataSource) byDisplayNameMultipleMatches(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "other" {
+  display_name = "acctestServicePrincipal-%[2]d-other"
+}
+
+resource "azuread_service_principal" "other" {
+  application_id = azuread_application.other.application_id
+}
+
+data "azuread_service_principal" "test" {
+  display_name = "acctestServicePrincipal-%[2]d"
+  match        = "startsWith"
+
+  depends_on = [azuread_service_principal.test, azuread_service_principal.other]
+}
+`, ServicePrincipalResource{}.basic(data), data.RandomInteger)
+}
+
 func (ServicePrincipalDataSource) byObjectId(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -104,3 +189,13 @@ data "azuread_service_principal" "test" {
 }
 `, ServicePrincipalResource{}.basic(data))
 }
+
+func (ServicePrincipalDataSource) credentials(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal" "test" {
+  object_id = azuread_service_principal_password.test.service_principal_id
+}
+`, ServicePrincipalPasswordResource{}.basic(data))
+}
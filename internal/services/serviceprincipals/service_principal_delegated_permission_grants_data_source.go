@@ -0,0 +1,77 @@
+package serviceprincipals
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+const servicePrincipalDelegatedPermissionGrantsDataSourceName = "azuread_service_principal_delegated_permission_grants"
+
+func servicePrincipalDelegatedPermissionGrantsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: servicePrincipalDelegatedPermissionGrantsDataSourceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"resource_service_principal_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"grants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"claim_values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"consent_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_service_principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func servicePrincipalDelegatedPermissionGrantsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := meta.(*clients.Client).RequireMsGraph(servicePrincipalDelegatedPermissionGrantsDataSourceName); diags != nil {
+		return diags
+	}
+	return servicePrincipalDelegatedPermissionGrantsDataSourceReadMsGraph(ctx, d, meta)
+}
@@ -0,0 +1,67 @@
+package serviceprincipals
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func servicePrincipalDelegatedPermissionGrantsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+	objectId := d.Get("service_principal_id").(string)
+	resourceId := d.Get("resource_service_principal_id").(string)
+
+	grants, status, err := helpers.ServicePrincipalDelegatedPermissionGrants(ctx, client.BaseClient, objectId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(fmt.Errorf("Service Principal with object ID %q was not found", objectId), "service_principal_id", "Retrieving delegated permission grants")
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving delegated permission grants for service principal with object ID %q", objectId)
+	}
+
+	result := make([]map[string]interface{}, 0, len(grants))
+	for _, grant := range grants {
+		grantResourceId := ""
+		if grant.ResourceId != nil {
+			grantResourceId = *grant.ResourceId
+		}
+		if resourceId != "" && !strings.EqualFold(grantResourceId, resourceId) {
+			continue
+		}
+
+		id := ""
+		if grant.ID != nil {
+			id = *grant.ID
+		}
+		consentType := ""
+		if grant.ConsentType != nil {
+			consentType = *grant.ConsentType
+		}
+		principalId := ""
+		if grant.PrincipalId != nil {
+			principalId = *grant.PrincipalId
+		}
+
+		result = append(result, map[string]interface{}{
+			"claim_values":                  grant.Scopes(),
+			"consent_type":                  consentType,
+			"id":                            id,
+			"principal_object_id":           principalId,
+			"resource_service_principal_id": grantResourceId,
+		})
+	}
+
+	d.SetId(objectId)
+
+	tf.Set(d, "grants", result)
+
+	return nil
+}
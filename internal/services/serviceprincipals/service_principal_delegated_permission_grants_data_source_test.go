@@ -0,0 +1,48 @@
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalDelegatedPermissionGrantsDataSource struct{}
+
+func TestAccServicePrincipalDelegatedPermissionGrantsDataSource_noGrants(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_delegated_permission_grants", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ServicePrincipalDelegatedPermissionGrantsDataSource{}.noGrants(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("service_principal_id").MatchesOtherKey(check.That("azuread_service_principal.test").Key("object_id")),
+				check.That(data.ResourceName).Key("grants.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (ServicePrincipalDelegatedPermissionGrantsDataSource) noGrants(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestDelegatedPermissionGrants-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+data "azuread_service_principal_delegated_permission_grants" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+}
+`, data.RandomInteger)
+}
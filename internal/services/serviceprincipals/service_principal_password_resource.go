@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +19,16 @@ func servicePrincipalPasswordResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: servicePrincipalPasswordResourceCreate,
 		ReadContext:   servicePrincipalPasswordResourceRead,
+		UpdateContext: servicePrincipalPasswordResourceUpdate,
 		DeleteContext: servicePrincipalPasswordResourceDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service_principal_id": {
 				Type:             schema.TypeString,
@@ -65,11 +74,20 @@ func servicePrincipalPasswordResource() *schema.Resource {
 			},
 
 			"start_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_date_relative"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"start_date_relative": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"start_date"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
 			"end_date": {
@@ -88,6 +106,21 @@ func servicePrincipalPasswordResource() *schema.Resource {
 				ConflictsWith:    []string{"end_date"},
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
+
+			"lifecycle_hints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiry_warning_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 		},
 
 		SchemaVersion: 1,
@@ -109,10 +142,21 @@ func servicePrincipalPasswordResourceCreate(ctx context.Context, d *schema.Resou
 }
 
 func servicePrincipalPasswordResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	if meta.(*clients.Client).EnableMsGraphBeta {
-		return servicePrincipalPasswordResourceReadMsGraph(ctx, d, meta)
+		diags = servicePrincipalPasswordResourceReadMsGraph(ctx, d, meta)
+	} else {
+		diags = servicePrincipalPasswordResourceReadAadGraph(ctx, d, meta)
 	}
-	return servicePrincipalPasswordResourceReadAadGraph(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, meta.(*clients.Client).CredentialExpiryDiags(d, "Service principal password")...)
+}
+
+func servicePrincipalPasswordResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// only `lifecycle_hints` can be updated in-place, which is not sent to the API
+	return servicePrincipalPasswordResourceRead(ctx, d, meta)
 }
 
 func servicePrincipalPasswordResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
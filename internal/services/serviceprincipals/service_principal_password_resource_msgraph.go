@@ -22,14 +22,6 @@ func servicePrincipalPasswordResourceCreateMsGraph(ctx context.Context, d *schem
 	client := meta.(*clients.Client).ServicePrincipals.MsClient
 	objectId := d.Get("service_principal_id").(string)
 
-	if val, ok := d.GetOk("description"); ok && val.(string) != "" {
-		return tf.ErrorDiagPathF(fmt.Errorf("`description` is a read-only field when using Microsoft Graph. Please remove the `description` field from your configuration"), "description", "Creating service principal password")
-	}
-
-	if val, ok := d.GetOk("display_name"); ok && val.(string) != "" {
-		return tf.ErrorDiagPathF(fmt.Errorf("`display_name` is a read-only field when using Microsoft Graph. Please remove the `display_name` field from your configuration"), "display_name", "Creating service principal password")
-	}
-
 	if val, ok := d.GetOk("end_date"); ok && val.(string) != "" {
 		return tf.ErrorDiagPathF(fmt.Errorf("`end_date` is a read-only field when using Microsoft Graph. Please remove the `end_date` field from your configuration"), "end_date", "Creating service principal password")
 	}
@@ -46,6 +38,10 @@ func servicePrincipalPasswordResourceCreateMsGraph(ctx context.Context, d *schem
 		return tf.ErrorDiagPathF(fmt.Errorf("`start_date` is a read-only field when using Microsoft Graph. Please remove the `start_date` field from your configuration"), "start_date", "Creating service principal password")
 	}
 
+	if val, ok := d.GetOk("start_date_relative"); ok && val.(string) != "" {
+		return tf.ErrorDiagPathF(fmt.Errorf("`start_date_relative` is a read-only field when using Microsoft Graph. Please remove the `start_date_relative` field from your configuration"), "start_date_relative", "Creating service principal password")
+	}
+
 	if val, ok := d.GetOk("value"); ok && val.(string) != "" {
 		return tf.ErrorDiagPathF(fmt.Errorf("`value` is a read-only field when using Microsoft Graph. Please remove the `value` field from your configuration"), "value", "Creating service principal password")
 	}
@@ -65,7 +61,12 @@ func servicePrincipalPasswordResourceCreateMsGraph(ctx context.Context, d *schem
 	tf.LockByName(servicePrincipalResourceName, objectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, objectId)
 
-	sp, status, err := client.Get(ctx, objectId)
+	// The service principal may have been created moments earlier in the same apply, so wait for it to become visible
+	var sp *msgraph.ServicePrincipal
+	status, err := helpers.WaitForParentReplication(ctx, objectId, func() (status int, err error) {
+		sp, status, err = client.Get(ctx, objectId)
+		return
+	})
 	if err != nil {
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagPathF(nil, "service_principal_id", "Service principal with object ID %q was not found", objectId)
@@ -76,7 +77,11 @@ func servicePrincipalPasswordResourceCreateMsGraph(ctx context.Context, d *schem
 		return tf.ErrorDiagF(errors.New("nil service principal or service principal with nil ID was returned"), "API error retrieving service principal with object ID %q", objectId)
 	}
 
-	newCredential, _, err := client.AddPassword(ctx, *sp.ID, *credential)
+	var newCredential *msgraph.PasswordCredential
+	_, err = helpers.WaitForParentReplication(ctx, *sp.ID, func() (status int, err error) {
+		newCredential, status, err = client.AddPassword(ctx, *sp.ID, *credential)
+		return
+	})
 	if err != nil {
 		return tf.ErrorDiagF(err, "Adding password for service principal with object ID %q", *sp.ID)
 	}
@@ -39,6 +39,26 @@ func TestAccServicePrincipalPassword_basic(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalPassword_displayName(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_service_principal_password", "test")
+	r := ServicePrincipalPasswordResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.displayName(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctest-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+	})
+}
+
 func TestAccServicePrincipalPassword_updateDeprecated(t *testing.T) {
 	// TODO: remove this test in v2.0
 	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v != "" {
@@ -211,6 +231,17 @@ resource "azuread_service_principal_password" "test" {
 `, r.template(data))
 }
 
+func (r ServicePrincipalPasswordResource) displayName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_password" "test" {
+  service_principal_id = azuread_service_principal.test.object_id
+  display_name         = "acctest-%[2]d"
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ServicePrincipalPasswordResource) basicAadGraph(data acceptance.TestData, endDate string) string {
 	// TODO: remove this config in v2.0
 	return fmt.Sprintf(`
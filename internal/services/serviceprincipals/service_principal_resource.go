@@ -3,10 +3,14 @@ package serviceprincipals
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
@@ -22,6 +26,13 @@ func servicePrincipalResource() *schema.Resource {
 		UpdateContext: servicePrincipalResourceUpdate,
 		DeleteContext: servicePrincipalResourceDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
 			if _, err := uuid.ParseUUID(id); err != nil {
 				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
@@ -32,21 +43,52 @@ func servicePrincipalResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ForceNew:         true,
+				ExactlyOneOf:     []string{"application_id", "use_template"},
 				ValidateDiagFunc: validate.UUID,
 			},
 
+			"use_template": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"application_id", "use_template"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"template_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validate.UUID,
+						},
+					},
+				},
+			},
+
 			"app_role_assignment_required": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
 
+			"custom_security_attribute": schemaCustomSecurityAttributes(),
+
 			"display_name": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"key_credentials": schemaKeyCredentialsComputed(),
+
 			"object_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,6 +100,23 @@ func servicePrincipalResource() *schema.Resource {
 
 			"oauth2_permission_scopes": schemaOauth2PermissionScopesComputed(),
 
+			"password_credentials": schemaPasswordCredentialsComputed(),
+
+			"preferred_single_sign_on_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"preferred_token_signing_key_thumbprint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9A-Fa-f]{40}$`), "thumbprint must be a 40 character hexadecimal string"),
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
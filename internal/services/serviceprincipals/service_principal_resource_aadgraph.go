@@ -19,6 +19,18 @@ import (
 func servicePrincipalResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.AadClient
 
+	if v, ok := d.GetOk("custom_security_attribute"); ok && v.(*schema.Set).Len() > 0 {
+		return tf.ErrorDiagPathF(errors.New("custom security attributes are only supported when using Microsoft Graph"), "custom_security_attribute", "Could not set custom security attributes for service principal")
+	}
+
+	if v, ok := d.GetOk("preferred_token_signing_key_thumbprint"); ok && v.(string) != "" {
+		return tf.ErrorDiagPathF(errors.New("the preferred token signing key thumbprint is only supported when using Microsoft Graph"), "preferred_token_signing_key_thumbprint", "Could not set preferred token signing key thumbprint for service principal")
+	}
+
+	if v, ok := d.GetOk("use_template"); ok && len(v.([]interface{})) > 0 {
+		return tf.ErrorDiagPathF(errors.New("creating service principals from application templates is only supported when using Microsoft Graph"), "use_template", "Could not create service principal")
+	}
+
 	applicationId := d.Get("application_id").(string)
 
 	properties := graphrbac.ServicePrincipalCreateParameters{
@@ -58,6 +70,14 @@ func servicePrincipalResourceCreateAadGraph(ctx context.Context, d *schema.Resou
 func servicePrincipalResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.AadClient
 
+	if v, ok := d.GetOk("custom_security_attribute"); ok && v.(*schema.Set).Len() > 0 {
+		return tf.ErrorDiagPathF(errors.New("custom security attributes are only supported when using Microsoft Graph"), "custom_security_attribute", "Could not set custom security attributes for service principal")
+	}
+
+	if v, ok := d.GetOk("preferred_token_signing_key_thumbprint"); ok && v.(string) != "" {
+		return tf.ErrorDiagPathF(errors.New("the preferred token signing key thumbprint is only supported when using Microsoft Graph"), "preferred_token_signing_key_thumbprint", "Could not set preferred token signing key thumbprint for service principal")
+	}
+
 	var properties graphrbac.ServicePrincipalUpdateParameters
 
 	if d.HasChange("app_role_assignment_required") {
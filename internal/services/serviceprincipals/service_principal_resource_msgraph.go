@@ -20,6 +20,10 @@ import (
 func servicePrincipalResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.MsClient
 
+	if v, ok := d.GetOk("use_template"); ok && len(v.([]interface{})) > 0 {
+		return servicePrincipalResourceCreateFromTemplateMsGraph(ctx, d, meta, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	properties := msgraph.ServicePrincipal{
 		AccountEnabled: utils.Bool(true),
 		AppId:          utils.String(d.Get("application_id").(string)),
@@ -33,7 +37,12 @@ func servicePrincipalResourceCreateMsGraph(ctx context.Context, d *schema.Resour
 		properties.Tags = tf.ExpandStringSlicePtr(v.(*schema.Set).List())
 	}
 
-	servicePrincipal, _, err := client.Create(ctx, properties)
+	// The application may have been created moments earlier and not yet be visible
+	var servicePrincipal *msgraph.ServicePrincipal
+	err := helpers.WaitForReferenceReplication(ctx, func() (status int, err error) {
+		servicePrincipal, status, err = client.Create(ctx, properties)
+		return
+	})
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not create service principal")
 	}
@@ -42,6 +51,83 @@ func servicePrincipalResourceCreateMsGraph(ctx context.Context, d *schema.Resour
 	}
 	d.SetId(*servicePrincipal.ID)
 
+	return servicePrincipalResourceCreateCompleteMsGraph(ctx, d, meta)
+}
+
+// servicePrincipalResourceCreateFromTemplateMsGraph instantiates an application and service principal from a gallery
+// application template, and applies the preconfigured single sign-on mode of the template to the service principal.
+func servicePrincipalResourceCreateFromTemplateMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}, template map[string]interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	templateId := template["template_id"].(string)
+	displayName := template["display_name"].(string)
+
+	applicationTemplate, status, err := helpers.ApplicationTemplateGet(ctx, client.BaseClient, templateId)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return tf.ErrorDiagPathF(nil, "use_template.0.template_id", "No application template found with ID: %q", templateId)
+		}
+		return tf.ErrorDiagPathF(err, "use_template.0.template_id", "Retrieving application template with ID: %q", templateId)
+	}
+
+	result, _, err := helpers.ApplicationTemplateInstantiate(ctx, client.BaseClient, templateId, displayName)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not instantiate application template with ID: %q", templateId)
+	}
+	if result.ServicePrincipal == nil || result.ServicePrincipal.ID == nil || *result.ServicePrincipal.ID == "" {
+		return tf.ErrorDiagF(errors.New("Object ID returned for service principal is nil"), "Bad API response")
+	}
+	d.SetId(*result.ServicePrincipal.ID)
+
+	// Wait for the instantiated service principal to be replicated before configuring it
+	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+		return client.Get(ctx, d.Id())
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for service principal with object ID: %q", d.Id())
+	}
+
+	properties := msgraph.ServicePrincipal{
+		ID: utils.String(d.Id()),
+	}
+
+	if applicationTemplate.SupportsSingleSignOnMode(helpers.ApplicationTemplateSingleSignOnModeSaml) {
+		properties.PreferredSingleSignOnMode = utils.String(helpers.ApplicationTemplateSingleSignOnModeSaml)
+	}
+
+	if v, ok := d.GetOk("app_role_assignment_required"); ok {
+		properties.AppRoleAssignmentRequired = utils.Bool(v.(bool))
+	}
+
+	// Tags set by the template are left intact unless tags are specified
+	if v, ok := d.GetOk("tags"); ok {
+		properties.Tags = tf.ExpandStringSlicePtr(v.(*schema.Set).List())
+	}
+
+	if _, err := client.Update(ctx, properties); err != nil {
+		return tf.ErrorDiagF(err, "Configuring service principal with object ID: %q", d.Id())
+	}
+
+	return servicePrincipalResourceCreateCompleteMsGraph(ctx, d, meta)
+}
+
+// servicePrincipalResourceCreateCompleteMsGraph sets the properties of a newly created service principal which cannot
+// be specified at creation time.
+func servicePrincipalResourceCreateCompleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	if v, ok := d.GetOk("custom_security_attribute"); ok {
+		if _, err := helpers.CustomSecurityAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/servicePrincipals/%s", d.Id()), nil, v.(*schema.Set).List()); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attribute", "Could not set custom security attributes for service principal with object ID: %q", d.Id())
+		}
+	}
+
+	if v, ok := d.GetOk("preferred_token_signing_key_thumbprint"); ok {
+		if _, err := helpers.ServicePrincipalSetPreferredTokenSigningKeyThumbprint(ctx, client.BaseClient, d.Id(), v.(string)); err != nil {
+			return tf.ErrorDiagPathF(err, "preferred_token_signing_key_thumbprint", "Could not set preferred token signing key thumbprint for service principal with object ID: %q", d.Id())
+		}
+	}
+
 	return servicePrincipalResourceReadMsGraph(ctx, d, meta)
 }
 
@@ -68,6 +154,19 @@ func servicePrincipalResourceUpdateMsGraph(ctx context.Context, d *schema.Resour
 		return tf.ErrorDiagF(err, "Updating service principal with object ID: %q", d.Id())
 	}
 
+	if d.HasChange("custom_security_attribute") {
+		o, n := d.GetChange("custom_security_attribute")
+		if _, err := helpers.CustomSecurityAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/servicePrincipals/%s", d.Id()), o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attribute", "Could not update custom security attributes for service principal with object ID: %q", d.Id())
+		}
+	}
+
+	if d.HasChange("preferred_token_signing_key_thumbprint") {
+		if _, err := helpers.ServicePrincipalSetPreferredTokenSigningKeyThumbprint(ctx, client.BaseClient, d.Id(), d.Get("preferred_token_signing_key_thumbprint").(string)); err != nil {
+			return tf.ErrorDiagPathF(err, "preferred_token_signing_key_thumbprint", "Could not update preferred token signing key thumbprint for service principal with object ID: %q", d.Id())
+		}
+	}
+
 	return servicePrincipalResourceReadMsGraph(ctx, d, meta)
 }
 
@@ -87,13 +186,28 @@ func servicePrincipalResourceReadMsGraph(ctx context.Context, d *schema.Resource
 		return tf.ErrorDiagF(err, "retrieving service principal with object ID: %q", d.Id())
 	}
 
+	customSecurityAttributes, _, err := helpers.CustomSecurityAttributesGet(ctx, client.BaseClient, fmt.Sprintf("/servicePrincipals/%s", objectId))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "custom_security_attribute", "Could not retrieve custom security attributes for service principal with object ID %q", objectId)
+	}
+
+	preferredTokenSigningKeyThumbprint, _, err := helpers.ServicePrincipalGetPreferredTokenSigningKeyThumbprint(ctx, client.BaseClient, objectId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "preferred_token_signing_key_thumbprint", "Could not retrieve preferred token signing key thumbprint for service principal with object ID %q", objectId)
+	}
+
 	tf.Set(d, "app_role_assignment_required", servicePrincipal.AppRoleAssignmentRequired)
 	tf.Set(d, "app_roles", helpers.ApplicationFlattenAppRoles(servicePrincipal.AppRoles))
 	tf.Set(d, "application_id", servicePrincipal.AppId)
+	tf.Set(d, "custom_security_attribute", customSecurityAttributes)
 	tf.Set(d, "display_name", servicePrincipal.DisplayName)
+	tf.Set(d, "key_credentials", helpers.KeyCredentialsFlatten(servicePrincipal.KeyCredentials))
 	tf.Set(d, "oauth2_permission_scopes", helpers.ApplicationFlattenOAuth2PermissionScopes(servicePrincipal.PublishedPermissionScopes))
 	tf.Set(d, "oauth2_permissions", helpers.ApplicationFlattenOAuth2Permissions(servicePrincipal.PublishedPermissionScopes)) // TODO: v2.0 remove this
 	tf.Set(d, "object_id", servicePrincipal.ID)
+	tf.Set(d, "password_credentials", helpers.PasswordCredentialsFlatten(servicePrincipal.PasswordCredentials))
+	tf.Set(d, "preferred_single_sign_on_mode", servicePrincipal.PreferredSingleSignOnMode)
+	tf.Set(d, "preferred_token_signing_key_thumbprint", preferredTokenSigningKeyThumbprint)
 	tf.Set(d, "tags", servicePrincipal.Tags)
 
 	return nil
@@ -102,7 +216,7 @@ func servicePrincipalResourceReadMsGraph(ctx context.Context, d *schema.Resource
 func servicePrincipalResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.MsClient
 
-	_, status, err := client.Get(ctx, d.Id())
+	servicePrincipal, status, err := client.Get(ctx, d.Id())
 	if err != nil {
 		if status == http.StatusNotFound {
 			return tf.ErrorDiagPathF(fmt.Errorf("Service Principal was not found"), "id", "Retrieving service principal with object ID %q", d.Id())
@@ -111,6 +225,21 @@ func servicePrincipalResourceDeleteMsGraph(ctx context.Context, d *schema.Resour
 		return tf.ErrorDiagPathF(err, "id", "Retrieving service principal with object ID %q", d.Id())
 	}
 
+	// The application instantiated from a template is owned by this resource, and deleting it also deletes the service principal
+	if v, ok := d.GetOk("use_template"); ok && len(v.([]interface{})) > 0 && servicePrincipal.AppId != nil {
+		appsClient := &msgraph.ApplicationsClient{BaseClient: client.BaseClient}
+		apps, _, err := appsClient.List(ctx, fmt.Sprintf("appId eq '%s'", *servicePrincipal.AppId))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "use_template", "Retrieving application with application ID %q", *servicePrincipal.AppId)
+		}
+		if apps != nil && len(*apps) > 0 && (*apps)[0].ID != nil {
+			if status, err := appsClient.Delete(ctx, *(*apps)[0].ID); err != nil {
+				return tf.ErrorDiagPathF(err, "use_template", "Deleting application with object ID %q, got status %d", *(*apps)[0].ID, status)
+			}
+			return nil
+		}
+	}
+
 	status, err = client.Delete(ctx, d.Id())
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "id", "Deleting service principal with object ID %q, got status %d", d.Id(), status)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -78,6 +79,48 @@ func TestAccServicePrincipal_update(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_customSecurityAttributes(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.customSecurityAttributes(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attribute.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipal_fromTemplate(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.fromTemplate(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_id").IsUuid(),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("preferred_single_sign_on_mode").HasValue("saml"),
+			),
+		},
+		data.ImportStep("use_template"),
+	})
+}
+
 func (r ServicePrincipalResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	var id *string
 
@@ -169,3 +212,63 @@ resource "azuread_service_principal" "test" {
 }
 `, data.RandomInteger, data.UUID(), data.UUID())
 }
+
+func (ServicePrincipalResource) customSecurityAttributes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name = "acctest%[2]s"
+}
+
+resource "azuread_custom_security_attribute_definition" "string" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "acctestString"
+  type          = "String"
+  collection    = true
+}
+
+resource "azuread_custom_security_attribute_definition" "integer" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "acctestInteger"
+  type          = "Integer"
+}
+
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+
+  custom_security_attribute {
+    attribute_set = azuread_custom_security_attribute_definition.string.attribute_set
+    name          = azuread_custom_security_attribute_definition.string.name
+    collection    = true
+    values        = ["foo", "bar"]
+  }
+
+  custom_security_attribute {
+    attribute_set = azuread_custom_security_attribute_definition.integer.attribute_set
+    name          = azuread_custom_security_attribute_definition.integer.name
+    type          = "Integer"
+    values        = ["42"]
+  }
+}
+`, data.RandomInteger, data.RandomString)
+}
+
+func (ServicePrincipalResource) fromTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_application_template" "test" {
+  display_name = "AWS Single-Account Access"
+}
+
+resource "azuread_service_principal" "test" {
+  app_role_assignment_required = true
+
+  use_template {
+    template_id  = data.azuread_application_template.test.template_id
+    display_name = "acctestServicePrincipal-%[1]d"
+  }
+}
+`, data.RandomInteger)
+}
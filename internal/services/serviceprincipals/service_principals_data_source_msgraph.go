@@ -0,0 +1,63 @@
+package serviceprincipals
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func servicePrincipalsDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.MsClient
+
+	search := d.Get("search").(string)
+
+	tags := *tf.ExpandStringSlicePtr(d.Get("tags").(*schema.Set).List())
+	sort.Strings(tags)
+	filter := helpers.TagsFilter(tags)
+
+	servicePrincipals, _, err := helpers.ServicePrincipalsSearch(ctx, client.BaseClient, search, filter)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Searching for service principals matching search %q and filter %q", search, filter)
+	}
+
+	applicationIds := make([]string, 0, len(servicePrincipals))
+	displayNames := make([]string, 0, len(servicePrincipals))
+	objectIds := make([]string, 0, len(servicePrincipals))
+	for _, servicePrincipal := range servicePrincipals {
+		if servicePrincipal.ID == nil || servicePrincipal.AppId == nil {
+			return tf.ErrorDiagF(errors.New("API returned service principal with nil object ID or application ID"), "Bad API response")
+		}
+
+		applicationIds = append(applicationIds, *servicePrincipal.AppId)
+		objectIds = append(objectIds, *servicePrincipal.ID)
+
+		displayName := ""
+		if servicePrincipal.DisplayName != nil {
+			displayName = *servicePrincipal.DisplayName
+		}
+		displayNames = append(displayNames, displayName)
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(search + "/" + strings.Join(tags, ",") + "/" + strings.Join(objectIds, "-"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("servicePrincipals#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "application_ids", applicationIds)
+	tf.Set(d, "display_names", displayNames)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalsDataSource struct{}
+
+func TestAccServicePrincipalsDataSource_bySearch(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_service_principals", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ServicePrincipalsDataSource{}.template(data),
+		},
+		{
+			Config: ServicePrincipalsDataSource{}.bySearch(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("display_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccServicePrincipalsDataSource_byTags(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_service_principals", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: ServicePrincipalsDataSource{}.template(data),
+		},
+		{
+			Config: ServicePrincipalsDataSource{}.byTags(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("object_ids.0").MatchesOtherKey(check.That("azuread_service_principal.testA").Key("object_id")),
+			),
+		},
+	})
+}
+
+func (ServicePrincipalsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "testA" {
+  display_name = "acctest-SP-A-%[1]d"
+}
+
+resource "azuread_service_principal" "testA" {
+  application_id = azuread_application.testA.application_id
+  tags           = ["acctest-%[1]d", "acctest-owner-%[1]d"]
+}
+
+resource "azuread_application" "testB" {
+  display_name = "acctest-SP-B-%[1]d"
+}
+
+resource "azuread_service_principal" "testB" {
+  application_id = azuread_application.testB.application_id
+  tags           = ["acctest-%[1]d"]
+}
+`, data.RandomInteger)
+}
+
+func (ServicePrincipalsDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principals" "test" {
+  search = "displayName:acctest-SP-"
+  tags   = ["acctest-%[2]d"]
+}
+`, ServicePrincipalsDataSource{}.template(data), data.RandomInteger)
+}
+
+func (ServicePrincipalsDataSource) byTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principals" "test" {
+  tags = ["acctest-%[2]d", "acctest-owner-%[2]d"]
+}
+`, ServicePrincipalsDataSource{}.template(data), data.RandomInteger)
+}
@@ -5,11 +5,13 @@ import (
 	"github.com/manicminer/hamilton/msgraph"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 )
 
 type Client struct {
-	AadClient *graphrbac.UsersClient
-	MsClient  *msgraph.UsersClient
+	AadClient   *graphrbac.UsersClient
+	MsClient    *msgraph.UsersClient
+	BatchGetter *helpers.BatchGetter
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,7 +20,8 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&msClient.BaseClient, &aadClient.Client)
 
 	return &Client{
-		AadClient: &aadClient,
-		MsClient:  msClient,
+		AadClient:   &aadClient,
+		MsClient:    msClient,
+		BatchGetter: helpers.NewBatchGetter(msClient.BaseClient),
 	}
 }
@@ -0,0 +1,73 @@
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func deletedUsersDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Users.MsClient
+
+	items, _, err := helpers.DeletedItemsList(ctx, client.BaseClient, helpers.DeletedItemTypeUser)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not retrieve deleted users")
+	}
+
+	result := make([]map[string]interface{}, 0, len(items))
+	objectIds := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.ID == nil {
+			return tf.ErrorDiagF(errors.New("API returned deleted user with nil object ID"), "Bad API response")
+		}
+		objectId := *item.ID
+		objectIds = append(objectIds, objectId)
+
+		deletedDate := ""
+		if item.DeletedDateTime != nil {
+			deletedDate = item.DeletedDateTime.Format(time.RFC3339)
+		}
+		displayName := ""
+		if item.DisplayName != nil {
+			displayName = *item.DisplayName
+		}
+		mailNickname := ""
+		if item.MailNickname != nil {
+			mailNickname = *item.MailNickname
+		}
+		userPrincipalName := ""
+		if item.UserPrincipalName != nil {
+			userPrincipalName = *item.UserPrincipalName
+		}
+
+		result = append(result, map[string]interface{}{
+			"deleted_date":        deletedDate,
+			"display_name":        displayName,
+			"mail_nickname":       mailNickname,
+			"object_id":           objectId,
+			"user_principal_name": userPrincipalName,
+		})
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(objectIds, "-"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("deletedUsers#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "users", result)
+	tf.Set(d, "object_ids", objectIds)
+
+	return nil
+}
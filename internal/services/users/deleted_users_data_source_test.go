@@ -0,0 +1,37 @@
+package users_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DeletedUsersDataSource struct{}
+
+func TestAccDeletedUsersDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_deleted_users", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: DeletedUsersDataSource{}.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("users.#").Exists(),
+				check.That(data.ResourceName).Key("object_ids.#").Exists(),
+			),
+		},
+	})
+}
+
+func (DeletedUsersDataSource) basic() string {
+	return `
+data "azuread_deleted_users" "test" {}
+`
+}
@@ -0,0 +1,52 @@
+package users
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
+)
+
+func schemaCustomSecurityAttributes() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute_set": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+
+				"name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "String",
+					ValidateFunc: validation.StringInSlice([]string{"Boolean", "Integer", "String"}, false),
+				},
+
+				"collection": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
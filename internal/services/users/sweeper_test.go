@@ -0,0 +1,41 @@
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("azuread_user", &resource.Sweeper{
+		Name: "azuread_user",
+		F:    sweepUsers,
+	})
+}
+
+// sweepUsers deletes and purges users left behind by acceptance tests
+func sweepUsers(_ string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), acceptance.SweepTimeout)
+	defer cancel()
+
+	client, err := acceptance.SweepClient(ctx)
+	if err != nil {
+		return fmt.Errorf("building client: %v", err)
+	}
+
+	cutoff, err := acceptance.SweepCutoff()
+	if err != nil {
+		return err
+	}
+
+	return acceptance.Sweep(ctx, client.Users.MsClient.BaseClient, "/users", helpers.DeletedItemTypeUser, cutoff)
+}
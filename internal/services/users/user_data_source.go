@@ -2,9 +2,11 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
@@ -14,6 +16,10 @@ func userDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: userDataSourceRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -43,6 +49,15 @@ func userDataSource() *schema.Resource {
 				ConflictsWith:    []string{"object_id", "user_principal_name"},
 			},
 
+			"include_group_memberships": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"direct",
+					"transitive",
+				}, false),
+			},
+
 			"account_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -53,6 +68,14 @@ func userDataSource() *schema.Resource {
 				Computed: true,
 			},
 
+			"group_object_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"given_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -70,6 +93,18 @@ func userDataSource() *schema.Resource {
 				Computed: true,
 			},
 
+			"im_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"proxy_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			// TODO: v2.0 remove this
 			"immutable_id": {
 				Type:       schema.TypeString,
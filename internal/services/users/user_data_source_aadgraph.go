@@ -17,6 +17,10 @@ import (
 func userDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.AadClient
 
+	if v, ok := d.GetOk("include_group_memberships"); ok && v.(string) != "" {
+		return tf.ErrorDiagPathF(errors.New("including group memberships is only supported when using Microsoft Graph"), "include_group_memberships", "Could not retrieve group memberships")
+	}
+
 	var user graphrbac.User
 
 	if upn, ok := d.Get("user_principal_name").(string); ok && upn != "" {
@@ -62,6 +66,8 @@ func userDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData, met
 	tf.Set(d, "given_name", user.GivenName)
 	tf.Set(d, "mail", user.Mail)
 	tf.Set(d, "mail_nickname", user.MailNickname)
+	tf.Set(d, "im_addresses", user.AdditionalProperties["imAddresses"])
+	tf.Set(d, "proxy_addresses", user.AdditionalProperties["proxyAddresses"])
 	tf.Set(d, "object_id", user.ObjectID)
 	tf.Set(d, "immutable_id", user.ImmutableID)
 	tf.Set(d, "onpremises_immutable_id", user.ImmutableID)
@@ -11,6 +11,7 @@ import (
 	"github.com/manicminer/hamilton/msgraph"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
@@ -77,6 +78,15 @@ func userDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(*user.ID)
 
+	groupObjectIds := make([]string, 0)
+	if v := d.Get("include_group_memberships").(string); v != "" {
+		ids, _, err := helpers.UserGroupMembershipIds(ctx, client.BaseClient, *user.ID, v == "transitive")
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving group memberships for user with object ID: %q", *user.ID)
+		}
+		groupObjectIds = ids
+	}
+
 	tf.Set(d, "account_enabled", user.AccountEnabled)
 	tf.Set(d, "city", user.City)
 	tf.Set(d, "company_name", user.CompanyName)
@@ -84,6 +94,8 @@ func userDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta
 	tf.Set(d, "department", user.Department)
 	tf.Set(d, "display_name", user.DisplayName)
 	tf.Set(d, "given_name", user.GivenName)
+	tf.Set(d, "group_object_ids", groupObjectIds)
+	tf.Set(d, "im_addresses", tf.FlattenStringSlicePtr(user.ImAddresses))
 	tf.Set(d, "immutable_id", user.OnPremisesImmutableId) // TODO: remove in v2.0
 	tf.Set(d, "job_title", user.JobTitle)
 	tf.Set(d, "mail", user.Mail)
@@ -97,6 +109,7 @@ func userDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta
 	tf.Set(d, "onpremises_user_principal_name", user.OnPremisesUserPrincipalName)
 	tf.Set(d, "physical_delivery_office_name", user.OfficeLocation) // TODO: remove in v2.0
 	tf.Set(d, "postal_code", user.PostalCode)
+	tf.Set(d, "proxy_addresses", tf.FlattenStringSlicePtr(user.ProxyAddresses))
 	tf.Set(d, "state", user.State)
 	tf.Set(d, "street_address", user.StreetAddress)
 	tf.Set(d, "surname", user.Surname)
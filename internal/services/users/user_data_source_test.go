@@ -81,7 +81,9 @@ func (UserDataSource) testCheckFunc(data acceptance.TestData) resource.TestCheck
 		check.That(data.ResourceName).Key("given_name").HasValue(fmt.Sprintf("acctestUser-%d-GivenName", data.RandomInteger)),
 		check.That(data.ResourceName).Key("surname").HasValue(fmt.Sprintf("acctestUser-%d-Surname", data.RandomInteger)),
 		//check.That(data.ResourceName).Key("mail").Exists(), // TODO only set for O365 domains
-		check.That(data.ResourceName).Key("mail_nickname").HasValue(fmt.Sprintf("acctestUser-%d-MailNickname", data.RandomInteger)),
+		check.That(data.ResourceName).Key("mail_nickname").HasValue(data.MailNickname("acctestUser-MailNickname")),
+		check.That(data.ResourceName).Key("im_addresses.#").Exists(),
+		check.That(data.ResourceName).Key("proxy_addresses.#").Exists(),
 		check.That(data.ResourceName).Key("usage_location").HasValue("NO"),
 		check.That(data.ResourceName).Key("job_title").HasValue(fmt.Sprintf("acctestUser-%d-Job", data.RandomInteger)),
 		check.That(data.ResourceName).Key("department").HasValue(fmt.Sprintf("acctestUser-%d-Dept", data.RandomInteger)),
@@ -0,0 +1,108 @@
+package users_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type UserMemberOfDataSource struct{}
+
+func TestAccUserMemberOfDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_user_member_of", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: UserMemberOfDataSource{}.basic(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("directory_role_object_ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("display_names.%").HasValue("0"),
+			),
+		},
+		{
+			Config: UserMemberOfDataSource{}.basic(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("display_names.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccUserMemberOfDataSource_securityEnabledOnly(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_user_member_of", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: UserMemberOfDataSource{}.securityEnabledOnly(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("security_enabled_only").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (UserMemberOfDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[4]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[3]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "child" {
+  display_name     = "acctestGroup-child-%[1]d"
+  security_enabled = true
+  members          = [azuread_user.test.object_id]
+}
+
+resource "azuread_group" "parent" {
+  display_name     = "acctestGroup-parent-%[1]d"
+  security_enabled = true
+  members          = [azuread_group.child.object_id]
+}
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (r UserMemberOfDataSource) basic(data acceptance.TestData, includeDisplayNames bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user_member_of" "test" {
+  object_id             = azuread_user.test.object_id
+  include_display_names = %[2]t
+
+  depends_on = [azuread_group.parent]
+}
+`, r.template(data), includeDisplayNames)
+}
+
+func (r UserMemberOfDataSource) securityEnabledOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user_member_of" "test" {
+  object_id             = azuread_user.test.object_id
+  security_enabled_only = true
+
+  depends_on = [azuread_group.parent]
+}
+`, r.template(data))
+}
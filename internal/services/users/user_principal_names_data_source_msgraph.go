@@ -0,0 +1,86 @@
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
+)
+
+func userPrincipalNamesDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Users.MsClient
+
+	domainName := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d.Get("domain_name").(string)), "@"))
+	failOnCollision := d.Get("fail_on_collision").(bool)
+
+	// Normalize every prefix first, so that collisions between the prefixes themselves are reported before querying the directory
+	mailNicknames := make([]string, 0)
+	seen := make(map[string]string)
+	prefixes := d.Get("prefixes").([]interface{})
+	for _, v := range prefixes {
+		prefix := v.(string)
+		mailNickname := helpers.UserNormalizePrincipalNamePrefix(prefix)
+		if mailNickname == "" {
+			return tf.ErrorDiagPathF(nil, "prefixes", "Prefix %q does not contain any characters which are valid in a user principal name", prefix)
+		}
+		if other, ok := seen[mailNickname]; ok {
+			return tf.ErrorDiagPathF(nil, "prefixes", "Prefixes %q and %q both normalize to %q", other, prefix, mailNickname)
+		}
+		seen[mailNickname] = prefix
+		mailNicknames = append(mailNicknames, mailNickname)
+	}
+
+	upns := make([]string, 0, len(mailNicknames))
+	users := make([]map[string]interface{}, 0, len(mailNicknames))
+	for i, mailNickname := range mailNicknames {
+		upn := fmt.Sprintf("%s@%s", mailNickname, domainName)
+
+		// Single quotes are valid in user principal names, and must be escaped in OData filters
+		filter := fmt.Sprintf("userPrincipalName eq '%s' or mailNickname eq '%s'", strings.ReplaceAll(upn, "'", "''"), strings.ReplaceAll(mailNickname, "'", "''"))
+		result, _, err := client.List(ctx, filter)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Finding users with UPN %q or email alias %q", upn, mailNickname)
+		}
+		if result == nil {
+			return tf.ErrorDiagF(errors.New("API returned nil result"), "Bad API Response")
+		}
+
+		existingObjectId := ""
+		if len(*result) > 0 && (*result)[0].ID != nil {
+			existingObjectId = *(*result)[0].ID
+			if failOnCollision {
+				return tf.ErrorDiagPathF(nil, "prefixes", "User principal name %q or email alias %q for prefix %q is already in use by the user with object ID %q", upn, mailNickname, prefixes[i], existingObjectId)
+			}
+		}
+
+		upns = append(upns, upn)
+		users = append(users, map[string]interface{}{
+			"available":           existingObjectId == "",
+			"existing_object_id":  existingObjectId,
+			"mail_nickname":       mailNickname,
+			"prefix":              prefixes[i],
+			"user_principal_name": upn,
+		})
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(upns, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for UPNs")
+	}
+
+	d.SetId("userPrincipalNames#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "mail_nicknames", mailNicknames)
+	tf.Set(d, "user_principal_names", upns)
+	tf.Set(d, "users", users)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package users_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type UserPrincipalNamesDataSource struct{}
+
+func TestAccUserPrincipalNamesDataSource_basic(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_user_principal_names", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: UserPrincipalNamesDataSource{}.basic(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("users.#").HasValue("2"),
+				check.That(data.ResourceName).Key("users.0.available").HasValue("false"),
+				check.That(data.ResourceName).Key("users.0.existing_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("users.1.available").HasValue("true"),
+				check.That(data.ResourceName).Key("users.1.existing_object_id").HasValue(""),
+				check.That(data.ResourceName).Key("mail_nicknames.1").HasValue(fmt.Sprintf("acctest.new.%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func TestAccUserPrincipalNamesDataSource_failOnCollision(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_user_principal_names", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config:      UserPrincipalNamesDataSource{}.basic(data, true),
+			ExpectError: regexp.MustCompile("is already in use"),
+		},
+	})
+}
+
+func (UserPrincipalNamesDataSource) basic(data acceptance.TestData, failOnCollision bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user_principal_names" "test" {
+  domain_name       = data.azuread_domains.test.domains.0.domain_name
+  prefixes          = ["AccTestUser.%[2]d", "AccTest New %[2]d"]
+  fail_on_collision = %[3]t
+
+  depends_on = [azuread_user.test]
+}
+`, UserResource{}.basic(data), data.RandomInteger, failOnCollision)
+}
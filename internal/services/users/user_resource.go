@@ -3,6 +3,7 @@ package users
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
 )
@@ -21,6 +23,13 @@ func userResource() *schema.Resource {
 		UpdateContext: userResourceUpdate,
 		DeleteContext: userResourceDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
 			if _, err := uuid.ParseUUID(id); err != nil {
 				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
@@ -34,6 +43,7 @@ func userResource() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				ValidateDiagFunc: validate.StringIsEmailAddress,
+				DiffSuppressFunc: tf.SuppressCaseDiff,
 			},
 
 			"display_name": {
@@ -82,8 +92,34 @@ func userResource() *schema.Resource {
 			},
 
 			"mail": {
-				Type:     schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"im_addresses": {
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"proxy_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"sponsors": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Set:         schema.HashString,
+				Description: "Object IDs of users or groups who are responsible for this guest user's privileges in the tenant.",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
 			},
 
 			// TODO: remove in v2.0
@@ -203,6 +239,18 @@ func userResource() *schema.Resource {
 					"In the United States of America, this attribute contains the ZIP code.",
 			},
 
+			"custom_security_attribute": schemaCustomSecurityAttributes(),
+
+			"extension_attributes": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateDiagFunc: validation.MapKeyMatch(helpers.ExtensionAttributeNameRegex, "keys must be directory extension attribute names in the format `extension_{appId}_{name}`"),
+				Description:      "Values for directory extension attributes registered by an application, keyed by attribute name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			// TODO: remove in v2.0
 			"mobile": {
 				Type:          schema.TypeString,
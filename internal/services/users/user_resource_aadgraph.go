@@ -19,6 +19,18 @@ import (
 func userResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.AadClient
 
+	if v, ok := d.GetOk("custom_security_attribute"); ok && v.(*schema.Set).Len() > 0 {
+		return tf.ErrorDiagPathF(errors.New("custom security attributes are only supported when using Microsoft Graph"), "custom_security_attribute", "Could not set custom security attributes for user")
+	}
+
+	if _, ok := d.GetOk("extension_attributes"); ok {
+		return tf.ErrorDiagPathF(errors.New("extension attributes are only supported when using Microsoft Graph"), "extension_attributes", "Could not set extension attributes for user")
+	}
+
+	if v, ok := d.GetOk("sponsors"); ok && v.(*schema.Set).Len() > 0 {
+		return tf.ErrorDiagPathF(errors.New("sponsors are only supported when using Microsoft Graph"), "sponsors", "Could not set sponsors for user")
+	}
+
 	upn := d.Get("user_principal_name").(string)
 	mailNickName := d.Get("mail_nickname").(string)
 
@@ -44,6 +56,10 @@ func userResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, met
 		userCreateParameters.GivenName = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("mail"); ok {
+		userCreateParameters.Mail = utils.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("surname"); ok {
 		userCreateParameters.Surname = utils.String(v.(string))
 	}
@@ -133,6 +149,18 @@ func userResourceCreateAadGraph(ctx context.Context, d *schema.ResourceData, met
 func userResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.AadClient
 
+	if v, ok := d.GetOk("custom_security_attribute"); ok && v.(*schema.Set).Len() > 0 {
+		return tf.ErrorDiagPathF(errors.New("custom security attributes are only supported when using Microsoft Graph"), "custom_security_attribute", "Could not set custom security attributes for user")
+	}
+
+	if _, ok := d.GetOk("extension_attributes"); ok {
+		return tf.ErrorDiagPathF(errors.New("extension attributes are only supported when using Microsoft Graph"), "extension_attributes", "Could not set extension attributes for user")
+	}
+
+	if v, ok := d.GetOk("sponsors"); ok && v.(*schema.Set).Len() > 0 {
+		return tf.ErrorDiagPathF(errors.New("sponsors are only supported when using Microsoft Graph"), "sponsors", "Could not set sponsors for user")
+	}
+
 	var userUpdateParameters graphrbac.UserUpdateParameters
 
 	if d.HasChange("display_name") {
@@ -180,6 +208,10 @@ func userResourceUpdateAadGraph(ctx context.Context, d *schema.ResourceData, met
 		additionalProperties["jobTitle"] = d.Get("job_title").(string)
 	}
 
+	if d.HasChange("mail") {
+		additionalProperties["mail"] = d.Get("mail").(string)
+	}
+
 	if d.HasChange("department") {
 		additionalProperties["department"] = d.Get("department").(string)
 	}
@@ -262,6 +294,8 @@ func userResourceReadAadGraph(ctx context.Context, d *schema.ResourceData, meta
 	tf.Set(d, "surname", user.Surname)
 	tf.Set(d, "mail", user.Mail)
 	tf.Set(d, "mail_nickname", user.MailNickname)
+	tf.Set(d, "im_addresses", user.AdditionalProperties["imAddresses"])
+	tf.Set(d, "proxy_addresses", user.AdditionalProperties["proxyAddresses"])
 	tf.Set(d, "usage_location", user.UsageLocation)
 	tf.Set(d, "user_type", user.UserType)
 
@@ -44,6 +44,10 @@ func userResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta
 		properties.GivenName = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("mail"); ok {
+		properties.Mail = utils.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("surname"); ok {
 		properties.Surname = utils.String(v.(string))
 	}
@@ -113,14 +117,40 @@ func userResourceCreateMsGraph(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(*user.ID)
 
-	_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
-		return client.Get(ctx, *user.ID)
-	})
+	if meta.(*clients.Client).FastUserCreation {
+		// confirm creation once using a batched request, rather than waiting for the user to replicate
+		_, err = helpers.WaitForCreationConfirmation(ctx, func() (interface{}, int, error) {
+			return meta.(*clients.Client).Users.BatchGetter.Get(ctx, fmt.Sprintf("/users/%s", *user.ID))
+		})
+	} else {
+		_, err = helpers.WaitForCreationReplication(ctx, func() (interface{}, int, error) {
+			return client.Get(ctx, *user.ID)
+		})
+	}
 
 	if err != nil {
 		return tf.ErrorDiagF(err, "Waiting for User with object ID: %q", *user.ID)
 	}
 
+	if v, ok := d.GetOk("custom_security_attribute"); ok {
+		if _, err := helpers.CustomSecurityAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/users/%s", *user.ID), nil, v.(*schema.Set).List()); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attribute", "Could not set custom security attributes for user with object ID: %q", *user.ID)
+		}
+	}
+
+	if v, ok := d.GetOk("extension_attributes"); ok {
+		extensionAttributes := helpers.ExtensionAttributesExpand(nil, v.(map[string]interface{}))
+		if _, err := helpers.ExtensionAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/users/%s", *user.ID), extensionAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "extension_attributes", "Could not set extension attributes for user with object ID: %q", *user.ID)
+		}
+	}
+
+	if v, ok := d.GetOk("sponsors"); ok {
+		if err := userSetSponsorsMsGraph(ctx, client.BaseClient, *user.ID, *tf.ExpandStringSlicePtr(v.(*schema.Set).List())); err != nil {
+			return tf.ErrorDiagPathF(err, "sponsors", "Could not set sponsors for user with object ID: %q", *user.ID)
+		}
+	}
+
 	return userResourceReadMsGraph(ctx, d, meta)
 }
 
@@ -143,6 +173,10 @@ func userResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta
 		properties.Surname = utils.String(d.Get("surname").(string))
 	}
 
+	if d.HasChange("mail") {
+		properties.Mail = utils.String(d.Get("mail").(string))
+	}
+
 	if d.HasChange("mail_nickname") {
 		properties.MailNickname = utils.String(d.Get("mail_nickname").(string))
 	}
@@ -216,6 +250,27 @@ func userResourceUpdateMsGraph(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagF(err, "Could not update user with ID: %q", d.Id())
 	}
 
+	if d.HasChange("custom_security_attribute") {
+		o, n := d.GetChange("custom_security_attribute")
+		if _, err := helpers.CustomSecurityAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/users/%s", d.Id()), o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return tf.ErrorDiagPathF(err, "custom_security_attribute", "Could not update custom security attributes for user with ID: %q", d.Id())
+		}
+	}
+
+	if d.HasChange("extension_attributes") {
+		o, n := d.GetChange("extension_attributes")
+		extensionAttributes := helpers.ExtensionAttributesExpand(o.(map[string]interface{}), n.(map[string]interface{}))
+		if _, err := helpers.ExtensionAttributesUpdate(ctx, client.BaseClient, fmt.Sprintf("/users/%s", d.Id()), extensionAttributes); err != nil {
+			return tf.ErrorDiagPathF(err, "extension_attributes", "Could not update extension attributes for user with ID: %q", d.Id())
+		}
+	}
+
+	if d.HasChange("sponsors") {
+		if err := userSetSponsorsMsGraph(ctx, client.BaseClient, d.Id(), *tf.ExpandStringSlicePtr(d.Get("sponsors").(*schema.Set).List())); err != nil {
+			return tf.ErrorDiagPathF(err, "sponsors", "Could not update sponsors for user with ID: %q", d.Id())
+		}
+	}
+
 	return userResourceReadMsGraph(ctx, d, meta)
 }
 
@@ -234,13 +289,35 @@ func userResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta i
 		return tf.ErrorDiagF(err, "Retrieving user with object ID: %q", objectId)
 	}
 
+	extensionAttributeNames := make([]string, 0)
+	for k := range d.Get("extension_attributes").(map[string]interface{}) {
+		extensionAttributeNames = append(extensionAttributeNames, k)
+	}
+	extensionAttributes, _, err := helpers.ExtensionAttributesGet(ctx, client.BaseClient, fmt.Sprintf("/users/%s", objectId), extensionAttributeNames)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "extension_attributes", "Could not retrieve extension attributes for user with object ID %q", objectId)
+	}
+
+	customSecurityAttributes, _, err := helpers.CustomSecurityAttributesGet(ctx, client.BaseClient, fmt.Sprintf("/users/%s", objectId))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "custom_security_attribute", "Could not retrieve custom security attributes for user with object ID %q", objectId)
+	}
+
+	sponsors, _, err := helpers.UserSponsorIds(ctx, client.BaseClient, objectId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "sponsors", "Could not retrieve sponsors for user with object ID %q", objectId)
+	}
+
 	tf.Set(d, "account_enabled", user.AccountEnabled)
 	tf.Set(d, "city", user.City)
 	tf.Set(d, "company_name", user.CompanyName)
 	tf.Set(d, "country", user.Country)
+	tf.Set(d, "custom_security_attribute", customSecurityAttributes)
 	tf.Set(d, "department", user.Department)
 	tf.Set(d, "display_name", user.DisplayName)
+	tf.Set(d, "extension_attributes", extensionAttributes)
 	tf.Set(d, "given_name", user.GivenName)
+	tf.Set(d, "im_addresses", tf.FlattenStringSlicePtr(user.ImAddresses))
 	tf.Set(d, "immutable_id", user.OnPremisesImmutableId) // TODO: remove in v2.0
 	tf.Set(d, "job_title", user.JobTitle)
 	tf.Set(d, "mail", user.Mail)
@@ -254,6 +331,8 @@ func userResourceReadMsGraph(ctx context.Context, d *schema.ResourceData, meta i
 	tf.Set(d, "onpremises_user_principal_name", user.OnPremisesUserPrincipalName)
 	tf.Set(d, "physical_delivery_office_name", user.OfficeLocation) // TODO: remove in v2.0
 	tf.Set(d, "postal_code", user.PostalCode)
+	tf.Set(d, "proxy_addresses", tf.FlattenStringSlicePtr(user.ProxyAddresses))
+	tf.Set(d, "sponsors", sponsors)
 	tf.Set(d, "state", user.State)
 	tf.Set(d, "street_address", user.StreetAddress)
 	tf.Set(d, "surname", user.Surname)
@@ -276,6 +355,12 @@ func userResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagPathF(err, "id", "Retrieving user with object ID %q", d.Id())
 	}
 
+	if meta.(*clients.Client).Features.Users.RevokeSessionsOnDelete {
+		if _, err := helpers.UserRevokeSignInSessions(ctx, client.BaseClient, d.Id()); err != nil {
+			return tf.ErrorDiagF(err, "Revoking sign-in sessions for user with object ID %q", d.Id())
+		}
+	}
+
 	status, err = client.Delete(ctx, d.Id())
 	if err != nil {
 		return tf.ErrorDiagPathF(err, "id", "Deleting user with object ID %q, got status %d", d.Id(), status)
@@ -283,3 +368,30 @@ func userResourceDeleteMsGraph(ctx context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
+
+// userSetSponsorsMsGraph reconciles the sponsors of a user with the desired object IDs, adding and removing
+// sponsor references as needed. Sponsors that were created moments earlier may not have replicated yet, so additions
+// are retried until they become visible.
+func userSetSponsorsMsGraph(ctx context.Context, client msgraph.Client, id string, desired []string) error {
+	existing, _, err := helpers.UserSponsorIds(ctx, client, id)
+	if err != nil {
+		return fmt.Errorf("retrieving existing sponsors: %v", err)
+	}
+
+	for _, sponsorId := range utils.Difference(existing, desired) {
+		if _, err := helpers.UserRemoveSponsor(ctx, client, id, sponsorId); err != nil {
+			return fmt.Errorf("removing sponsor %q: %v", sponsorId, err)
+		}
+	}
+
+	for _, sponsorId := range utils.Difference(desired, existing) {
+		sponsorId := sponsorId
+		if err := helpers.WaitForReferenceReplication(ctx, func() (int, error) {
+			return helpers.UserAddSponsor(ctx, client, id, sponsorId)
+		}); err != nil {
+			return fmt.Errorf("adding sponsor %q: %v", sponsorId, err)
+		}
+	}
+
+	return nil
+}
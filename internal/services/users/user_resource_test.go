@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -32,6 +33,21 @@ func TestAccUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccUser_mixedCaseUserPrincipalName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.mixedCaseUserPrincipalName(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_password_change", "password", "user_principal_name"),
+	})
+}
+
 func TestAccUser_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_user", "test")
 	r := UserResource{}
@@ -47,6 +63,30 @@ func TestAccUser_complete(t *testing.T) {
 	})
 }
 
+func TestAccUser_mail(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.withMail(data, "primary"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("im_addresses.#").Exists(),
+				check.That(data.ResourceName).Key("proxy_addresses.#").Exists(),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.withMail(data, "updated"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
 func TestAccUser_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_user", "test")
 	r := UserResource{}
@@ -76,6 +116,113 @@ func TestAccUser_update(t *testing.T) {
 	})
 }
 
+func TestAccUser_extensionAttributes(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.extensionAttributes(data, "foo"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_attributes.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("extension_attributes", "force_password_change", "password"),
+		{
+			Config: r.extensionAttributes(data, "bar"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_attributes.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("extension_attributes", "force_password_change", "password"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
+func TestAccUser_sponsors(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.sponsors(data, "azuread_user.sponsor.object_id, azuread_group.sponsor.object_id"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.sponsors(data, "azuread_group.sponsor.object_id"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.sponsors(data, ""),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sponsors.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
+func TestAccUser_customSecurityAttributes(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.customSecurityAttributes(data, "foo"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attribute.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.customSecurityAttributes(data, "bar"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attribute.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.customSecurityAttributesTemplate(data) + r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_security_attribute.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
 func TestAccUser_threeUsersABC(t *testing.T) {
 	dataA := acceptance.BuildTestData(t, "azuread_user", "testA")
 	dataB := acceptance.BuildTestData(t, "azuread_user", "testB")
@@ -97,6 +244,24 @@ func TestAccUser_threeUsersABC(t *testing.T) {
 	})
 }
 
+func TestAccUser_revokeSessionsOnDelete(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.revokeSessionsOnDelete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
 func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	var id *string
 
@@ -126,32 +291,57 @@ func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state
 
 func (UserResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
+%[4]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[3]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (UserResource) withMail(data acceptance.TestData, suffix string) string {
+	return fmt.Sprintf(`
+%[5]s
+
 data "azuread_domains" "test" {
   only_initial = true
 }
 
 resource "azuread_user" "test" {
-  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[4]s"
   display_name        = "acctestUser-%[1]d"
   password            = "%[2]s"
+  mail                = "acctestUser.%[1]d.%[3]s@${data.azuread_domains.test.domains.0.domain_name}"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, suffix, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
 }
 
-func (UserResource) complete(data acceptance.TestData) string {
+func (UserResource) mixedCaseUserPrincipalName(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
+%[4]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "AccTestUser.%[1]d.%[3]s@${upper(data.azuread_domains.acctest_default.domains.0.domain_name)}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword, data.RunSuffix, data.DefaultDomainTemplate())
 }
 
+func (UserResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[5]s
+
 resource "azuread_user" "test" {
-  user_principal_name   = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name   = "%[3]s"
   force_password_change = true
 
   display_name    = "acctestUser-%[1]d-DisplayName"
   given_name      = "acctestUser-%[1]d-GivenName"
   surname         = "acctestUser-%[1]d-Surname"
-  mail_nickname   = "acctestUser-%[1]d-MailNickname"
+  mail_nickname   = "%[4]s"
   account_enabled = false
   password        = "%[2]s"
   usage_location  = "NO"
@@ -169,32 +359,128 @@ resource "azuread_user" "test" {
 
   physical_delivery_office_name = "acctestUser-%[1]d-PDON"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser"), data.MailNickname("acctestUser-MailNickname"), data.DefaultDomainTemplate())
 }
 
 func (UserResource) threeUsersABC(data acceptance.TestData) string {
 	return fmt.Sprintf(`
-data "azuread_domains" "test" {
-  only_initial = true
-}
+%[7]s
 
 resource "azuread_user" "testA" {
-  user_principal_name = "acctestUser.%[1]d.A@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[3]s"
   display_name        = "acctestUser-%[1]d-A"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testB" {
-  user_principal_name = "acctestUser.%[1]d.B@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[4]s"
   display_name        = "acctestUser-%[1]d-B"
-  mail_nickname       = "acctestUser-%[1]d-B"
+  mail_nickname       = "%[6]s"
   password            = "%[2]s"
 }
 
 resource "azuread_user" "testC" {
-  user_principal_name = "acctestUser.%[1]d.C@${data.azuread_domains.test.domains.0.domain_name}"
+  user_principal_name = "%[5]s"
   display_name        = "acctestUser-%[1]d-C"
   password            = "%[2]s"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.UserPrincipalName("acctestUser.A"), data.UserPrincipalName("acctestUser.B"), data.UserPrincipalName("acctestUser.C"), data.MailNickname("acctestUser-B"), data.DefaultDomainTemplate())
+}
+
+func (UserResource) extensionAttributes(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+%[6]s
+
+resource "azuread_application" "test" {
+  name = "acctestUser-%[1]d"
+}
+
+resource "azuread_application_extension_property" "test" {
+  application_object_id = azuread_application.test.object_id
+  name                  = "acctest%[3]s"
+  target_objects        = ["User"]
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[5]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+
+  extension_attributes = {
+    (azuread_application_extension_property.test.attribute_name) = "%[4]s"
+  }
+}
+`, data.RandomInteger, data.RandomPassword, data.RandomString, value, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (UserResource) sponsors(data acceptance.TestData, sponsors string) string {
+	return fmt.Sprintf(`
+%[6]s
+
+resource "azuread_user" "sponsor" {
+  user_principal_name = "%[4]s"
+  display_name        = "acctestSponsor-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_group" "sponsor" {
+  display_name     = "acctestSponsor-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[5]s"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  sponsors            = [%[3]s]
+}
+`, data.RandomInteger, data.RandomPassword, sponsors, data.UserPrincipalName("acctestSponsor"), data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (UserResource) customSecurityAttributesTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_attribute_set" "test" {
+  name = "acctest%[1]s"
+}
+
+resource "azuread_custom_security_attribute_definition" "test" {
+  attribute_set = azuread_attribute_set.test.name
+  name          = "acctestAttribute"
+  type          = "String"
+}
+`, data.RandomString)
+}
+
+func (r UserResource) customSecurityAttributes(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[6]s
+
+resource "azuread_user" "test" {
+  user_principal_name = "%[5]s"
+  display_name        = "acctestUser-%[2]d"
+  password            = "%[3]s"
+
+  custom_security_attribute {
+    attribute_set = azuread_custom_security_attribute_definition.test.attribute_set
+    name          = azuread_custom_security_attribute_definition.test.name
+    values        = ["%[4]s"]
+  }
+}
+`, r.customSecurityAttributesTemplate(data), data.RandomInteger, data.RandomPassword, value, data.UserPrincipalName("acctestUser"), data.DefaultDomainTemplate())
+}
+
+func (r UserResource) revokeSessionsOnDelete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {
+  features {
+    users {
+      revoke_sessions_on_delete = true
+    }
+  }
+}
+
+%[1]s
+`, r.basic(data))
 }
@@ -2,9 +2,11 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/validate"
@@ -14,6 +16,10 @@ func usersData() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: usersDataSourceRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -23,7 +29,7 @@ func usersData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames"},
+				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "search"},
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: validate.UUID,
@@ -34,7 +40,7 @@ func usersData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames"},
+				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "search"},
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: validate.NoEmptyStrings,
@@ -45,13 +51,29 @@ func usersData() *schema.Resource {
 				Type:         schema.TypeList,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames"},
+				ExactlyOneOf: []string{"object_ids", "user_principal_names", "mail_nicknames", "search"},
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: validate.NoEmptyStrings,
 				},
 			},
 
+			"search": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"object_ids", "user_principal_names", "mail_nicknames", "search"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"include_group_memberships": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"direct",
+					"transitive",
+				}, false),
+			},
+
 			"ignore_missing": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -73,6 +95,14 @@ func usersData() *schema.Resource {
 							Computed: true,
 						},
 
+						"group_object_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
 						"mail": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -21,6 +21,14 @@ import (
 func usersDataSourceReadAadGraph(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.AadClient
 
+	if v, ok := d.GetOk("search"); ok && v.(string) != "" {
+		return tf.ErrorDiagPathF(errors.New("searching is only supported when using Microsoft Graph"), "search", "Could not search for users")
+	}
+
+	if v, ok := d.GetOk("include_group_memberships"); ok && v.(string) != "" {
+		return tf.ErrorDiagPathF(errors.New("including group memberships is only supported when using Microsoft Graph"), "include_group_memberships", "Could not retrieve group memberships")
+	}
+
 	var users []*graphrbac.User
 	expectedCount := 0
 
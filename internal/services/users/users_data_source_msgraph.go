@@ -14,6 +14,7 @@ import (
 	"github.com/manicminer/hamilton/msgraph"
 
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	helpers "github.com/hashicorp/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/hashicorp/terraform-provider-azuread/internal/tf"
 )
 
@@ -24,7 +25,14 @@ func usersDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, met
 	var expectedCount int
 	ignoreMissing := d.Get("ignore_missing").(bool)
 
-	if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
+	if search, ok := d.Get("search").(string); ok && search != "" {
+		result, _, err := helpers.UsersSearch(ctx, client.BaseClient, search)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "search", "Searching for users matching: %q", search)
+		}
+		expectedCount = len(result)
+		users = result
+	} else if upns, ok := d.Get("user_principal_names").([]interface{}); ok && len(upns) > 0 {
 		expectedCount = len(upns)
 		for _, v := range upns {
 			filter := fmt.Sprintf("userPrincipalName eq '%s'", v)
@@ -98,6 +106,8 @@ func usersDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, met
 		return tf.ErrorDiagF(fmt.Errorf("Expected: %d, Actual: %d", expectedCount, len(users)), "Unexpected number of users returned")
 	}
 
+	includeGroupMemberships := d.Get("include_group_memberships").(string)
+
 	upns := make([]string, 0)
 	objectIds := make([]string, 0)
 	mailNicknames := make([]string, 0)
@@ -116,6 +126,17 @@ func usersDataSourceReadMsGraph(ctx context.Context, d *schema.ResourceData, met
 		user := make(map[string]interface{})
 		user["account_enabled"] = u.AccountEnabled
 		user["display_name"] = u.DisplayName
+
+		groupObjectIds := make([]string, 0)
+		if includeGroupMemberships != "" {
+			ids, _, err := helpers.UserGroupMembershipIds(ctx, client.BaseClient, *u.ID, includeGroupMemberships == "transitive")
+			if err != nil {
+				return tf.ErrorDiagF(err, "Retrieving group memberships for user with object ID: %q", *u.ID)
+			}
+			groupObjectIds = ids
+		}
+		user["group_object_ids"] = groupObjectIds
+
 		user["mail"] = u.Mail
 		user["mail_nickname"] = u.MailNickname
 		user["object_id"] = u.ID
@@ -2,6 +2,7 @@ package users_test
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -106,6 +107,55 @@ func TestAccUsersDataSource_noNames(t *testing.T) {
 	}})
 }
 
+func TestAccUsersDataSource_includeGroupMemberships(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: UsersDataSource{}.includeGroupMemberships(data, "direct"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("users.#").HasValue("2"),
+				check.That(data.ResourceName).Key("users.0.group_object_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("users.1.group_object_ids.#").HasValue("0"),
+			),
+		},
+		{
+			Config: UsersDataSource{}.includeGroupMemberships(data, "transitive"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("users.#").HasValue("2"),
+				check.That(data.ResourceName).Key("users.0.group_object_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("users.1.group_object_ids.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccUsersDataSource_bySearch(t *testing.T) {
+	if v := os.Getenv("AAD_USE_MICROSOFT_GRAPH"); v == "" {
+		t.Skipf("Test requires MS Graph")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azuread_users", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: UserResource{}.threeUsersABC(data),
+		},
+		{
+			Config: UsersDataSource{}.bySearch(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("user_principal_names.#").HasValue("3"),
+				check.That(data.ResourceName).Key("object_ids.#").HasValue("3"),
+				check.That(data.ResourceName).Key("users.#").HasValue("3"),
+			),
+		},
+	})
+}
+
 func (UsersDataSource) byUserPrincipalNames(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -185,6 +235,16 @@ data "azuread_users" "test" {
 `, UserResource{}.threeUsersABC(data), data.RandomInteger)
 }
 
+func (UsersDataSource) bySearch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_users" "test" {
+  search = "displayName:%[2]d"
+}
+`, UserResource{}.threeUsersABC(data), data.RandomInteger)
+}
+
 func (UsersDataSource) noNames() string {
 	return `
 data "azuread_users" "test" {
@@ -192,3 +252,28 @@ data "azuread_users" "test" {
 }
 `
 }
+
+func (UsersDataSource) includeGroupMemberships(data acceptance.TestData, includeGroupMemberships string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "child" {
+  display_name     = "acctestGroup-child-%[2]d"
+  security_enabled = true
+  members          = [azuread_user.testA.object_id]
+}
+
+resource "azuread_group" "parent" {
+  display_name     = "acctestGroup-parent-%[2]d"
+  security_enabled = true
+  members          = [azuread_group.child.object_id]
+}
+
+data "azuread_users" "test" {
+  include_group_memberships = "%[3]s"
+  object_ids                = [azuread_user.testA.object_id, azuread_user.testB.object_id]
+
+  depends_on = [azuread_group.parent]
+}
+`, UserResource{}.threeUsersABC(data), data.RandomInteger, includeGroupMemberships)
+}
@@ -0,0 +1,31 @@
+package tf
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RetainCallerAsOwnerDiff is a CustomizeDiff helper for resources having `owners` and `retain_caller_as_owner`
+// attributes. When `retain_caller_as_owner` is set and a change to `owners` would remove the principal running
+// Terraform, the principal is kept in the planned owners so that it is not removed. A principal which relies on
+// ownership for permission to manage a resource would otherwise be unable to make any further changes to it.
+func RetainCallerAsOwnerDiff(diff *schema.ResourceDiff, callerId string) error {
+	if !diff.Get("retain_caller_as_owner").(bool) || !diff.HasChange("owners") || !diff.NewValueKnown("owners") {
+		return nil
+	}
+	oldOwners, newOwners := diff.GetChange("owners")
+	if owners, retained := OwnersRetainingCaller(callerId, oldOwners.(*schema.Set), newOwners.(*schema.Set)); retained {
+		return diff.SetNew("owners", owners.List())
+	}
+	return nil
+}
+
+// OwnersRetainingCaller returns newOwners with the principal running Terraform added, when it is one of oldOwners
+// but not one of newOwners. The second return value indicates whether the principal was added.
+func OwnersRetainingCaller(callerId string, oldOwners, newOwners *schema.Set) (*schema.Set, bool) {
+	if callerId == "" || oldOwners == nil || newOwners == nil || !oldOwners.Contains(callerId) || newOwners.Contains(callerId) {
+		return newOwners, false
+	}
+	owners := schema.CopySet(newOwners)
+	owners.Add(callerId)
+	return owners, true
+}
@@ -0,0 +1,80 @@
+package tf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestOwnersRetainingCaller(t *testing.T) {
+	const caller = "00000000-0000-0000-0000-000000000001"
+	const other = "00000000-0000-0000-0000-000000000002"
+
+	set := func(v ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashString, v)
+	}
+
+	cases := []struct {
+		name             string
+		callerId         string
+		oldOwners        *schema.Set
+		newOwners        *schema.Set
+		expectedOwners   *schema.Set
+		expectedRetained bool
+	}{
+		{
+			name:             "caller removed",
+			callerId:         caller,
+			oldOwners:        set(caller, other),
+			newOwners:        set(other),
+			expectedOwners:   set(caller, other),
+			expectedRetained: true,
+		},
+		{
+			name:             "all owners removed",
+			callerId:         caller,
+			oldOwners:        set(caller),
+			newOwners:        set(),
+			expectedOwners:   set(caller),
+			expectedRetained: true,
+		},
+		{
+			name:           "caller retained in config",
+			callerId:       caller,
+			oldOwners:      set(caller),
+			newOwners:      set(caller, other),
+			expectedOwners: set(caller, other),
+		},
+		{
+			name:           "caller not previously an owner",
+			callerId:       caller,
+			oldOwners:      set(other),
+			newOwners:      set(),
+			expectedOwners: set(),
+		},
+		{
+			name:           "caller unknown",
+			callerId:       "",
+			oldOwners:      set(caller),
+			newOwners:      set(other),
+			expectedOwners: set(other),
+		},
+	}
+
+	for _, tc := range cases {
+		owners, retained := OwnersRetainingCaller(tc.callerId, tc.oldOwners, tc.newOwners)
+		if retained != tc.expectedRetained {
+			t.Errorf("%s: expected retained to be %t, got %t", tc.name, tc.expectedRetained, retained)
+		}
+		if !owners.Equal(tc.expectedOwners) {
+			t.Errorf("%s: expected owners %v, got %v", tc.name, tc.expectedOwners.List(), owners.List())
+		}
+	}
+
+	// the new owners must not be modified
+	newOwners := set(other)
+	OwnersRetainingCaller(caller, set(caller), newOwners)
+	if newOwners.Len() != 1 {
+		t.Errorf("expected new owners to be unmodified, got %v", newOwners.List())
+	}
+}
@@ -0,0 +1,42 @@
+package tf
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SuppressCaseDiff suppresses diffs for values which differ only in case, such as user principal names
+// which may be normalised by the API on write
+func SuppressCaseDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// SuppressURIDiff suppresses diffs for URIs which differ only in the case of their scheme or host, or by the presence
+// of a trailing slash when they have no path. The path, query and fragment of a URI are compared exactly, since these
+// are case sensitive and a trailing slash on a path is significant.
+func SuppressURIDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return NormalizeURI(old) == NormalizeURI(new)
+}
+
+// HashURI is a set hash function for URIs, consistent with SuppressURIDiff, so that set elements which differ only in
+// the case of their scheme or host, or by the presence of a trailing slash when they have no path, are considered equal
+func HashURI(v interface{}) int {
+	return schema.HashString(NormalizeURI(v.(string)))
+}
+
+// NormalizeURI returns the provided URI with its scheme and host in lower case, and with the trailing slash removed
+// when it has no path. URIs which cannot be parsed are returned unchanged.
+func NormalizeURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Opaque != "" {
+		return uri
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if u.Path == "/" && u.RawQuery == "" && u.Fragment == "" {
+		u.Path = ""
+	}
+	return u.String()
+}
@@ -0,0 +1,60 @@
+package tf
+
+import "testing"
+
+func TestSuppressCaseDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"user@example.com", "user@example.com", true},
+		{"User@Example.com", "user@example.com", true},
+		{"user@example.com", "other@example.com", false},
+		{"", "user@example.com", false},
+	}
+
+	for _, tc := range cases {
+		if got := SuppressCaseDiff("", tc.old, tc.new, nil); got != tc.suppress {
+			t.Errorf("SuppressCaseDiff(%q, %q): expected %t, got %t", tc.old, tc.new, tc.suppress, got)
+		}
+	}
+}
+
+func TestSuppressURIDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"https://example.com/app", "https://example.com/app", true},
+		{"https://Example.COM/app", "HTTPS://example.com/app", true},
+		{"https://example.com/", "https://example.com", true},
+		{"api://my-app", "API://My-App/", true},
+		{"https://example.com/app/", "https://example.com/app", false},
+		{"https://example.com/App", "https://example.com/app", false},
+		{"https://example.com/app?a=B", "https://example.com/app?a=b", false},
+		{"https://example.com/app", "https://example.com/app2", false},
+		{"https://example.com/app", "http://example.com/app", false},
+		{"", "https://example.com", false},
+	}
+
+	for _, tc := range cases {
+		if got := SuppressURIDiff("", tc.old, tc.new, nil); got != tc.suppress {
+			t.Errorf("SuppressURIDiff(%q, %q): expected %t, got %t", tc.old, tc.new, tc.suppress, got)
+		}
+	}
+}
+
+func TestHashURI(t *testing.T) {
+	if HashURI("https://Example.com/cb") != HashURI("https://example.com/cb") {
+		t.Errorf("HashURI: expected URIs differing only in host case to have the same hash")
+	}
+	if HashURI("HTTPS://example.com/") != HashURI("https://example.com") {
+		t.Errorf("HashURI: expected URIs differing only in scheme case and a bare trailing slash to have the same hash")
+	}
+	if HashURI("https://example.com/Cb") == HashURI("https://example.com/cb") {
+		t.Errorf("HashURI: expected URIs differing in path case to have different hashes")
+	}
+	if HashURI("https://example.com/cb/") == HashURI("https://example.com/cb") {
+		t.Errorf("HashURI: expected URIs differing by a trailing slash on the path to have different hashes")
+	}
+}
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// CertificateFromPKCS12 extracts the public certificate from a PKCS#12 (PFX) bundle and returns it DER encoded. Where
+// the bundle contains a certificate chain, the certificate matching the bundled private key is returned, or the first
+// certificate when no private key is present.
+func CertificateFromPKCS12(pfxData []byte, password string) ([]byte, error) {
+	blocks, err := pkcs12.ToPEM(pfxData, password)
+	if err != nil {
+		return nil, fmt.Errorf("decoding PKCS#12 data: %v", err)
+	}
+
+	var keyId string
+	for _, block := range blocks {
+		if block.Type == "PRIVATE KEY" {
+			keyId = block.Headers["localKeyId"]
+			break
+		}
+	}
+
+	var cert *pem.Block
+	for _, block := range blocks {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if keyId != "" && block.Headers["localKeyId"] == keyId {
+			cert = block
+			break
+		}
+		if cert == nil {
+			cert = block
+		}
+	}
+
+	if cert == nil {
+		return nil, fmt.Errorf("no certificate was found in the PKCS#12 data")
+	}
+
+	return cert.Bytes, nil
+}
+
+// CertificateThumbprint returns the SHA-1 thumbprint of a DER encoded certificate as an uppercase hexadecimal string.
+func CertificateThumbprint(der []byte) string {
+	sum := sha1.Sum(der)
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// CertificateThumbprintFromEncodedPEM returns the thumbprint of a base64 encoded PEM certificate, which is how the
+// certificate value of a key credential is sent to the API. An empty string is returned when the value does not
+// contain a certificate.
+func CertificateThumbprintFromEncodedPEM(v string) string {
+	data, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return ""
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return ""
+	}
+	return CertificateThumbprint(block.Bytes)
+}
+
+// CertificateThumbprintFromCustomKeyIdentifier returns the thumbprint held in the base64 encoded custom key identifier
+// of a certificate key credential. An empty string is returned when the identifier does not contain a thumbprint.
+func CertificateThumbprintFromCustomKeyIdentifier(v *string) string {
+	if v == nil {
+		return ""
+	}
+	data, err := base64.StdEncoding.DecodeString(*v)
+	if err != nil || len(data) != sha1.Size {
+		return ""
+	}
+	return strings.ToUpper(hex.EncodeToString(data))
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+)
+
+// openssl req -subj '/CN=hashicorptest/O=HashiCorp, Inc./ST=CA/C=US' -new -newkey rsa:1024 -sha256 -days 3650 -nodes -x509 -keyout p.key -out p.crt
+// openssl pkcs12 -export -in p.crt -inkey p.key -out p.pfx -passout pass:hashicorp -keypbe PBE-SHA1-3DES -certpbe PBE-SHA1-3DES -macalg sha1
+const testPkcs12Password = "hashicorp"
+
+const testPkcs12 = "MIIGcQIBAzCCBjcGCSqGSIb3DQEHAaCCBigEggYkMIIGIDCCAx8GCSqGSIb3DQEHBqCCAxAwggMMAgEAMIIDBQYJKoZIhvcNAQcBMBwGCiqGSIb3DQEMAQMwDgQIkd/ZUrGulAACAggAgIIC2HsvXXU524zUVd1+aUg55tkJMhvZmbv2uGb7K0E8wo14Bu0zxJGjmIwtBU2LvtE1dLgyxv9n8xpCo6rGeoho3S+N0MXJrlkmzIhm3sbbhc6XJiAOXSkt2MzjInjgHneVAosabQ6xuSEnognnpBoK9kvOAUkje47l0NZFiBMhHTbf2OoG8bUR5E8R0FLYPLJqOGhosDwGx9E3VZXW2fHXDO4GqaXUR6a2vtz7Qg24xBaC8wX87lN5xuznITR2DsVTIVStN/9Zgwo3+d744Kk9DFqdB0He+RMCg4KB11pLTktro6W+mpzrgwxVbu93KNpJ1JTPTP8o2EPO+um3j4rvHl5F6msLp4WZoSHzqA7ZOXjzUQ4/0DlDwiDahV1uy+KLMc/piJ5hJCoZT6EpVglL8xlzYpmucnRiJCAwolvyhvTvVCQW91A50myDPFUT09uoxYy4ZtEPUNIfvu9UgVUb3ign7HOsUcoJIENDHCWQ1GRG6bp/eGqP0MyXXAz3cMm1ZpjgU/Hrp20FFNo7DsHMj7EZWaL2f5LLANBnNfxFWuoLz+E6O5t02gMm6h4GsICH7lapKwY52mkMv7/JT4xORzSyDR2vcr16nqTYuAAorx3TxwN0sFiGF4gUTMEo+3radSJdmDktAqfIAlDhp/7XP0Nu2BLAHhd6wLMGsvMiVSO5DE+VG8zi+gHjUIqvgbGeYzrQ3Y4EfZDdKdbw47p5/BKF180nbUtPx43wyb3Lwr5nx40x1qY48Xocu7o8S06h9v97gFf7X+jA7MhiSX9a3zJBiogdkv6Lqy0bhJaKmkwNargDPem3SGSX8fon9qnTCHu2Z+rBm0wUMxd5g8PmM+qDRCxw6qBAnKGUUY3tzf62pE/Hv681l6oSKJ3JyWhiTnjFpdT5ZQcXCbeGYoctMEXxeVd7r2646RKZZEyLGaWkK3uhQEv6KKea0YuQBvhVeTSyapI7kj9vMIIC+QYJKoZIhvcNAQcBoIIC6gSCAuYwggLiMIIC3gYLKoZIhvcNAQwKAQKgggKmMIICojAcBgoqhkiG9w0BDAEDMA4ECKkSDrSDuM2dAgIIAASCAoDwxPhziYHj4KSEIKXzC6xXw6+W06AJDsmgVpKrrKw4YKJ4o2YXfOtWas+OXDMjWx1aPQXg/EDCC4gxmrLuX5CwNS15osMcz/AY79804fB0UmFKH9ALJkn0jM+ew7N168+6MY28d0KuSTA5qFJKOqtgAW4ZNgdTAA9gI4bjeKEf1KeC2bwOKXXYhKo8b4s3n4A2N3HpalTOxGxOVrVo7taa1M5ZvTk1JHt7AhFmmp8YR8VSC2DbaBfy1Ib0E9SdHx4DSaYBAysGmDEG+aFBOwFtUttRl3xqYs0BSj71YsvomKcuFLTkQQEVgLWBfdowQOqS+Ngg+KFVAhWt21Nq7xArpdI0gbKg8JhNL43jkylOns/17ityMeGB/4swYmSD9ZW7Vouda3PRBsZssRQAQaVZ9QN2pzPR6UC7BmWtso+3sId2ba5OOM/qDPWJ6uVt6BQex95Pg7SicLEvuA/MlK8ix7OXFudHSve5mulvOMWefDbXBNS0tic/vxHrSXIK59AGzitAXQSP9Jd9ZYBjHqeVujAB6S+zN58JT7rPuoRHjU5wmCN4WIo/FdpdYcPP9bmEtt39ObK0jkMP5p+/wOh+yTJYOq3DIZbBRizvaT3FbQRR/alp5tOl0+P+gIyjlLahoywQ+ji4yZDH9f7bHycEgFafrJhZJ99PQD1/ZVQaRiivTmR+S4cxTzr00nEi1IpiZvcXrFBOpTTT+eInMrwLymQa0eXur3XNAgN842400mthefckC+aIS7hYBKeofDCXYyRnU58VDLSO15VlFgvkzP3HUOCggokpxeisyy3qGUse7YXVCdSmO2UJsPi8YeQOFEVAAfqWYaONEdhGfwlOMSUwIwYJKoZIhvcNAQkVMRYEFHmKMWPdlgqLY6FAHs7PpojrPS+hMDEwITAJBgUrDgMCGgUABBSsXWYoSzyBpoW85dHtkyDrDdulTAQIE7J63OeZKHQCAggA"
+
+func TestCertificateFromPKCS12(t *testing.T) {
+	pfx, err := base64.StdEncoding.DecodeString(testPkcs12)
+	if err != nil {
+		t.Fatalf("decoding test data: %v", err)
+	}
+
+	der, err := CertificateFromPKCS12(pfx, testPkcs12Password)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing returned certificate: %v", err)
+	}
+	if cert.Subject.CommonName != "hashicorptest" {
+		t.Fatalf("expected certificate with common name %q, got %q", "hashicorptest", cert.Subject.CommonName)
+	}
+}
+
+func TestCertificateFromPKCS12_incorrectPassword(t *testing.T) {
+	pfx, err := base64.StdEncoding.DecodeString(testPkcs12)
+	if err != nil {
+		t.Fatalf("decoding test data: %v", err)
+	}
+
+	if _, err := CertificateFromPKCS12(pfx, "incorrect"); err == nil {
+		t.Fatalf("expected an error for an incorrect password")
+	}
+}
+
+func TestCertificateThumbprint(t *testing.T) {
+	pfx, err := base64.StdEncoding.DecodeString(testPkcs12)
+	if err != nil {
+		t.Fatalf("decoding test data: %v", err)
+	}
+
+	der, err := CertificateFromPKCS12(pfx, testPkcs12Password)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	thumbprint := CertificateThumbprint(der)
+	if len(thumbprint) != 40 {
+		t.Fatalf("expected a 40 character thumbprint, got %q", thumbprint)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	if v := CertificateThumbprintFromEncodedPEM(encoded); v != thumbprint {
+		t.Fatalf("expected thumbprint %q from encoded PEM, got %q", thumbprint, v)
+	}
+	if v := CertificateThumbprintFromEncodedPEM(base64.StdEncoding.EncodeToString([]byte("symmetric"))); v != "" {
+		t.Fatalf("expected no thumbprint for a non-certificate value, got %q", v)
+	}
+}
+
+func TestCertificateThumbprintFromCustomKeyIdentifier(t *testing.T) {
+	testCases := []struct {
+		input    *string
+		expected string
+	}{
+		{nil, ""},
+		{String("not base64!"), ""},
+		{String(base64.StdEncoding.EncodeToString([]byte("too short"))), ""},
+		{String("Dx0EVwh8G4AXdvyzZbZDgQmPIF0="), "0F1D0457087C1B801776FCB365B64381098F205D"},
+	}
+
+	for _, test := range testCases {
+		if actual := CertificateThumbprintFromCustomKeyIdentifier(test.input); actual != test.expected {
+			t.Fatalf("expected %q, got %q", test.expected, actual)
+		}
+	}
+}
@@ -14,3 +14,18 @@ func Difference(a, b []string) []string {
 	}
 	return diff
 }
+
+// Intersection returns the elements in `a` that are also in `b`.
+func Intersection(a, b []string) []string {
+	mb := make(map[string]struct{}, len(b))
+	for _, x := range b {
+		mb[x] = struct{}{}
+	}
+	var intersection []string
+	for _, x := range a {
+		if _, found := mb[x]; found {
+			intersection = append(intersection, x)
+		}
+	}
+	return intersection
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDifference(t *testing.T) {
+	testCases := []struct {
+		a, b     []string
+		expected []string
+	}{
+		{nil, nil, nil},
+		{[]string{"a", "b"}, nil, []string{"a", "b"}},
+		{[]string{"a", "b", "c"}, []string{"b"}, []string{"a", "c"}},
+		{[]string{"a"}, []string{"a", "b"}, nil},
+	}
+
+	for _, test := range testCases {
+		if v := Difference(test.a, test.b); !reflect.DeepEqual(v, test.expected) {
+			t.Fatalf("Difference(%v, %v): expected %v, got %v", test.a, test.b, test.expected, v)
+		}
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	testCases := []struct {
+		a, b     []string
+		expected []string
+	}{
+		{nil, nil, nil},
+		{[]string{"a", "b"}, nil, nil},
+		{[]string{"a", "b", "c"}, []string{"c", "a", "d"}, []string{"a", "c"}},
+		{[]string{"a"}, []string{"b"}, nil},
+	}
+
+	for _, test := range testCases {
+		if v := Intersection(test.a, test.b); !reflect.DeepEqual(v, test.expected) {
+			t.Fatalf("Intersection(%v, %v): expected %v, got %v", test.a, test.b, test.expected, v)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+var iso8601DurationRegExp = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// RelativeStartTime returns the current time offset by the duration `d`. When `d` spans one day or more, the result is
+// truncated to midnight UTC so that credentials created on the same day share the same start date.
+func RelativeStartTime(d time.Duration) time.Time {
+	return relativeStartTime(time.Now(), d)
+}
+
+// RelativeEndTime returns the current time offset by the duration `d`. When `d` spans one day or more, the result is
+// rounded up to the following midnight UTC so that credentials created on the same day share the same end date.
+func RelativeEndTime(d time.Duration) time.Time {
+	return relativeEndTime(time.Now(), d)
+}
+
+func relativeStartTime(now time.Time, d time.Duration) time.Time {
+	t := now.Add(d).UTC()
+	if d < day && d > -day {
+		return t
+	}
+	return t.Truncate(day)
+}
+
+func relativeEndTime(now time.Time, d time.Duration) time.Time {
+	t := now.Add(d).UTC()
+	if d < day && d > -day {
+		return t
+	}
+	if r := t.Truncate(day); !r.Equal(t) {
+		return r.Add(day)
+	}
+	return t
+}
+
+// ApproximateISO8601Duration converts an ISO 8601 duration such as `P90D` to a time.Duration. Since the duration is
+// not anchored to a date, years and months are approximated as 365 and 30 days respectively.
+func ApproximateISO8601Duration(v string) (time.Duration, error) {
+	m := iso8601DurationRegExp.FindStringSubmatch(v)
+	if m == nil || v == "P" || v[len(v)-1] == 'T' {
+		return 0, fmt.Errorf("%q is not a valid ISO 8601 duration", v)
+	}
+
+	units := []time.Duration{365 * day, 30 * day, 7 * day, day, time.Hour, time.Minute}
+	var result time.Duration
+	for i, unit := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(m[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing %q: %v", v, err)
+		}
+		result += time.Duration(n) * unit
+	}
+	if m[7] != "" {
+		seconds, err := strconv.ParseFloat(m[7], 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing %q: %v", v, err)
+		}
+		result += time.Duration(seconds * float64(time.Second))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeStartTime(t *testing.T) {
+	now := time.Date(2021, 6, 15, 13, 45, 30, 0, time.UTC)
+
+	testCases := []struct {
+		duration time.Duration
+		expected time.Time
+	}{
+		{0, now},
+		{time.Hour, time.Date(2021, 6, 15, 14, 45, 30, 0, time.UTC)},
+		{24 * time.Hour, time.Date(2021, 6, 16, 0, 0, 0, 0, time.UTC)},
+		{240 * time.Hour, time.Date(2021, 6, 25, 0, 0, 0, 0, time.UTC)},
+		{-48 * time.Hour, time.Date(2021, 6, 13, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range testCases {
+		if actual := relativeStartTime(now, test.duration); !actual.Equal(test.expected) {
+			t.Fatalf("relativeStartTime(%s): expected %s, got %s", test.duration, test.expected, actual)
+		}
+	}
+}
+
+func TestRelativeEndTime(t *testing.T) {
+	now := time.Date(2021, 6, 15, 13, 45, 30, 0, time.UTC)
+
+	testCases := []struct {
+		now      time.Time
+		duration time.Duration
+		expected time.Time
+	}{
+		{now, time.Hour, time.Date(2021, 6, 15, 14, 45, 30, 0, time.UTC)},
+		{now, 24 * time.Hour, time.Date(2021, 6, 17, 0, 0, 0, 0, time.UTC)},
+		{now, 17520 * time.Hour, time.Date(2023, 6, 16, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2021, 6, 15, 0, 0, 0, 0, time.UTC), 48 * time.Hour, time.Date(2021, 6, 17, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2021, 6, 15, 2, 0, 0, 0, time.FixedZone("CEST", 7200)), 24 * time.Hour, time.Date(2021, 6, 16, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range testCases {
+		if actual := relativeEndTime(test.now, test.duration); !actual.Equal(test.expected) {
+			t.Fatalf("relativeEndTime(%s, %s): expected %s, got %s", test.now, test.duration, test.expected, actual)
+		}
+	}
+}
+
+func TestApproximateISO8601Duration(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected time.Duration
+		error    bool
+	}{
+		{"P90D", 90 * day, false},
+		{"P1Y", 365 * day, false},
+		{"P2M1W", 67 * day, false},
+		{"PT12H30M", 12*time.Hour + 30*time.Minute, false},
+		{"P1DT1.5S", day + 1500*time.Millisecond, false},
+		{"P", 0, true},
+		{"P1DT", 0, true},
+		{"90D", 0, true},
+		{"", 0, true},
+	}
+
+	for _, test := range testCases {
+		actual, err := ApproximateISO8601Duration(test.input)
+		if test.error {
+			if err == nil {
+				t.Fatalf("ApproximateISO8601Duration(%q): expected error, got %s", test.input, actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ApproximateISO8601Duration(%q): unexpected error: %v", test.input, err)
+		}
+		if actual != test.expected {
+			t.Fatalf("ApproximateISO8601Duration(%q): expected %s, got %s", test.input, test.expected, actual)
+		}
+	}
+}
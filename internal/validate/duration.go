@@ -0,0 +1,32 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var ISO8601DurationRegExp = regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H)?(\d+M)?(\d+(\.\d+)?S)?)?$`)
+
+func ISO8601Duration(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if v == "P" || v == "PT" || len(v) > 0 && v[len(v)-1] == 'T' || !ISO8601DurationRegExp.MatchString(v) {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Value must be a valid ISO 8601 duration, e.g. P90D or PT12H",
+			AttributePath: path,
+		})
+	}
+
+	return
+}
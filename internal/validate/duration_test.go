@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestISO8601Duration(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Errors int
+	}{
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "P",
+			Errors: 1,
+		},
+		{
+			Input:  "PT",
+			Errors: 1,
+		},
+		{
+			Input:  "P1DT",
+			Errors: 1,
+		},
+		{
+			Input:  "90 days",
+			Errors: 1,
+		},
+		{
+			Input:  "P90D",
+			Errors: 0,
+		},
+		{
+			Input:  "P1Y2M",
+			Errors: 0,
+		},
+		{
+			Input:  "PT12H30M",
+			Errors: 0,
+		},
+		{
+			Input:  "P1DT1.5S",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			diags := ISO8601Duration(tc.Input, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected ISO8601Duration to have %d not %d errors for %q", tc.Errors, len(diags), tc.Input)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/manicminer/hamilton
+
+go 1.16
+
+require (
+	github.com/golang/protobuf v1.4.3 // indirect
+	github.com/hashicorp/go-uuid v1.0.2
+	github.com/hashicorp/go-version v1.2.1
+	golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2
+	golang.org/x/net v0.0.0-20201209123823-ac852fbbde11 // indirect
+	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
+	google.golang.org/appengine v1.6.7 // indirect
+)